@@ -46,6 +46,109 @@ type ComponentDeploymentSpec struct {
 	// These values override or add to the configurations defined in the workload.yaml
 	// +optional
 	ConfigurationOverrides *EnvConfigurationOverrides `json:"configurationOverrides,omitempty"`
+
+	// Rollout configures progressive delivery of workload changes in this environment.
+	// When unset, new revisions replace the workload in a single step.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+}
+
+// RolloutStrategyType is the progressive delivery strategy used for a ComponentDeployment.
+// +kubebuilder:validation:Enum=Canary;BlueGreen
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyCanary shifts traffic to the new revision in weighted steps.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+	// RolloutStrategyBlueGreen runs the new revision behind a preview service and switches all traffic at once.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutStrategy defines how a new workload revision is progressively rolled out.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Canary' || has(self.canary)",message="canary is required when type is Canary"
+type RolloutStrategy struct {
+	// Type is the rollout strategy
+	// +kubebuilder:validation:Required
+	Type RolloutStrategyType `json:"type"`
+
+	// Canary configures the canary steps. Required when type is Canary.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+
+	// BlueGreen configures the blue-green switch.
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+
+	// Analysis configures automated metric analysis of the new revision.
+	// A failed analysis aborts the rollout and restores the stable revision.
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// CanaryStrategy defines the traffic steps of a canary rollout.
+type CanaryStrategy struct {
+	// Steps are executed in order. After the last step the new revision becomes stable.
+	// +kubebuilder:validation:MinItems=1
+	Steps []CanaryStep `json:"steps"`
+}
+
+// CanaryStep defines a single traffic shift in a canary rollout.
+type CanaryStep struct {
+	// Weight is the percentage of traffic routed to the canary revision
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long to stay at this step once the canary is healthy before moving on
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// BlueGreenStrategy defines how traffic is switched in a blue-green rollout.
+type BlueGreenStrategy struct {
+	// AutoPromotionDelay is how long the preview revision must stay healthy before traffic is switched.
+	// When unset, the switch happens only when the openchoreo.dev/rollout-promote annotation
+	// is set to the preview revision.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	AutoPromotionDelay *metav1.Duration `json:"autoPromotionDelay,omitempty"`
+}
+
+// RolloutAnalysis defines the metric thresholds a new revision must meet during a rollout.
+// Metrics are queried from the observer configured on the environment's DataPlane.
+type RolloutAnalysis struct {
+	// Interval between analysis runs. Defaults to 1m.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// MaxErrorRatePercent is the highest acceptable percentage of failed requests
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxErrorRatePercent *int32 `json:"maxErrorRatePercent,omitempty"`
+
+	// MaxLatencyP99 is the highest acceptable 99th percentile request latency
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	MaxLatencyP99 *metav1.Duration `json:"maxLatencyP99,omitempty"`
+
+	// FailureLimit is the number of failed analysis runs tolerated before the rollout is aborted
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailureLimit int32 `json:"failureLimit,omitempty"`
+
+	// ConsecutiveErrorLimit is the number of consecutive analysis runs whose metrics could not be queried
+	// tolerated before the rollout is aborted. The rollout is held at its current step while they are retried.
+	// Defaults to 4.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ConsecutiveErrorLimit *int32 `json:"consecutiveErrorLimit,omitempty"`
 }
 
 // ComponentDeploymentOwner identifies the component this ComponentDeployment applies to
@@ -70,6 +173,82 @@ type ComponentDeploymentStatus struct {
 	// Conditions represent the latest available observations of the ComponentDeployment's state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Rollout reports the progress of the current progressive rollout
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// RolloutPhase is the phase of a progressive rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseHealthy indicates the stable revision is serving all traffic and no rollout is in progress.
+	RolloutPhaseHealthy RolloutPhase = "Healthy"
+	// RolloutPhaseProgressing indicates a new revision is being rolled out.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused indicates the rollout is waiting for a pause to elapse or for manual promotion.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhaseSwitching indicates a blue-green rollout has switched traffic and is replacing the stable workload.
+	RolloutPhaseSwitching RolloutPhase = "Switching"
+	// RolloutPhaseAborted indicates the rollout failed and the stable revision was restored.
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+)
+
+// RolloutStatus reports the state of a progressive rollout.
+type RolloutStatus struct {
+	// Phase is the current phase of the rollout
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty"`
+
+	// StableRevision is the workload revision serving production traffic
+	// +optional
+	StableRevision string `json:"stableRevision,omitempty"`
+
+	// StableWorkload is the workload of the stable revision, recorded when a blue-green rollout switches
+	// the stable workload to the new revision so that an abort can restore it
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	StableWorkload *runtime.RawExtension `json:"stableWorkload,omitempty"`
+
+	// CurrentRevision is the workload revision being rolled out
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// AbortedRevision is the most recent revision whose rollout was aborted.
+	// It is not retried until a different revision is rendered.
+	// +optional
+	AbortedRevision string `json:"abortedRevision,omitempty"`
+
+	// CurrentStep is the index of the active canary step
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CurrentWeight is the percentage of traffic routed to the new revision
+	// +optional
+	CurrentWeight int32 `json:"currentWeight,omitempty"`
+
+	// StepStartedAt is when the current step (or blue-green preview) became healthy
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// AnalysisFailures is the number of failed analysis runs for the current revision
+	// +optional
+	AnalysisFailures int32 `json:"analysisFailures,omitempty"`
+
+	// AnalysisErrors is the number of consecutive analysis runs for the current revision whose metrics
+	// could not be queried
+	// +optional
+	AnalysisErrors int32 `json:"analysisErrors,omitempty"`
+
+	// LastAnalysisTime is when the metrics of the current revision were last analyzed
+	// +optional
+	LastAnalysisTime *metav1.Time `json:"lastAnalysisTime,omitempty"`
+
+	// Message is a human readable description of the rollout state
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
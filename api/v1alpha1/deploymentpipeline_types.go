@@ -20,6 +20,19 @@ type TargetEnvironmentRef struct {
 	// IsManualApprovalRequired indicates if manual approval is needed for promotion
 	// +optional
 	IsManualApprovalRequired bool `json:"isManualApprovalRequired,omitempty"`
+	// AutoPromote enables automatic promotion to this environment once a new workload
+	// is healthy in the source environment. Promotions to environments that require approval
+	// are not performed automatically.
+	// +optional
+	AutoPromote *AutoPromotePolicy `json:"autoPromote,omitempty"`
+}
+
+// AutoPromotePolicy defines when a component is promoted automatically along a promotion path
+type AutoPromotePolicy struct {
+	// HealthyFor is how long the new workload must stay ready in the source environment
+	// before it is promoted. Promotes as soon as the workload is ready if not set.
+	// +optional
+	HealthyFor *metav1.Duration `json:"healthyFor,omitempty"`
 }
 
 // PromotionPath defines a path for promoting between environments
@@ -48,6 +61,35 @@ type DeploymentPipelineStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Conditions represent the latest available observations of an object's state
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// PendingPromotions lists the automatic promotions that are waiting to be performed
+	// +optional
+	PendingPromotions []PendingPromotion `json:"pendingPromotions,omitempty"`
+}
+
+// PendingPromotion describes an automatic promotion that has not been performed yet
+type PendingPromotion struct {
+	// ProjectName is the project of the component
+	ProjectName string `json:"projectName"`
+	// ComponentName is the component being promoted
+	ComponentName string `json:"componentName"`
+	// SourceEnvironment is the environment the workload is promoted from
+	SourceEnvironment string `json:"sourceEnvironment"`
+	// TargetEnvironment is the environment the workload is promoted to
+	TargetEnvironment string `json:"targetEnvironment"`
+	// Revision identifies the workload in the source environment
+	Revision string `json:"revision"`
+	// RevisionObservedAt is when the revision was first observed in the source environment
+	// +optional
+	RevisionObservedAt *metav1.Time `json:"revisionObservedAt,omitempty"`
+	// HealthySince is when the revision became ready in the source environment. Readiness reported before the
+	// revision was observed belongs to a previous revision and is not counted.
+	// +optional
+	HealthySince *metav1.Time `json:"healthySince,omitempty"`
+	// Reason is a machine-readable reason why the promotion is pending
+	Reason string `json:"reason"`
+	// Message is a human-readable description of why the promotion is pending
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,6 +19,51 @@ type EnvironmentSpec struct {
 	DataPlaneRef string        `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewayConfig `json:"gateway,omitempty"`
+
+	// FreezeWindows define periods during which promotions, binding updates and
+	// release applies targeting this environment are refused unless overridden.
+	// +optional
+	FreezeWindows []FreezeWindow `json:"freezeWindows,omitempty"`
+}
+
+// FreezeWindow defines a period during which deployments to an environment are blocked.
+// A window is either recurring (schedule + duration) or absolute (start + end).
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) != has(self.start)",message="exactly one of schedule or start must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.schedule) || has(self.duration)",message="duration is required when schedule is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.start) || has(self.end)",message="end is required when start is set"
+type FreezeWindow struct {
+	// Name identifies the freeze window within the environment
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Reason explains why deployments are frozen during this window
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Schedule is a standard 5-field cron expression marking the start of a recurring window
+	// (e.g., "0 18 * * 5" for every Friday at 18:00).
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is the length of each recurring window started by the schedule
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// TimeZone is the IANA time zone used to evaluate the schedule. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Start is the beginning of an absolute freeze window
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end of an absolute freeze window
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -27,6 +72,41 @@ type EnvironmentStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// FreezeOverrides is the audit trail of deployments that were allowed during an active freeze window.
+	// Only the most recent entries are retained.
+	// +optional
+	FreezeOverrides []FreezeOverrideRecord `json:"freezeOverrides,omitempty"`
+}
+
+// FreezeOverrideRecord records a deployment that bypassed an active freeze window.
+type FreezeOverrideRecord struct {
+	// Window is the name of the freeze window that was overridden
+	Window string `json:"window"`
+
+	// Justification is the reason given for the override
+	Justification string `json:"justification"`
+
+	// Action is the operation that was performed (e.g., Promote, UpdateBinding)
+	Action string `json:"action"`
+
+	// Target identifies the resource that was changed (e.g., ServiceBinding/my-service-production)
+	Target string `json:"target"`
+
+	// Project is the project of the component that was deployed
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Component is the component that was deployed
+	// +optional
+	Component string `json:"component,omitempty"`
+
+	// Principal is the authenticated user who requested the override
+	// +optional
+	Principal string `json:"principal,omitempty"`
+
+	// Timestamp is the time the override was recorded
+	Timestamp metav1.Time `json:"timestamp"`
 }
 
 // +kubebuilder:object:root=true
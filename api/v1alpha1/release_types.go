@@ -50,6 +50,11 @@ type ReleaseStatus struct {
 	// Conditions represent the latest available observations of the Release's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// AppliedGeneration is the generation of the spec last applied to the data plane.
+	// Changes of the spec after it are held while the environment is in a freeze window.
+	// +optional
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,213 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReleaseTrainSpec defines the desired state of ReleaseTrain.
+type ReleaseTrainSpec struct {
+	// Owner identifies the project this release train belongs to
+	// +kubebuilder:validation:Required
+	Owner ReleaseTrainOwner `json:"owner"`
+
+	// SourceEnvironment is the environment the component snapshots are taken from.
+	// The snapshots are pinned when the release train is first reconciled.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceEnvironment is immutable"
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment is the environment the release train is promoted to.
+	// Changing it promotes all components of the train together along the project's DeploymentPipeline.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// Components are the components shipped together by this release train
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="components are immutable"
+	Components []ReleaseTrainComponent `json:"components"`
+
+	// HealthTimeout is how long to wait for all components to become healthy in the target environment
+	// before the release train is rolled back. Defaults to 10 minutes.
+	// +optional
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty"`
+}
+
+// ReleaseTrainOwner identifies the project a release train belongs to
+type ReleaseTrainOwner struct {
+	// ProjectName is the name of the project
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+}
+
+// ReleaseTrainComponent is a component shipped by a release train
+type ReleaseTrainComponent struct {
+	// Name is the name of the component. The component must be defined with a ComponentType.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ReleaseTrainPhase is the phase of the current promotion of a release train
+type ReleaseTrainPhase string
+
+const (
+	// ReleaseTrainPhasePending indicates the promotion has not started yet
+	ReleaseTrainPhasePending ReleaseTrainPhase = "Pending"
+	// ReleaseTrainPhaseVerifying indicates the components have been promoted and their health is being verified
+	ReleaseTrainPhaseVerifying ReleaseTrainPhase = "Verifying"
+	// ReleaseTrainPhaseSucceeded indicates all components are healthy in the target environment
+	ReleaseTrainPhaseSucceeded ReleaseTrainPhase = "Succeeded"
+	// ReleaseTrainPhaseRolledBack indicates a component failed and all components were rolled back
+	ReleaseTrainPhaseRolledBack ReleaseTrainPhase = "RolledBack"
+	// ReleaseTrainPhaseFailed indicates the promotion could not be performed or rolled back
+	ReleaseTrainPhaseFailed ReleaseTrainPhase = "Failed"
+)
+
+// ReleaseTrainStatus defines the observed state of ReleaseTrain.
+type ReleaseTrainStatus struct {
+	// ObservedGeneration reflects the generation of the most recently observed ReleaseTrain
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the ReleaseTrain's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Phase is the phase of the current or last promotion
+	// +optional
+	Phase ReleaseTrainPhase `json:"phase,omitempty"`
+
+	// CurrentEnvironment is the environment the release train was last promoted to successfully
+	// +optional
+	CurrentEnvironment string `json:"currentEnvironment,omitempty"`
+
+	// PromotionEnvironment is the environment of the current or last promotion
+	// +optional
+	PromotionEnvironment string `json:"promotionEnvironment,omitempty"`
+
+	// PromotionGeneration is the generation of the ReleaseTrain the current or last promotion was started for
+	// +optional
+	PromotionGeneration int64 `json:"promotionGeneration,omitempty"`
+
+	// PromotionStartedAt is when the current or last promotion started
+	// +optional
+	PromotionStartedAt *metav1.Time `json:"promotionStartedAt,omitempty"`
+
+	// Components is the status of each component of the release train
+	// +optional
+	Components []ReleaseTrainComponentStatus `json:"components,omitempty"`
+
+	// FreezeOverride allows the next promotion to proceed during an active freeze window of its environment.
+	// It is granted through the API, which authorizes the caller, and is kept in the status so that creating
+	// or editing a ReleaseTrain can't grant it. It is recorded on the environment and cleared once the
+	// components were promoted.
+	// +optional
+	FreezeOverride *ReleaseTrainFreezeOverride `json:"freezeOverride,omitempty"`
+}
+
+// ReleaseTrainFreezeOverride is an override of a freeze window granted for the promotion of a release train
+type ReleaseTrainFreezeOverride struct {
+	// Environment is the environment the train may be promoted to
+	Environment string `json:"environment"`
+
+	// Window is the name of the freeze window that is overridden
+	Window string `json:"window"`
+
+	// Justification is the reason given for the override
+	Justification string `json:"justification"`
+
+	// Principal is the authenticated user who granted the override
+	// +optional
+	Principal string `json:"principal,omitempty"`
+
+	// GrantedAt is when the override was granted
+	GrantedAt metav1.Time `json:"grantedAt"`
+}
+
+// ReleaseTrainComponentStatus is the status of a component of a release train
+type ReleaseTrainComponentStatus struct {
+	// Name is the name of the component
+	Name string `json:"name"`
+
+	// Revision is a short hash identifying the pinned snapshot
+	Revision string `json:"revision"`
+
+	// Snapshot is the pinned ComponentEnvSnapshot spec shipped by the release train
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Snapshot runtime.RawExtension `json:"snapshot"`
+
+	// PreviousSnapshot is the ComponentEnvSnapshot spec the target environment had before the current promotion,
+	// used to roll the component back. Unset if the component was not deployed to the target environment.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PreviousSnapshot *runtime.RawExtension `json:"previousSnapshot,omitempty"`
+
+	// CreatedDeployment indicates the ComponentDeployment of the target environment was created by the promotion
+	// and is removed on rollback
+	// +optional
+	CreatedDeployment bool `json:"createdDeployment,omitempty"`
+
+	// ReleaseGeneration is the generation of the component's Release in the promotion environment before the
+	// current promotion. The health of the component is only read from a later generation once it is applied.
+	// Zero when the component had no Release or the promotion left its snapshot unchanged.
+	// +optional
+	ReleaseGeneration int64 `json:"releaseGeneration,omitempty"`
+
+	// HealthStatus is the health of the component in the promotion environment
+	// +optional
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+
+	// Message describes the state of the component
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=rt;rts
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.owner.projectName`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetEnvironment`
+// +kubebuilder:printcolumn:name="Current",type=string,JSONPath=`.status.currentEnvironment`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ReleaseTrain is the Schema for the releasetrains API.
+// A ReleaseTrain ships pinned snapshots of several components of a project together,
+// promoting them atomically and rolling all of them back if any of them fails.
+type ReleaseTrain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleaseTrainSpec   `json:"spec,omitempty"`
+	Status ReleaseTrainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReleaseTrainList contains a list of ReleaseTrain.
+type ReleaseTrainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReleaseTrain `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReleaseTrain{}, &ReleaseTrainList{})
+}
+
+func (r *ReleaseTrain) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+func (r *ReleaseTrain) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
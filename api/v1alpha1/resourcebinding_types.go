@@ -0,0 +1,78 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceBindingSpec defines the desired state of ResourceBinding.
+type ResourceBindingSpec struct {
+	// Type is the connection type the resource can be consumed with
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=database;messageQueue;objectStorage;external
+	Type string `json:"type"`
+
+	// ResourceName is the name workload connections refer to the resource by, using the "resource" param.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ResourceName string `json:"resourceName"`
+
+	// Environment is the environment this binding provides the resource for
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Environment string `json:"environment"`
+
+	// Properties are the plain values of the connection properties (e.g. host, port)
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// SecretProperties are the connection properties whose values are read from a SecretReference
+	// (e.g. password). They are only injected through Kubernetes secrets.
+	// +optional
+	SecretProperties map[string]ResourceBindingSecretKeyRef `json:"secretProperties,omitempty"`
+}
+
+// ResourceBindingSecretKeyRef selects a key of the secret created from a SecretReference
+type ResourceBindingSecretKeyRef struct {
+	// SecretReference is the name of the SecretReference in the same namespace
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SecretReference string `json:"secretReference"`
+
+	// Key is the secret key of the SecretReference data
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=rb;rbs
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Resource",type=string,JSONPath=`.spec.resourceName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// A ResourceBinding is defined by the platform to provide a resource, such as a database,
+// to the workloads of an environment. Workload connections of the same type that refer to the
+// resource are resolved from its properties.
+type ResourceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ResourceBindingSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceBindingList contains a list of ResourceBinding.
+type ResourceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceBinding{}, &ResourceBindingList{})
+}
@@ -41,8 +41,9 @@ type ScheduledTaskBindingSpec struct {
 
 // ScheduledTaskBindingStatus defines the observed state of ScheduledTaskBinding.
 type ScheduledTaskBindingStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Conditions represent the latest available observations of the ScheduledTaskBinding's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -66,6 +67,16 @@ type ScheduledTaskBindingList struct {
 	Items           []ScheduledTaskBinding `json:"items"`
 }
 
+// GetConditions returns the conditions from the status
+func (b *ScheduledTaskBinding) GetConditions() []metav1.Condition {
+	return b.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (b *ScheduledTaskBinding) SetConditions(conditions []metav1.Condition) {
+	b.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&ScheduledTaskBinding{}, &ScheduledTaskBindingList{})
 }
@@ -79,11 +79,14 @@ type Schema struct {
 	Content string `json:"content,omitempty"`
 }
 
-// WorkloadConnection represents an internal API connection
+// WorkloadConnection represents a connection of the workload to an API of another component
+// or to a resource such as a database, message queue, object storage or external service.
 type WorkloadConnection struct {
-	// Type of connection - only "api" for now
+	// Type of connection. Each type exposes a fixed set of properties that can be injected.
+	// An "api" connection is resolved from the endpoint of another component, while the other
+	// types are resolved from the ResourceBinding of the environment named by the "resource" param.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=api
+	// +kubebuilder:validation:Enum=api;database;messageQueue;objectStorage;external
 	Type string `json:"type"`
 
 	// Parameters for connection configuration (dynamic key-value pairs)
@@ -98,8 +101,12 @@ type WorkloadConnection struct {
 // WorkloadConnectionInject defines how connection details are injected
 type WorkloadConnectionInject struct {
 	// Environment variables to inject
-	// +kubebuilder:validation:Required
-	Env []WorkloadConnectionEnvVar `json:"env"`
+	// +optional
+	Env []WorkloadConnectionEnvVar `json:"env,omitempty"`
+
+	// Files to mount into the workload
+	// +optional
+	Files []WorkloadConnectionFile `json:"files,omitempty"`
 }
 
 // WorkloadConnectionEnvVar defines an environment variable injection
@@ -113,6 +120,18 @@ type WorkloadConnectionEnvVar struct {
 	Value string `json:"value"`
 }
 
+// WorkloadConnectionFile defines a file injection
+type WorkloadConnectionFile struct {
+	// Absolute path the file is mounted at
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^/`
+	MountPath string `json:"mountPath"`
+
+	// Template content using connection properties (e.g., "{{ .password }}")
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+}
+
 // WorkloadTemplateSpec defines the desired state of Workload.
 type WorkloadTemplateSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -158,9 +177,13 @@ const (
 	WorkloadTypeWebApplication WorkloadType = "WebApplication"
 )
 
-// ConnectionTypeAPI represents an API connection type
+// Connection types of a workload connection
 const (
-	ConnectionTypeAPI = "api"
+	ConnectionTypeAPI           = "api"
+	ConnectionTypeDatabase      = "database"
+	ConnectionTypeMessageQueue  = "messageQueue"
+	ConnectionTypeObjectStorage = "objectStorage"
+	ConnectionTypeExternal      = "external"
 )
 
 // WorkloadStatus defines the observed state of Workload.
@@ -503,6 +503,26 @@ func (in *AuthzSpec) DeepCopy() *AuthzSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoPromotePolicy) DeepCopyInto(out *AutoPromotePolicy) {
+	*out = *in
+	if in.HealthyFor != nil {
+		in, out := &in.HealthyFor, &out.HealthyFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoPromotePolicy.
+func (in *AutoPromotePolicy) DeepCopy() *AutoPromotePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoPromotePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendJWTConfig) DeepCopyInto(out *BackendJWTConfig) {
 	*out = *in
@@ -614,6 +634,26 @@ func (in *BasicAuthUser) DeepCopy() *BasicAuthUser {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
+	*out = *in
+	if in.AutoPromotionDelay != nil {
+		in, out := &in.AutoPromotionDelay, &out.AutoPromotionDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStrategy.
+func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Build) DeepCopyInto(out *Build) {
 	*out = *in
@@ -990,6 +1030,48 @@ func (in *CORSPolicySpec) DeepCopy() *CORSPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreakerPolicy) DeepCopyInto(out *CircuitBreakerPolicy) {
 	*out = *in
@@ -1162,6 +1244,11 @@ func (in *ComponentDeploymentSpec) DeepCopyInto(out *ComponentDeploymentSpec) {
 		*out = new(EnvConfigurationOverrides)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDeploymentSpec.
@@ -1184,6 +1271,11 @@ func (in *ComponentDeploymentStatus) DeepCopyInto(out *ComponentDeploymentStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDeploymentStatus.
@@ -2321,6 +2413,13 @@ func (in *DeploymentPipelineStatus) DeepCopyInto(out *DeploymentPipelineStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PendingPromotions != nil {
+		in, out := &in.PendingPromotions, &out.PendingPromotions
+		*out = make([]PendingPromotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineStatus.
@@ -2844,7 +2943,7 @@ func (in *Environment) DeepCopyInto(out *Environment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2922,6 +3021,13 @@ func (in *EnvironmentList) DeepCopyObject() runtime.Object {
 func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
 	*out = *in
 	out.Gateway = in.Gateway
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]FreezeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
@@ -2944,6 +3050,13 @@ func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreezeOverrides != nil {
+		in, out := &in.FreezeOverrides, &out.FreezeOverrides
+		*out = make([]FreezeOverrideRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
@@ -3061,6 +3174,50 @@ func (in *FileVar) DeepCopy() *FileVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeOverrideRecord) DeepCopyInto(out *FreezeOverrideRecord) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeOverrideRecord.
+func (in *FreezeOverrideRecord) DeepCopy() *FreezeOverrideRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeOverrideRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeWindow) DeepCopyInto(out *FreezeWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeWindow.
+func (in *FreezeWindow) DeepCopy() *FreezeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FromBuildRef) DeepCopyInto(out *FromBuildRef) {
 	*out = *in
@@ -3939,6 +4096,29 @@ func (in *PatchTarget) DeepCopy() *PatchTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingPromotion) DeepCopyInto(out *PendingPromotion) {
+	*out = *in
+	if in.RevisionObservedAt != nil {
+		in, out := &in.RevisionObservedAt, &out.RevisionObservedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.HealthySince != nil {
+		in, out := &in.HealthySince, &out.HealthySince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingPromotion.
+func (in *PendingPromotion) DeepCopy() *PendingPromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingPromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	*out = *in
@@ -4126,7 +4306,9 @@ func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
 	if in.TargetEnvironmentRefs != nil {
 		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
 		*out = make([]TargetEnvironmentRef, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -4489,6 +4671,196 @@ func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrain) DeepCopyInto(out *ReleaseTrain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrain.
+func (in *ReleaseTrain) DeepCopy() *ReleaseTrain {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseTrain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainComponent) DeepCopyInto(out *ReleaseTrainComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainComponent.
+func (in *ReleaseTrainComponent) DeepCopy() *ReleaseTrainComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainComponentStatus) DeepCopyInto(out *ReleaseTrainComponentStatus) {
+	*out = *in
+	in.Snapshot.DeepCopyInto(&out.Snapshot)
+	if in.PreviousSnapshot != nil {
+		in, out := &in.PreviousSnapshot, &out.PreviousSnapshot
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainComponentStatus.
+func (in *ReleaseTrainComponentStatus) DeepCopy() *ReleaseTrainComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainFreezeOverride) DeepCopyInto(out *ReleaseTrainFreezeOverride) {
+	*out = *in
+	in.GrantedAt.DeepCopyInto(&out.GrantedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainFreezeOverride.
+func (in *ReleaseTrainFreezeOverride) DeepCopy() *ReleaseTrainFreezeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainFreezeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainList) DeepCopyInto(out *ReleaseTrainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReleaseTrain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainList.
+func (in *ReleaseTrainList) DeepCopy() *ReleaseTrainList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseTrainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainOwner) DeepCopyInto(out *ReleaseTrainOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainOwner.
+func (in *ReleaseTrainOwner) DeepCopy() *ReleaseTrainOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainSpec) DeepCopyInto(out *ReleaseTrainSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ReleaseTrainComponent, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthTimeout != nil {
+		in, out := &in.HealthTimeout, &out.HealthTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainSpec.
+func (in *ReleaseTrainSpec) DeepCopy() *ReleaseTrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainStatus) DeepCopyInto(out *ReleaseTrainStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromotionStartedAt != nil {
+		in, out := &in.PromotionStartedAt, &out.PromotionStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ReleaseTrainComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FreezeOverride != nil {
+		in, out := &in.FreezeOverride, &out.FreezeOverride
+		*out = new(ReleaseTrainFreezeOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainStatus.
+func (in *ReleaseTrainStatus) DeepCopy() *ReleaseTrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
 	*out = *in
@@ -4555,6 +4927,108 @@ func (in *Resource) DeepCopy() *Resource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBinding) DeepCopyInto(out *ResourceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBinding.
+func (in *ResourceBinding) DeepCopy() *ResourceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingList) DeepCopyInto(out *ResourceBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingList.
+func (in *ResourceBindingList) DeepCopy() *ResourceBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingSecretKeyRef) DeepCopyInto(out *ResourceBindingSecretKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSecretKeyRef.
+func (in *ResourceBindingSecretKeyRef) DeepCopy() *ResourceBindingSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretProperties != nil {
+		in, out := &in.SecretProperties, &out.SecretProperties
+		*out = make(map[string]ResourceBindingSecretKeyRef, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
+func (in *ResourceBindingSpec) DeepCopy() *ResourceBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
 	*out = *in
@@ -4659,6 +5133,99 @@ func (in *Revision) DeepCopy() *Revision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxErrorRatePercent != nil {
+		in, out := &in.MaxErrorRatePercent, &out.MaxErrorRatePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLatencyP99 != nil {
+		in, out := &in.MaxLatencyP99, &out.MaxLatencyP99
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConsecutiveErrorLimit != nil {
+		in, out := &in.ConsecutiveErrorLimit, &out.ConsecutiveErrorLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAnalysis.
+func (in *RolloutAnalysis) DeepCopy() *RolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.StableWorkload != nil {
+		in, out := &in.StableWorkload, &out.StableWorkload
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StepStartedAt != nil {
+		in, out := &in.StepStartedAt, &out.StepStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAnalysisTime != nil {
+		in, out := &in.LastAnalysisTime, &out.LastAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S2ZConfig) DeepCopyInto(out *S2ZConfig) {
 	*out = *in
@@ -4742,7 +5309,7 @@ func (in *ScheduledTaskBinding) DeepCopyInto(out *ScheduledTaskBinding) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledTaskBinding.
@@ -4822,6 +5389,13 @@ func (in *ScheduledTaskBindingSpec) DeepCopy() *ScheduledTaskBindingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledTaskBindingStatus) DeepCopyInto(out *ScheduledTaskBindingStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledTaskBindingStatus.
@@ -5730,6 +6304,11 @@ func (in *TargetArtifact) DeepCopy() *TargetArtifact {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetEnvironmentRef) DeepCopyInto(out *TargetEnvironmentRef) {
 	*out = *in
+	if in.AutoPromote != nil {
+		in, out := &in.AutoPromote, &out.AutoPromote
+		*out = new(AutoPromotePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetEnvironmentRef.
@@ -6704,6 +7283,21 @@ func (in *WorkloadConnectionEnvVar) DeepCopy() *WorkloadConnectionEnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadConnectionFile) DeepCopyInto(out *WorkloadConnectionFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadConnectionFile.
+func (in *WorkloadConnectionFile) DeepCopy() *WorkloadConnectionFile {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadConnectionFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadConnectionInject) DeepCopyInto(out *WorkloadConnectionInject) {
 	*out = *in
@@ -6712,6 +7306,11 @@ func (in *WorkloadConnectionInject) DeepCopyInto(out *WorkloadConnectionInject)
 		*out = make([]WorkloadConnectionEnvVar, len(*in))
 		copy(*out, *in)
 	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]WorkloadConnectionFile, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadConnectionInject.
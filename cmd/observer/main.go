@@ -17,8 +17,10 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/handlers"
+	"github.com/openchoreo/openchoreo/internal/observer/loki"
 	"github.com/openchoreo/openchoreo/internal/observer/middleware"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
 )
 
@@ -47,14 +49,25 @@ func main() {
 		log.Fatalf("Failed to initialize OpenSearch client: %v", err)
 	}
 
+	// Initialize the stores of logs and traces. Traces are always read from OpenSearch.
+	openSearchStore := service.NewOpenSearchStore(osClient, cfg.OpenSearch.IndexPrefix, logger)
+	var logStore service.LogStore = openSearchStore
+	if cfg.LogBackend == config.LogBackendLoki {
+		logStore = service.NewLokiStore(loki.NewClient(&cfg.Loki, logger), logger)
+		logger.Info("Reading logs from Loki", "address", cfg.Loki.Address)
+	}
+
 	// Initialize logging service
-	loggingService := service.NewLoggingService(osClient, cfg, logger)
+	loggingService := service.NewLoggingService(logStore, openSearchStore, cfg, logger)
+
+	// Initialize metrics service
+	metricsService := service.NewMetricsService(prometheus.NewClient(&cfg.Prometheus, logger), logger)
 
 	// Initialize HTTP server
 	mux := http.NewServeMux()
 
 	// Initialize handlers
-	handler := handlers.NewHandler(loggingService, logger)
+	handler := handlers.NewHandler(loggingService, metricsService, logger)
 
 	// Health check endpoint
 	mux.HandleFunc("GET /health", handler.Health)
@@ -64,7 +77,11 @@ func main() {
 	mux.HandleFunc("POST /api/logs/project/{projectId}", handler.GetProjectLogs)
 	mux.HandleFunc("POST /api/logs/gateway", handler.GetGatewayLogs)
 	mux.HandleFunc("POST /api/logs/org/{orgId}", handler.GetOrganizationLogs)
+	mux.HandleFunc("POST /api/logs/component/{componentId}/follow", handler.FollowComponentLogs)
+	mux.HandleFunc("POST /api/logs/project/{projectId}/follow", handler.FollowProjectLogs)
+	mux.HandleFunc("POST /api/logs/gateway/follow", handler.FollowGatewayLogs)
 	mux.HandleFunc("POST /api/traces/component", handler.GetComponentTraces)
+	mux.HandleFunc("POST /api/metrics/component/{componentId}", handler.GetComponentMetrics)
 
 	// Apply middleware
 	handlerWithMiddleware := middleware.Chain(
@@ -6,6 +6,8 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -14,17 +16,66 @@ import (
 	"time"
 
 	"golang.org/x/exp/slog"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
 	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/handlers"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/health"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/idempotency"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/ratelimit"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 var (
 	port = flag.Int("port", 8080, "port http server runs on")
+
+	tokenFile           = flag.String("token-file", "", "path of a static token file (token,user,uid,\"group1,group2\") used to authenticate CI clients")
+	oidcIssuerURL       = flag.String("oidc-issuer-url", "", "issuer URL of the OIDC provider whose JWT bearer tokens are accepted")
+	oidcAudience        = flag.String("oidc-audience", "", "expected audience of the JWT bearer tokens")
+	oidcJWKSURL         = flag.String("oidc-jwks-url", "", "JWKS URL of the OIDC provider, discovered from the issuer if empty")
+	oidcUsernameClaim   = flag.String("oidc-username-claim", "sub", "JWT claim used as the user name")
+	oidcGroupsClaim     = flag.String("oidc-groups-claim", "groups", "JWT claim holding the groups of the user")
+	authorizationConfig = flag.String("authorization-config", "", "path of the role bindings file authorizing the authenticated users")
+	impersonate         = flag.Bool("impersonate", false, "impersonate the authenticated user toward the Kubernetes API so that its RBAC applies")
+
+	auditLog            = flag.Bool("audit-log", true, "write audit records of mutating operations to the server log")
+	auditFile           = flag.String("audit-file", "", "path of the file audit records are appended to, kept in memory if empty")
+	auditFileMaxSizeMB  = flag.Int("audit-file-max-size-mb", 100, "size in megabytes at which the audit file is rotated")
+	auditFileMaxBackups = flag.Int("audit-file-max-backups", 5, "number of rotated audit files to keep")
+	auditWebhookURL     = flag.String("audit-webhook-url", "", "URL audit records are posted to")
+
+	idempotencyStore     = flag.String("idempotency-store", "memory", "store of the outcomes of requests with an Idempotency-Key header: memory, configmap or none")
+	idempotencyTTL       = flag.Duration("idempotency-ttl", 24*time.Hour, "time the outcome of a request with an Idempotency-Key header is kept")
+	idempotencyNamespace = flag.String("idempotency-namespace", os.Getenv("POD_NAMESPACE"), "namespace of the ConfigMaps of the configmap idempotency store")
+	idempotencyMaxSizeMB = flag.Int("idempotency-max-size-mb", 64, "size in megabytes of the outcomes kept by the memory idempotency store")
+
+	healthCheckTimeout = flag.Duration("health-check-timeout", 5*time.Second, "time each readiness check of a dependency may take")
+	healthCheckPlanes  = flag.Bool("health-check-planes", false, "report the connectivity of the data planes and build planes in the readiness report, without failing readiness")
+
+	rateLimitConfig = flag.String("rate-limit-config", "", "path of the file of rate limits per principal and organization, requests are not limited if empty")
 )
 
+// auditMemoryCapacity is the number of audit records kept in memory when no audit file is configured
+const auditMemoryCapacity = 10000
+
+// healthCheckMaxAge is the time the results of the readiness checks are reused by later probes
+const healthCheckMaxAge = 2 * time.Second
+
+// idempotencyCleanupInterval is the interval at which expired records of the idempotency store are removed
+const idempotencyCleanupInterval = 5 * time.Minute
+
+// requestTimeout is the time the server takes to read a request and to write its response
+const requestTimeout = 15 * time.Second
+
+// idempotencyReservationTimeout is the time the key of a request in progress stays reserved. It outlasts
+// the request timeout, so that a key is only freed once its request can no longer complete.
+const idempotencyReservationTimeout = 2 * requestTimeout
+
 func main() {
 	flag.Parse()
 
@@ -36,24 +87,89 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	k8sClient, err := k8s.NewK8sClient()
+	authn, err := auth.NewFromConfig(auth.Config{
+		TokenFile: *tokenFile,
+		OIDC: auth.OIDCConfig{
+			IssuerURL:     *oidcIssuerURL,
+			Audience:      *oidcAudience,
+			JWKSURL:       *oidcJWKSURL,
+			UsernameClaim: *oidcUsernameClaim,
+			GroupsClaim:   *oidcGroupsClaim,
+		},
+		AuthorizationConfigFile: *authorizationConfig,
+	})
+	if err != nil {
+		baseLogger.Error("Failed to initialize authentication", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if !authn.Enabled() {
+		baseLogger.Warn("Authentication is disabled, all requests are allowed")
+		if *impersonate {
+			baseLogger.Error("Impersonation requires authentication to be enabled")
+			os.Exit(1)
+		}
+	}
+
+	var k8sClient client.Client
+	if *impersonate {
+		k8sClient, err = k8s.NewImpersonatingK8sClient()
+	} else {
+		k8sClient, err = k8s.NewK8sClient()
+	}
 	if err != nil {
 		baseLogger.Error("Failed to initialize Kubernetes client", slog.Any("error", err))
 		os.Exit(1)
 	}
 
+	auditor, err := newAuditor(baseLogger)
+	if err != nil {
+		baseLogger.Error("Failed to initialize audit", slog.Any("error", err))
+		os.Exit(1)
+	}
+	defer func() {
+		if err := auditor.Close(); err != nil {
+			baseLogger.Error("Failed to close audit sinks", slog.Any("error", err))
+		}
+	}()
+
+	idempotent, err := newIdempotency(ctx, baseLogger)
+	if err != nil {
+		baseLogger.Error("Failed to initialize idempotency", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	var rateLimiter *ratelimit.Limiter
+	if *rateLimitConfig != "" {
+		config, err := ratelimit.LoadConfig(*rateLimitConfig)
+		if err != nil {
+			baseLogger.Error("Failed to initialize rate limits", slog.Any("error", err))
+			os.Exit(1)
+		}
+		rateLimiter = ratelimit.New(config)
+	}
+
+	clientMgr := kubernetesClient.NewManager()
+
+	checks, err := newHealthRegistry(k8sClient, clientMgr)
+	if err != nil {
+		baseLogger.Error("Failed to initialize health checks", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	// Initialize services
-	services := services.NewServices(k8sClient, kubernetesClient.NewManager(), baseLogger)
+	services := services.NewServices(k8sClient, clientMgr, baseLogger)
 
 	// Initialize HTTP handlers
-	handler := handlers.New(services, baseLogger.With("component", "handlers"))
+	handler := handlers.New(services, authn, auditor, idempotent, rateLimiter, checks, baseLogger.With("component", "handlers"))
 
 	srv := &http.Server{
 		Addr:         ":" + strconv.Itoa(*port),
 		Handler:      handler.Routes(),
-		ReadTimeout:  15 * time.Second, // TODO: Make these configurable
-		WriteTimeout: 15 * time.Second,
+		ReadTimeout:  requestTimeout, // TODO: Make these configurable
+		WriteTimeout: requestTimeout,
 		IdleTimeout:  60 * time.Second,
+		// Requests are canceled on shutdown, so that event streams end instead of holding the shutdown
+		BaseContext: func(net.Listener) context.Context { return ctx },
 	}
 
 	// Start server
@@ -78,3 +194,90 @@ func main() {
 
 	baseLogger.Info("Server stopped gracefully")
 }
+
+// newAuditor creates the auditor writing to the configured sinks. Audit records are queried from
+// the audit file if one is configured, and from the most recent records kept in memory otherwise.
+func newAuditor(logger *slog.Logger) (*audit.Auditor, error) {
+	var sinks []audit.Sink
+	if *auditLog {
+		sinks = append(sinks, audit.NewLogSink(logger))
+	}
+
+	var querier audit.Querier
+	if *auditFile != "" {
+		file, err := audit.NewFileSink(*auditFile, int64(*auditFileMaxSizeMB)*1024*1024, *auditFileMaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, file)
+		querier = file
+	} else {
+		memory := audit.NewMemoryStore(auditMemoryCapacity)
+		sinks = append(sinks, memory)
+		querier = memory
+	}
+
+	if *auditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(*auditWebhookURL, logger))
+	}
+	return audit.New(querier, logger.With("component", "audit"), sinks...), nil
+}
+
+// newIdempotency creates the handling of idempotency keys with the configured store. The memory store
+// only suits a single replica; the configmap store is shared by all replicas, one of which removes the
+// expired records while it holds the cleanup lease.
+func newIdempotency(ctx context.Context, logger *slog.Logger) (*idempotency.Idempotency, error) {
+	switch *idempotencyStore {
+	case "none":
+		return nil, nil
+	case "memory":
+		store := idempotency.NewMemoryStore(int64(*idempotencyMaxSizeMB) * 1024 * 1024)
+		go store.RunCleanup(ctx, idempotencyCleanupInterval)
+		return idempotency.New(store, *idempotencyTTL, idempotencyReservationTimeout), nil
+	case "configmap":
+		if *idempotencyNamespace == "" {
+			return nil, fmt.Errorf("the configmap idempotency store requires --idempotency-namespace or POD_NAMESPACE")
+		}
+		// The store uses the server's own credentials, also when requests impersonate their caller
+		storeClient, err := k8s.NewK8sClient()
+		if err != nil {
+			return nil, err
+		}
+		identity := os.Getenv("POD_NAME")
+		if identity == "" {
+			if identity, err = os.Hostname(); err != nil {
+				return nil, fmt.Errorf("failed to determine the replica identity: %w", err)
+			}
+		}
+		store := idempotency.NewConfigMapStore(storeClient, *idempotencyNamespace, logger.With("component", "idempotency"))
+		go store.RunCleanup(ctx, idempotencyCleanupInterval, identity)
+		return idempotency.New(store, *idempotencyTTL, idempotencyReservationTimeout), nil
+	default:
+		return nil, fmt.Errorf("unknown idempotency store %q", *idempotencyStore)
+	}
+}
+
+// newHealthRegistry creates the registry of the checks run by the readiness and startup probes:
+// the reachability of the Kubernetes API and the presence of the OpenChoreo CRDs, and optionally
+// the connectivity of the data planes and build planes.
+func newHealthRegistry(k8sClient client.Client, clientMgr *kubernetesClient.KubeMultiClientManager) (*health.Registry, error) {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
+	}
+	// Discovery requests don't take a context, so the timeout of the checks is set on the client
+	config.Timeout = *healthCheckTimeout
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	registry := health.NewRegistry(*healthCheckTimeout, healthCheckMaxAge)
+	registry.Register(health.KubernetesAPI(dc))
+	registry.Register(health.CRDs(dc, k8sClient.Scheme()))
+	if *healthCheckPlanes {
+		registry.Register(health.DataPlanes(k8sClient, clientMgr))
+		registry.Register(health.BuildPlanes(k8sClient, clientMgr))
+	}
+	return registry, nil
+}
@@ -55,9 +55,8 @@ func (i *ApplyImpl) Apply(params api.ApplyParams) error {
 		return fmt.Errorf("no YAML files found in: %s", params.FilePath)
 	}
 
-	totalResources := 0
-
-	// Process each file
+	// Collect the resources of all files, to apply them in a single request
+	var resources []map[string]interface{}
 	for _, filePath := range resourceFiles {
 		fmt.Printf("Processing file: %s\n", filePath)
 
@@ -68,28 +67,45 @@ func (i *ApplyImpl) Apply(params api.ApplyParams) error {
 		}
 
 		// Parse resources from this file
-		resources, err := parseYAMLResources(content)
+		fileResources, err := parseYAMLResources(content)
 		if err != nil {
 			return fmt.Errorf("failed to parse resources in %s: %w", filePath, err)
 		}
 
-		if len(resources) == 0 {
+		if len(fileResources) == 0 {
 			fmt.Printf("  No resources found in %s\n", filePath)
 			continue
 		}
+		resources = append(resources, fileResources...)
+	}
 
-		// Apply each resource in this file
-		for j, resource := range resources {
-			if err := applyResource(ctx, apiClient, resource, j+1, len(resources)); err != nil {
-				return fmt.Errorf("failed to apply resource from %s: %w", filePath, err)
-			}
-		}
+	if len(resources) == 0 {
+		return fmt.Errorf("no resources found in: %s", params.FilePath)
+	}
+
+	applyCtx, applyCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer applyCancel()
 
-		totalResources += len(resources)
-		fmt.Printf("  Applied %d resource(s) from %s\n", len(resources), filePath)
+	resp, err := apiClient.ApplyBatch(applyCtx, resources, client.ApplyOptions{
+		DryRun: params.DryRun,
+		Force:  params.Force,
+		Atomic: params.Atomic,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply resources from %s: %w", params.FilePath, err)
+	}
+
+	printApplyResults(resp)
+
+	if resp.Data.Failed > 0 {
+		return fmt.Errorf("%d of %d resource(s) failed to apply", resp.Data.Failed, len(resources))
 	}
 
-	fmt.Printf("\nSuccessfully applied %d resource(s) from %d file(s) in: %s\n", totalResources, len(resourceFiles), params.FilePath)
+	verb := "applied"
+	if params.DryRun {
+		verb = "validated (dry run)"
+	}
+	fmt.Printf("\nSuccessfully %s %d resource(s) from %d file(s) in: %s\n", verb, len(resources), len(resourceFiles), params.FilePath)
 	return nil
 }
 
@@ -205,26 +221,30 @@ func parseYAMLResources(content []byte) ([]map[string]interface{}, error) {
 	return resources, nil
 }
 
-// applyResource applies a single resource to the API server
-func applyResource(ctx context.Context, apiClient *client.APIClient, resource map[string]interface{}, index, total int) error {
-	kind, _ := resource["kind"].(string)
-	metadata, _ := resource["metadata"].(map[string]interface{})
-	name, _ := metadata["name"].(string)
-
-	fmt.Printf("Applying %d/%d: %s/%s...", index, total, kind, name)
-
-	resp, err := apiClient.Apply(ctx, resource)
-	if err != nil {
-		fmt.Printf(" FAILED\n")
-		return fmt.Errorf("failed to apply %s/%s: %w", kind, name, err)
-	}
+// printApplyResults prints the result of each applied resource, with the changes of dry runs
+func printApplyResults(resp *client.ApplyBatchResponse) {
+	for i, result := range resp.Data.Results {
+		fmt.Printf("%d/%d: %s/%s", i+1, len(resp.Data.Results), result.Kind, result.Name)
+		if result.Namespace != "" {
+			fmt.Printf(" in %s", result.Namespace)
+		}
+		fmt.Printf(" %s\n", strings.ToUpper(result.Operation))
 
-	operation := resp.Data.Operation
-	if resp.Data.Namespace != "" {
-		fmt.Printf(" %s (%s/%s in %s)\n", strings.ToUpper(operation), kind, name, resp.Data.Namespace)
-	} else {
-		fmt.Printf(" %s (%s/%s)\n", strings.ToUpper(operation), kind, name)
+		if result.Error != "" {
+			fmt.Printf("  %s\n", result.Error)
+			if result.Code == "FIELD_CONFLICT" {
+				fmt.Printf("  hint: use --force-conflicts to take over the conflicting fields\n")
+			}
+		}
+		for _, change := range result.Diff {
+			switch change.Operation {
+			case "add":
+				fmt.Printf("  + %s: %v\n", change.Path, change.New)
+			case "remove":
+				fmt.Printf("  - %s: %v\n", change.Path, change.Old)
+			default:
+				fmt.Printf("  ~ %s: %v -> %v\n", change.Path, change.Old, change.New)
+			}
+		}
 	}
-
-	return nil
 }
@@ -10,13 +10,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"sort"
+	"syscall"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	choreoctlClient "github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/kinds"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
 	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
@@ -24,6 +28,9 @@ import (
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
 
+// maxTailLines is the most lines the API server returns for a logs request
+const maxTailLines = 1000
+
 type LogsImpl struct{}
 
 func NewLogsImpl() *LogsImpl {
@@ -151,6 +158,11 @@ func getDeploymentLogs(params api.LogParams) error {
 		return fmt.Errorf("organization, project, component, environment and deployment values are required for deployment logs")
 	}
 
+	// Followed logs are read through the API server, so the data plane cluster need not be reachable
+	if params.Follow {
+		return followDeploymentLogs(params)
+	}
+
 	deployRes, err := kinds.NewDeploymentResource(
 		constants.DeploymentV1Config,
 		params.Organization,
@@ -217,20 +229,7 @@ func getDeploymentLogs(params api.LogParams) error {
 
 	tailLinesPtr := &params.TailLines
 
-	// If following logs, only show the latest pod
-	if params.Follow {
-		pod := pods.Items[0]
-		fmt.Printf("\n=== Pod: %s ===\n", pod.Name)
-		logs, err := GetPodLogs(pod.Name, pod.Namespace, "", true, tailLinesPtr)
-		if err != nil {
-			return fmt.Errorf("failed to get logs for pod %s: %w", pod.Name, err)
-		}
-		fmt.Println("=======================================")
-		fmt.Println(logs)
-		return nil
-	}
-
-	// Show logs from all pods if not following
+	// Show logs from all pods
 	for _, pod := range pods.Items {
 		fmt.Printf("\n=== Pod: %s ===\n", pod.Name)
 		logs, err := GetPodLogs(pod.Name, pod.Namespace, "", false, tailLinesPtr)
@@ -243,6 +242,66 @@ func getDeploymentLogs(params api.LogParams) error {
 	return nil
 }
 
+// followDeploymentLogs prints the last lines of the logs of a deployed component and then follows its new log lines
+// through the API server, which reads them from the observer of the data plane
+func followDeploymentLogs(params api.LogParams) error {
+	apiClient, err := choreoctlClient.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Following starts from the last printed line, and the lines printed already are skipped
+	start := time.Now()
+	printed := make(map[string]bool)
+	limit := min(int(params.TailLines), maxTailLines)
+	tail, err := apiClient.GetComponentLogs(ctx, params.Organization, params.Project, params.Component,
+		params.Environment, choreoctlClient.LogOptions{Limit: limit, SortOrder: "desc"})
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+	for i := len(tail) - 1; i >= 0; i-- {
+		printLogEntry(tail[i])
+		printed[logEntryKey(tail[i])] = true
+	}
+	if len(tail) > 0 {
+		start = tail[0].Timestamp
+	}
+
+	err = apiClient.FollowComponentLogs(ctx, params.Organization, params.Project, params.Component,
+		params.Environment, choreoctlClient.LogOptions{StartTime: start},
+		func(entry choreoctlClient.LogEntry) error {
+			if key := logEntryKey(entry); printed[key] {
+				delete(printed, key)
+				return nil
+			}
+			printLogEntry(entry)
+			return nil
+		},
+		func(gap choreoctlClient.LogGap) error {
+			fmt.Fprintf(os.Stderr, "Warning: %s (%s)\n", gap.Message, gap.Timestamp.Format(time.RFC3339Nano))
+			return nil
+		})
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to follow logs: %w", err)
+	}
+	return nil
+}
+
+func printLogEntry(entry choreoctlClient.LogEntry) {
+	if entry.Pod != "" {
+		fmt.Printf("[%s] %s\n", entry.Pod, entry.Log)
+		return
+	}
+	fmt.Println(entry.Log)
+}
+
+func logEntryKey(entry choreoctlClient.LogEntry) string {
+	return entry.Timestamp.String() + "/" + entry.Pod + "/" + entry.Container + "/" + entry.Log
+}
+
 func GetPodLogs(podName, namespace, containerName string, follow bool, tailLines *int64) (string, error) {
 	k8sClient, err := resources.GetClient()
 	if err != nil {
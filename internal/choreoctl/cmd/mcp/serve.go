@@ -0,0 +1,78 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+	openchoreomcp "github.com/openchoreo/openchoreo/pkg/mcp"
+)
+
+type MCPImpl struct{}
+
+func NewMCPImpl() *MCPImpl {
+	return &MCPImpl{}
+}
+
+// ServeMCP serves the tools of the MCP endpoint of the API server over stdio, until stdin is closed or the
+// process is interrupted. Nothing but MCP messages may be written to stdout.
+func (i *MCPImpl) ServeMCP(params api.ServeMCPParams) error {
+	toolsets, err := parseToolsets(params.Toolsets)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	remote, err := apiClient.ConnectMCP(ctx)
+	if err != nil {
+		return fmt.Errorf("OpenChoreo API server not accessible: %w", err)
+	}
+	defer remote.Close()
+
+	server := openchoreomcp.NewSTDIOProxy(remote, toolsets, params.ReadOnly)
+	if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("MCP server failed: %w", err)
+	}
+	return nil
+}
+
+// parseToolsets parses the toolsets to serve, defaulting to all toolsets
+func parseToolsets(toolsetsStr string) (map[openchoreomcp.ToolsetType]bool, error) {
+	toolsets := openchoreomcp.ParseToolsets(toolsetsStr)
+	if len(toolsets) == 0 {
+		for _, ts := range openchoreomcp.AllToolsets {
+			toolsets[ts] = true
+		}
+		return toolsets, nil
+	}
+
+	for ts := range toolsets {
+		if !slices.Contains(openchoreomcp.AllToolsets, ts) {
+			valid := make([]string, 0, len(openchoreomcp.AllToolsets))
+			for _, ts := range openchoreomcp.AllToolsets {
+				valid = append(valid, string(ts))
+			}
+			return nil, fmt.Errorf("unknown toolset %q, valid toolsets are: %s", ts, strings.Join(valid, ", "))
+		}
+	}
+	return toolsets, nil
+}
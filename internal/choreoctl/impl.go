@@ -33,6 +33,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logout"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logs"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/mcp"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
@@ -190,6 +191,13 @@ func (c *CommandImplementation) Apply(params api.ApplyParams) error {
 	return applyImpl.Apply(params)
 }
 
+// MCP Operations
+
+func (c *CommandImplementation) ServeMCP(params api.ServeMCPParams) error {
+	mcpImpl := mcp.NewMCPImpl()
+	return mcpImpl.ServeMCP(params)
+}
+
 // Logs Operations
 
 func (c *CommandImplementation) GetLogs(params api.LogParams) error {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
@@ -37,6 +38,47 @@ type ApplyResponse struct {
 	Code  string `json:"code,omitempty"`
 }
 
+// ApplyResult represents the result of applying one resource of a batch
+type ApplyResult struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Name       string        `json:"name"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Operation  string        `json:"operation"` // "created", "updated", "unchanged", "failed" or "skipped"
+	Diff       []FieldChange `json:"diff,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Code       string        `json:"code,omitempty"`
+}
+
+// FieldChange represents a change of a field reported by a dry run apply
+type FieldChange struct {
+	Path      string `json:"path"`
+	Operation string `json:"operation"` // "add", "remove" or "replace"
+	Old       any    `json:"old,omitempty"`
+	New       any    `json:"new,omitempty"`
+}
+
+// ApplyBatchResponse represents the response from /api/v1/apply for several resources
+type ApplyBatchResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		DryRun  bool          `json:"dryRun"`
+		Atomic  bool          `json:"atomic"`
+		Applied int           `json:"applied"`
+		Failed  int           `json:"failed"`
+		Results []ApplyResult `json:"results"`
+	} `json:"data"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// ApplyOptions represents the options of a batch apply
+type ApplyOptions struct {
+	DryRun bool
+	Force  bool
+	Atomic bool
+}
+
 type DeleteResponse struct {
 	Success bool `json:"success"`
 	Data    struct {
@@ -178,6 +220,47 @@ func (c *APIClient) Apply(ctx context.Context, resource map[string]interface{})
 	return &applyResp, nil
 }
 
+// ApplyBatch sends several resources to the /api/v1/apply endpoint in a single request.
+// Failures of individual resources are reported in the results rather than as an error.
+func (c *APIClient) ApplyBatch(ctx context.Context, resources []map[string]interface{}, opts ApplyOptions) (*ApplyBatchResponse, error) {
+	query := url.Values{}
+	if opts.DryRun {
+		query.Set("dryRun", "true")
+	}
+	if opts.Force {
+		query.Set("force", "true")
+	}
+	if opts.Atomic {
+		query.Set("atomic", "true")
+	}
+	path := "/api/v1/apply"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, err := c.post(ctx, path, resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make apply request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var applyResp ApplyBatchResponse
+	if err := json.Unmarshal(body, &applyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !applyResp.Success && applyResp.Data.Results == nil {
+		return &applyResp, fmt.Errorf("apply failed: %s", applyResp.Error)
+	}
+
+	return &applyResp, nil
+}
+
 func (c *APIClient) Delete(ctx context.Context, resource map[string]interface{}) (*DeleteResponse, error) {
 	resp, err := c.delete(ctx, "/api/v1/delete", resource)
 	if err != nil {
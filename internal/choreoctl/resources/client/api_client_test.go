@@ -0,0 +1,178 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/handlers"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/openapi"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// undocumentedPaths are requested by the client outside of the API described by the OpenAPI document
+var undocumentedPaths = []string{"/health", "/mcp"}
+
+// loadOpenAPIDocument reads the OpenAPI document served by the API server routes
+func loadOpenAPIDocument(t *testing.T) *openapi.Document {
+	t.Helper()
+	routes := handlers.New(&services.Services{}, nil, nil, nil, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil))).Routes()
+	rec := httptest.NewRecorder()
+	routes.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /api/v1/openapi.json = %d", rec.Code)
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode the OpenAPI document: %v", err)
+	}
+	return &doc
+}
+
+// findOperation returns the operation of the document for the method and path, matching the
+// path parameters of the document to any path segment
+func findOperation(doc *openapi.Document, method, path string) *openapi.Operation {
+	segments := strings.Split(path, "/")
+	for template, item := range doc.Paths {
+		templateSegments := strings.Split(template, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+		matches := true
+		for i, segment := range templateSegments {
+			if !strings.HasPrefix(segment, "{") && segment != segments[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			if operation := item[strings.ToLower(method)]; operation != nil {
+				return operation
+			}
+		}
+	}
+	return nil
+}
+
+func TestAPIClientRequestsMatchOpenAPIDocument(t *testing.T) {
+	doc := loadOpenAPIDocument(t)
+
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		if r.URL.Query().Get("follow") == "true" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"success":true,"data":{}}`)
+	}))
+	defer server.Close()
+
+	c := &APIClient{baseURL: server.URL, token: "token", httpClient: server.Client()}
+	ctx := context.Background()
+	resource := map[string]interface{}{"apiVersion": "openchoreo.dev/v1alpha1", "kind": "Project", "metadata": map[string]interface{}{"name": "shop"}}
+	logOptions := LogOptions{StartTime: time.Now(), Levels: []string{"ERROR"}, Search: "timeout", Limit: 10, SortOrder: "desc"}
+
+	calls := map[string]func() error{
+		"HealthCheck": func() error { return c.HealthCheck(ctx) },
+		"Apply":       func() error { _, err := c.Apply(ctx, resource); return err },
+		"ApplyBatch": func() error {
+			_, err := c.ApplyBatch(ctx, []map[string]interface{}{resource}, ApplyOptions{DryRun: true, Force: true, Atomic: true})
+			return err
+		},
+		"Delete":            func() error { _, err := c.Delete(ctx, resource); return err },
+		"ListOrganizations": func() error { _, err := c.ListOrganizations(ctx); return err },
+		"ListProjects":      func() error { _, err := c.ListProjects(ctx, "acme"); return err },
+		"ListComponents":    func() error { _, err := c.ListComponents(ctx, "acme", "shop"); return err },
+		"GetComponentLogs": func() error {
+			_, err := c.GetComponentLogs(ctx, "acme", "shop", "cart", "development", logOptions)
+			return err
+		},
+		"FollowComponentLogs": func() error {
+			return c.FollowComponentLogs(ctx, "acme", "shop", "cart", "development", logOptions, func(LogEntry) error { return nil }, nil)
+		},
+	}
+	for name, call := range calls {
+		if err := call(); err != nil {
+			t.Errorf("%s() = %v", name, err)
+		}
+	}
+
+	if len(requests) != len(calls) {
+		t.Fatalf("requests = %d, want one per call (%d)", len(requests), len(calls))
+	}
+	for _, r := range requests {
+		if strings.HasPrefix(r.URL.Path, "/api/") {
+			if got := r.Header.Get("Authorization"); got != "Bearer token" {
+				t.Errorf("%s %s is not authenticated: Authorization = %q", r.Method, r.URL.Path, got)
+			}
+		}
+		undocumented := false
+		for _, path := range undocumentedPaths {
+			undocumented = undocumented || r.URL.Path == path
+		}
+		if undocumented {
+			continue
+		}
+
+		operation := findOperation(doc, r.Method, r.URL.Path)
+		if operation == nil {
+			t.Errorf("%s %s is not an operation of the OpenAPI document", r.Method, r.URL.Path)
+			continue
+		}
+		parameters := make(map[string]bool, len(operation.Parameters))
+		for _, p := range operation.Parameters {
+			if p.In == "query" {
+				parameters[p.Name] = true
+			}
+		}
+		for name := range r.URL.Query() {
+			if !parameters[name] {
+				t.Errorf("%s %s sends the query parameter %q, which operation %s doesn't describe",
+					r.Method, r.URL.Path, name, operation.OperationID)
+			}
+		}
+	}
+}
+
+func TestReadLogEvents(t *testing.T) {
+	stream := "event: log\ndata: {\"log\":\"first\"}\n\n" +
+		": heartbeat\n\n" +
+		"event: gap\ndata: {\"timestamp\":\"2025-01-01T00:00:00Z\",\"message\":\"entries were skipped\"}\n\n" +
+		"event: log\ndata: {\"log\":\"second\"}\n\n"
+
+	var events []string
+	err := readLogEvents(context.Background(), strings.NewReader(stream),
+		func(entry LogEntry) error {
+			events = append(events, "log: "+entry.Log)
+			return nil
+		},
+		func(gap LogGap) error {
+			events = append(events, "gap: "+gap.Message)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("readLogEvents() = %v", err)
+	}
+	want := []string{"log: first", "gap: entries were skipped", "log: second"}
+	if strings.Join(events, "|") != strings.Join(want, "|") {
+		t.Errorf("events = %v, want %v", events, want)
+	}
+}
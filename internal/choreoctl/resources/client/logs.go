@@ -0,0 +1,203 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogEntry represents a log entry of a component returned by the API
+type LogEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Level     string    `json:"level,omitempty"`
+	Log       string    `json:"log"`
+	Pod       string    `json:"pod,omitempty"`
+	Container string    `json:"container,omitempty"`
+	Version   string    `json:"version,omitempty"`
+}
+
+// LogGap reports log entries a followed log stream skipped
+type LogGap struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// LogsResponse represents the response from reading the logs of a component
+type LogsResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Entries    []LogEntry `json:"entries"`
+		TotalCount int        `json:"totalCount"`
+	} `json:"data"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// LogOptions filters the logs of a component
+type LogOptions struct {
+	StartTime time.Time
+	Levels    []string
+	Search    string
+	// Limit and SortOrder only apply when the logs are not followed
+	Limit     int
+	SortOrder string
+}
+
+// GetComponentLogs retrieves the runtime logs of a component in an environment from the API
+func (c *APIClient) GetComponentLogs(ctx context.Context, orgName, projectName, componentName, environment string,
+	opts LogOptions) ([]LogEntry, error) {
+	resp, err := c.get(ctx, componentLogsPath(orgName, projectName, componentName, environment, opts, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to make get component logs request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var logsResp LogsResponse
+	if err := json.Unmarshal(body, &logsResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !logsResp.Success {
+		return nil, fmt.Errorf("get component logs failed: %s", logsResp.Error)
+	}
+
+	return logsResp.Data.Entries, nil
+}
+
+// FollowComponentLogs streams the new runtime logs of a component in an environment from the API, calling handle
+// for each entry and handleGap, if set, for each gap of skipped entries until the context is cancelled, the stream
+// ends or a handler returns an error
+func (c *APIClient) FollowComponentLogs(ctx context.Context, orgName, projectName, componentName, environment string,
+	opts LogOptions, handle func(LogEntry) error, handleGap func(LogGap) error) error {
+	path := componentLogsPath(orgName, projectName, componentName, environment, opts, true)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	// The stream is long-lived, so it can't share the timeout of the other requests
+	httpClient := &http.Client{Transport: &bearerTransport{token: c.token, base: http.DefaultTransport}}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make follow component logs request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		var errResp LogsResponse
+		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+			return fmt.Errorf("follow component logs failed: %s", errResp.Error)
+		}
+		return fmt.Errorf("follow component logs failed with status %d", resp.StatusCode)
+	}
+
+	return readLogEvents(ctx, resp.Body, handle, handleGap)
+}
+
+// readLogEvents reads the log, gap and error events of a Server-Sent Events stream
+func readLogEvents(ctx context.Context, r io.Reader, handle func(LogEntry) error, handleGap func(LogGap) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var event string
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if err := dispatchLogEvent(event, data.String(), handle, handleGap); err != nil {
+				return err
+			}
+			event = ""
+			data.Reset()
+		case strings.HasPrefix(line, ":"):
+			// Heartbeat
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read the log stream: %w", err)
+	}
+	return nil
+}
+
+func dispatchLogEvent(event, data string, handle func(LogEntry) error, handleGap func(LogGap) error) error {
+	switch event {
+	case "log":
+		var entry LogEntry
+		if err := json.Unmarshal([]byte(data), &entry); err != nil {
+			return fmt.Errorf("failed to parse log entry: %w", err)
+		}
+		return handle(entry)
+	case "gap":
+		if handleGap == nil {
+			return nil
+		}
+		var gap LogGap
+		if err := json.Unmarshal([]byte(data), &gap); err != nil {
+			return fmt.Errorf("failed to parse log gap: %w", err)
+		}
+		return handleGap(gap)
+	case "error":
+		var errResp LogsResponse
+		if err := json.Unmarshal([]byte(data), &errResp); err != nil || errResp.Error == "" {
+			return fmt.Errorf("log stream failed")
+		}
+		return fmt.Errorf("log stream failed: %s", errResp.Error)
+	default:
+		return nil
+	}
+}
+
+func componentLogsPath(orgName, projectName, componentName, environment string, opts LogOptions, follow bool) string {
+	query := url.Values{}
+	if follow {
+		query.Set("follow", "true")
+	}
+	if !opts.StartTime.IsZero() {
+		query.Set("startTime", opts.StartTime.UTC().Format(time.RFC3339))
+	}
+	if len(opts.Levels) > 0 {
+		query.Set("level", strings.Join(opts.Levels, ","))
+	}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if !follow {
+		if opts.Limit > 0 {
+			query.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.SortOrder != "" {
+			query.Set("sortOrder", opts.SortOrder)
+		}
+	}
+	return fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/environments/%s/logs?%s",
+		url.PathEscape(orgName), url.PathEscape(projectName), url.PathEscape(componentName),
+		url.PathEscape(environment), query.Encode())
+}
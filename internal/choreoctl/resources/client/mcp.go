@@ -0,0 +1,46 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ConnectMCP connects to the MCP endpoint of the API server, authenticating with the token of the control plane.
+// The session has to be closed by the caller.
+func (c *APIClient) ConnectMCP(ctx context.Context) (*mcp.ClientSession, error) {
+	client := mcp.NewClient(&mcp.Implementation{
+		Name:    "choreoctl",
+		Version: "1.0.0",
+	}, nil)
+	transport := &mcp.StreamableClientTransport{
+		Endpoint: c.baseURL + "/mcp",
+		// The session streams the notifications of the server, so its requests have no timeout
+		HTTPClient: &http.Client{Transport: &bearerTransport{token: c.token, base: http.DefaultTransport}},
+	}
+	session, err := client.Connect(ctx, transport, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the MCP server: %w", err)
+	}
+	return session, nil
+}
+
+// bearerTransport authenticates the requests it sends with a bearer token
+type bearerTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token == "" {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(req)
+}
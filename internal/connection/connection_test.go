@@ -0,0 +1,229 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"strings"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func databaseConnection(env map[string]string, files map[string]string) openchoreov1alpha1.WorkloadConnection {
+	conn := openchoreov1alpha1.WorkloadConnection{
+		Type:   openchoreov1alpha1.ConnectionTypeDatabase,
+		Params: map[string]string{ParamResource: "orders-db"},
+	}
+	for _, name := range sortedNames(env) {
+		conn.Inject.Env = append(conn.Inject.Env, openchoreov1alpha1.WorkloadConnectionEnvVar{Name: name, Value: env[name]})
+	}
+	for _, mountPath := range sortedNames(files) {
+		conn.Inject.Files = append(conn.Inject.Files, openchoreov1alpha1.WorkloadConnectionFile{MountPath: mountPath, Value: files[mountPath]})
+	}
+	return conn
+}
+
+func databaseBinding() *openchoreov1alpha1.ResourceBinding {
+	return &openchoreov1alpha1.ResourceBinding{
+		Spec: openchoreov1alpha1.ResourceBindingSpec{
+			Type:         openchoreov1alpha1.ConnectionTypeDatabase,
+			ResourceName: "orders-db",
+			Environment:  "development",
+			Properties:   map[string]string{"host": "orders.db.internal", "port": "5432", "username": "orders"},
+			SecretProperties: map[string]openchoreov1alpha1.ResourceBindingSecretKeyRef{
+				"password": {SecretReference: "orders-db-credentials", Key: "password"},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := databaseConnection(map[string]string{
+		"DB_URL":      "postgres://{{ .username }}@{{ .host }}:{{ .port }}",
+		"DB_PASSWORD": "{{ .password }}",
+	}, map[string]string{"/etc/db/host": "{{ if .host }}{{ .host }}{{ end }}"})
+	if err := Validate(valid); err != nil {
+		t.Fatalf("expected a valid connection, got %v", err)
+	}
+
+	tests := map[string]struct {
+		conn openchoreov1alpha1.WorkloadConnection
+		want string
+	}{
+		"unknown property": {
+			conn: databaseConnection(map[string]string{"DB_URL": "{{ .url }}"}, nil),
+			want: `unknown property "url"`,
+		},
+		"unknown property in a branch": {
+			conn: databaseConnection(nil, map[string]string{"/etc/db": "{{ with .host }}{{ .bucket }}{{ end }}"}),
+			want: `unknown property "bucket"`,
+		},
+		"relative mount path": {
+			conn: databaseConnection(nil, map[string]string{"etc/db": "{{ .host }}"}),
+			want: "must be absolute",
+		},
+		"invalid template": {
+			conn: databaseConnection(map[string]string{"DB_HOST": "{{ .host "}, nil),
+			want: "invalid template",
+		},
+		"nothing injected": {
+			conn: databaseConnection(nil, nil),
+			want: "at least one env var or file",
+		},
+		"unsupported type": {
+			conn: openchoreov1alpha1.WorkloadConnection{Type: "cache"},
+			want: `unsupported connection type "cache"`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := Validate(tt.conn)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	missingResource := databaseConnection(map[string]string{"DB_HOST": "{{ .host }}"}, nil)
+	missingResource.Params = nil
+	if err := Validate(missingResource); err == nil || !strings.Contains(err.Error(), `param "resource"`) {
+		t.Errorf("expected the resource param to be required, got %v", err)
+	}
+}
+
+func TestFromResourceBinding(t *testing.T) {
+	t.Parallel()
+
+	resolved, err := FromResourceBinding(databaseBinding())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolved.Values["host"] != "orders.db.internal" || resolved.Secrets["password"].Key != "password" {
+		t.Errorf("unexpected resolved properties %+v", resolved)
+	}
+
+	binding := databaseBinding()
+	delete(binding.Spec.Properties, "port")
+	binding.Spec.Properties["password"] = "hunter2"
+	binding.Spec.Properties["bucket"] = "orders"
+	_, err = FromResourceBinding(binding)
+	for _, want := range []string{
+		`property "bucket" is not a database property`,
+		`property "password" is sensitive`,
+		`required database property "port" is not provided`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got %v", want, err)
+		}
+	}
+}
+
+func TestInject(t *testing.T) {
+	t.Parallel()
+
+	resolved, err := FromResourceBinding(databaseBinding())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	conn := databaseConnection(map[string]string{
+		"DB_URL":      "postgres://{{ .username }}@{{ .host }}:{{ .port }}",
+		"DB_PASSWORD": "{{ .password }}",
+	}, map[string]string{
+		"/etc/db/password": "{{ .password }}",
+		"/etc/db/host":     "{{ .host }}",
+	})
+	injection, err := Inject(conn, resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	injections := map[string]*Injection{"orders": injection}
+	secretName := func(ref string) string { return "binding-" + ref }
+
+	envVars := EnvVars(injections, secretName)
+	if len(envVars) != 2 {
+		t.Fatalf("expected 2 env vars, got %+v", envVars)
+	}
+	if envVars[0].Name != "DB_PASSWORD" || envVars[0].ValueFrom == nil ||
+		envVars[0].ValueFrom.SecretKeyRef.Name != "binding-orders-db-credentials" {
+		t.Errorf("expected DB_PASSWORD to be read from the secret, got %+v", envVars[0])
+	}
+	if envVars[1].Value != "postgres://orders@orders.db.internal:5432" {
+		t.Errorf("unexpected DB_URL value %q", envVars[1].Value)
+	}
+
+	volumes, mounts, data := Files(injections, "binding-connections", secretName)
+	if len(volumes) != 2 || len(mounts) != 2 || len(data) != 1 {
+		t.Fatalf("expected a secret and a config map volume with 2 mounts, got %+v %+v %+v", volumes, mounts, data)
+	}
+	if volumes[0].Secret == nil || volumes[0].Secret.SecretName != "binding-orders-db-credentials" {
+		t.Errorf("expected the password file to be mounted from the secret, got %+v", volumes[0])
+	}
+	if data[fileKey("/etc/db/host")] != "orders.db.internal" {
+		t.Errorf("unexpected config map data %+v", data)
+	}
+
+	if got := SecretReferences(injections); len(got) != 1 || got[0] != "orders-db-credentials" {
+		t.Errorf("unexpected secret references %v", got)
+	}
+}
+
+func TestInjectErrors(t *testing.T) {
+	t.Parallel()
+
+	resolved, err := FromResourceBinding(databaseBinding())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		value string
+		want  string
+	}{
+		"secret property in a larger template": {
+			value: "postgres://{{ .username }}:{{ .password }}@{{ .host }}",
+			want:  `secret property "password" must be injected on its own`,
+		},
+		"property not provided by the binding": {
+			value: "{{ .database }}",
+			want:  `property "database" is not provided`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Inject(databaseConnection(map[string]string{"VALUE": tt.value}, nil), resolved)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestFromEndpoint(t *testing.T) {
+	t.Parallel()
+
+	resolved := FromEndpoint(&openchoreov1alpha1.EndpointAccess{Host: "cart", Port: 8080, Scheme: "http", URI: "http://cart:8080"})
+	conn := openchoreov1alpha1.WorkloadConnection{
+		Type:   openchoreov1alpha1.ConnectionTypeAPI,
+		Params: map[string]string{"componentName": "cart", "endpoint": "http"},
+		Inject: openchoreov1alpha1.WorkloadConnectionInject{
+			Env: []openchoreov1alpha1.WorkloadConnectionEnvVar{{Name: "CART_URL", Value: "{{ .url }}/v1"}},
+		},
+	}
+	if err := Validate(conn); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	injection, err := Inject(conn, resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if injection.Env[0].Value != "http://cart:8080/v1" {
+		t.Errorf("unexpected value %q", injection.Env[0].Value)
+	}
+}
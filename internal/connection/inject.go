@@ -0,0 +1,205 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+const (
+	filesVolumeName = "connection-files"
+	fileKeyLength   = 8
+)
+
+// soleProperty matches a template that consists of a single property, e.g. "{{ .password }}"
+var soleProperty = regexp.MustCompile(`^\s*\{\{-?\s*\.[A-Za-z_][A-Za-z0-9_]*\s*-?\}\}\s*$`)
+
+// EnvVar is an environment variable injected for a connection.
+// Secret is set instead of Value when the variable is read from a secret.
+type EnvVar struct {
+	Name   string
+	Value  string
+	Secret *SecretKeyRef
+}
+
+// File is a file injected for a connection.
+// Secret is set instead of Content when the file is read from a secret.
+type File struct {
+	MountPath string
+	Content   string
+	Secret    *SecretKeyRef
+}
+
+// Injection is what gets injected into a workload for a connection
+type Injection struct {
+	Env   []EnvVar
+	Files []File
+}
+
+// Inject renders the injection templates of the connection with its resolved properties.
+// A template that uses a secret property must consist of that property alone, since secret
+// values are never rendered by the control plane but referenced from the secret in the data plane.
+func Inject(conn openchoreov1alpha1.WorkloadConnection, resolved *Resolved) (*Injection, error) {
+	injection := &Injection{}
+	for _, env := range conn.Inject.Env {
+		value, secret, err := render(env.Value, resolved)
+		if err != nil {
+			return nil, fmt.Errorf("env var %q: %w", env.Name, err)
+		}
+		injection.Env = append(injection.Env, EnvVar{Name: env.Name, Value: value, Secret: secret})
+	}
+	for _, file := range conn.Inject.Files {
+		content, secret, err := render(file.Value, resolved)
+		if err != nil {
+			return nil, fmt.Errorf("file %q: %w", file.MountPath, err)
+		}
+		injection.Files = append(injection.Files, File{MountPath: file.MountPath, Content: content, Secret: secret})
+	}
+	return injection, nil
+}
+
+func render(text string, resolved *Resolved) (string, *SecretKeyRef, error) {
+	fields, err := templateFields(text)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, field := range fields {
+		if secret, ok := resolved.Secrets[field]; ok {
+			if len(fields) != 1 || !soleProperty.MatchString(text) {
+				return "", nil, fmt.Errorf("secret property %q must be injected on its own as {{ .%s }}", field, field)
+			}
+			return "", &secret, nil
+		}
+		if _, ok := resolved.Values[field]; !ok {
+			return "", nil, fmt.Errorf("property %q is not provided by the connection target", field)
+		}
+	}
+
+	tmpl, err := template.New("connection").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, resolved.Values); err != nil {
+		return "", nil, fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.String(), nil, nil
+}
+
+// SecretReferences returns the names of the SecretReferences used by the injections, in order.
+func SecretReferences(injections map[string]*Injection) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(ref *SecretKeyRef) {
+		if ref != nil && !seen[ref.SecretReference] {
+			seen[ref.SecretReference] = true
+			names = append(names, ref.SecretReference)
+		}
+	}
+	for _, injection := range injections {
+		for _, env := range injection.Env {
+			add(env.Secret)
+		}
+		for _, file := range injection.Files {
+			add(file.Secret)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EnvVars returns the environment variables of the injections ordered by connection name.
+// secretName maps a SecretReference to the name of the secret created from it in the data plane.
+func EnvVars(injections map[string]*Injection, secretName func(secretRef string) string) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	for _, name := range sortedNames(injections) {
+		for _, env := range injections[name].Env {
+			if env.Secret == nil {
+				envVars = append(envVars, corev1.EnvVar{Name: env.Name, Value: env.Value})
+				continue
+			}
+			envVars = append(envVars, corev1.EnvVar{
+				Name: env.Name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName(env.Secret.SecretReference)},
+						Key:                  env.Secret.Key,
+					},
+				},
+			})
+		}
+	}
+	return envVars
+}
+
+// Files returns the volumes and mounts of the files of the injections ordered by connection name.
+// Plain files are mounted from the config map with the returned data, which is empty if there are none.
+// Secret files are mounted from the secrets created from their SecretReferences.
+func Files(injections map[string]*Injection, configMapName string,
+	secretName func(secretRef string) string) ([]corev1.Volume, []corev1.VolumeMount, map[string]string) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	data := make(map[string]string)
+	secretVolumes := make(map[string]string)
+
+	for _, name := range sortedNames(injections) {
+		for _, file := range injections[name].Files {
+			if file.Secret == nil {
+				key := fileKey(file.MountPath)
+				data[key] = file.Content
+				mounts = append(mounts, corev1.VolumeMount{Name: filesVolumeName, MountPath: file.MountPath, SubPath: key, ReadOnly: true})
+				continue
+			}
+
+			secret := secretName(file.Secret.SecretReference)
+			volumeName, ok := secretVolumes[secret]
+			if !ok {
+				volumeName = dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxVolumeNameLength, "connection", file.Secret.SecretReference)
+				secretVolumes[secret] = volumeName
+				volumes = append(volumes, corev1.Volume{
+					Name:         volumeName,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret}},
+				})
+			}
+			mounts = append(mounts, corev1.VolumeMount{Name: volumeName, MountPath: file.MountPath, SubPath: file.Secret.Key, ReadOnly: true})
+		}
+	}
+
+	if len(data) > 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: filesVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMapName}},
+			},
+		})
+	}
+	return volumes, mounts, data
+}
+
+// fileKey returns the config map key of a file, derived from its mount path
+func fileKey(mountPath string) string {
+	hash := sha256.Sum256([]byte(mountPath))
+	return "file-" + hex.EncodeToString(hash[:])[:fileKeyLength]
+}
+
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// SecretKeyRef refers to a key of the secret created from a SecretReference
+type SecretKeyRef struct {
+	SecretReference string
+	Key             string
+}
+
+// Resolved holds the property values a connection resolved to in an environment
+type Resolved struct {
+	// Values are the plain property values
+	Values map[string]string
+	// Secrets are the properties whose values are only available in a secret
+	Secrets map[string]SecretKeyRef
+}
+
+// FromEndpoint resolves an API connection to the endpoint of the target component.
+func FromEndpoint(endpoint *openchoreov1alpha1.EndpointAccess) *Resolved {
+	return &Resolved{
+		Values: map[string]string{
+			"host":     endpoint.Host,
+			"port":     fmt.Sprintf("%d", endpoint.Port),
+			"scheme":   endpoint.Scheme,
+			"basePath": endpoint.BasePath,
+			"uri":      endpoint.URI,
+			"url":      endpoint.URI, // Common alias for uri
+		},
+	}
+}
+
+// FromResourceBinding resolves a connection to the properties of the resource binding.
+// The binding must provide every required property of its type, only properties of the type,
+// and sensitive properties only as secret properties.
+func FromResourceBinding(binding *openchoreov1alpha1.ResourceBinding) (*Resolved, error) {
+	schema, ok := SchemaOf(binding.Spec.Type)
+	if !ok || binding.Spec.Type == openchoreov1alpha1.ConnectionTypeAPI {
+		return nil, fmt.Errorf("resource binding %q has unsupported type %q", binding.Name, binding.Spec.Type)
+	}
+
+	var errs []error
+	resolved := &Resolved{
+		Values:  make(map[string]string, len(binding.Spec.Properties)),
+		Secrets: make(map[string]SecretKeyRef, len(binding.Spec.SecretProperties)),
+	}
+	for _, name := range sortedNames(binding.Spec.Properties) {
+		value := binding.Spec.Properties[name]
+		prop, ok := schema[name]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("property %q is not a %s property", name, binding.Spec.Type))
+		case prop.Sensitive:
+			errs = append(errs, fmt.Errorf("property %q is sensitive and must be a secret property", name))
+		default:
+			resolved.Values[name] = value
+		}
+	}
+	for _, name := range sortedNames(binding.Spec.SecretProperties) {
+		ref := binding.Spec.SecretProperties[name]
+		if _, ok := schema[name]; !ok {
+			errs = append(errs, fmt.Errorf("secret property %q is not a %s property", name, binding.Spec.Type))
+			continue
+		}
+		if _, ok := resolved.Values[name]; ok {
+			errs = append(errs, fmt.Errorf("property %q is defined both as a property and a secret property", name))
+			continue
+		}
+		resolved.Secrets[name] = SecretKeyRef{SecretReference: ref.SecretReference, Key: ref.Key}
+	}
+	for _, name := range schema.Names() {
+		if !schema[name].Required {
+			continue
+		}
+		_, isValue := resolved.Values[name]
+		_, isSecret := resolved.Secrets[name]
+		if !isValue && !isSecret {
+			errs = append(errs, fmt.Errorf("required %s property %q is not provided", binding.Spec.Type, name))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid resource binding %q: %w", binding.Name, err)
+	}
+	return resolved, nil
+}
+
+// FindResourceBinding returns the binding of the resource of the given type in the environment.
+// It returns nil if the platform hasn't bound the resource in the environment.
+func FindResourceBinding(ctx context.Context, c client.Reader, namespace, connectionType, resourceName,
+	environment string) (*openchoreov1alpha1.ResourceBinding, error) {
+	bindingList := &openchoreov1alpha1.ResourceBindingList{}
+	if err := c.List(ctx, bindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list resource bindings: %w", err)
+	}
+
+	for _, binding := range bindingList.Items {
+		if binding.Spec.ResourceName == resourceName && binding.Spec.Environment == environment &&
+			binding.Spec.Type == connectionType {
+			return &binding, nil
+		}
+	}
+	return nil, nil
+}
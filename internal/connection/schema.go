@@ -0,0 +1,81 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package connection resolves the connections of workloads to the properties of the API or
+// resource they connect to, and renders how those properties are injected into the workload.
+package connection
+
+import (
+	"sort"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Connection params
+const (
+	// ParamResource is the name of the resource a non-API connection is bound to
+	ParamResource = "resource"
+)
+
+// Property is a property exposed by a connection type
+type Property struct {
+	// Required properties must be provided by every binding of the type
+	Required bool
+	// Sensitive properties can only be provided as secret properties of a binding
+	Sensitive bool
+}
+
+// Schema is the set of properties exposed by a connection type
+type Schema map[string]Property
+
+// Names returns the property names of the schema in order.
+func (s Schema) Names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var schemas = map[string]Schema{
+	openchoreov1alpha1.ConnectionTypeAPI: {
+		"host":     {Required: true},
+		"port":     {Required: true},
+		"scheme":   {},
+		"basePath": {},
+		"uri":      {},
+		"url":      {},
+	},
+	openchoreov1alpha1.ConnectionTypeDatabase: {
+		"host":     {Required: true},
+		"port":     {Required: true},
+		"database": {},
+		"username": {},
+		"password": {Sensitive: true},
+	},
+	openchoreov1alpha1.ConnectionTypeMessageQueue: {
+		"brokers":  {Required: true},
+		"topic":    {},
+		"username": {},
+		"password": {Sensitive: true},
+	},
+	openchoreov1alpha1.ConnectionTypeObjectStorage: {
+		"endpoint":        {Required: true},
+		"bucket":          {Required: true},
+		"region":          {},
+		"accessKeyId":     {},
+		"secretAccessKey": {Sensitive: true},
+	},
+	openchoreov1alpha1.ConnectionTypeExternal: {
+		"url":    {Required: true},
+		"apiKey": {Sensitive: true},
+		"token":  {Sensitive: true},
+	},
+}
+
+// SchemaOf returns the schema of a connection type.
+func SchemaOf(connectionType string) (Schema, bool) {
+	s, ok := schemas[connectionType]
+	return s, ok
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Validate checks that the connection is of a known type, has the params needed to resolve it
+// and that every property used in its injection templates is exposed by its type.
+func Validate(conn openchoreov1alpha1.WorkloadConnection) error {
+	schema, ok := SchemaOf(conn.Type)
+	if !ok {
+		return fmt.Errorf("unsupported connection type %q", conn.Type)
+	}
+
+	var errs []error
+	if conn.Type == openchoreov1alpha1.ConnectionTypeAPI {
+		for _, param := range []string{"componentName", "endpoint"} {
+			if conn.Params[param] == "" {
+				errs = append(errs, fmt.Errorf("param %q is required for %s connections", param, conn.Type))
+			}
+		}
+	} else if conn.Params[ParamResource] == "" {
+		errs = append(errs, fmt.Errorf("param %q is required for %s connections", ParamResource, conn.Type))
+	}
+
+	if len(conn.Inject.Env) == 0 && len(conn.Inject.Files) == 0 {
+		errs = append(errs, errors.New("at least one env var or file must be injected"))
+	}
+
+	envNames := make(map[string]bool)
+	for _, env := range conn.Inject.Env {
+		if env.Name == "" {
+			errs = append(errs, errors.New("env var name must not be empty"))
+		} else if envNames[env.Name] {
+			errs = append(errs, fmt.Errorf("env var %q is injected more than once", env.Name))
+		}
+		envNames[env.Name] = true
+		if err := validateTemplate(schema, env.Value); err != nil {
+			errs = append(errs, fmt.Errorf("env var %q: %w", env.Name, err))
+		}
+	}
+
+	mountPaths := make(map[string]bool)
+	for _, file := range conn.Inject.Files {
+		if !path.IsAbs(file.MountPath) {
+			errs = append(errs, fmt.Errorf("file mount path %q must be absolute", file.MountPath))
+		} else if mountPaths[file.MountPath] {
+			errs = append(errs, fmt.Errorf("file %q is injected more than once", file.MountPath))
+		}
+		mountPaths[file.MountPath] = true
+		if err := validateTemplate(schema, file.Value); err != nil {
+			errs = append(errs, fmt.Errorf("file %q: %w", file.MountPath, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateTemplate checks that every property used in the template is part of the schema.
+func validateTemplate(schema Schema, text string) error {
+	fields, err := templateFields(text)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if _, ok := schema[field]; !ok {
+			return fmt.Errorf("unknown property %q, must be one of %s", field, strings.Join(schema.Names(), ", "))
+		}
+	}
+	return nil
+}
+
+// templateFields returns the top level fields referenced by a template, e.g. "url" for "{{ .url }}".
+func templateFields(text string) ([]string, error) {
+	tmpl, err := template.New("connection").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	if tmpl.Tree == nil {
+		return nil, nil
+	}
+
+	var fields []string
+	seen := make(map[string]bool)
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				for _, arg := range cmd.Args {
+					walk(arg)
+				}
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			if !seen[n.Ident[0]] {
+				seen[n.Ident[0]] = true
+				fields = append(fields, n.Ident[0])
+			}
+		}
+	}
+	walk(tmpl.Tree.Root)
+	return fields, nil
+}
@@ -8,4 +8,13 @@ package controller
 const (
 	AnnotationKeyDisplayName = "openchoreo.dev/display-name"
 	AnnotationKeyDescription = "openchoreo.dev/description"
+
+	// AnnotationKeyRolloutPromote promotes the rollout of the given revision, skipping any remaining steps or pauses.
+	AnnotationKeyRolloutPromote = "openchoreo.dev/rollout-promote"
+
+	// AnnotationKeyRolloutAbort aborts the rollout of the given revision and restores the stable revision.
+	AnnotationKeyRolloutAbort = "openchoreo.dev/rollout-abort"
+
+	// AnnotationKeySnapshotGeneration is the generation of the ComponentEnvSnapshot a Release was rendered from.
+	AnnotationKeySnapshotGeneration = "openchoreo.dev/component-env-snapshot-generation"
 )
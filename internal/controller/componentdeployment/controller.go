@@ -6,7 +6,9 @@ package componentdeployment
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,6 +24,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/componentdeployment/rollout"
 	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
@@ -36,6 +39,10 @@ type Reconciler struct {
 	// Pipeline is the component rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different component types and reconciliations.
 	Pipeline *componentpipeline.Pipeline
+
+	// MetricsProviderFactory creates the metrics provider used for rollout analysis.
+	// Defaults to the observer configured on the DataPlane.
+	MetricsProviderFactory func(dataPlane *openchoreov1alpha1.DataPlane) rollout.MetricsProvider
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -162,12 +169,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	}
 
 	// Create or update Release
-	if err := r.reconcileRelease(ctx, componentDeployment, snapshot, environment, dataPlane); err != nil {
+	result, err = r.reconcileRelease(ctx, componentDeployment, snapshot, environment, dataPlane)
+	if err != nil {
 		logger.Error(err, "Failed to reconcile Release")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 // findSnapshot finds the ComponentEnvSnapshot for the given ComponentDeployment by owner fields
@@ -368,7 +376,7 @@ func (r *Reconciler) collectSecretReferences(ctx context.Context, snapshot *open
 
 // reconcileRelease creates or updates the Release resource
 func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment, snapshot *openchoreov1alpha1.ComponentEnvSnapshot,
-	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane) error {
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Build MetadataContext with computed names
@@ -381,7 +389,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		controller.MarkFalseCondition(componentDeployment, ConditionReady,
 			ReasonRenderingFailed, msg)
 		logger.Error(err, "Failed to collect SecretReferences")
-		return fmt.Errorf("failed to collect SecretReferences: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to collect SecretReferences: %w", err)
 	}
 
 	// Prepare RenderInput
@@ -405,7 +413,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		controller.MarkFalseCondition(componentDeployment, ConditionReady,
 			ReasonRenderingFailed, msg)
 		logger.Error(err, "Failed to render resources")
-		return fmt.Errorf("failed to render resources: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to render resources: %w", err)
 	}
 
 	// Log warnings if any
@@ -414,8 +422,23 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 			"warnings", renderOutput.Metadata.Warnings)
 	}
 
+	// Apply the rollout strategy to progressively deliver the workload
+	resources := renderOutput.Resources
+	var requeueAfter time.Duration
+	if componentDeployment.Spec.Rollout != nil {
+		rolloutResult, err := r.reconcileRollout(ctx, componentDeployment, dataPlane, metadataContext.Namespace, resources)
+		if err != nil {
+			logger.Error(err, "Failed to reconcile rollout")
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile rollout: %w", err)
+		}
+		resources = rolloutResult.resources
+		requeueAfter = rolloutResult.requeueAfter
+	} else {
+		componentDeployment.Status.Rollout = nil
+	}
+
 	// Convert rendered resources to Release format
-	releaseResources := r.convertToReleaseResources(renderOutput.Resources)
+	releaseResources := r.convertToReleaseResources(resources)
 
 	// Create or update Release
 	release := &openchoreov1alpha1.Release{
@@ -445,6 +468,8 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 			labels.LabelKeyComponentName:    componentDeployment.Spec.Owner.ComponentName,
 			labels.LabelKeyEnvironmentName:  componentDeployment.Spec.Environment,
 		}
+		metav1.SetMetaDataAnnotation(&release.ObjectMeta, controller.AnnotationKeySnapshotGeneration,
+			strconv.FormatInt(snapshot.Generation, 10))
 
 		// Set spec
 		release.Spec = openchoreov1alpha1.ReleaseSpec{
@@ -466,7 +491,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 			controller.MarkFalseCondition(componentDeployment, ConditionReady,
 				ReasonReleaseOwnershipConflict, msg)
 			logger.Error(err, msg)
-			return nil
+			return ctrl.Result{}, nil
 		}
 
 		// Transient errors - return error to trigger automatic retry
@@ -479,7 +504,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		msg := fmt.Sprintf("Failed to reconcile Release: %v", err)
 		controller.MarkFalseCondition(componentDeployment, ConditionReady, reason, msg)
 		logger.Error(err, "Failed to reconcile Release", "release", release.Name)
-		return err
+		return ctrl.Result{}, err
 	}
 
 	// Success - mark as ready
@@ -494,7 +519,12 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 			"resourceCount", len(releaseResources))
 	}
 
-	return nil
+	// An aborted rollout keeps serving the stable revision, but the requested revision is not deployed
+	if rs := componentDeployment.Status.Rollout; rs != nil && rs.Phase == openchoreov1alpha1.RolloutPhaseAborted {
+		controller.MarkFalseCondition(componentDeployment, ConditionReady, ReasonRolloutAborted, rs.Message)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // convertToReleaseResources converts unstructured resources to Release.Resource format
@@ -60,4 +60,9 @@ const (
 	ReasonResourcesProgressing controller.ConditionReason = "ResourcesProgressing"
 	// ReasonResourcesDegraded indicates one or more resources are in error state
 	ReasonResourcesDegraded controller.ConditionReason = "ResourcesDegraded"
+
+	// Progressive delivery issues (Status=False)
+
+	// ReasonRolloutAborted indicates the rollout of the current revision failed and the stable revision was restored
+	ReasonRolloutAborted controller.ConditionReason = "RolloutAborted"
 )
@@ -0,0 +1,472 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/componentdeployment/rollout"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// rolloutProgressCheckInterval is how often an in-progress rollout is re-evaluated while waiting
+	// for the new revision to become healthy.
+	rolloutProgressCheckInterval = 10 * time.Second
+	// analysisRetryInterval is how often the analysis of a rollout is retried after its metrics could not be queried.
+	analysisRetryInterval = 10 * time.Second
+)
+
+// rolloutResult is the outcome of evaluating the rollout strategy for a reconciliation.
+type rolloutResult struct {
+	// resources are the resources to place in the Release
+	resources []map[string]any
+	// requeueAfter is when the rollout should be re-evaluated, zero if no re-evaluation is needed
+	requeueAfter time.Duration
+}
+
+// reconcileRollout applies the progressive delivery strategy of the ComponentDeployment to the rendered resources.
+// The stable workload is taken from the current Release so that it keeps serving until the new revision is promoted.
+func (r *Reconciler) reconcileRollout(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment,
+	dataPlane *openchoreov1alpha1.DataPlane, metadataNamespace string, rendered []map[string]any) (*rolloutResult, error) {
+	logger := log.FromContext(ctx)
+
+	status := componentDeployment.Status.Rollout
+	if status == nil {
+		status = &openchoreov1alpha1.RolloutStatus{}
+	}
+	defer func() { componentDeployment.Status.Rollout = status }()
+
+	w := rollout.FindWorkload(rendered)
+	if w < 0 {
+		status.Phase = openchoreov1alpha1.RolloutPhaseHealthy
+		status.Message = "No Deployment rendered; rollout strategy is not applied"
+		return &rolloutResult{resources: rendered}, nil
+	}
+	workloadName := nameOf(rendered[w])
+
+	revision, err := rollout.Revision(rendered[w])
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.getRelease(ctx, componentDeployment)
+	if err != nil {
+		return nil, err
+	}
+	var deployedWorkload map[string]any
+	if release != nil {
+		current, err := decodeReleaseResources(release)
+		if err != nil {
+			return nil, err
+		}
+		deployedWorkload = rollout.FindWorkloadByName(current, workloadName)
+	}
+
+	// Initial deployment or no change to the workload: deploy directly
+	if deployedWorkload == nil || status.StableRevision == "" || revision == status.StableRevision {
+		*status = openchoreov1alpha1.RolloutStatus{
+			Phase:           openchoreov1alpha1.RolloutPhaseHealthy,
+			StableRevision:  revision,
+			AbortedRevision: status.AbortedRevision,
+			Message:         fmt.Sprintf("Revision %s is stable", revision),
+		}
+		return &rolloutResult{resources: rollout.Stable(rendered, deployedWorkload)}, nil
+	}
+
+	// Once a blue-green rollout has switched, the deployed workload runs the new revision and
+	// the stable revision is only available from the status
+	stableWorkload := deployedWorkload
+	if status.StableWorkload != nil {
+		stableWorkload = map[string]any{}
+		if err := json.Unmarshal(status.StableWorkload.Raw, &stableWorkload); err != nil {
+			return nil, fmt.Errorf("failed to decode the stable workload of the rollout: %w", err)
+		}
+	}
+
+	if revision == status.AbortedRevision {
+		return &rolloutResult{resources: rollout.Rollback(rendered, stableWorkload)}, nil
+	}
+
+	if status.CurrentRevision != revision {
+		logger.Info("Starting rollout", "strategy", componentDeployment.Spec.Rollout.Type,
+			"stableRevision", status.StableRevision, "revision", revision)
+		status.CurrentRevision = revision
+		status.CurrentStep = 0
+		status.CurrentWeight = 0
+		status.StepStartedAt = nil
+		status.AnalysisFailures = 0
+		status.AnalysisErrors = 0
+		status.LastAnalysisTime = nil
+		status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		status.Message = fmt.Sprintf("Rolling out revision %s", revision)
+	}
+
+	rs := &rolloutState{
+		componentDeployment: componentDeployment,
+		dataPlane:           dataPlane,
+		namespace:           metadataNamespace,
+		status:              status,
+		revision:            revision,
+		rendered:            rendered,
+		stableWorkload:      stableWorkload,
+		workloadName:        workloadName,
+		release:             release,
+	}
+
+	if componentDeployment.Annotations[controller.AnnotationKeyRolloutAbort] == revision {
+		return rs.abort(ctx, "Rollout aborted manually"), nil
+	}
+
+	switch componentDeployment.Spec.Rollout.Type {
+	case openchoreov1alpha1.RolloutStrategyBlueGreen:
+		return r.progressBlueGreen(ctx, rs)
+	default:
+		return r.progressCanary(ctx, rs), nil
+	}
+}
+
+// rolloutState carries the inputs of a single rollout evaluation.
+type rolloutState struct {
+	componentDeployment *openchoreov1alpha1.ComponentDeployment
+	dataPlane           *openchoreov1alpha1.DataPlane
+	namespace           string
+	status              *openchoreov1alpha1.RolloutStatus
+	revision            string
+	rendered            []map[string]any
+	stableWorkload      map[string]any
+	workloadName        string
+	release             *openchoreov1alpha1.Release
+}
+
+func (rs *rolloutState) promoteRequested() bool {
+	return rs.componentDeployment.Annotations[controller.AnnotationKeyRolloutPromote] == rs.revision
+}
+
+// complete makes the new revision stable.
+func (rs *rolloutState) complete(ctx context.Context) *rolloutResult {
+	log.FromContext(ctx).Info("Rollout completed", "revision", rs.revision)
+	*rs.status = openchoreov1alpha1.RolloutStatus{
+		Phase:           openchoreov1alpha1.RolloutPhaseHealthy,
+		StableRevision:  rs.revision,
+		AbortedRevision: rs.status.AbortedRevision,
+		Message:         fmt.Sprintf("Revision %s is stable", rs.revision),
+	}
+	return &rolloutResult{resources: rollout.Stable(rs.rendered, rs.stableWorkload)}
+}
+
+// abort restores the stable revision. The aborted revision is not retried until a different revision is rendered.
+func (rs *rolloutState) abort(ctx context.Context, reason string) *rolloutResult {
+	log.FromContext(ctx).Info("Rollout aborted", "revision", rs.revision, "reason", reason)
+	*rs.status = openchoreov1alpha1.RolloutStatus{
+		Phase:           openchoreov1alpha1.RolloutPhaseAborted,
+		StableRevision:  rs.status.StableRevision,
+		StableWorkload:  rs.status.StableWorkload,
+		AbortedRevision: rs.revision,
+		Message:         fmt.Sprintf("Rollout of revision %s aborted: %s", rs.revision, reason),
+	}
+	return &rolloutResult{resources: rollout.Rollback(rs.rendered, rs.stableWorkload)}
+}
+
+// progressCanary advances a canary rollout through its traffic steps.
+func (r *Reconciler) progressCanary(ctx context.Context, rs *rolloutState) *rolloutResult {
+	var steps []openchoreov1alpha1.CanaryStep
+	if rs.componentDeployment.Spec.Rollout.Canary != nil {
+		steps = rs.componentDeployment.Spec.Rollout.Canary.Steps
+	}
+	if int(rs.status.CurrentStep) >= len(steps) || rs.promoteRequested() {
+		return rs.complete(ctx)
+	}
+
+	step := steps[rs.status.CurrentStep]
+	rs.status.CurrentWeight = step.Weight
+	resources := rollout.Canary(rs.rendered, rs.stableWorkload, step.Weight)
+
+	health := r.workloadHealth(rs.release, rollout.CanaryName(rs.workloadName))
+	switch health {
+	case openchoreov1alpha1.HealthStatusDegraded:
+		return rs.abort(ctx, "canary workload is degraded")
+	case openchoreov1alpha1.HealthStatusHealthy:
+	default:
+		rs.status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		rs.status.Message = fmt.Sprintf("Waiting for canary of revision %s to become healthy at step %d (weight %d%%)",
+			rs.revision, rs.status.CurrentStep+1, step.Weight)
+		return &rolloutResult{resources: resources, requeueAfter: rolloutProgressCheckInterval}
+	}
+
+	now := time.Now()
+	if rs.status.StepStartedAt == nil {
+		rs.status.StepStartedAt = &metav1.Time{Time: now}
+	}
+
+	analysis := r.analyze(ctx, rs, rollout.TrackCanary, now)
+	if analysis.failure != "" {
+		return rs.abort(ctx, analysis.failure)
+	}
+	if analysis.inconclusive {
+		rs.status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		rs.status.Message = fmt.Sprintf("Holding canary of revision %s at step %d (weight %d%%): %s",
+			rs.revision, rs.status.CurrentStep+1, step.Weight, analysis.reason)
+		return &rolloutResult{resources: resources, requeueAfter: analysis.next}
+	}
+
+	var pause time.Duration
+	if step.Pause != nil {
+		pause = step.Pause.Duration
+	}
+	if remaining := pause - now.Sub(rs.status.StepStartedAt.Time); remaining > 0 {
+		rs.status.Phase = openchoreov1alpha1.RolloutPhasePaused
+		rs.status.Message = fmt.Sprintf("Canary of revision %s at step %d (weight %d%%), paused for %s",
+			rs.revision, rs.status.CurrentStep+1, step.Weight, remaining.Round(time.Second))
+		return &rolloutResult{resources: resources, requeueAfter: minPositive(remaining, analysis.next)}
+	}
+
+	// Move to the next step
+	rs.status.CurrentStep++
+	rs.status.StepStartedAt = nil
+	if int(rs.status.CurrentStep) >= len(steps) {
+		return rs.complete(ctx)
+	}
+	next := steps[rs.status.CurrentStep]
+	rs.status.CurrentWeight = next.Weight
+	rs.status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+	rs.status.Message = fmt.Sprintf("Canary of revision %s advanced to step %d (weight %d%%)",
+		rs.revision, rs.status.CurrentStep+1, next.Weight)
+	return &rolloutResult{
+		resources:    rollout.Canary(rs.rendered, rs.stableWorkload, next.Weight),
+		requeueAfter: rolloutProgressCheckInterval,
+	}
+}
+
+// progressBlueGreen runs the new revision as a preview and switches traffic once it is promoted.
+// The stable workload is recorded in the status on the switch, as it is replaced by the new revision.
+func (r *Reconciler) progressBlueGreen(ctx context.Context, rs *rolloutState) (*rolloutResult, error) {
+	now := time.Now()
+
+	if rs.status.Phase == openchoreov1alpha1.RolloutPhaseSwitching {
+		// Traffic is served by the preview pods while the stable workload is updated
+		resources := rollout.Switch(rs.rendered)
+		switch r.workloadHealth(rs.release, rs.workloadName) {
+		case openchoreov1alpha1.HealthStatusDegraded:
+			return rs.abort(ctx, "stable workload is degraded after the switch"), nil
+		case openchoreov1alpha1.HealthStatusHealthy:
+			// Give the Release controller one cycle to apply the new template before trusting its health
+			if rs.status.StepStartedAt != nil && now.Sub(rs.status.StepStartedAt.Time) >= rolloutProgressCheckInterval {
+				return rs.complete(ctx), nil
+			}
+		}
+		return &rolloutResult{resources: resources, requeueAfter: rolloutProgressCheckInterval}, nil
+	}
+
+	resources := rollout.Preview(rs.rendered, rs.stableWorkload)
+	switch r.workloadHealth(rs.release, rollout.PreviewName(rs.workloadName)) {
+	case openchoreov1alpha1.HealthStatusDegraded:
+		return rs.abort(ctx, "preview workload is degraded"), nil
+	case openchoreov1alpha1.HealthStatusHealthy:
+	default:
+		rs.status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		rs.status.Message = fmt.Sprintf("Waiting for preview of revision %s to become healthy", rs.revision)
+		return &rolloutResult{resources: resources, requeueAfter: rolloutProgressCheckInterval}, nil
+	}
+
+	if rs.status.StepStartedAt == nil {
+		rs.status.StepStartedAt = &metav1.Time{Time: now}
+	}
+
+	analysis := r.analyze(ctx, rs, rollout.TrackPreview, now)
+	if analysis.failure != "" {
+		return rs.abort(ctx, analysis.failure), nil
+	}
+	if analysis.inconclusive {
+		rs.status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		rs.status.Message = fmt.Sprintf("Holding preview of revision %s: %s", rs.revision, analysis.reason)
+		return &rolloutResult{resources: resources, requeueAfter: analysis.next}, nil
+	}
+
+	promote := rs.promoteRequested()
+	var remaining time.Duration
+	if bg := rs.componentDeployment.Spec.Rollout.BlueGreen; !promote && bg != nil && bg.AutoPromotionDelay != nil {
+		remaining = bg.AutoPromotionDelay.Duration - now.Sub(rs.status.StepStartedAt.Time)
+		promote = remaining <= 0
+	}
+
+	if promote {
+		stableWorkload, err := json.Marshal(rs.stableWorkload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the stable workload of the rollout: %w", err)
+		}
+		rs.status.StableWorkload = &runtime.RawExtension{Raw: stableWorkload}
+		rs.status.Phase = openchoreov1alpha1.RolloutPhaseSwitching
+		rs.status.StepStartedAt = &metav1.Time{Time: now}
+		rs.status.CurrentWeight = 100
+		rs.status.Message = fmt.Sprintf("Switched traffic to revision %s", rs.revision)
+		return &rolloutResult{resources: rollout.Switch(rs.rendered), requeueAfter: rolloutProgressCheckInterval}, nil
+	}
+
+	rs.status.Phase = openchoreov1alpha1.RolloutPhasePaused
+	if remaining > 0 {
+		rs.status.Message = fmt.Sprintf("Preview of revision %s is healthy, switching in %s", rs.revision, remaining.Round(time.Second))
+	} else {
+		rs.status.Message = fmt.Sprintf("Preview of revision %s is healthy, set annotation %s=%s to switch traffic",
+			rs.revision, controller.AnnotationKeyRolloutPromote, rs.revision)
+	}
+	return &rolloutResult{resources: resources, requeueAfter: minPositive(remaining, analysis.next)}, nil
+}
+
+// analysisResult is the outcome of the metric analysis of a rollout track.
+type analysisResult struct {
+	// next is when the next analysis is due, zero if analysis is not configured
+	next time.Duration
+	// failure is the reason to abort the rollout, empty if it may go on
+	failure string
+	// inconclusive is set while the metrics of the analysis can't be queried, which holds the rollout
+	inconclusive bool
+	// reason describes why the analysis is inconclusive
+	reason string
+}
+
+// analyze runs the metric analysis for the rollout track when it is due.
+// Metrics that fail the thresholds count towards the failure limit of the analysis. Metrics that can't be
+// queried make the analysis inconclusive and are retried, counting towards the consecutive error limit instead.
+func (r *Reconciler) analyze(ctx context.Context, rs *rolloutState, track string, now time.Time) analysisResult {
+	logger := log.FromContext(ctx)
+
+	analysis := rs.componentDeployment.Spec.Rollout.Analysis
+	if analysis == nil {
+		return analysisResult{}
+	}
+	interval := rollout.AnalysisInterval(analysis)
+	if rs.status.LastAnalysisTime != nil {
+		if rs.status.AnalysisErrors > 0 {
+			if wait := analysisRetryInterval - now.Sub(rs.status.LastAnalysisTime.Time); wait > 0 {
+				return analysisResult{next: wait, inconclusive: true, reason: "retrying the metrics query of the analysis"}
+			}
+		} else if wait := interval - now.Sub(rs.status.LastAnalysisTime.Time); wait > 0 {
+			return analysisResult{next: wait}
+		}
+	}
+	if rs.dataPlane.Spec.Observer.URL == "" {
+		return analysisResult{failure: fmt.Sprintf("analysis is configured but DataPlane %q has no observer", rs.dataPlane.Name)}
+	}
+
+	start := now.Add(-interval)
+	if rs.status.StepStartedAt != nil && rs.status.StepStartedAt.After(start) {
+		start = rs.status.StepStartedAt.Time
+	}
+
+	provider := r.metricsProvider(rs.dataPlane)
+	metrics, err := provider.QueryMetrics(ctx, rollout.MetricsQuery{
+		ComponentName:   rs.componentDeployment.Spec.Owner.ComponentName,
+		ProjectName:     rs.componentDeployment.Spec.Owner.ProjectName,
+		EnvironmentName: rs.componentDeployment.Spec.Environment,
+		Namespace:       rs.namespace,
+		PodLabels:       map[string]string{labels.LabelKeyRolloutTrack: track},
+		Start:           start,
+		End:             now,
+	})
+	rs.status.LastAnalysisTime = &metav1.Time{Time: now}
+
+	if err != nil {
+		rs.status.AnalysisErrors++
+		logger.Error(err, "Failed to query rollout metrics", "revision", rs.revision, "errors", rs.status.AnalysisErrors)
+		if limit := rollout.ConsecutiveErrorLimit(analysis); rs.status.AnalysisErrors > limit {
+			return analysisResult{failure: fmt.Sprintf("metrics query failed %d time(s) in a row: %v", rs.status.AnalysisErrors, err)}
+		}
+		return analysisResult{next: analysisRetryInterval, inconclusive: true, reason: fmt.Sprintf("metrics query failed: %v", err)}
+	}
+	rs.status.AnalysisErrors = 0
+
+	failure := rollout.Evaluate(analysis, metrics)
+	if failure == "" {
+		return analysisResult{next: interval}
+	}
+
+	rs.status.AnalysisFailures++
+	logger.Info("Rollout analysis failed", "revision", rs.revision, "failures", rs.status.AnalysisFailures, "reason", failure)
+	if rs.status.AnalysisFailures > analysis.FailureLimit {
+		return analysisResult{failure: fmt.Sprintf("analysis failed %d time(s): %s", rs.status.AnalysisFailures, failure)}
+	}
+	return analysisResult{next: interval}
+}
+
+func (r *Reconciler) metricsProvider(dataPlane *openchoreov1alpha1.DataPlane) rollout.MetricsProvider {
+	if r.MetricsProviderFactory != nil {
+		return r.MetricsProviderFactory(dataPlane)
+	}
+	return rollout.NewObserverClient(dataPlane.Spec.Observer)
+}
+
+// workloadHealth returns the health of the named Deployment as reported by the Release.
+func (r *Reconciler) workloadHealth(release *openchoreov1alpha1.Release, name string) openchoreov1alpha1.HealthStatus {
+	if release == nil {
+		return openchoreov1alpha1.HealthStatusUnknown
+	}
+	id := r.generateResourceID(map[string]any{
+		"kind":     "Deployment",
+		"metadata": map[string]any{"name": name},
+	}, 0)
+	for _, res := range release.Status.Resources {
+		if res.ID == id {
+			return res.HealthStatus
+		}
+	}
+	return openchoreov1alpha1.HealthStatusUnknown
+}
+
+// getRelease returns the Release of the ComponentDeployment, or nil if it does not exist yet.
+func (r *Reconciler) getRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment) (*openchoreov1alpha1.Release, error) {
+	release := &openchoreov1alpha1.Release{}
+	err := r.Get(ctx, client.ObjectKey{Name: componentDeployment.Name, Namespace: componentDeployment.Namespace}, release)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get Release: %w", err)
+	}
+	return release, nil
+}
+
+// decodeReleaseResources decodes the resources of a Release into their unstructured form.
+func decodeReleaseResources(release *openchoreov1alpha1.Release) ([]map[string]any, error) {
+	resources := make([]map[string]any, 0, len(release.Spec.Resources))
+	for _, res := range release.Spec.Resources {
+		if res.Object == nil || len(res.Object.Raw) == 0 {
+			continue
+		}
+		obj := map[string]any{}
+		if err := json.Unmarshal(res.Object.Raw, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode Release resource %s: %w", res.ID, err)
+		}
+		resources = append(resources, obj)
+	}
+	return resources, nil
+}
+
+func nameOf(resource map[string]any) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	return name
+}
+
+// minPositive returns the smallest positive duration, or zero if none are positive.
+func minPositive(durations ...time.Duration) time.Duration {
+	var result time.Duration
+	for _, d := range durations {
+		if d > 0 && (result == 0 || d < result) {
+			result = d
+		}
+	}
+	return result
+}
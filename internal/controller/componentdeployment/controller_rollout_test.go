@@ -0,0 +1,366 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/componentdeployment/rollout"
+)
+
+// fakeMetricsProvider returns its metrics, or its error, for every query
+type fakeMetricsProvider struct {
+	metrics *rollout.Metrics
+	err     error
+	queries int
+}
+
+func (f *fakeMetricsProvider) QueryMetrics(ctx context.Context, query rollout.MetricsQuery) (*rollout.Metrics, error) {
+	f.queries++
+	return f.metrics, f.err
+}
+
+var _ = Describe("ComponentDeployment rollouts", func() {
+	const (
+		namespace    = "rollout-org"
+		workloadName = "cart-production-1234"
+	)
+
+	var (
+		reconciler *Reconciler
+		metrics    *fakeMetricsProvider
+		dataPlane  *openchoreov1alpha1.DataPlane
+	)
+
+	rendered := func(image string) []map[string]any {
+		return []map[string]any{
+			{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": workloadName, "namespace": "dp-ns"},
+				"spec": map[string]any{
+					"replicas": int64(4),
+					"selector": map[string]any{"matchLabels": map[string]any{"app": "cart"}},
+					"template": map[string]any{
+						"metadata": map[string]any{"labels": map[string]any{"app": "cart"}},
+						"spec": map[string]any{
+							"containers": []any{map[string]any{"name": "main", "image": image}},
+						},
+					},
+				},
+			},
+			{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]any{"name": workloadName, "namespace": "dp-ns"},
+				"spec": map[string]any{
+					"selector": map[string]any{"app": "cart"},
+					"ports":    []any{map[string]any{"port": int64(80)}},
+				},
+			},
+		}
+	}
+
+	revisionOf := func(image string) string {
+		revision, err := rollout.Revision(rendered(image)[0])
+		Expect(err).NotTo(HaveOccurred())
+		return revision
+	}
+
+	// releaseOf makes the Release of the resources, reporting the health of the named Deployments
+	releaseOf := func(resources []map[string]any, health map[string]openchoreov1alpha1.HealthStatus) *openchoreov1alpha1.Release {
+		release := &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-production", Namespace: namespace},
+		}
+		for _, res := range resources {
+			raw, err := json.Marshal(res)
+			Expect(err).NotTo(HaveOccurred())
+			release.Spec.Resources = append(release.Spec.Resources, openchoreov1alpha1.Resource{
+				ID:     reconciler.generateResourceID(res, 0),
+				Object: &runtime.RawExtension{Raw: raw},
+			})
+		}
+		for name, status := range health {
+			release.Status.Resources = append(release.Status.Resources, openchoreov1alpha1.ResourceStatus{
+				ID:           reconciler.generateResourceID(map[string]any{"kind": "Deployment", "metadata": map[string]any{"name": name}}, 0),
+				Version:      "v1",
+				Kind:         "Deployment",
+				Name:         name,
+				HealthStatus: status,
+			})
+		}
+		return release
+	}
+
+	// newRelease makes the Release of the stable image, reporting the health of the named Deployments
+	newRelease := func(health map[string]openchoreov1alpha1.HealthStatus) *openchoreov1alpha1.Release {
+		return releaseOf(rollout.Stable(rendered("cart:v1"), nil), health)
+	}
+
+	imageOf := func(resources []map[string]any) string {
+		workload := rollout.FindWorkloadByName(resources, workloadName)
+		Expect(workload).NotTo(BeNil())
+		spec := workload["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+		return spec["containers"].([]any)[0].(map[string]any)["image"].(string)
+	}
+
+	newComponentDeployment := func(strategy openchoreov1alpha1.RolloutStrategy) *openchoreov1alpha1.ComponentDeployment {
+		return &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-production", Namespace: namespace},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: "shop", ComponentName: "cart"},
+				Environment: "production",
+				Rollout:     &strategy,
+			},
+			Status: openchoreov1alpha1.ComponentDeploymentStatus{
+				Rollout: &openchoreov1alpha1.RolloutStatus{
+					Phase:          openchoreov1alpha1.RolloutPhaseHealthy,
+					StableRevision: revisionOf("cart:v1"),
+				},
+			},
+		}
+	}
+
+	canaryStrategy := func(analysis *openchoreov1alpha1.RolloutAnalysis) openchoreov1alpha1.RolloutStrategy {
+		return openchoreov1alpha1.RolloutStrategy{
+			Type: openchoreov1alpha1.RolloutStrategyCanary,
+			Canary: &openchoreov1alpha1.CanaryStrategy{Steps: []openchoreov1alpha1.CanaryStep{
+				{Weight: 20},
+				{Weight: 50},
+			}},
+			Analysis: analysis,
+		}
+	}
+
+	setup := func(objs ...client.Object) {
+		testScheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(testScheme)).To(Succeed())
+		metrics = &fakeMetricsProvider{metrics: &rollout.Metrics{RequestCount: 100}}
+		reconciler = &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build(),
+			Scheme: testScheme,
+			MetricsProviderFactory: func(*openchoreov1alpha1.DataPlane) rollout.MetricsProvider {
+				return metrics
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		dataPlane = &openchoreov1alpha1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+			Spec: openchoreov1alpha1.DataPlaneSpec{
+				Observer: openchoreov1alpha1.ObserverAPI{URL: "http://observer:8080"},
+			},
+		}
+		setup()
+	})
+
+	reconcileRollout := func(cd *openchoreov1alpha1.ComponentDeployment, image string) *rolloutResult {
+		result, err := reconciler.reconcileRollout(context.Background(), cd, dataPlane, "dp-ns", rendered(image))
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("deploys directly when there is no stable workload", func() {
+		cd := newComponentDeployment(canaryStrategy(nil))
+		cd.Status.Rollout = nil
+
+		result := reconcileRollout(cd, "cart:v2")
+
+		Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseHealthy))
+		Expect(cd.Status.Rollout.StableRevision).To(Equal(revisionOf("cart:v2")))
+		Expect(rollout.FindWorkloadByName(result.resources, rollout.CanaryName(workloadName))).To(BeNil())
+	})
+
+	Context("with a canary strategy", func() {
+		It("waits for the canary to become healthy at the first step", func() {
+			cd := newComponentDeployment(canaryStrategy(nil))
+			setup(newRelease(nil))
+
+			result := reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseProgressing))
+			Expect(cd.Status.Rollout.CurrentRevision).To(Equal(revisionOf("cart:v2")))
+			Expect(cd.Status.Rollout.CurrentWeight).To(Equal(int32(20)))
+			Expect(result.requeueAfter).To(Equal(rolloutProgressCheckInterval))
+			Expect(rollout.FindWorkloadByName(result.resources, rollout.CanaryName(workloadName))).NotTo(BeNil())
+		})
+
+		It("advances a healthy canary whose analysis passes", func() {
+			cd := newComponentDeployment(canaryStrategy(&openchoreov1alpha1.RolloutAnalysis{MaxErrorRatePercent: ptr.To(int32(5))}))
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.CanaryName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+			metrics.metrics.ErrorRatePercent = 1
+
+			reconcileRollout(cd, "cart:v2")
+
+			Expect(metrics.queries).To(Equal(1))
+			Expect(cd.Status.Rollout.CurrentStep).To(Equal(int32(1)))
+			Expect(cd.Status.Rollout.CurrentWeight).To(Equal(int32(50)))
+		})
+
+		It("aborts when the canary is degraded", func() {
+			cd := newComponentDeployment(canaryStrategy(nil))
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.CanaryName(workloadName): openchoreov1alpha1.HealthStatusDegraded}))
+
+			result := reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseAborted))
+			Expect(cd.Status.Rollout.AbortedRevision).To(Equal(revisionOf("cart:v2")))
+			Expect(rollout.FindWorkloadByName(result.resources, rollout.CanaryName(workloadName))).To(BeNil())
+		})
+
+		It("aborts when the analysis fails its thresholds beyond the failure limit", func() {
+			cd := newComponentDeployment(canaryStrategy(&openchoreov1alpha1.RolloutAnalysis{MaxErrorRatePercent: ptr.To(int32(5))}))
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.CanaryName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+			metrics.metrics.ErrorRatePercent = 20
+
+			reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseAborted))
+			Expect(cd.Status.Rollout.Message).To(ContainSubstring("error rate"))
+		})
+
+		It("holds the canary while the metrics can't be queried", func() {
+			cd := newComponentDeployment(canaryStrategy(&openchoreov1alpha1.RolloutAnalysis{
+				MaxErrorRatePercent:   ptr.To(int32(5)),
+				ConsecutiveErrorLimit: ptr.To(int32(1)),
+			}))
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.CanaryName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+			metrics.err = errors.New("observer timed out")
+
+			result := reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseProgressing))
+			Expect(cd.Status.Rollout.CurrentStep).To(BeZero())
+			Expect(cd.Status.Rollout.AnalysisErrors).To(Equal(int32(1)))
+			Expect(cd.Status.Rollout.AnalysisFailures).To(BeZero())
+			Expect(result.requeueAfter).To(Equal(analysisRetryInterval))
+
+			By("not querying again before the retry interval")
+			reconcileRollout(cd, "cart:v2")
+			Expect(metrics.queries).To(Equal(1))
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseProgressing))
+
+			By("resetting the errors once the metrics can be queried")
+			cd.Status.Rollout.LastAnalysisTime = &metav1.Time{Time: time.Now().Add(-analysisRetryInterval)}
+			metrics.err = nil
+			reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.AnalysisErrors).To(BeZero())
+			Expect(cd.Status.Rollout.CurrentStep).To(Equal(int32(1)))
+		})
+
+		It("aborts when the metrics can't be queried beyond the consecutive error limit", func() {
+			cd := newComponentDeployment(canaryStrategy(&openchoreov1alpha1.RolloutAnalysis{
+				MaxErrorRatePercent:   ptr.To(int32(5)),
+				ConsecutiveErrorLimit: ptr.To(int32(1)),
+			}))
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.CanaryName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+			metrics.err = errors.New("observer timed out")
+
+			reconcileRollout(cd, "cart:v2")
+			cd.Status.Rollout.LastAnalysisTime = &metav1.Time{Time: time.Now().Add(-analysisRetryInterval)}
+			reconcileRollout(cd, "cart:v2")
+
+			Expect(metrics.queries).To(Equal(2))
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseAborted))
+			Expect(cd.Status.Rollout.Message).To(ContainSubstring("metrics query failed"))
+		})
+	})
+
+	Context("with a blue-green strategy", func() {
+		blueGreen := openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyBlueGreen}
+
+		It("pauses a healthy preview until it is promoted", func() {
+			cd := newComponentDeployment(blueGreen)
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.PreviewName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+
+			result := reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhasePaused))
+			Expect(rollout.FindWorkloadByName(result.resources, rollout.PreviewName(workloadName))).NotTo(BeNil())
+		})
+
+		It("switches traffic when promoted and completes once the stable workload is healthy", func() {
+			cd := newComponentDeployment(blueGreen)
+			cd.Annotations = map[string]string{controller.AnnotationKeyRolloutPromote: revisionOf("cart:v2")}
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{
+				rollout.PreviewName(workloadName): openchoreov1alpha1.HealthStatusHealthy,
+				workloadName:                      openchoreov1alpha1.HealthStatusHealthy,
+			}))
+
+			reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseSwitching))
+			Expect(cd.Status.Rollout.CurrentWeight).To(Equal(int32(100)))
+
+			cd.Status.Rollout.StepStartedAt = &metav1.Time{Time: time.Now().Add(-rolloutProgressCheckInterval)}
+			reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseHealthy))
+			Expect(cd.Status.Rollout.StableRevision).To(Equal(revisionOf("cart:v2")))
+			Expect(cd.Status.Rollout.StableWorkload).To(BeNil())
+		})
+
+		It("restores the stable revision when the switched workload is degraded", func() {
+			cd := newComponentDeployment(blueGreen)
+			cd.Annotations = map[string]string{controller.AnnotationKeyRolloutPromote: revisionOf("cart:v2")}
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.PreviewName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+
+			result := reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseSwitching))
+			Expect(cd.Status.Rollout.StableWorkload).NotTo(BeNil())
+			Expect(imageOf(result.resources)).To(Equal("cart:v2"))
+
+			By("aborting once the switched workload of the Release is degraded")
+			setup(releaseOf(result.resources, map[string]openchoreov1alpha1.HealthStatus{workloadName: openchoreov1alpha1.HealthStatusDegraded}))
+			result = reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseAborted))
+			Expect(imageOf(result.resources)).To(Equal("cart:v1"))
+
+			By("keeping the stable revision while the aborted revision is rendered")
+			result = reconcileRollout(cd, "cart:v2")
+			Expect(imageOf(result.resources)).To(Equal("cart:v1"))
+		})
+
+		It("restores the stable revision when aborted manually after the switch", func() {
+			cd := newComponentDeployment(blueGreen)
+			cd.Annotations = map[string]string{controller.AnnotationKeyRolloutPromote: revisionOf("cart:v2")}
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.PreviewName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+
+			result := reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseSwitching))
+
+			setup(releaseOf(result.resources, nil))
+			cd.Annotations[controller.AnnotationKeyRolloutAbort] = revisionOf("cart:v2")
+			result = reconcileRollout(cd, "cart:v2")
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseAborted))
+			Expect(imageOf(result.resources)).To(Equal("cart:v1"))
+		})
+
+		It("holds the preview while the metrics can't be queried", func() {
+			strategy := blueGreen
+			strategy.BlueGreen = &openchoreov1alpha1.BlueGreenStrategy{AutoPromotionDelay: &metav1.Duration{}}
+			strategy.Analysis = &openchoreov1alpha1.RolloutAnalysis{MaxErrorRatePercent: ptr.To(int32(5))}
+			cd := newComponentDeployment(strategy)
+			setup(newRelease(map[string]openchoreov1alpha1.HealthStatus{rollout.PreviewName(workloadName): openchoreov1alpha1.HealthStatusHealthy}))
+			metrics.err = errors.New("observer timed out")
+
+			reconcileRollout(cd, "cart:v2")
+
+			Expect(cd.Status.Rollout.Phase).To(Equal(openchoreov1alpha1.RolloutPhaseProgressing))
+			Expect(cd.Status.Rollout.AnalysisErrors).To(Equal(int32(1)))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// DefaultAnalysisInterval is used when the analysis interval is not configured.
+	DefaultAnalysisInterval = time.Minute
+	// DefaultConsecutiveErrorLimit is used when the consecutive error limit of the analysis is not configured.
+	DefaultConsecutiveErrorLimit = 4
+
+	observerRequestTimeout = 10 * time.Second
+)
+
+// MetricsQuery selects the pods whose request metrics are analyzed.
+type MetricsQuery struct {
+	ComponentName   string
+	ProjectName     string
+	EnvironmentName string
+	Namespace       string
+	PodLabels       map[string]string
+	Start           time.Time
+	End             time.Time
+}
+
+// Metrics are the request metrics of a rollout track over the analysis window.
+type Metrics struct {
+	RequestCount     float64
+	ErrorRatePercent float64
+	LatencyP99       time.Duration
+}
+
+// MetricsProvider retrieves request metrics for a rollout track.
+type MetricsProvider interface {
+	QueryMetrics(ctx context.Context, query MetricsQuery) (*Metrics, error)
+}
+
+// Evaluate checks the metrics against the analysis thresholds.
+// It returns an empty string when the metrics pass, or a description of the failed threshold.
+func Evaluate(analysis *openchoreov1alpha1.RolloutAnalysis, m *Metrics) string {
+	if analysis == nil || m == nil || m.RequestCount == 0 {
+		// Without traffic there is nothing to judge the revision by
+		return ""
+	}
+
+	var failures []string
+	if analysis.MaxErrorRatePercent != nil && m.ErrorRatePercent > float64(*analysis.MaxErrorRatePercent) {
+		failures = append(failures, fmt.Sprintf("error rate %.2f%% exceeds %d%%", m.ErrorRatePercent, *analysis.MaxErrorRatePercent))
+	}
+	if analysis.MaxLatencyP99 != nil && m.LatencyP99 > analysis.MaxLatencyP99.Duration {
+		failures = append(failures, fmt.Sprintf("p99 latency %s exceeds %s", m.LatencyP99, analysis.MaxLatencyP99.Duration))
+	}
+	return strings.Join(failures, "; ")
+}
+
+// AnalysisInterval returns the configured analysis interval or the default.
+func AnalysisInterval(analysis *openchoreov1alpha1.RolloutAnalysis) time.Duration {
+	if analysis == nil || analysis.Interval == nil || analysis.Interval.Duration <= 0 {
+		return DefaultAnalysisInterval
+	}
+	return analysis.Interval.Duration
+}
+
+// ConsecutiveErrorLimit returns the configured consecutive error limit of the analysis or the default.
+func ConsecutiveErrorLimit(analysis *openchoreov1alpha1.RolloutAnalysis) int32 {
+	if analysis == nil || analysis.ConsecutiveErrorLimit == nil {
+		return DefaultConsecutiveErrorLimit
+	}
+	return *analysis.ConsecutiveErrorLimit
+}
+
+// ObserverClient queries component metrics from the OpenChoreo observer API.
+type ObserverClient struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+var _ MetricsProvider = (*ObserverClient)(nil)
+
+// NewObserverClient creates a client for the observer configured on a DataPlane.
+func NewObserverClient(observer openchoreov1alpha1.ObserverAPI) *ObserverClient {
+	return &ObserverClient{
+		baseURL:    strings.TrimSuffix(observer.URL, "/"),
+		username:   observer.Authentication.BasicAuth.Username,
+		password:   observer.Authentication.BasicAuth.Password,
+		httpClient: &http.Client{Timeout: observerRequestTimeout},
+	}
+}
+
+// componentMetricsRequest is the request body of POST /api/metrics/component/{componentId}
+type componentMetricsRequest struct {
+	StartTime     string            `json:"startTime"`
+	EndTime       string            `json:"endTime"`
+	EnvironmentID string            `json:"environmentId"`
+	ProjectID     string            `json:"projectId,omitempty"`
+	Namespace     string            `json:"namespace"`
+	PodLabels     map[string]string `json:"podLabels,omitempty"`
+}
+
+// componentMetricsResponse contains the subset of the observer metrics response used for analysis
+type componentMetricsResponse struct {
+	HTTP struct {
+		RequestCount     float64 `json:"requestCount"`
+		ErrorRatePercent float64 `json:"errorRatePercent"`
+		LatencyP99Ms     float64 `json:"latencyP99Ms"`
+	} `json:"http"`
+}
+
+// QueryMetrics implements MetricsProvider
+func (c *ObserverClient) QueryMetrics(ctx context.Context, query MetricsQuery) (*Metrics, error) {
+	body, err := json.Marshal(componentMetricsRequest{
+		StartTime:     query.Start.UTC().Format(time.RFC3339),
+		EndTime:       query.End.UTC().Format(time.RFC3339),
+		EnvironmentID: query.EnvironmentName,
+		ProjectID:     query.ProjectName,
+		Namespace:     query.Namespace,
+		PodLabels:     query.PodLabels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metrics request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/api/metrics/component/%s", c.baseURL, url.PathEscape(query.ComponentName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query observer metrics: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("observer returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var out componentMetricsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode observer metrics response: %w", err)
+	}
+
+	return &Metrics{
+		RequestCount:     out.HTTP.RequestCount,
+		ErrorRatePercent: out.HTTP.ErrorRatePercent,
+		LatencyP99:       time.Duration(out.HTTP.LatencyP99Ms * float64(time.Millisecond)),
+	}, nil
+}
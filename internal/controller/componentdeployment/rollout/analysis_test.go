@@ -0,0 +1,140 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	maxErrorRate := int32(5)
+	analysis := &openchoreov1alpha1.RolloutAnalysis{
+		MaxErrorRatePercent: &maxErrorRate,
+		MaxLatencyP99:       &metav1.Duration{Duration: 500 * time.Millisecond},
+	}
+
+	tests := []struct {
+		name     string
+		metrics  *Metrics
+		wantFail bool
+	}{
+		{name: "within thresholds", metrics: &Metrics{RequestCount: 100, ErrorRatePercent: 1, LatencyP99: 200 * time.Millisecond}},
+		{name: "error rate exceeded", metrics: &Metrics{RequestCount: 100, ErrorRatePercent: 7.5}, wantFail: true},
+		{name: "latency exceeded", metrics: &Metrics{RequestCount: 100, LatencyP99: time.Second}, wantFail: true},
+		{name: "no traffic", metrics: &Metrics{ErrorRatePercent: 100, LatencyP99: time.Minute}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Evaluate(analysis, tt.metrics)
+			if (got != "") != tt.wantFail {
+				t.Errorf("Evaluate() = %q, wantFail %v", got, tt.wantFail)
+			}
+		})
+	}
+}
+
+func TestConsecutiveErrorLimit(t *testing.T) {
+	t.Parallel()
+
+	limit := int32(0)
+	tests := []struct {
+		name     string
+		analysis *openchoreov1alpha1.RolloutAnalysis
+		want     int32
+	}{
+		{name: "no analysis", want: DefaultConsecutiveErrorLimit},
+		{name: "not configured", analysis: &openchoreov1alpha1.RolloutAnalysis{}, want: DefaultConsecutiveErrorLimit},
+		{name: "configured", analysis: &openchoreov1alpha1.RolloutAnalysis{ConsecutiveErrorLimit: &limit}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ConsecutiveErrorLimit(tt.analysis); got != tt.want {
+				t.Errorf("ConsecutiveErrorLimit() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserverClientQueryMetrics(t *testing.T) {
+	t.Parallel()
+
+	var gotPath string
+	var gotBody componentMetricsRequest
+	var gotUser string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUser, _, _ = r.BasicAuth()
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"http":{"requestCount":120,"errorRatePercent":2.5,"latencyP99Ms":350}}`))
+	}))
+	defer server.Close()
+
+	observer := openchoreov1alpha1.ObserverAPI{URL: server.URL + "/"}
+	observer.Authentication.BasicAuth.Username = "observer"
+	observer.Authentication.BasicAuth.Password = "secret"
+	client := NewObserverClient(observer)
+
+	end := time.Date(2025, 6, 6, 12, 0, 0, 0, time.UTC)
+	m, err := client.QueryMetrics(context.Background(), MetricsQuery{
+		ComponentName:   "cart",
+		ProjectName:     "shop",
+		EnvironmentName: "production",
+		Namespace:       "dp-ns",
+		PodLabels:       map[string]string{"openchoreo.dev/rollout-track": TrackCanary},
+		Start:           end.Add(-time.Minute),
+		End:             end,
+	})
+	if err != nil {
+		t.Fatalf("QueryMetrics() error = %v", err)
+	}
+
+	if gotPath != "/api/metrics/component/cart" {
+		t.Errorf("path = %q, want /api/metrics/component/cart", gotPath)
+	}
+	if gotUser != "observer" {
+		t.Errorf("basic auth user = %q, want observer", gotUser)
+	}
+	if gotBody.EnvironmentID != "production" || gotBody.Namespace != "dp-ns" || gotBody.EndTime != "2025-06-06T12:00:00Z" {
+		t.Errorf("unexpected request body: %+v", gotBody)
+	}
+	if gotBody.PodLabels["openchoreo.dev/rollout-track"] != TrackCanary {
+		t.Errorf("pod labels = %v, want canary track", gotBody.PodLabels)
+	}
+	if m.RequestCount != 120 || m.ErrorRatePercent != 2.5 || m.LatencyP99 != 350*time.Millisecond {
+		t.Errorf("metrics = %+v", m)
+	}
+}
+
+func TestObserverClientErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "backend unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewObserverClient(openchoreov1alpha1.ObserverAPI{URL: server.URL})
+	if _, err := client.QueryMetrics(context.Background(), MetricsQuery{ComponentName: "cart"}); err == nil {
+		t.Fatalf("expected error for non-200 response")
+	}
+}
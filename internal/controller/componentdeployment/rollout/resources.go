@@ -0,0 +1,391 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rollout implements the resource transformations used for progressive delivery
+// (canary and blue-green) of ComponentDeployments.
+//
+// Only the workload (the Deployment rendered from the ComponentType) is delivered progressively.
+// Other rendered resources are applied as soon as a new revision is rendered.
+package rollout
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Rollout tracks applied as pod labels to the workloads of a rollout.
+const (
+	TrackStable  = "stable"
+	TrackCanary  = "canary"
+	TrackPreview = "preview"
+)
+
+const (
+	canarySuffix  = "-canary"
+	previewSuffix = "-preview"
+
+	revisionLength = 10
+)
+
+// FindWorkload returns the index of the workload Deployment in the rendered resources, or -1 if there is none.
+func FindWorkload(resources []map[string]any) int {
+	for i, res := range resources {
+		if isKind(res, "apps", "Deployment") {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindWorkloadByName returns the Deployment with the given name, or nil if it is not present.
+func FindWorkloadByName(resources []map[string]any, name string) map[string]any {
+	for _, res := range resources {
+		if isKind(res, "apps", "Deployment") && nameOf(res) == name {
+			return res
+		}
+	}
+	return nil
+}
+
+// Revision returns a short hash identifying the pod template of the workload.
+// Rollout track labels are ignored so that the stable and rollout copies of a template hash the same.
+func Revision(workload map[string]any) (string, error) {
+	template, _ := nestedMap(workload, "spec", "template")
+	template = clone(template)
+	if tmplLabels, ok := nestedMap(template, "metadata", "labels"); ok {
+		delete(tmplLabels, labels.LabelKeyRolloutTrack)
+	}
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:revisionLength], nil
+}
+
+// CanaryName returns the name of the canary copy of a resource.
+func CanaryName(name string) string {
+	return extraName(name, canarySuffix)
+}
+
+// PreviewName returns the name of the preview copy of a resource.
+func PreviewName(name string) string {
+	return extraName(name, previewSuffix)
+}
+
+// extraName appends the suffix to the name, shortening it with a hash if it would
+// exceed the Service name length limit.
+func extraName(name, suffix string) string {
+	if len(name)+len(suffix) <= dpkubernetes.MaxServiceNameLength {
+		return name + suffix
+	}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, name, strings.TrimPrefix(suffix, "-"))
+}
+
+// Stable returns the rendered resources with the workload and its services pinned to the stable track.
+// The deployed workload, nil if the workload is new, is only labelled with the track if it already is:
+// adding the label changes the pod template and would restart its pods. It is labelled once a rollout starts.
+func Stable(resources []map[string]any, deployed map[string]any) []map[string]any {
+	if deployed != nil && !hasTrack(deployed) {
+		return build(resources, options{})
+	}
+	return build(resources, options{serviceTrack: TrackStable})
+}
+
+// Rollback returns the rendered resources with the workload replaced by the stable workload.
+func Rollback(resources []map[string]any, stableWorkload map[string]any) []map[string]any {
+	return build(resources, options{stableWorkload: stableWorkload, serviceTrack: TrackStable})
+}
+
+// Canary returns the resources for a canary step: the stable workload keeps serving, a scaled canary
+// copy of the new workload is added behind its own services, and HTTPRoutes split traffic by weight.
+func Canary(resources []map[string]any, stableWorkload map[string]any, weight int32) []map[string]any {
+	return build(resources, options{
+		stableWorkload: stableWorkload,
+		serviceTrack:   TrackStable,
+		extraTrack:     TrackCanary,
+		extraSuffix:    canarySuffix,
+		weight:         &weight,
+	})
+}
+
+// Preview returns the resources for a blue-green preview: the stable workload keeps serving all traffic
+// while the new workload runs behind preview services.
+func Preview(resources []map[string]any, stableWorkload map[string]any) []map[string]any {
+	return build(resources, options{
+		stableWorkload: stableWorkload,
+		serviceTrack:   TrackStable,
+		extraTrack:     TrackPreview,
+		extraSuffix:    previewSuffix,
+	})
+}
+
+// Switch returns the resources for a blue-green switch: the services are pointed at the preview pods
+// while the stable workload is updated to the new revision.
+func Switch(resources []map[string]any) []map[string]any {
+	return build(resources, options{
+		serviceTrack: TrackPreview,
+		extraTrack:   TrackPreview,
+		extraSuffix:  previewSuffix,
+	})
+}
+
+type options struct {
+	// stableWorkload replaces the rendered workload when set
+	stableWorkload map[string]any
+	// serviceTrack is the track selected by the workload's services, the workload and its services
+	// are not pinned to a track when empty
+	serviceTrack string
+	// extraTrack, when set, adds a copy of the rendered workload and its services on this track
+	extraTrack  string
+	extraSuffix string
+	// weight, when set, splits HTTPRoute traffic between the stable and extra services
+	weight *int32
+}
+
+func build(resources []map[string]any, opts options) []map[string]any {
+	out := make([]map[string]any, 0, len(resources)+3)
+	for _, res := range resources {
+		out = append(out, clone(res))
+	}
+
+	w := FindWorkload(out)
+	if w < 0 {
+		return out
+	}
+
+	rendered := out[w]
+	podLabels, _ := nestedStringMap(rendered, "spec", "template", "metadata", "labels")
+
+	if opts.stableWorkload != nil {
+		out[w] = clone(opts.stableWorkload)
+	}
+	if opts.serviceTrack != "" {
+		setTrack(out[w], TrackStable, false)
+	}
+
+	// Services selecting the workload pods are pinned to a track so that stable and rollout pods
+	// do not receive each other's traffic.
+	var extras []map[string]any
+	serviceNames := map[string]string{}
+	for _, res := range out {
+		if !isKind(res, "", "Service") || !selectsPods(res, podLabels) {
+			continue
+		}
+		if opts.extraTrack != "" {
+			extra := clone(res)
+			setName(extra, extraName(nameOf(res), opts.extraSuffix))
+			setServiceTrack(extra, opts.extraTrack)
+			extras = append(extras, extra)
+			serviceNames[nameOf(res)] = nameOf(extra)
+		}
+		if opts.serviceTrack != "" {
+			setServiceTrack(res, opts.serviceTrack)
+		}
+	}
+
+	if opts.extraTrack != "" {
+		extra := clone(rendered)
+		setName(extra, extraName(nameOf(rendered), opts.extraSuffix))
+		setTrack(extra, opts.extraTrack, true)
+		if opts.weight != nil {
+			scaleReplicas(extra, *opts.weight)
+		}
+		extras = append(extras, extra)
+	}
+
+	if opts.weight != nil {
+		for _, res := range out {
+			if isKind(res, "gateway.networking.k8s.io", "HTTPRoute") {
+				splitRoute(res, serviceNames, *opts.weight)
+			}
+		}
+	}
+
+	return append(out, extras...)
+}
+
+// setTrack labels the workload pods with the track. When inSelector is true the track is also added
+// to the Deployment selector, which is only safe for newly created Deployments as selectors are immutable.
+func setTrack(workload map[string]any, track string, inSelector bool) {
+	tmplLabels := ensureMap(workload, "spec", "template", "metadata", "labels")
+	tmplLabels[labels.LabelKeyRolloutTrack] = track
+	if inSelector {
+		matchLabels := ensureMap(workload, "spec", "selector", "matchLabels")
+		matchLabels[labels.LabelKeyRolloutTrack] = track
+	}
+}
+
+// hasTrack reports whether the workload pods are labelled with a track.
+func hasTrack(workload map[string]any) bool {
+	tmplLabels, _ := nestedStringMap(workload, "spec", "template", "metadata", "labels")
+	return tmplLabels[labels.LabelKeyRolloutTrack] != ""
+}
+
+func setServiceTrack(service map[string]any, track string) {
+	selector := ensureMap(service, "spec", "selector")
+	selector[labels.LabelKeyRolloutTrack] = track
+}
+
+// scaleReplicas scales the workload to the share of replicas matching the traffic weight (at least one).
+func scaleReplicas(workload map[string]any, weight int32) {
+	spec := ensureMap(workload, "spec")
+	replicas := 1.0
+	switch v := spec["replicas"].(type) {
+	case int:
+		replicas = float64(v)
+	case int32:
+		replicas = float64(v)
+	case int64:
+		replicas = float64(v)
+	case float64:
+		replicas = v
+	}
+	spec["replicas"] = int64(math.Max(1, math.Ceil(replicas*float64(weight)/100)))
+}
+
+// splitRoute rewrites every backendRef pointing at a stable service into a weighted pair of stable and extra refs.
+func splitRoute(route map[string]any, serviceNames map[string]string, weight int32) {
+	rules, _ := nestedSlice(route, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		refs, _ := rule["backendRefs"].([]any)
+		split := make([]any, 0, len(refs)*2)
+		for _, ref := range refs {
+			backend, ok := ref.(map[string]any)
+			if !ok {
+				split = append(split, ref)
+				continue
+			}
+			name, _ := backend["name"].(string)
+			kind, _ := backend["kind"].(string)
+			extraName, found := serviceNames[name]
+			if !found || (kind != "" && kind != "Service") {
+				split = append(split, backend)
+				continue
+			}
+			extra := clone(backend)
+			extra["name"] = extraName
+			extra["weight"] = int64(weight)
+			backend["weight"] = int64(100 - weight)
+			split = append(split, backend, extra)
+		}
+		rule["backendRefs"] = split
+	}
+}
+
+// selectsPods reports whether the service has a non-empty selector matching the pod labels.
+func selectsPods(service map[string]any, podLabels map[string]string) bool {
+	selector, ok := nestedStringMap(service, "spec", "selector")
+	if !ok || len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if k == labels.LabelKeyRolloutTrack {
+			continue
+		}
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func isKind(res map[string]any, group, kind string) bool {
+	apiVersion, _ := res["apiVersion"].(string)
+	k, _ := res["kind"].(string)
+	if k != kind {
+		return false
+	}
+	g, _, found := strings.Cut(apiVersion, "/")
+	if !found {
+		g = ""
+	}
+	return g == group
+}
+
+func nameOf(res map[string]any) string {
+	metadata, _ := res["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	return name
+}
+
+func setName(res map[string]any, name string) {
+	ensureMap(res, "metadata")["name"] = name
+}
+
+func nestedMap(obj map[string]any, fields ...string) (map[string]any, bool) {
+	cur := obj
+	for _, f := range fields {
+		next, ok := cur[f].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		cur = next
+	}
+	return cur, true
+}
+
+func nestedSlice(obj map[string]any, fields ...string) ([]any, bool) {
+	parent, ok := nestedMap(obj, fields[:len(fields)-1]...)
+	if !ok {
+		return nil, false
+	}
+	s, ok := parent[fields[len(fields)-1]].([]any)
+	return s, ok
+}
+
+func nestedStringMap(obj map[string]any, fields ...string) (map[string]string, bool) {
+	m, ok := nestedMap(obj, fields...)
+	if !ok {
+		return nil, false
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out, true
+}
+
+// ensureMap returns the nested map at the given path, creating any missing levels.
+func ensureMap(obj map[string]any, fields ...string) map[string]any {
+	cur := obj
+	for _, f := range fields {
+		next, ok := cur[f].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			cur[f] = next
+		}
+		cur = next
+	}
+	return cur
+}
+
+// clone deep copies a rendered resource. Rendered values may contain Go types that are not valid
+// JSON values for runtime.DeepCopyJSON, so a JSON round trip is used instead.
+func clone(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return obj
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return obj
+	}
+	return out
+}
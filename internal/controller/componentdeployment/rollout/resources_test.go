@@ -0,0 +1,301 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func renderedResources(image string) []map[string]any {
+	return []map[string]any{
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "cart-dev-1234", "namespace": "dp-ns"},
+			"spec": map[string]any{
+				"replicas": int64(4),
+				"selector": map[string]any{"matchLabels": map[string]any{"app": "cart"}},
+				"template": map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"app": "cart"}},
+					"spec": map[string]any{
+						"containers": []any{map[string]any{"name": "main", "image": image}},
+					},
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "cart-dev-1234", "namespace": "dp-ns"},
+			"spec": map[string]any{
+				"selector": map[string]any{"app": "cart"},
+				"ports":    []any{map[string]any{"port": int64(80)}},
+			},
+		},
+		{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata":   map[string]any{"name": "cart-dev-1234", "namespace": "dp-ns"},
+			"spec": map[string]any{
+				"rules": []any{
+					map[string]any{
+						"backendRefs": []any{map[string]any{"name": "cart-dev-1234", "port": int64(80)}},
+					},
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "cart-config", "namespace": "dp-ns"},
+		},
+	}
+}
+
+func podTrack(t *testing.T, workload map[string]any) string {
+	t.Helper()
+	l, _ := nestedStringMap(workload, "spec", "template", "metadata", "labels")
+	return l[labels.LabelKeyRolloutTrack]
+}
+
+func serviceTrack(t *testing.T, service map[string]any) string {
+	t.Helper()
+	s, _ := nestedStringMap(service, "spec", "selector")
+	return s[labels.LabelKeyRolloutTrack]
+}
+
+func image(t *testing.T, workload map[string]any) string {
+	t.Helper()
+	containers, _ := nestedSlice(workload, "spec", "template", "spec", "containers")
+	if len(containers) == 0 {
+		t.Fatalf("workload %q has no containers", nameOf(workload))
+	}
+	img, _ := containers[0].(map[string]any)["image"].(string)
+	return img
+}
+
+func findByKindAndName(resources []map[string]any, kind, name string) map[string]any {
+	for _, res := range resources {
+		if k, _ := res["kind"].(string); k == kind && nameOf(res) == name {
+			return res
+		}
+	}
+	return nil
+}
+
+func TestRevisionIgnoresTrackLabel(t *testing.T) {
+	t.Parallel()
+
+	rendered := renderedResources("cart:v1")[0]
+	rev, err := Revision(rendered)
+	if err != nil {
+		t.Fatalf("Revision() error = %v", err)
+	}
+	if len(rev) != revisionLength {
+		t.Errorf("len(revision) = %d, want %d", len(rev), revisionLength)
+	}
+
+	stable := Stable([]map[string]any{rendered}, nil)[0]
+	stableRev, err := Revision(stable)
+	if err != nil {
+		t.Fatalf("Revision() error = %v", err)
+	}
+	if stableRev != rev {
+		t.Errorf("revision of stable copy = %q, want %q", stableRev, rev)
+	}
+
+	changedRev, err := Revision(renderedResources("cart:v2")[0])
+	if err != nil {
+		t.Fatalf("Revision() error = %v", err)
+	}
+	if changedRev == rev {
+		t.Errorf("revision did not change when the image changed")
+	}
+}
+
+func TestStable(t *testing.T) {
+	t.Parallel()
+
+	rendered := renderedResources("cart:v1")
+	out := Stable(rendered, nil)
+
+	if len(out) != len(rendered) {
+		t.Fatalf("len(out) = %d, want %d", len(out), len(rendered))
+	}
+	if got := podTrack(t, out[0]); got != TrackStable {
+		t.Errorf("workload track = %q, want %q", got, TrackStable)
+	}
+	// Deployment selectors are immutable, so the stable workload keeps its rendered selector
+	if sel, _ := nestedStringMap(out[0], "spec", "selector", "matchLabels"); sel[labels.LabelKeyRolloutTrack] != "" {
+		t.Errorf("stable workload selector must not include the track label")
+	}
+	if got := serviceTrack(t, out[1]); got != TrackStable {
+		t.Errorf("service track = %q, want %q", got, TrackStable)
+	}
+	// The input must not be modified
+	if got := podTrack(t, rendered[0]); got != "" {
+		t.Errorf("rendered workload was modified, track = %q", got)
+	}
+}
+
+func TestStableKeepsDeployedWorkloadsWithoutTrack(t *testing.T) {
+	t.Parallel()
+
+	deployed := renderedResources("cart:v1")[0]
+	out := Stable(renderedResources("cart:v2"), deployed)
+
+	if got := podTrack(t, out[0]); got != "" {
+		t.Errorf("workload track = %q, want none so that its pods are not restarted", got)
+	}
+	if got := serviceTrack(t, out[1]); got != "" {
+		t.Errorf("service track = %q, want none to keep selecting the unlabelled pods", got)
+	}
+
+	// Workloads labelled by an earlier rollout keep their track
+	out = Stable(renderedResources("cart:v2"), Stable(renderedResources("cart:v1"), nil)[0])
+	if got := podTrack(t, out[0]); got != TrackStable {
+		t.Errorf("workload track = %q, want %q", got, TrackStable)
+	}
+	if got := serviceTrack(t, out[1]); got != TrackStable {
+		t.Errorf("service track = %q, want %q", got, TrackStable)
+	}
+}
+
+func TestCanary(t *testing.T) {
+	t.Parallel()
+
+	stableWorkload := Stable(renderedResources("cart:v1"), nil)[0]
+	out := Canary(renderedResources("cart:v2"), stableWorkload, 25)
+
+	if len(out) != 6 {
+		t.Fatalf("len(out) = %d, want 6", len(out))
+	}
+
+	stable := findByKindAndName(out, "Deployment", "cart-dev-1234")
+	if got := image(t, stable); got != "cart:v1" {
+		t.Errorf("stable image = %q, want cart:v1", got)
+	}
+
+	canary := findByKindAndName(out, "Deployment", CanaryName("cart-dev-1234"))
+	if canary == nil {
+		t.Fatalf("canary workload not found")
+	}
+	if got := image(t, canary); got != "cart:v2" {
+		t.Errorf("canary image = %q, want cart:v2", got)
+	}
+	if got := podTrack(t, canary); got != TrackCanary {
+		t.Errorf("canary track = %q, want %q", got, TrackCanary)
+	}
+	if sel, _ := nestedStringMap(canary, "spec", "selector", "matchLabels"); sel[labels.LabelKeyRolloutTrack] != TrackCanary {
+		t.Errorf("canary selector track = %q, want %q", sel[labels.LabelKeyRolloutTrack], TrackCanary)
+	}
+	if got := canary["spec"].(map[string]any)["replicas"]; got != int64(1) {
+		t.Errorf("canary replicas = %v, want 1", got)
+	}
+
+	canaryService := findByKindAndName(out, "Service", CanaryName("cart-dev-1234"))
+	if canaryService == nil {
+		t.Fatalf("canary service not found")
+	}
+	if got := serviceTrack(t, canaryService); got != TrackCanary {
+		t.Errorf("canary service track = %q, want %q", got, TrackCanary)
+	}
+	if got := serviceTrack(t, findByKindAndName(out, "Service", "cart-dev-1234")); got != TrackStable {
+		t.Errorf("stable service track = %q, want %q", got, TrackStable)
+	}
+
+	route := findByKindAndName(out, "HTTPRoute", "cart-dev-1234")
+	rules, _ := nestedSlice(route, "spec", "rules")
+	refs := rules[0].(map[string]any)["backendRefs"].([]any)
+	if len(refs) != 2 {
+		t.Fatalf("len(backendRefs) = %d, want 2", len(refs))
+	}
+	wantRefs := []struct {
+		name   string
+		weight int64
+	}{
+		{"cart-dev-1234", 75},
+		{CanaryName("cart-dev-1234"), 25},
+	}
+	for i, want := range wantRefs {
+		ref := refs[i].(map[string]any)
+		if ref["name"] != want.name || ref["weight"] != want.weight {
+			t.Errorf("backendRefs[%d] = %v/%v, want %s/%d", i, ref["name"], ref["weight"], want.name, want.weight)
+		}
+	}
+}
+
+func TestCanaryScalesReplicasWithWeight(t *testing.T) {
+	t.Parallel()
+
+	stableWorkload := Stable(renderedResources("cart:v1"), nil)[0]
+	out := Canary(renderedResources("cart:v2"), stableWorkload, 50)
+
+	canary := findByKindAndName(out, "Deployment", CanaryName("cart-dev-1234"))
+	if got := canary["spec"].(map[string]any)["replicas"]; got != int64(2) {
+		t.Errorf("canary replicas = %v, want 2", got)
+	}
+}
+
+func TestPreviewAndSwitch(t *testing.T) {
+	t.Parallel()
+
+	stableWorkload := Stable(renderedResources("cart:v1"), nil)[0]
+
+	preview := Preview(renderedResources("cart:v2"), stableWorkload)
+	if got := image(t, findByKindAndName(preview, "Deployment", "cart-dev-1234")); got != "cart:v1" {
+		t.Errorf("stable image during preview = %q, want cart:v1", got)
+	}
+	previewWorkload := findByKindAndName(preview, "Deployment", PreviewName("cart-dev-1234"))
+	if previewWorkload == nil {
+		t.Fatalf("preview workload not found")
+	}
+	if got := previewWorkload["spec"].(map[string]any)["replicas"]; got != float64(4) {
+		t.Errorf("preview replicas = %v, want 4", got)
+	}
+	route := findByKindAndName(preview, "HTTPRoute", "cart-dev-1234")
+	rules, _ := nestedSlice(route, "spec", "rules")
+	if refs := rules[0].(map[string]any)["backendRefs"].([]any); len(refs) != 1 {
+		t.Errorf("preview must not split route traffic, got %d backendRefs", len(refs))
+	}
+
+	switched := Switch(renderedResources("cart:v2"))
+	if got := image(t, findByKindAndName(switched, "Deployment", "cart-dev-1234")); got != "cart:v2" {
+		t.Errorf("stable image after switch = %q, want cart:v2", got)
+	}
+	if got := serviceTrack(t, findByKindAndName(switched, "Service", "cart-dev-1234")); got != TrackPreview {
+		t.Errorf("service track after switch = %q, want %q", got, TrackPreview)
+	}
+	if findByKindAndName(switched, "Deployment", PreviewName("cart-dev-1234")) == nil {
+		t.Errorf("preview workload must be kept during the switch")
+	}
+}
+
+func TestRollback(t *testing.T) {
+	t.Parallel()
+
+	stableWorkload := Stable(renderedResources("cart:v1"), nil)[0]
+	out := Rollback(renderedResources("cart:v2"), stableWorkload)
+
+	if len(out) != 4 {
+		t.Fatalf("len(out) = %d, want 4", len(out))
+	}
+	if got := image(t, out[0]); got != "cart:v1" {
+		t.Errorf("image after rollback = %q, want cart:v1", got)
+	}
+}
+
+func TestExtraNameLengthLimit(t *testing.T) {
+	t.Parallel()
+
+	long := "a-very-long-component-name-that-is-close-to-the-service-limit-x"
+	if got := CanaryName(long); len(got) > 63 {
+		t.Errorf("len(CanaryName) = %d, want <= 63", len(got))
+	}
+	if got := CanaryName("cart"); got != "cart-canary" {
+		t.Errorf("CanaryName(cart) = %q, want cart-canary", got)
+	}
+}
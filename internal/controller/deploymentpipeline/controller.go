@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -31,6 +32,14 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=deploymentpipelines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=deploymentpipelines/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=servicebindings,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=webapplicationbindings,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=scheduledtaskbindings,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentenvsnapshots,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentdeployments,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -77,7 +86,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return r.reconcileAutoPromotions(ctx, deploymentPipeline)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -88,6 +97,18 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.DeploymentPipeline{}).
+		Watches(&openchoreov1alpha1.ServiceBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
+		Watches(&openchoreov1alpha1.WebApplicationBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
+		Watches(&openchoreov1alpha1.ScheduledTaskBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
+		Watches(&openchoreov1alpha1.ComponentEnvSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
+		Watches(&openchoreov1alpha1.ComponentDeployment{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
+		Watches(&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.listDeploymentPipelinesForBinding)).
 		Named("deploymentpipeline").
 		Complete(r)
 }
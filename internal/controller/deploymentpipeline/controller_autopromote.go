@@ -0,0 +1,452 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/freeze"
+	"github.com/openchoreo/openchoreo/internal/promotion"
+)
+
+// Reasons reported on pending promotions
+const (
+	// ReasonWaitingForHealthy indicates the workload is not ready in the source environment yet
+	ReasonWaitingForHealthy = "WaitingForHealthy"
+	// ReasonHealthPeriodPending indicates the workload is ready but has not been healthy for long enough
+	ReasonHealthPeriodPending = "HealthPeriodPending"
+	// ReasonApprovalRequired indicates the target environment requires approval, so the promotion must be done manually
+	ReasonApprovalRequired = "ApprovalRequired"
+	// ReasonEnvironmentFrozen indicates the target environment is in a deployment freeze window
+	ReasonEnvironmentFrozen = "EnvironmentFrozen"
+	// ReasonInvalidFreezeWindow indicates the freeze windows of the target environment could not be evaluated
+	ReasonInvalidFreezeWindow = "InvalidFreezeWindow"
+	// ReasonTargetEnvironmentNotFound indicates the target environment does not exist
+	ReasonTargetEnvironmentNotFound = "TargetEnvironmentNotFound"
+)
+
+const (
+	// autoPromoteRetryInterval is used to re-check promotions blocked by conditions that are not watched
+	autoPromoteRetryInterval = 5 * time.Minute
+
+	workloadRevisionLength = 10
+)
+
+// binding is the part of an environment binding needed to promote it.
+// Service, WebApplication and ScheduledTask bindings report readiness through their Ready condition. Components
+// defined with a ComponentType are bound by a ComponentEnvSnapshot, whose health is that of the Release rendered
+// from it.
+type binding struct {
+	// bindingType is the binding type promotion.Promote copies the binding with
+	bindingType string
+	namespace   string
+	project     string
+	component   string
+	environment string
+	// promoted is the part of the spec copied to the target environment, which identifies the promoted revision
+	promoted any
+	// healthy reports whether the promoted revision is healthy in the environment
+	healthy bool
+	// healthySince is when the binding last became healthy, if it reports it
+	healthySince metav1.Time
+}
+
+// bindingKey identifies the binding of a component in an environment
+type bindingKey struct {
+	project     string
+	component   string
+	environment string
+}
+
+func (b *binding) key() bindingKey {
+	return bindingKey{project: b.project, component: b.component, environment: b.environment}
+}
+
+// withReadyCondition sets the health of a binding from its Ready condition, which must be reported for the
+// current generation of the binding
+func (b *binding) withReadyCondition(generation int64, conditions []metav1.Condition) *binding {
+	ready := meta.FindStatusCondition(conditions, conditionTypeReady)
+	if ready != nil && ready.Status == metav1.ConditionTrue && ready.ObservedGeneration == generation {
+		b.healthy = true
+		b.healthySince = ready.LastTransitionTime
+	}
+	return b
+}
+
+// reconcileAutoPromotions promotes components along the promotion paths with an autoPromote policy and
+// records the promotions that are still pending in the DeploymentPipeline status.
+func (r *Reconciler) reconcileAutoPromotions(ctx context.Context, pipeline *openchoreov1alpha1.DeploymentPipeline) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !hasAutoPromotePaths(pipeline) {
+		if len(pipeline.Status.PendingPromotions) == 0 {
+			return ctrl.Result{}, nil
+		}
+		pipeline.Status.PendingPromotions = nil
+		return ctrl.Result{}, r.Status().Update(ctx, pipeline)
+	}
+
+	projects, err := r.projectsUsingPipeline(ctx, pipeline)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	bindings, err := r.listBindings(ctx, pipeline.Namespace, projects)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	previous := make(map[string]openchoreov1alpha1.PendingPromotion, len(pipeline.Status.PendingPromotions))
+	for _, p := range pipeline.Status.PendingPromotions {
+		previous[pendingKey(p)] = p
+	}
+
+	now := time.Now()
+	environments := map[string]*openchoreov1alpha1.Environment{}
+	var pending []openchoreov1alpha1.PendingPromotion
+	var requeueAfter time.Duration
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.AutoPromote == nil {
+				continue
+			}
+			for _, source := range bindings {
+				if source.environment != path.SourceEnvironmentRef {
+					continue
+				}
+				targetKey := bindingKey{project: source.project, component: source.component, environment: target.Name}
+				existing := bindings[targetKey]
+				if existing != nil && apiequality.Semantic.DeepEqual(existing.promoted, source.promoted) {
+					// Already promoted
+					continue
+				}
+
+				revision, err := specRevision(source.promoted)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+				p := openchoreov1alpha1.PendingPromotion{
+					ProjectName:       source.project,
+					ComponentName:     source.component,
+					SourceEnvironment: source.environment,
+					TargetEnvironment: target.Name,
+					Revision:          revision,
+				}
+				if prev, ok := previous[pendingKey(p)]; ok && prev.Revision == revision {
+					p.RevisionObservedAt = prev.RevisionObservedAt
+					p.HealthySince = prev.HealthySince
+				}
+				if p.RevisionObservedAt == nil {
+					p.RevisionObservedAt = &metav1.Time{Time: now}
+				}
+
+				wait := r.evaluatePromotion(ctx, &p, source, target, environments, now)
+				if wait < 0 {
+					if err := r.promote(ctx, source, target.Name); err != nil {
+						logger.Error(err, "Failed to promote component automatically", "project", source.project,
+							"component", source.component, "source", source.environment, "target", target.Name)
+						return ctrl.Result{}, err
+					}
+					r.Recorder.Eventf(pipeline, corev1.EventTypeNormal, "AutoPromoted",
+						"Promoted component %s/%s from %s to %s (revision %s)",
+						source.project, source.component, source.environment, target.Name, revision)
+					logger.Info("Promoted component automatically", "project", source.project,
+						"component", source.component, "source", source.environment, "target", target.Name,
+						"revision", revision)
+					continue
+				}
+
+				pending = append(pending, p)
+				requeueAfter = minPositive(requeueAfter, wait)
+			}
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool { return pendingKey(pending[i]) < pendingKey(pending[j]) })
+	if !apiequality.Semantic.DeepEqual(pipeline.Status.PendingPromotions, pending) {
+		pipeline.Status.PendingPromotions = pending
+		if err := r.Status().Update(ctx, pipeline); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// evaluatePromotion decides whether a pending promotion can be performed now.
+// It returns a negative duration if the promotion should be performed, otherwise the pending promotion is
+// updated with the reason and the returned duration is when it should be re-evaluated (zero if it will be
+// re-evaluated when the source binding changes).
+func (r *Reconciler) evaluatePromotion(ctx context.Context, p *openchoreov1alpha1.PendingPromotion, source *binding,
+	target openchoreov1alpha1.TargetEnvironmentRef, environments map[string]*openchoreov1alpha1.Environment, now time.Time) time.Duration {
+	if !source.healthy {
+		p.HealthySince = nil
+		p.Reason = ReasonWaitingForHealthy
+		p.Message = fmt.Sprintf("Waiting for the workload to become ready in environment %q", source.environment)
+		return 0
+	}
+	// A binding that became ready before the revision was observed may have been ready with a previous revision,
+	// so the revision is only counted as healthy from when it was observed. A later transition is reported by the
+	// binding even if it happened between two reconciles.
+	if since := source.healthySince; since.After(p.RevisionObservedAt.Time) {
+		p.HealthySince = since.DeepCopy()
+	} else if p.HealthySince == nil {
+		p.HealthySince = &metav1.Time{Time: now}
+	}
+
+	if target.RequiresApproval || target.IsManualApprovalRequired {
+		p.Reason = ReasonApprovalRequired
+		p.Message = fmt.Sprintf("Promotion to environment %q requires approval and must be done manually", target.Name)
+		return 0
+	}
+
+	if target.AutoPromote.HealthyFor != nil {
+		if remaining := target.AutoPromote.HealthyFor.Duration - now.Sub(p.HealthySince.Time); remaining > 0 {
+			p.Reason = ReasonHealthPeriodPending
+			p.Message = fmt.Sprintf("Workload must stay ready in environment %q for another %s",
+				source.environment, remaining.Round(time.Second))
+			return remaining
+		}
+	}
+
+	environment, ok := environments[target.Name]
+	if !ok {
+		environment = &openchoreov1alpha1.Environment{}
+		if err := r.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: source.namespace}, environment); err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.FromContext(ctx).Error(err, "Failed to get target environment", "environment", target.Name)
+			}
+			environment = nil
+		}
+		environments[target.Name] = environment
+	}
+	if environment == nil {
+		p.Reason = ReasonTargetEnvironmentNotFound
+		p.Message = fmt.Sprintf("Environment %q not found", target.Name)
+		return autoPromoteRetryInterval
+	}
+
+	active, err := freeze.Evaluate(environment.Spec.FreezeWindows, now)
+	if err != nil {
+		p.Reason = ReasonInvalidFreezeWindow
+		p.Message = fmt.Sprintf("Cannot evaluate freeze windows of environment %q: %v", target.Name, err)
+		return autoPromoteRetryInterval
+	}
+	if active != nil {
+		p.Reason = ReasonEnvironmentFrozen
+		p.Message = fmt.Sprintf("Environment %q is frozen by window %q until %s: %s",
+			target.Name, active.Name, active.End.UTC().Format(time.RFC3339), active.Reason)
+		return active.End.Sub(now)
+	}
+
+	return -1
+}
+
+// promote creates or updates the binding of the component in the target environment from the source binding,
+// the same way a manual promotion does.
+func (r *Reconciler) promote(ctx context.Context, source *binding, targetEnvironment string) error {
+	return promotion.Promote(ctx, r.Client, source.bindingType, promotion.Request{
+		Namespace:         source.namespace,
+		ProjectName:       source.project,
+		ComponentName:     source.component,
+		SourceEnvironment: source.environment,
+		TargetEnvironment: targetEnvironment,
+	})
+}
+
+// projectsUsingPipeline returns the names of the projects that reference the DeploymentPipeline
+func (r *Reconciler) projectsUsingPipeline(ctx context.Context, pipeline *openchoreov1alpha1.DeploymentPipeline) (map[string]bool, error) {
+	projectList := &openchoreov1alpha1.ProjectList{}
+	if err := r.List(ctx, projectList, client.InNamespace(pipeline.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	projects := map[string]bool{}
+	for _, project := range projectList.Items {
+		if project.Spec.DeploymentPipelineRef == pipeline.Name {
+			projects[project.Name] = true
+		}
+	}
+	return projects, nil
+}
+
+// listBindings returns the promotable bindings of the given projects keyed by component and environment
+func (r *Reconciler) listBindings(ctx context.Context, namespace string, projects map[string]bool) (map[bindingKey]*binding, error) {
+	bindings := map[bindingKey]*binding{}
+	add := func(b *binding) {
+		if projects[b.project] {
+			b.namespace = namespace
+			bindings[b.key()] = b
+		}
+	}
+
+	serviceBindings := &openchoreov1alpha1.ServiceBindingList{}
+	if err := r.List(ctx, serviceBindings, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list service bindings: %w", err)
+	}
+	for i := range serviceBindings.Items {
+		b := &serviceBindings.Items[i]
+		add((&binding{
+			bindingType: string(openchoreov1alpha1.ComponentTypeService),
+			project:     b.Spec.Owner.ProjectName,
+			component:   b.Spec.Owner.ComponentName,
+			environment: b.Spec.Environment,
+			promoted:    b.Spec.WorkloadSpec,
+		}).withReadyCondition(b.Generation, b.Status.Conditions))
+	}
+
+	webAppBindings := &openchoreov1alpha1.WebApplicationBindingList{}
+	if err := r.List(ctx, webAppBindings, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list web application bindings: %w", err)
+	}
+	for i := range webAppBindings.Items {
+		b := &webAppBindings.Items[i]
+		add((&binding{
+			bindingType: string(openchoreov1alpha1.ComponentTypeWebApplication),
+			project:     b.Spec.Owner.ProjectName,
+			component:   b.Spec.Owner.ComponentName,
+			environment: b.Spec.Environment,
+			promoted:    b.Spec.WorkloadSpec,
+		}).withReadyCondition(b.Generation, b.Status.Conditions))
+	}
+
+	scheduledTaskBindings := &openchoreov1alpha1.ScheduledTaskBindingList{}
+	if err := r.List(ctx, scheduledTaskBindings, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list scheduled task bindings: %w", err)
+	}
+	for i := range scheduledTaskBindings.Items {
+		b := &scheduledTaskBindings.Items[i]
+		add((&binding{
+			bindingType: string(openchoreov1alpha1.ComponentTypeScheduledTask),
+			project:     b.Spec.Owner.ProjectName,
+			component:   b.Spec.Owner.ComponentName,
+			environment: b.Spec.Environment,
+			promoted:    b.Spec.WorkloadSpec,
+		}).withReadyCondition(b.Generation, b.Status.Conditions))
+	}
+
+	// A ComponentEnvSnapshot is healthy once the Release the ComponentDeployment of its environment rendered
+	// from it is healthy
+	componentDeployments := &openchoreov1alpha1.ComponentDeploymentList{}
+	if err := r.List(ctx, componentDeployments, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component deployments: %w", err)
+	}
+	deployments := make(map[bindingKey]*openchoreov1alpha1.ComponentDeployment, len(componentDeployments.Items))
+	for i := range componentDeployments.Items {
+		cd := &componentDeployments.Items[i]
+		deployments[bindingKey{project: cd.Spec.Owner.ProjectName, component: cd.Spec.Owner.ComponentName,
+			environment: cd.Spec.Environment}] = cd
+	}
+
+	releaseList := &openchoreov1alpha1.ReleaseList{}
+	if err := r.List(ctx, releaseList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	// Releases are named after the ComponentDeployment they are rendered from
+	releases := make(map[string]*openchoreov1alpha1.Release, len(releaseList.Items))
+	for i := range releaseList.Items {
+		releases[releaseList.Items[i].Name] = &releaseList.Items[i]
+	}
+
+	snapshots := &openchoreov1alpha1.ComponentEnvSnapshotList{}
+	if err := r.List(ctx, snapshots, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component env snapshots: %w", err)
+	}
+	for i := range snapshots.Items {
+		snapshot := &snapshots.Items[i]
+		// The environment differs between the snapshots of the environments, the rest is promoted as is
+		promoted := snapshot.Spec.DeepCopy()
+		promoted.Environment = ""
+		b := &binding{
+			bindingType: promotion.BindingTypeComponentDeployment,
+			project:     snapshot.Spec.Owner.ProjectName,
+			component:   snapshot.Spec.Owner.ComponentName,
+			environment: snapshot.Spec.Environment,
+			promoted:    *promoted,
+		}
+		if cd := deployments[b.key()]; cd != nil {
+			b.healthy = releaseHealthy(snapshot, cd, releases[cd.Name])
+		}
+		add(b)
+	}
+
+	return bindings, nil
+}
+
+// releaseHealthy reports whether the Release of a ComponentDeployment runs the snapshot: the Release must be rendered
+// from the current generation of the snapshot and applied, no rollout may be in progress, and all of its resources
+// must be healthy
+func releaseHealthy(snapshot *openchoreov1alpha1.ComponentEnvSnapshot, cd *openchoreov1alpha1.ComponentDeployment,
+	release *openchoreov1alpha1.Release) bool {
+	if release == nil ||
+		release.Annotations[controller.AnnotationKeySnapshotGeneration] != strconv.FormatInt(snapshot.Generation, 10) ||
+		release.Status.AppliedGeneration != release.Generation || len(release.Status.Resources) == 0 {
+		return false
+	}
+	if cd.Status.Rollout != nil && cd.Status.Rollout.Phase != openchoreov1alpha1.RolloutPhaseHealthy {
+		return false
+	}
+	for _, res := range release.Status.Resources {
+		if res.HealthStatus != openchoreov1alpha1.HealthStatusHealthy && res.HealthStatus != openchoreov1alpha1.HealthStatusSuspended {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionTypeReady is the readiness condition reported by the binding controllers
+const conditionTypeReady = "Ready"
+
+func hasAutoPromotePaths(pipeline *openchoreov1alpha1.DeploymentPipeline) bool {
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.AutoPromote != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// specRevision returns a short hash identifying the promoted part of a binding spec
+func specRevision(promoted any) (string, error) {
+	data, err := json.Marshal(promoted)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the promoted spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:workloadRevisionLength], nil
+}
+
+func pendingKey(p openchoreov1alpha1.PendingPromotion) string {
+	return p.ProjectName + "/" + p.ComponentName + "/" + p.SourceEnvironment + "/" + p.TargetEnvironment
+}
+
+// minPositive returns the smaller of two durations, ignoring non-positive values
+func minPositive(a, b time.Duration) time.Duration {
+	if a <= 0 {
+		return b
+	}
+	if b <= 0 || a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,443 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var _ = Describe("DeploymentPipeline automatic promotion", func() {
+	const (
+		namespace = "auto-promote-org"
+		project   = "shop"
+		component = "cart"
+	)
+
+	var (
+		scheme     *runtime.Scheme
+		reconciler *Reconciler
+		recorder   *record.FakeRecorder
+	)
+
+	newPipeline := func(target openchoreov1alpha1.TargetEnvironmentRef) *openchoreov1alpha1.DeploymentPipeline {
+		return &openchoreov1alpha1.DeploymentPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+			Spec: openchoreov1alpha1.DeploymentPipelineSpec{
+				PromotionPaths: []openchoreov1alpha1.PromotionPath{
+					{SourceEnvironmentRef: "development", TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{target}},
+				},
+			},
+		}
+	}
+
+	newBinding := func(environment, image string, ready bool) *openchoreov1alpha1.ServiceBinding {
+		b := &openchoreov1alpha1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: component + "-" + environment, Namespace: namespace, Generation: 1},
+			Spec: openchoreov1alpha1.ServiceBindingSpec{
+				Owner:       openchoreov1alpha1.ServiceOwner{ProjectName: project, ComponentName: component},
+				Environment: environment,
+				ClassName:   "default",
+				WorkloadSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Containers: map[string]openchoreov1alpha1.Container{"main": {Image: image}},
+				},
+			},
+		}
+		if ready {
+			b.Status.Conditions = []metav1.Condition{{
+				Type:               conditionTypeReady,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ResourcesActive",
+				ObservedGeneration: 1,
+				LastTransitionTime: metav1.Now(),
+			}}
+		}
+		return b
+	}
+
+	setup := func(pipeline *openchoreov1alpha1.DeploymentPipeline, objs ...client.Object) {
+		objs = append(objs,
+			pipeline,
+			&openchoreov1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: project, Namespace: namespace},
+				Spec:       openchoreov1alpha1.ProjectSpec{DeploymentPipelineRef: pipeline.Name},
+			},
+		)
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithStatusSubresource(&openchoreov1alpha1.DeploymentPipeline{}).Build(),
+			Scheme:   scheme,
+			Recorder: recorder,
+		}
+	}
+
+	getBinding := func(environment string) *openchoreov1alpha1.ServiceBinding {
+		b := &openchoreov1alpha1.ServiceBinding{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: component + "-" + environment, Namespace: namespace}, b)).To(Succeed())
+		return b
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	It("promotes a ready workload to the target environment", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+		})
+		setup(pipeline,
+			newBinding("development", "cart:v2", true),
+			newBinding("staging", "cart:v1", true),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+		)
+
+		_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(getBinding("staging").Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart:v2"))
+		Expect(pipeline.Status.PendingPromotions).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(ContainSubstring("AutoPromoted")))
+	})
+
+	It("waits until the workload has been healthy for the configured period", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{HealthyFor: &metav1.Duration{Duration: 30 * time.Minute}},
+		})
+		setup(pipeline,
+			newBinding("development", "cart:v2", true),
+			newBinding("staging", "cart:v1", true),
+		)
+
+		result, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+
+		Expect(getBinding("staging").Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart:v1"))
+		Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+		Expect(pipeline.Status.PendingPromotions[0].Reason).To(Equal(ReasonHealthPeriodPending))
+		Expect(pipeline.Status.PendingPromotions[0].HealthySince).NotTo(BeNil())
+	})
+
+	It("does not promote while the workload is not ready", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+		})
+		setup(pipeline,
+			newBinding("development", "cart:v2", false),
+			newBinding("staging", "cart:v1", true),
+		)
+
+		_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getBinding("staging").Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart:v1"))
+		Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+		Expect(pipeline.Status.PendingPromotions[0].Reason).To(Equal(ReasonWaitingForHealthy))
+	})
+
+	It("leaves promotions that require approval to be done manually", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:             "production",
+			RequiresApproval: true,
+			AutoPromote:      &openchoreov1alpha1.AutoPromotePolicy{},
+		})
+		setup(pipeline, newBinding("development", "cart:v2", true))
+
+		_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+		Expect(pipeline.Status.PendingPromotions[0].Reason).To(Equal(ReasonApprovalRequired))
+	})
+
+	It("defers promotions while the target environment is frozen", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+		})
+		now := time.Now()
+		setup(pipeline,
+			newBinding("development", "cart:v2", true),
+			&openchoreov1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace},
+				Spec: openchoreov1alpha1.EnvironmentSpec{
+					FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+						Name:   "launch",
+						Reason: "product launch",
+						Start:  &metav1.Time{Time: now.Add(-time.Hour)},
+						End:    &metav1.Time{Time: now.Add(time.Hour)},
+					}},
+				},
+			},
+		)
+
+		result, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+		Expect(pipeline.Status.PendingPromotions[0].Reason).To(Equal(ReasonEnvironmentFrozen))
+	})
+
+	It("ignores paths without an autoPromote policy", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{Name: "staging"})
+		setup(pipeline, newBinding("development", "cart:v2", true))
+
+		result, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(pipeline.Status.PendingPromotions).To(BeEmpty())
+	})
+
+	It("counts the healthy period from the time the workload became ready after the revision was observed", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{HealthyFor: &metav1.Duration{Duration: 30 * time.Minute}},
+		})
+		source := newBinding("development", "cart:v2", true)
+		source.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+		revision, err := specRevision(source.Spec.WorkloadSpec)
+		Expect(err).NotTo(HaveOccurred())
+		pipeline.Status.PendingPromotions = []openchoreov1alpha1.PendingPromotion{{
+			ProjectName:        project,
+			ComponentName:      component,
+			SourceEnvironment:  "development",
+			TargetEnvironment:  "staging",
+			Revision:           revision,
+			RevisionObservedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+			Reason:             ReasonWaitingForHealthy,
+		}}
+		setup(pipeline,
+			source,
+			newBinding("staging", "cart:v1", true),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+		)
+
+		_, err = reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getBinding("staging").Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart:v2"))
+		Expect(pipeline.Status.PendingPromotions).To(BeEmpty())
+	})
+
+	It("doesn't count the time the workload was ready with a previous revision", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{HealthyFor: &metav1.Duration{Duration: 30 * time.Minute}},
+		})
+		// The Ready condition stays true while the binding is updated to the new revision
+		source := newBinding("development", "cart:v2", true)
+		source.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+		longAgo := &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+		pipeline.Status.PendingPromotions = []openchoreov1alpha1.PendingPromotion{{
+			ProjectName:        project,
+			ComponentName:      component,
+			SourceEnvironment:  "development",
+			TargetEnvironment:  "staging",
+			Revision:           "previous",
+			RevisionObservedAt: longAgo,
+			HealthySince:       longAgo,
+			Reason:             ReasonHealthPeriodPending,
+		}}
+		setup(pipeline,
+			source,
+			newBinding("staging", "cart:v1", true),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+		)
+
+		result, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+		Expect(getBinding("staging").Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart:v1"))
+		Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+		p := pipeline.Status.PendingPromotions[0]
+		Expect(p.Revision).NotTo(Equal("previous"))
+		Expect(p.Reason).To(Equal(ReasonHealthPeriodPending))
+		Expect(p.RevisionObservedAt.Time).To(BeTemporally("~", time.Now(), time.Minute))
+		Expect(p.HealthySince.Time).To(BeTemporally("~", time.Now(), time.Minute))
+	})
+
+	It("promotes a ready scheduled task", func() {
+		pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+			Name:        "staging",
+			AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+		})
+		setup(pipeline,
+			&openchoreov1alpha1.ScheduledTaskBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: component + "-development", Namespace: namespace, Generation: 1},
+				Spec: openchoreov1alpha1.ScheduledTaskBindingSpec{
+					Owner:       openchoreov1alpha1.ScheduledTaskOwner{ProjectName: project, ComponentName: component},
+					Environment: "development",
+					ClassName:   "default",
+					WorkloadSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+						Containers: map[string]openchoreov1alpha1.Container{"main": {Image: "cart-job:v2"}},
+					},
+				},
+				Status: openchoreov1alpha1.ScheduledTaskBindingStatus{Conditions: []metav1.Condition{{
+					Type:               conditionTypeReady,
+					Status:             metav1.ConditionTrue,
+					Reason:             "ResourcesActive",
+					ObservedGeneration: 1,
+					LastTransitionTime: metav1.Now(),
+				}}},
+			},
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+		)
+
+		_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+		Expect(err).NotTo(HaveOccurred())
+
+		promoted := &openchoreov1alpha1.ScheduledTaskBinding{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: component + "-staging", Namespace: namespace}, promoted)).To(Succeed())
+		Expect(promoted.Spec.Environment).To(Equal("staging"))
+		Expect(promoted.Spec.WorkloadSpec.Containers["main"].Image).To(Equal("cart-job:v2"))
+	})
+
+	Context("with a ComponentType based component", func() {
+		newSnapshot := func(environment, image string) *openchoreov1alpha1.ComponentEnvSnapshot {
+			return &openchoreov1alpha1.ComponentEnvSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: component + "-" + environment, Namespace: namespace, Generation: 2},
+				Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+					Owner:       openchoreov1alpha1.ComponentEnvSnapshotOwner{ProjectName: project, ComponentName: component},
+					Environment: environment,
+					Workload: openchoreov1alpha1.Workload{
+						ObjectMeta: metav1.ObjectMeta{Name: component},
+						Spec: openchoreov1alpha1.WorkloadSpec{WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+							Containers: map[string]openchoreov1alpha1.Container{"main": {Image: image}},
+						}},
+					},
+				},
+			}
+		}
+
+		// The ComponentDeployment reports the Release as written, which says nothing about its health
+		newComponentDeployment := func(environment string) *openchoreov1alpha1.ComponentDeployment {
+			return &openchoreov1alpha1.ComponentDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: component + "-" + environment, Namespace: namespace, Generation: 1},
+				Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+					Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: project, ComponentName: component},
+					Environment: environment,
+				},
+				Status: openchoreov1alpha1.ComponentDeploymentStatus{Conditions: []metav1.Condition{{
+					Type:               conditionTypeReady,
+					Status:             metav1.ConditionTrue,
+					Reason:             "ReleaseReady",
+					ObservedGeneration: 1,
+					LastTransitionTime: metav1.Now(),
+				}}},
+			}
+		}
+
+		newRelease := func(environment, snapshotGeneration string, health openchoreov1alpha1.HealthStatus) *openchoreov1alpha1.Release {
+			return &openchoreov1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        component + "-" + environment,
+					Namespace:   namespace,
+					Generation:  3,
+					Annotations: map[string]string{controller.AnnotationKeySnapshotGeneration: snapshotGeneration},
+				},
+				Spec: openchoreov1alpha1.ReleaseSpec{
+					Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: project, ComponentName: component},
+					EnvironmentName: environment,
+				},
+				Status: openchoreov1alpha1.ReleaseStatus{
+					AppliedGeneration: 3,
+					Resources: []openchoreov1alpha1.ResourceStatus{
+						{ID: "deployment", Group: "apps", Version: "v1", Kind: "Deployment", Name: component, HealthStatus: health},
+					},
+				},
+			}
+		}
+
+		getSnapshot := func(environment string) *openchoreov1alpha1.ComponentEnvSnapshot {
+			snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+			Expect(reconciler.Get(ctx, client.ObjectKey{Name: component + "-" + environment, Namespace: namespace}, snapshot)).To(Succeed())
+			return snapshot
+		}
+
+		It("promotes the snapshot once its Release is healthy", func() {
+			pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+				Name:        "staging",
+				AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+			})
+			setup(pipeline,
+				newSnapshot("development", "cart:v2"),
+				newComponentDeployment("development"),
+				newRelease("development", "2", openchoreov1alpha1.HealthStatusHealthy),
+				&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+			)
+
+			_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot := getSnapshot("staging")
+			Expect(snapshot.Spec.Environment).To(Equal("staging"))
+			Expect(snapshot.Spec.Workload.Spec.Containers["main"].Image).To(Equal("cart:v2"))
+			cd := &openchoreov1alpha1.ComponentDeployment{}
+			Expect(reconciler.Get(ctx, client.ObjectKey{Name: component + "-staging", Namespace: namespace}, cd)).To(Succeed())
+			Expect(cd.Spec.Environment).To(Equal("staging"))
+
+			By("not promoting the same snapshot again")
+			pipeline.Status.PendingPromotions = nil
+			_, err = reconciler.reconcileAutoPromotions(ctx, pipeline)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipeline.Status.PendingPromotions).To(BeEmpty())
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		DescribeTable("waits for the Release of the snapshot to become healthy",
+			func(release *openchoreov1alpha1.Release) {
+				pipeline := newPipeline(openchoreov1alpha1.TargetEnvironmentRef{
+					Name:        "staging",
+					AutoPromote: &openchoreov1alpha1.AutoPromotePolicy{},
+				})
+				objs := []client.Object{
+					newSnapshot("development", "cart:v2"),
+					newComponentDeployment("development"),
+					newSnapshot("staging", "cart:v1"),
+				}
+				if release != nil {
+					objs = append(objs, release)
+				}
+				setup(pipeline, objs...)
+
+				_, err := reconciler.reconcileAutoPromotions(ctx, pipeline)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(getSnapshot("staging").Spec.Workload.Spec.Containers["main"].Image).To(Equal("cart:v1"))
+				Expect(pipeline.Status.PendingPromotions).To(HaveLen(1))
+				Expect(pipeline.Status.PendingPromotions[0].Reason).To(Equal(ReasonWaitingForHealthy))
+			},
+			Entry("without a Release", nil),
+			Entry("while the resources are progressing", newRelease("development", "2", openchoreov1alpha1.HealthStatusProgressing)),
+			Entry("while the resources are degraded", newRelease("development", "2", openchoreov1alpha1.HealthStatusDegraded)),
+			Entry("while the Release is rendered from a previous snapshot", newRelease("development", "1", openchoreov1alpha1.HealthStatusHealthy)),
+		)
+
+		It("doesn't count a Release that is not applied or is rolling out as healthy", func() {
+			release := newRelease("development", "2", openchoreov1alpha1.HealthStatusHealthy)
+			release.Generation = 4
+			cd := newComponentDeployment("development")
+			cd.Status.Rollout = &openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhaseProgressing}
+			snapshot := newSnapshot("development", "cart:v2")
+
+			Expect(releaseHealthy(snapshot, cd, release)).To(BeFalse())
+			release.Generation = 3
+			Expect(releaseHealthy(snapshot, cd, release)).To(BeFalse())
+			cd.Status.Rollout.Phase = openchoreov1alpha1.RolloutPhaseHealthy
+			Expect(releaseHealthy(snapshot, cd, release)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,58 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// listDeploymentPipelinesForBinding enqueues the DeploymentPipeline used by the project of the given binding
+// so that automatic promotions are evaluated when a binding changes. The ComponentEnvSnapshot, the
+// ComponentDeployment and the Release of a ComponentType based component together make up its binding.
+func (r *Reconciler) listDeploymentPipelinesForBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	var projectName string
+	switch b := obj.(type) {
+	case *openchoreov1alpha1.ServiceBinding:
+		projectName = b.Spec.Owner.ProjectName
+	case *openchoreov1alpha1.WebApplicationBinding:
+		projectName = b.Spec.Owner.ProjectName
+	case *openchoreov1alpha1.ScheduledTaskBinding:
+		projectName = b.Spec.Owner.ProjectName
+	case *openchoreov1alpha1.ComponentEnvSnapshot:
+		projectName = b.Spec.Owner.ProjectName
+	case *openchoreov1alpha1.ComponentDeployment:
+		projectName = b.Spec.Owner.ProjectName
+	case *openchoreov1alpha1.Release:
+		projectName = b.Spec.Owner.ProjectName
+	default:
+		return nil
+	}
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, client.ObjectKey{Name: projectName, Namespace: obj.GetNamespace()}, project); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.FromContext(ctx).Error(err, "Failed to get project for binding", "project", projectName)
+		}
+		return nil
+	}
+	if project.Spec.DeploymentPipelineRef == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      project.Spec.DeploymentPipelineRef,
+				Namespace: obj.GetNamespace(),
+			},
+		},
+	}
+}
@@ -76,6 +76,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Hold the changes of the spec while the environment is in a freeze window, still refreshing the status
+	// of the resources applied before
+	frozenFor, err := r.checkFreeze(ctx, release)
+	if err != nil {
+		logger.Error(err, "Failed to check environment freeze windows")
+		return ctrl.Result{}, err
+	}
+	if frozenFor > 0 {
+		return r.reconcileFrozen(ctx, old, release, frozenFor)
+	}
+
 	// Get dataplane client for the environment
 	dpClient, err := r.getDPClient(ctx, release.Namespace, release.Spec.EnvironmentName)
 	if err != nil {
@@ -125,6 +136,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// PHASE 4: Update status with applied resources inventory (done last after all operations)
 	// This maintains an inventory of what we applied for future cleanup operations
+	release.Status.AppliedGeneration = release.Generation
 	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources); err != nil || statusUpdated {
 		// Return after updating the status to ensure it is persisted before continuing
 		return ctrl.Result{}, err
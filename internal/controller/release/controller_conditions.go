@@ -14,6 +14,9 @@ import (
 const (
 	// ConditionFinalizing represents whether the Release is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionFrozen represents whether applying the Release is blocked by an environment freeze window
+	ConditionFrozen controller.ConditionType = "Frozen"
 )
 
 // Constants for condition reasons
@@ -25,6 +28,13 @@ const (
 	ReasonCleanupInProgress controller.ConditionReason = "CleanupInProgress"
 	// ReasonCleanupFailed cleanup of dataplane resources failed
 	ReasonCleanupFailed controller.ConditionReason = "CleanupFailed"
+
+	// Reasons for Frozen condition type
+
+	// ReasonFreezeWindowActive the target environment is in an active freeze window
+	ReasonFreezeWindowActive controller.ConditionReason = "FreezeWindowActive"
+	// ReasonInvalidFreezeWindow the target environment has a freeze window that cannot be evaluated
+	ReasonInvalidFreezeWindow controller.ConditionReason = "InvalidFreezeWindow"
 )
 
 func NewReleaseFinalizingCondition(generation int64) metav1.Condition {
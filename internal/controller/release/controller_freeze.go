@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/freeze"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// invalidFreezeWindowRetryInterval is how often a Release blocked by a misconfigured freeze window is retried.
+const invalidFreezeWindowRetryInterval = 5 * time.Minute
+
+// checkFreeze determines whether applying the changes of the Release spec is blocked by a freeze window on its
+// environment. The spec last applied is not held, so its resources are still kept in their desired state.
+// A change is allowed through an active window when an override was recorded for its component on the
+// environment during the current window.
+// Returns the duration after which the Release should be reconciled again when blocked, or zero if not blocked.
+func (r *Reconciler) checkFreeze(ctx context.Context, release *openchoreov1alpha1.Release) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+
+	if release.Status.AppliedGeneration == release.Generation {
+		meta.RemoveStatusCondition(&release.Status.Conditions, string(ConditionFrozen))
+		return 0, nil
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: release.Spec.EnvironmentName, Namespace: release.Namespace}, env); err != nil {
+		return 0, fmt.Errorf("failed to get environment %s: %w", release.Spec.EnvironmentName, err)
+	}
+
+	now := time.Now()
+	active, err := freeze.Evaluate(env.Spec.FreezeWindows, now)
+	if err != nil {
+		// Fail closed so that a broken window definition does not silently allow deployments
+		logger.Error(err, "Failed to evaluate freeze windows", "environment", env.Name)
+		controller.MarkTrueCondition(release, ConditionFrozen, ReasonInvalidFreezeWindow, err.Error())
+		return invalidFreezeWindowRetryInterval, nil
+	}
+
+	if active == nil || freeze.HasOverride(env.Status.FreezeOverrides, active, release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName) {
+		meta.RemoveStatusCondition(&release.Status.Conditions, string(ConditionFrozen))
+		return 0, nil
+	}
+
+	msg := fmt.Sprintf("Environment %s is frozen by window %q (%s) until %s",
+		env.Name, active.Name, active.Reason, active.End.UTC().Format(time.RFC3339))
+	controller.MarkTrueCondition(release, ConditionFrozen, ReasonFreezeWindowActive, msg)
+	logger.Info("Holding changes during freeze window", "environment", env.Name, "window", active.Name, "until", active.End)
+
+	return active.End.Sub(now), nil
+}
+
+// reconcileFrozen refreshes the status of the resources applied before the freeze window, without applying the
+// changes of the spec or deleting the resources removed from it
+func (r *Reconciler) reconcileFrozen(ctx context.Context, old, release *openchoreov1alpha1.Release,
+	frozenFor time.Duration) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	dpClient, err := r.getDPClient(ctx, release.Namespace, release.Spec.EnvironmentName)
+	if err != nil {
+		logger.Error(err, "Failed to get dataplane client")
+		return ctrl.Result{}, err
+	}
+
+	appliedResources := makeAppliedResources(release.Status.Resources)
+	gvks := findAllKnownGVKs(nil, release.Status.Resources)
+	liveResources, err := r.listLiveResourcesByGVKs(ctx, dpClient, release, gvks)
+	if err != nil {
+		logger.Error(err, "Failed to list live resources from dataplane")
+		return ctrl.Result{}, err
+	}
+
+	if statusUpdated, err := r.updateStatus(ctx, old, release, appliedResources, liveResources); err != nil || statusUpdated {
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := getStableRequeueInterval(release)
+	if r.hasTransitioningResources(release.Status.Resources) {
+		requeueAfter = getProgressingRequeueInterval(release)
+	}
+	if requeueAfter == 0 || frozenFor < requeueAfter {
+		requeueAfter = frozenFor
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// makeAppliedResources makes the identities of the resources in the status inventory, which are enough to
+// match them with their live resources
+func makeAppliedResources(resources []openchoreov1alpha1.ResourceStatus) []*unstructured.Unstructured {
+	applied := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: resource.Group, Version: resource.Version, Kind: resource.Kind})
+		obj.SetName(resource.Name)
+		obj.SetNamespace(resource.Namespace)
+		obj.SetLabels(map[string]string{labels.LabelKeyReleaseResourceID: resource.ID})
+		applied = append(applied, obj)
+	}
+	return applied
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var _ = Describe("Release freeze windows", func() {
+	const namespace = "freeze-org"
+
+	var reconciler *Reconciler
+
+	newRelease := func(generation, appliedGeneration int64) *openchoreov1alpha1.Release {
+		return &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-production", Namespace: namespace, Generation: generation},
+			Spec: openchoreov1alpha1.ReleaseSpec{
+				Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: "shop", ComponentName: "cart"},
+				EnvironmentName: "production",
+			},
+			Status: openchoreov1alpha1.ReleaseStatus{AppliedGeneration: appliedGeneration},
+		}
+	}
+
+	setup := func(overrides ...openchoreov1alpha1.FreezeOverrideRecord) {
+		now := time.Now()
+		env := &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: namespace},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+					Name:   "release-week",
+					Reason: "Quarterly release",
+					Start:  &metav1.Time{Time: now.Add(-time.Hour)},
+					End:    &metav1.Time{Time: now.Add(time.Hour)},
+				}},
+			},
+			Status: openchoreov1alpha1.EnvironmentStatus{FreezeOverrides: overrides},
+		}
+		testScheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(testScheme)).To(Succeed())
+		reconciler = &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(testScheme).WithObjects(env).Build(),
+			Scheme: testScheme,
+		}
+	}
+
+	It("holds a change of the spec during an active window", func() {
+		setup()
+		release := newRelease(2, 1)
+
+		frozenFor, err := reconciler.checkFreeze(ctx, release)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(frozenFor).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(meta.IsStatusConditionTrue(release.Status.Conditions, string(ConditionFrozen))).To(BeTrue())
+	})
+
+	It("keeps reconciling the applied spec during an active window", func() {
+		setup()
+		release := newRelease(2, 2)
+		release.Status.Conditions = []metav1.Condition{{Type: string(ConditionFrozen), Status: metav1.ConditionTrue}}
+
+		frozenFor, err := reconciler.checkFreeze(ctx, release)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(frozenFor).To(BeZero())
+		Expect(meta.FindStatusCondition(release.Status.Conditions, string(ConditionFrozen))).To(BeNil())
+	})
+
+	It("applies a change with an override recorded on the environment", func() {
+		setup(openchoreov1alpha1.FreezeOverrideRecord{
+			Window:        "release-week",
+			Justification: "hotfix",
+			Action:        "Promote",
+			Target:        "Component/cart",
+			Project:       "shop",
+			Component:     "cart",
+			Timestamp:     metav1.Now(),
+		})
+		release := newRelease(2, 1)
+
+		frozenFor, err := reconciler.checkFreeze(ctx, release)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(frozenFor).To(BeZero())
+	})
+
+	It("makes the applied resources from the status inventory", func() {
+		applied := makeAppliedResources([]openchoreov1alpha1.ResourceStatus{
+			{ID: "deployment", Group: "apps", Version: "v1", Kind: "Deployment", Name: "cart", Namespace: "dp-shop"},
+		})
+
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].GroupVersionKind()).To(Equal(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+		Expect(applied[0].GetName()).To(Equal("cart"))
+		Expect(applied[0].GetNamespace()).To(Equal("dp-shop"))
+		Expect(applied[0].GetLabels()).To(HaveKeyWithValue(labels.LabelKeyReleaseResourceID, "deployment"))
+	})
+})
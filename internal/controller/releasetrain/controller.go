@@ -0,0 +1,223 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasetrain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/promotion"
+)
+
+// revisionLength is the number of hex characters of the snapshot hash used as the component revision.
+const revisionLength = 10
+
+// Reconciler reconciles a ReleaseTrain object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasetrains,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasetrains/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasetrains/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentenvsnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentdeployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=deploymentpipelines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	train := &openchoreov1alpha1.ReleaseTrain{}
+	if err := r.Get(ctx, req.NamespacedName, train); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get ReleaseTrain")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Keep a copy for comparison
+	old := train.DeepCopy()
+
+	// Deferred status update
+	defer func() {
+		train.Status.ObservedGeneration = train.Generation
+
+		if apiequality.Semantic.DeepEqual(old.Status, train.Status) {
+			return
+		}
+
+		if err := r.Status().Update(ctx, train); err != nil {
+			logger.Error(err, "Failed to update ReleaseTrain status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	// Pin the snapshots of the source environment the first time the train is reconciled
+	if len(train.Status.Components) == 0 {
+		pinned, err := r.pinSnapshots(ctx, train)
+		if err != nil || !pinned {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if train.Status.Phase == openchoreov1alpha1.ReleaseTrainPhaseVerifying {
+		return r.verifyPromotion(ctx, train)
+	}
+
+	if train.Spec.TargetEnvironment == train.Status.CurrentEnvironment {
+		if train.Status.Phase == "" {
+			train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhaseSucceeded
+			controller.MarkTrueCondition(train, ConditionReady, ReasonPromotionSucceeded,
+				fmt.Sprintf("Release train is deployed to environment %q", train.Status.CurrentEnvironment))
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Do not retry a failed promotion until the train is changed
+	if isFailed(train.Status.Phase) &&
+		train.Status.PromotionGeneration == train.Generation &&
+		train.Status.PromotionEnvironment == train.Spec.TargetEnvironment {
+		return ctrl.Result{}, nil
+	}
+
+	return r.promote(ctx, train)
+}
+
+// pinSnapshots records the ComponentEnvSnapshot of each component in the source environment in the status.
+// Returns false if any of the snapshots is not available yet.
+func (r *Reconciler) pinSnapshots(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	components := make([]openchoreov1alpha1.ReleaseTrainComponentStatus, 0, len(train.Spec.Components))
+	for _, c := range train.Spec.Components {
+		snapshot, err := r.findSnapshot(ctx, train.Namespace, train.Spec.Owner.ProjectName, c.Name, train.Spec.SourceEnvironment)
+		if err != nil {
+			return false, err
+		}
+		if snapshot == nil {
+			msg := fmt.Sprintf("ComponentEnvSnapshot for component %q in environment %q not found",
+				c.Name, train.Spec.SourceEnvironment)
+			controller.MarkFalseCondition(train, ConditionReady, ReasonComponentEnvSnapshotNotFound, msg)
+			logger.Info(msg, "project", train.Spec.Owner.ProjectName)
+			return false, nil
+		}
+
+		raw, err := json.Marshal(snapshot.Spec)
+		if err != nil {
+			return false, fmt.Errorf("failed to encode snapshot of component %s: %w", c.Name, err)
+		}
+		sum := sha256.Sum256(raw)
+		components = append(components, openchoreov1alpha1.ReleaseTrainComponentStatus{
+			Name:     c.Name,
+			Revision: hex.EncodeToString(sum[:])[:revisionLength],
+			Snapshot: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	train.Status.Components = components
+	train.Status.CurrentEnvironment = train.Spec.SourceEnvironment
+	logger.Info("Pinned component snapshots", "components", len(components), "environment", train.Spec.SourceEnvironment)
+	return true, nil
+}
+
+// findSnapshot returns the ComponentEnvSnapshot of the component in the environment, or nil if there is none.
+func (r *Reconciler) findSnapshot(ctx context.Context, namespace, project, component, environment string) (*openchoreov1alpha1.ComponentEnvSnapshot, error) {
+	snapshot, err := promotion.GetComponentEnvSnapshot(ctx, r.Client, namespace, project, component, environment)
+	if errors.Is(err, promotion.ErrBindingNotFound) {
+		return nil, nil
+	}
+	return snapshot, err
+}
+
+// findComponentDeployment returns the ComponentDeployment of the component in the environment, or nil if there is none.
+func (r *Reconciler) findComponentDeployment(ctx context.Context, namespace, project, component, environment string) (*openchoreov1alpha1.ComponentDeployment, error) {
+	cd, err := promotion.GetComponentDeployment(ctx, r.Client, namespace, project, component, environment)
+	if errors.Is(err, promotion.ErrBindingNotFound) {
+		return nil, nil
+	}
+	return cd, err
+}
+
+func isFailed(phase openchoreov1alpha1.ReleaseTrainPhase) bool {
+	return phase == openchoreov1alpha1.ReleaseTrainPhaseRolledBack || phase == openchoreov1alpha1.ReleaseTrainPhaseFailed
+}
+
+// listReleaseTrainsForSnapshot maps a ComponentEnvSnapshot to the release trains of its project
+// that are still waiting for their snapshots to be pinned.
+func (r *Reconciler) listReleaseTrainsForSnapshot(ctx context.Context, obj client.Object) []reconcile.Request {
+	snapshot, ok := obj.(*openchoreov1alpha1.ComponentEnvSnapshot)
+	if !ok {
+		return nil
+	}
+	return r.listReleaseTrains(ctx, snapshot.Namespace, snapshot.Spec.Owner.ProjectName, func(t *openchoreov1alpha1.ReleaseTrain) bool {
+		return len(t.Status.Components) == 0
+	})
+}
+
+// listReleaseTrainsForRelease maps a Release to the release trains of its project that are verifying a promotion.
+func (r *Reconciler) listReleaseTrainsForRelease(ctx context.Context, obj client.Object) []reconcile.Request {
+	release, ok := obj.(*openchoreov1alpha1.Release)
+	if !ok {
+		return nil
+	}
+	return r.listReleaseTrains(ctx, release.Namespace, release.Spec.Owner.ProjectName, func(t *openchoreov1alpha1.ReleaseTrain) bool {
+		return t.Status.Phase == openchoreov1alpha1.ReleaseTrainPhaseVerifying
+	})
+}
+
+func (r *Reconciler) listReleaseTrains(ctx context.Context, namespace, project string,
+	filter func(*openchoreov1alpha1.ReleaseTrain) bool) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	var trains openchoreov1alpha1.ReleaseTrainList
+	if err := r.List(ctx, &trains, client.InNamespace(namespace)); err != nil {
+		logger.Error(err, "Failed to list ReleaseTrains", "namespace", namespace)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range trains.Items {
+		t := &trains.Items[i]
+		if t.Spec.Owner.ProjectName != project || !filter(t) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(t)})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.ReleaseTrain{}).
+		Watches(&openchoreov1alpha1.ComponentEnvSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.listReleaseTrainsForSnapshot)).
+		Watches(&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.listReleaseTrainsForRelease)).
+		Named("releasetrain").
+		Complete(r)
+}
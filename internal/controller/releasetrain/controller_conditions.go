@@ -0,0 +1,55 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasetrain
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates that all components of the ReleaseTrain are healthy in the target environment
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// Success states (Status=True)
+
+	// ReasonPromotionSucceeded indicates all components were promoted and are healthy
+	ReasonPromotionSucceeded controller.ConditionReason = "PromotionSucceeded"
+
+	// In-progress states (Status=False)
+
+	// ReasonPromotionInProgress indicates the components were promoted and their health is being verified
+	ReasonPromotionInProgress controller.ConditionReason = "PromotionInProgress"
+	// ReasonEnvironmentFrozen indicates the target environment is in a deployment freeze window
+	ReasonEnvironmentFrozen controller.ConditionReason = "EnvironmentFrozen"
+
+	// Configuration issues (Status=False)
+
+	// ReasonProjectNotFound indicates the project of the ReleaseTrain doesn't exist
+	ReasonProjectNotFound controller.ConditionReason = "ProjectNotFound"
+	// ReasonDeploymentPipelineNotFound indicates the DeploymentPipeline of the project doesn't exist
+	ReasonDeploymentPipelineNotFound controller.ConditionReason = "DeploymentPipelineNotFound"
+	// ReasonComponentNotFound indicates a component of the ReleaseTrain doesn't exist or has no ComponentType
+	ReasonComponentNotFound controller.ConditionReason = "ComponentNotFound"
+	// ReasonComponentEnvSnapshotNotFound indicates a component has no snapshot in the source environment
+	ReasonComponentEnvSnapshotNotFound controller.ConditionReason = "ComponentEnvSnapshotNotFound"
+	// ReasonInvalidPromotionPath indicates the DeploymentPipeline has no path to the target environment
+	ReasonInvalidPromotionPath controller.ConditionReason = "InvalidPromotionPath"
+	// ReasonEnvironmentNotFound indicates the target environment doesn't exist
+	ReasonEnvironmentNotFound controller.ConditionReason = "EnvironmentNotFound"
+	// ReasonInvalidFreezeWindow indicates the freeze windows of the target environment could not be evaluated
+	ReasonInvalidFreezeWindow controller.ConditionReason = "InvalidFreezeWindow"
+
+	// Failure states (Status=False)
+
+	// ReasonRolledBack indicates a component failed and all components were rolled back
+	ReasonRolledBack controller.ConditionReason = "RolledBack"
+	// ReasonPromotionFailed indicates the promotion or its rollback could not be completed
+	ReasonPromotionFailed controller.ConditionReason = "PromotionFailed"
+)
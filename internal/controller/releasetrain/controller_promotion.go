@@ -0,0 +1,503 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasetrain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/freeze"
+)
+
+const (
+	// defaultHealthTimeout is how long to wait for the components to become healthy when the train doesn't specify it.
+	defaultHealthTimeout = 10 * time.Minute
+
+	// verifyInterval is how often the health of the promoted components is checked.
+	verifyInterval = 10 * time.Second
+
+	// invalidFreezeWindowRetryInterval is how often a train blocked by a misconfigured freeze window is retried.
+	invalidFreezeWindowRetryInterval = 5 * time.Minute
+
+	// freezeOverrideAction is the action recorded on the environment when a train overrides a freeze window.
+	freezeOverrideAction = "PromoteReleaseTrain"
+)
+
+// promote copies the pinned snapshots of all components to the target environment.
+// Nothing is written unless all components can be promoted; if a write fails part way,
+// the components that were already promoted are rolled back.
+func (r *Reconciler) promote(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	target := train.Spec.TargetEnvironment
+
+	train.Status.PromotionEnvironment = target
+	train.Status.PromotionGeneration = train.Generation
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, client.ObjectKey{Name: train.Spec.Owner.ProjectName, Namespace: train.Namespace}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.markFailed(train, ReasonProjectNotFound,
+				fmt.Sprintf("Project %q not found", train.Spec.Owner.ProjectName))
+		}
+		return ctrl.Result{}, err
+	}
+
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	if err := r.Get(ctx, client.ObjectKey{Name: project.Spec.DeploymentPipelineRef, Namespace: train.Namespace}, pipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.markFailed(train, ReasonDeploymentPipelineNotFound,
+				fmt.Sprintf("DeploymentPipeline %q not found", project.Spec.DeploymentPipelineRef))
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !hasPromotionPath(pipeline, train.Status.CurrentEnvironment, target) {
+		return r.markFailed(train, ReasonInvalidPromotionPath,
+			fmt.Sprintf("DeploymentPipeline %q has no promotion path from %q to %q",
+				pipeline.Name, train.Status.CurrentEnvironment, target))
+	}
+
+	components := make([]*openchoreov1alpha1.Component, 0, len(train.Status.Components))
+	for _, c := range train.Status.Components {
+		comp := &openchoreov1alpha1.Component{}
+		if err := r.Get(ctx, client.ObjectKey{Name: c.Name, Namespace: train.Namespace}, comp); err != nil {
+			if apierrors.IsNotFound(err) {
+				return r.markFailed(train, ReasonComponentNotFound, fmt.Sprintf("Component %q not found", c.Name))
+			}
+			return ctrl.Result{}, err
+		}
+		components = append(components, comp)
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: target, Namespace: train.Namespace}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.markFailed(train, ReasonEnvironmentNotFound, fmt.Sprintf("Environment %q not found", target))
+		}
+		return ctrl.Result{}, err
+	}
+
+	if wait := r.checkFreeze(ctx, train, env); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	for i := range train.Status.Components {
+		status := &train.Status.Components[i]
+		status.PreviousSnapshot = nil
+		status.CreatedDeployment = false
+		status.HealthStatus = openchoreov1alpha1.HealthStatusProgressing
+		status.Message = ""
+	}
+
+	for i := range train.Status.Components {
+		if err := r.promoteComponent(ctx, train, components[i], &train.Status.Components[i]); err != nil {
+			logger.Error(err, "Failed to promote component, rolling back", "component", train.Status.Components[i].Name)
+			msg := fmt.Sprintf("Failed to promote component %q: %v", train.Status.Components[i].Name, err)
+			if rbErr := r.rollback(ctx, train, i+1); rbErr != nil {
+				msg = fmt.Sprintf("%s; rollback failed: %v", msg, rbErr)
+			}
+			return r.markFailed(train, ReasonPromotionFailed, msg)
+		}
+	}
+
+	now := metav1.Now()
+	train.Status.PromotionStartedAt = &now
+	train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhaseVerifying
+	controller.MarkFalseCondition(train, ConditionReady, ReasonPromotionInProgress,
+		fmt.Sprintf("Promoted %d components to environment %q, waiting for them to become healthy",
+			len(train.Status.Components), target))
+	logger.Info("Promoted release train", "from", train.Status.CurrentEnvironment, "to", target)
+
+	// A failure is retried while verifying, as the components were promoted
+	if err := r.recordFreezeOverride(ctx, train); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: verifyInterval}, nil
+}
+
+// promoteComponent deploys the pinned snapshot of a component to the promotion environment,
+// recording what is needed to roll it back in the component status.
+func (r *Reconciler) promoteComponent(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain,
+	comp *openchoreov1alpha1.Component, status *openchoreov1alpha1.ReleaseTrainComponentStatus) error {
+	env := train.Status.PromotionEnvironment
+
+	var spec openchoreov1alpha1.ComponentEnvSnapshotSpec
+	if err := json.Unmarshal(status.Snapshot.Raw, &spec); err != nil {
+		return fmt.Errorf("failed to decode pinned snapshot: %w", err)
+	}
+	spec.Environment = env
+
+	existing, err := r.findSnapshot(ctx, train.Namespace, train.Spec.Owner.ProjectName, comp.Name, env)
+	if err != nil {
+		return err
+	}
+	cd, err := r.findComponentDeployment(ctx, train.Namespace, train.Spec.Owner.ProjectName, comp.Name, env)
+	if err != nil {
+		return err
+	}
+
+	// The health of the component is read from the Release rendered from the promoted snapshot, which is
+	// a later generation unless the snapshot is unchanged
+	status.ReleaseGeneration = 0
+	if cd != nil && (existing == nil || !apiequality.Semantic.DeepEqual(existing.Spec, spec)) {
+		release := &openchoreov1alpha1.Release{}
+		if err := r.Get(ctx, client.ObjectKey{Name: cd.Name, Namespace: cd.Namespace}, release); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get release: %w", err)
+		}
+		status.ReleaseGeneration = release.Generation
+	}
+
+	if existing != nil {
+		previous, err := json.Marshal(existing.Spec)
+		if err != nil {
+			return fmt.Errorf("failed to encode previous snapshot: %w", err)
+		}
+		status.PreviousSnapshot = &runtime.RawExtension{Raw: previous}
+		existing.Spec = spec
+		if err := r.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update component env snapshot: %w", err)
+		}
+	} else {
+		// Owned by the Component, same as the snapshot of the root environment
+		snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", comp.Name, env),
+				Namespace: train.Namespace,
+			},
+			Spec: spec,
+		}
+		if err := controllerutil.SetControllerReference(comp, snapshot, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to create component env snapshot: %w", err)
+		}
+	}
+
+	if cd == nil {
+		cd = &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", comp.Name, env),
+				Namespace: train.Namespace,
+			},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner: openchoreov1alpha1.ComponentDeploymentOwner{
+					ProjectName:   train.Spec.Owner.ProjectName,
+					ComponentName: comp.Name,
+				},
+				Environment: env,
+			},
+		}
+		if err := r.Create(ctx, cd); err != nil {
+			return fmt.Errorf("failed to create component deployment: %w", err)
+		}
+		status.CreatedDeployment = true
+	}
+
+	return nil
+}
+
+// verifyPromotion checks the health of the promoted components. The train succeeds once all of them
+// are healthy and is rolled back as soon as one of them is degraded or the health timeout expires.
+func (r *Reconciler) verifyPromotion(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if err := r.recordFreezeOverride(ctx, train); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	startedAt := time.Now()
+	if train.Status.PromotionStartedAt != nil {
+		startedAt = train.Status.PromotionStartedAt.Time
+	}
+
+	var degraded, pending []string
+	for i := range train.Status.Components {
+		status := &train.Status.Components[i]
+		health, msg, err := r.componentHealth(ctx, train, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		status.HealthStatus = health
+		status.Message = msg
+		switch health {
+		case openchoreov1alpha1.HealthStatusHealthy:
+		case openchoreov1alpha1.HealthStatusDegraded:
+			degraded = append(degraded, status.Name)
+		default:
+			pending = append(pending, status.Name)
+		}
+	}
+
+	if len(degraded) > 0 {
+		return r.rollbackPromotion(ctx, train,
+			fmt.Sprintf("Components degraded in environment %q: %s", train.Status.PromotionEnvironment, strings.Join(degraded, ", ")))
+	}
+
+	if len(pending) == 0 {
+		train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhaseSucceeded
+		train.Status.CurrentEnvironment = train.Status.PromotionEnvironment
+		for i := range train.Status.Components {
+			train.Status.Components[i].PreviousSnapshot = nil
+			train.Status.Components[i].CreatedDeployment = false
+		}
+		controller.MarkTrueCondition(train, ConditionReady, ReasonPromotionSucceeded,
+			fmt.Sprintf("All components are healthy in environment %q", train.Status.CurrentEnvironment))
+		logger.Info("Release train promotion succeeded", "environment", train.Status.CurrentEnvironment)
+		return ctrl.Result{}, nil
+	}
+
+	timeout := defaultHealthTimeout
+	if train.Spec.HealthTimeout != nil {
+		timeout = train.Spec.HealthTimeout.Duration
+	}
+	remaining := time.Until(startedAt.Add(timeout))
+	if remaining <= 0 {
+		return r.rollbackPromotion(ctx, train,
+			fmt.Sprintf("Components did not become healthy in environment %q within %s: %s",
+				train.Status.PromotionEnvironment, timeout, strings.Join(pending, ", ")))
+	}
+
+	return ctrl.Result{RequeueAfter: min(verifyInterval, remaining)}, nil
+}
+
+// componentHealth derives the health of a component in the promotion environment from its Release, once the
+// Release rendered from the promoted snapshot has been applied.
+func (r *Reconciler) componentHealth(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain,
+	status *openchoreov1alpha1.ReleaseTrainComponentStatus) (openchoreov1alpha1.HealthStatus, string, error) {
+	env := train.Status.PromotionEnvironment
+
+	cd, err := r.findComponentDeployment(ctx, train.Namespace, train.Spec.Owner.ProjectName, status.Name, env)
+	if err != nil {
+		return "", "", err
+	}
+	if cd == nil {
+		return openchoreov1alpha1.HealthStatusDegraded, "ComponentDeployment was removed", nil
+	}
+
+	// Releases are named after the ComponentDeployment they are rendered from
+	release := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, client.ObjectKey{Name: cd.Name, Namespace: cd.Namespace}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return openchoreov1alpha1.HealthStatusProgressing, "Waiting for the Release to be created", nil
+		}
+		return "", "", err
+	}
+
+	if release.Generation <= status.ReleaseGeneration {
+		return openchoreov1alpha1.HealthStatusProgressing, "Waiting for the Release to be updated", nil
+	}
+	if release.Status.AppliedGeneration != release.Generation || len(release.Status.Resources) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, "Waiting for resources to be applied", nil
+	}
+
+	for _, res := range release.Status.Resources {
+		name := fmt.Sprintf("%s/%s", res.Kind, res.Name)
+		switch res.HealthStatus {
+		case openchoreov1alpha1.HealthStatusDegraded:
+			return openchoreov1alpha1.HealthStatusDegraded, fmt.Sprintf("Resource %s is degraded", name), nil
+		case openchoreov1alpha1.HealthStatusHealthy, openchoreov1alpha1.HealthStatusSuspended:
+		default:
+			return openchoreov1alpha1.HealthStatusProgressing, fmt.Sprintf("Resource %s is not healthy yet", name), nil
+		}
+	}
+
+	return openchoreov1alpha1.HealthStatusHealthy, "", nil
+}
+
+// rollbackPromotion rolls all components back to the state before the promotion.
+func (r *Reconciler) rollbackPromotion(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain, reason string) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("Rolling back release train", "reason", reason, "environment", train.Status.PromotionEnvironment)
+
+	if err := r.rollback(ctx, train, len(train.Status.Components)); err != nil {
+		return r.markFailed(train, ReasonPromotionFailed, fmt.Sprintf("%s; rollback failed: %v", reason, err))
+	}
+
+	train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhaseRolledBack
+	controller.MarkFalseCondition(train, ConditionReady, ReasonRolledBack,
+		fmt.Sprintf("%s; all components were rolled back", reason))
+	return ctrl.Result{}, nil
+}
+
+// rollback restores the snapshots and deployments of the first n components of the train
+// to the state they had before the promotion.
+func (r *Reconciler) rollback(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain, n int) error {
+	env := train.Status.PromotionEnvironment
+
+	var errs []string
+	for i := 0; i < n; i++ {
+		status := &train.Status.Components[i]
+		if err := r.rollbackComponent(ctx, train, status, env); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", status.Name, err))
+			continue
+		}
+		status.PreviousSnapshot = nil
+		status.CreatedDeployment = false
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (r *Reconciler) rollbackComponent(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain,
+	status *openchoreov1alpha1.ReleaseTrainComponentStatus, env string) error {
+	snapshot, err := r.findSnapshot(ctx, train.Namespace, train.Spec.Owner.ProjectName, status.Name, env)
+	if err != nil {
+		return err
+	}
+
+	if status.PreviousSnapshot != nil {
+		if snapshot == nil {
+			return fmt.Errorf("component env snapshot in environment %q was removed", env)
+		}
+		var previous openchoreov1alpha1.ComponentEnvSnapshotSpec
+		if err := json.Unmarshal(status.PreviousSnapshot.Raw, &previous); err != nil {
+			return fmt.Errorf("failed to decode previous snapshot: %w", err)
+		}
+		snapshot.Spec = previous
+		if err := r.Update(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to restore component env snapshot: %w", err)
+		}
+	} else if snapshot != nil {
+		if err := r.Delete(ctx, snapshot); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete component env snapshot: %w", err)
+		}
+	}
+
+	if status.CreatedDeployment {
+		cd, err := r.findComponentDeployment(ctx, train.Namespace, train.Spec.Owner.ProjectName, status.Name, env)
+		if err != nil {
+			return err
+		}
+		if cd != nil {
+			if err := r.Delete(ctx, cd); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete component deployment: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkFreeze determines whether the promotion is blocked by a freeze window on the target environment.
+// A train is only let through when a freeze override for the active window was granted through the API.
+// Returns the duration after which the train should be reconciled again when blocked, or zero if not blocked.
+func (r *Reconciler) checkFreeze(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain,
+	env *openchoreov1alpha1.Environment) time.Duration {
+	logger := log.FromContext(ctx)
+
+	now := time.Now()
+	active, err := freeze.Evaluate(env.Spec.FreezeWindows, now)
+	if err != nil {
+		// Fail closed so that a broken window definition does not silently allow deployments
+		logger.Error(err, "Failed to evaluate freeze windows", "environment", env.Name)
+		train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhasePending
+		controller.MarkFalseCondition(train, ConditionReady, ReasonInvalidFreezeWindow, err.Error())
+		return invalidFreezeWindowRetryInterval
+	}
+	if active == nil {
+		return 0
+	}
+
+	if override := train.Status.FreezeOverride; override == nil || override.Environment != env.Name || override.Window != active.Name {
+		train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhasePending
+		controller.MarkFalseCondition(train, ConditionReady, ReasonEnvironmentFrozen,
+			fmt.Sprintf("Environment %s is frozen by window %q (%s) until %s",
+				env.Name, active.Name, active.Reason, active.End.UTC().Format(time.RFC3339)))
+		logger.Info("Deferring promotion during freeze window", "environment", env.Name, "window", active.Name, "until", active.End)
+		return active.End.Sub(now)
+	}
+	return 0
+}
+
+// recordFreezeOverride records the freeze override of the train on its environment for each of its components,
+// so that their Releases are applied during the window, and clears it from the train. It is called once the
+// components were promoted, so that a promotion that didn't happen leaves no record.
+func (r *Reconciler) recordFreezeOverride(ctx context.Context, train *openchoreov1alpha1.ReleaseTrain) error {
+	override := train.Status.FreezeOverride
+	if override == nil {
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		env := &openchoreov1alpha1.Environment{}
+		if err := r.Get(ctx, client.ObjectKey{Name: override.Environment, Namespace: train.Namespace}, env); err != nil {
+			return err
+		}
+		active, err := freeze.Evaluate(env.Spec.FreezeWindows, time.Now())
+		if err != nil || active == nil || active.Name != override.Window {
+			// The window the override was granted for is over
+			return nil
+		}
+		changed := false
+		for _, c := range train.Status.Components {
+			if freeze.HasOverride(env.Status.FreezeOverrides, active, train.Spec.Owner.ProjectName, c.Name) {
+				continue
+			}
+			env.Status.FreezeOverrides = freeze.AppendOverride(env.Status.FreezeOverrides, openchoreov1alpha1.FreezeOverrideRecord{
+				Window:        active.Name,
+				Justification: override.Justification,
+				Action:        freezeOverrideAction,
+				Target:        fmt.Sprintf("ReleaseTrain/%s", train.Name),
+				Project:       train.Spec.Owner.ProjectName,
+				Component:     c.Name,
+				Principal:     override.Principal,
+				Timestamp:     metav1.Now(),
+			})
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+		return r.Status().Update(ctx, env)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record freeze override: %w", err)
+	}
+
+	log.FromContext(ctx).Info("Freeze window overridden", "environment", override.Environment, "window", override.Window,
+		"principal", override.Principal, "justification", override.Justification)
+	train.Status.FreezeOverride = nil
+	return nil
+}
+
+// markFailed marks the promotion as failed. The promotion is not retried until the train is changed.
+func (r *Reconciler) markFailed(train *openchoreov1alpha1.ReleaseTrain, reason controller.ConditionReason,
+	msg string) (ctrl.Result, error) {
+	train.Status.Phase = openchoreov1alpha1.ReleaseTrainPhaseFailed
+	controller.MarkFalseCondition(train, ConditionReady, reason, msg)
+	return ctrl.Result{}, nil
+}
+
+// hasPromotionPath reports whether the pipeline allows promoting directly from source to target.
+func hasPromotionPath(pipeline *openchoreov1alpha1.DeploymentPipeline, source, target string) bool {
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef != source {
+			continue
+		}
+		for _, t := range path.TargetEnvironmentRefs {
+			if t.Name == target {
+				return true
+			}
+		}
+	}
+	return false
+}
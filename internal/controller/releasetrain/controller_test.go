@@ -0,0 +1,408 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasetrain
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("ReleaseTrain Controller", func() {
+	const (
+		namespace = "release-train-org"
+		project   = "shop"
+		trainName = "checkout"
+	)
+
+	var (
+		scheme     *runtime.Scheme
+		reconciler *Reconciler
+	)
+
+	newSnapshot := func(component, environment, image string) *openchoreov1alpha1.ComponentEnvSnapshot {
+		return &openchoreov1alpha1.ComponentEnvSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: component + "-" + environment, Namespace: namespace},
+			Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+				Owner:       openchoreov1alpha1.ComponentEnvSnapshotOwner{ProjectName: project, ComponentName: component},
+				Environment: environment,
+				Workload: openchoreov1alpha1.Workload{
+					Spec: openchoreov1alpha1.WorkloadSpec{
+						WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+							Containers: map[string]openchoreov1alpha1.Container{"main": {Image: image}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newDeployment := func(component, environment string) *openchoreov1alpha1.ComponentDeployment {
+		return &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: component + "-" + environment, Namespace: namespace},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: project, ComponentName: component},
+				Environment: environment,
+			},
+		}
+	}
+
+	newComponent := func(name string) *openchoreov1alpha1.Component {
+		return &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name + "-uid")},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Owner: openchoreov1alpha1.ComponentOwner{ProjectName: project},
+			},
+		}
+	}
+
+	newTrain := func(target string) *openchoreov1alpha1.ReleaseTrain {
+		return &openchoreov1alpha1.ReleaseTrain{
+			ObjectMeta: metav1.ObjectMeta{Name: trainName, Namespace: namespace, Generation: 1},
+			Spec: openchoreov1alpha1.ReleaseTrainSpec{
+				Owner:             openchoreov1alpha1.ReleaseTrainOwner{ProjectName: project},
+				SourceEnvironment: "development",
+				TargetEnvironment: target,
+				Components:        []openchoreov1alpha1.ReleaseTrainComponent{{Name: "cart"}, {Name: "payments"}},
+			},
+		}
+	}
+
+	setup := func(objs ...client.Object) {
+		objs = append(objs,
+			&openchoreov1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: project, Namespace: namespace},
+				Spec:       openchoreov1alpha1.ProjectSpec{DeploymentPipelineRef: "default"},
+			},
+			&openchoreov1alpha1.DeploymentPipeline{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+				Spec: openchoreov1alpha1.DeploymentPipelineSpec{
+					PromotionPaths: []openchoreov1alpha1.PromotionPath{{
+						SourceEnvironmentRef:  "development",
+						TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{{Name: "staging"}},
+					}},
+				},
+			},
+			newComponent("cart"),
+			newComponent("payments"),
+			newSnapshot("cart", "development", "cart:v2"),
+			newSnapshot("payments", "development", "payments:v2"),
+		)
+		reconciler = &Reconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithStatusSubresource(&openchoreov1alpha1.ReleaseTrain{}, &openchoreov1alpha1.Environment{}).Build(),
+			Scheme: scheme,
+		}
+	}
+
+	reconcileTrain := func() (ctrl.Result, *openchoreov1alpha1.ReleaseTrain) {
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: trainName, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		train := &openchoreov1alpha1.ReleaseTrain{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: trainName, Namespace: namespace}, train)).To(Succeed())
+		return result, train
+	}
+
+	getSnapshot := func(name string) *openchoreov1alpha1.ComponentEnvSnapshot {
+		snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, snapshot)).To(Succeed())
+		return snapshot
+	}
+
+	// newRelease makes the applied Release of a component in the staging environment
+	newRelease := func(component string, generation int64, health openchoreov1alpha1.HealthStatus) *openchoreov1alpha1.Release {
+		return &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: component + "-staging", Namespace: namespace, Generation: generation},
+			Spec: openchoreov1alpha1.ReleaseSpec{
+				Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: project, ComponentName: component},
+				EnvironmentName: "staging",
+			},
+			Status: openchoreov1alpha1.ReleaseStatus{
+				AppliedGeneration: generation,
+				Resources: []openchoreov1alpha1.ResourceStatus{{
+					ID: "deployment", Version: "v1", Kind: "Deployment", Name: component, HealthStatus: health,
+				}},
+			},
+		}
+	}
+
+	// setReleaseHealth creates or replaces the Release of a component with a later, applied generation
+	setReleaseHealth := func(component string, health openchoreov1alpha1.HealthStatus) {
+		existing := &openchoreov1alpha1.Release{}
+		err := reconciler.Get(ctx, client.ObjectKey{Name: component + "-staging", Namespace: namespace}, existing)
+		if apierrors.IsNotFound(err) {
+			Expect(reconciler.Create(ctx, newRelease(component, 1, health))).To(Succeed())
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.Delete(ctx, existing)).To(Succeed())
+		Expect(reconciler.Create(ctx, newRelease(component, existing.Generation+1, health))).To(Succeed())
+	}
+
+	startVerification := func() {
+		_, train := reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseVerifying))
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	It("pins the source snapshots and promotes all components together", func() {
+		setup(
+			newTrain("staging"),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+			newSnapshot("cart", "staging", "cart:v1"),
+			newDeployment("cart", "staging"),
+		)
+
+		result, train := reconcileTrain()
+		Expect(result.RequeueAfter).To(Equal(verifyInterval))
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseVerifying))
+		Expect(train.Status.CurrentEnvironment).To(Equal("development"))
+		Expect(train.Status.Components).To(HaveLen(2))
+		Expect(train.Status.Components[0].Revision).To(HaveLen(revisionLength))
+		Expect(train.Status.Components[0].PreviousSnapshot).NotTo(BeNil())
+		Expect(train.Status.Components[0].CreatedDeployment).To(BeFalse())
+		Expect(train.Status.Components[1].PreviousSnapshot).To(BeNil())
+		Expect(train.Status.Components[1].CreatedDeployment).To(BeTrue())
+
+		Expect(getSnapshot("cart-staging").Spec.Workload.Spec.Containers["main"].Image).To(Equal("cart:v2"))
+		payments := getSnapshot("payments-staging")
+		Expect(payments.Spec.Environment).To(Equal("staging"))
+		Expect(payments.Spec.Workload.Spec.Containers["main"].Image).To(Equal("payments:v2"))
+		Expect(payments.OwnerReferences).To(HaveLen(1))
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: "payments-staging", Namespace: namespace},
+			&openchoreov1alpha1.ComponentDeployment{})).To(Succeed())
+	})
+
+	It("succeeds once all components are healthy", func() {
+		setup(
+			newTrain("staging"),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+		)
+		startVerification()
+		setReleaseHealth("cart", openchoreov1alpha1.HealthStatusHealthy)
+
+		_, train := reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseVerifying))
+		Expect(train.Status.Components[1].HealthStatus).To(Equal(openchoreov1alpha1.HealthStatusProgressing))
+
+		setReleaseHealth("payments", openchoreov1alpha1.HealthStatusHealthy)
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseSucceeded))
+		Expect(train.Status.CurrentEnvironment).To(Equal("staging"))
+		Expect(train.Status.Components[1].CreatedDeployment).To(BeFalse())
+	})
+
+	It("rolls all components back when one of them is degraded", func() {
+		setup(
+			newTrain("staging"),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+			newSnapshot("cart", "staging", "cart:v1"),
+			newDeployment("cart", "staging"),
+		)
+		startVerification()
+		setReleaseHealth("cart", openchoreov1alpha1.HealthStatusHealthy)
+		setReleaseHealth("payments", openchoreov1alpha1.HealthStatusDegraded)
+
+		_, train := reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseRolledBack))
+		Expect(train.Status.CurrentEnvironment).To(Equal("development"))
+		Expect(train.Status.Conditions[0].Reason).To(Equal(string(ReasonRolledBack)))
+
+		Expect(getSnapshot("cart-staging").Spec.Workload.Spec.Containers["main"].Image).To(Equal("cart:v1"))
+		err := reconciler.Get(ctx, client.ObjectKey{Name: "payments-staging", Namespace: namespace}, &openchoreov1alpha1.ComponentEnvSnapshot{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = reconciler.Get(ctx, client.ObjectKey{Name: "payments-staging", Namespace: namespace}, &openchoreov1alpha1.ComponentDeployment{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: "cart-staging", Namespace: namespace},
+			&openchoreov1alpha1.ComponentDeployment{})).To(Succeed())
+
+		// A rolled back promotion is not retried until the train is changed
+		result, train := reconcileTrain()
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseRolledBack))
+	})
+
+	It("doesn't read the health of the Release of the previous snapshot", func() {
+		setup(
+			newTrain("staging"),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}},
+			newSnapshot("cart", "staging", "cart:v1"),
+			newDeployment("cart", "staging"),
+			newRelease("cart", 3, openchoreov1alpha1.HealthStatusHealthy),
+		)
+		startVerification()
+		setReleaseHealth("payments", openchoreov1alpha1.HealthStatusHealthy)
+
+		_, train := reconcileTrain()
+		Expect(train.Status.Components[0].ReleaseGeneration).To(Equal(int64(3)))
+		Expect(train.Status.Components[0].HealthStatus).To(Equal(openchoreov1alpha1.HealthStatusProgressing))
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseVerifying))
+
+		By("waiting until the rendered Release is applied")
+		release := newRelease("cart", 4, openchoreov1alpha1.HealthStatusHealthy)
+		release.Status.AppliedGeneration = 3
+		Expect(reconciler.Delete(ctx, newRelease("cart", 3, ""))).To(Succeed())
+		Expect(reconciler.Create(ctx, release)).To(Succeed())
+		_, train = reconcileTrain()
+		Expect(train.Status.Components[0].HealthStatus).To(Equal(openchoreov1alpha1.HealthStatusProgressing))
+
+		setReleaseHealth("cart", openchoreov1alpha1.HealthStatusHealthy)
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseSucceeded))
+	})
+
+	It("rolls back when the components don't become healthy in time", func() {
+		train := newTrain("staging")
+		train.Spec.HealthTimeout = &metav1.Duration{Duration: 30 * time.Second}
+		setup(train, &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace}})
+		_, train = reconcileTrain()
+		started := metav1.NewTime(time.Now().Add(-time.Minute))
+		train.Status.PromotionStartedAt = &started
+		Expect(reconciler.Status().Update(ctx, train)).To(Succeed())
+		setReleaseHealth("cart", openchoreov1alpha1.HealthStatusHealthy)
+
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseRolledBack))
+		err := reconciler.Get(ctx, client.ObjectKey{Name: "cart-staging", Namespace: namespace}, &openchoreov1alpha1.ComponentEnvSnapshot{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("fails without a promotion path to the target environment", func() {
+		setup(
+			newTrain("production"),
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: namespace}},
+		)
+
+		_, train := reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseFailed))
+		Expect(train.Status.Conditions[0].Reason).To(Equal(string(ReasonInvalidPromotionPath)))
+		err := reconciler.Get(ctx, client.ObjectKey{Name: "cart-production", Namespace: namespace}, &openchoreov1alpha1.ComponentEnvSnapshot{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("defers the promotion while the target environment is frozen", func() {
+		now := time.Now()
+		setup(
+			newTrain("staging"),
+			&openchoreov1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace},
+				Spec: openchoreov1alpha1.EnvironmentSpec{
+					FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+						Name:   "launch",
+						Reason: "product launch",
+						Start:  &metav1.Time{Time: now.Add(-time.Hour)},
+						End:    &metav1.Time{Time: now.Add(time.Hour)},
+					}},
+				},
+			},
+		)
+
+		result, train := reconcileTrain()
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhasePending))
+		Expect(train.Status.Conditions[0].Reason).To(Equal(string(ReasonEnvironmentFrozen)))
+	})
+
+	It("promotes during a freeze with an override granted for the window and records it once promoted", func() {
+		now := time.Now()
+		train := newTrain("staging")
+		train.Status.FreezeOverride = &openchoreov1alpha1.ReleaseTrainFreezeOverride{
+			Environment:   "staging",
+			Window:        "maintenance",
+			Justification: "hotfix",
+			Principal:     "alice",
+			GrantedAt:     metav1.NewTime(now),
+		}
+		setup(
+			train,
+			&openchoreov1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace},
+				Spec: openchoreov1alpha1.EnvironmentSpec{
+					FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+						Name:  "launch",
+						Start: &metav1.Time{Time: now.Add(-time.Hour)},
+						End:   &metav1.Time{Time: now.Add(time.Hour)},
+					}},
+				},
+			},
+		)
+		getEnvironment := func() *openchoreov1alpha1.Environment {
+			env := &openchoreov1alpha1.Environment{}
+			Expect(reconciler.Get(ctx, client.ObjectKey{Name: "staging", Namespace: namespace}, env)).To(Succeed())
+			return env
+		}
+
+		By("deferring the promotion with an override of another window")
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhasePending))
+		Expect(train.Status.Conditions[0].Reason).To(Equal(string(ReasonEnvironmentFrozen)))
+		Expect(getEnvironment().Status.FreezeOverrides).To(BeEmpty())
+
+		train.Status.FreezeOverride.Window = "launch"
+		Expect(reconciler.Status().Update(ctx, train)).To(Succeed())
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseVerifying))
+		Expect(train.Status.FreezeOverride).To(BeNil())
+
+		records := getEnvironment().Status.FreezeOverrides
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Target).To(Equal("ReleaseTrain/" + trainName))
+		Expect(records[0].Principal).To(Equal("alice"))
+		Expect(records[0].Justification).To(Equal("hotfix"))
+	})
+
+	It("doesn't record a freeze override when the promotion fails", func() {
+		now := time.Now()
+		train := newTrain("staging")
+		train.Status.FreezeOverride = &openchoreov1alpha1.ReleaseTrainFreezeOverride{
+			Environment: "staging", Window: "launch", Justification: "hotfix", GrantedAt: metav1.NewTime(now),
+		}
+		setup(
+			train,
+			&openchoreov1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: namespace},
+				Spec: openchoreov1alpha1.EnvironmentSpec{
+					FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+						Name:  "launch",
+						Start: &metav1.Time{Time: now.Add(-time.Hour)},
+						End:   &metav1.Time{Time: now.Add(time.Hour)},
+					}},
+				},
+			},
+		)
+		Expect(reconciler.Delete(ctx, newComponent("payments"))).To(Succeed())
+
+		_, train = reconcileTrain()
+		Expect(train.Status.Phase).To(Equal(openchoreov1alpha1.ReleaseTrainPhaseFailed))
+		env := &openchoreov1alpha1.Environment{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Name: "staging", Namespace: namespace}, env)).To(Succeed())
+		Expect(env.Status.FreezeOverrides).To(BeEmpty())
+	})
+
+	It("waits until every component has a snapshot in the source environment", func() {
+		setup(newTrain("staging"))
+		Expect(reconciler.Delete(ctx, newSnapshot("payments", "development", ""))).To(Succeed())
+
+		_, train := reconcileTrain()
+		Expect(train.Status.Components).To(BeEmpty())
+		Expect(train.Status.Phase).To(BeEmpty())
+		Expect(train.Status.Conditions[0].Reason).To(Equal(string(ReasonComponentEnvSnapshotNotFound)))
+	})
+})
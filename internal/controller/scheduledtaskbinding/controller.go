@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/controller/scheduledtaskbinding/render"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
@@ -37,7 +40,7 @@ type Reconciler struct {
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (rResult ctrl.Result, rErr error) {
 	logger := log.FromContext(ctx)
 
 	// Fetch the ScheduledTaskBinding instance
@@ -50,6 +53,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	old := scheduledTaskBinding.DeepCopy()
+
+	defer func() {
+		// Skip update if nothing changed
+		if apiequality.Semantic.DeepEqual(old.Status, scheduledTaskBinding.Status) {
+			return
+		}
+
+		// Update the status
+		if err := r.Status().Update(ctx, scheduledTaskBinding); err != nil {
+			logger.Error(err, "Failed to update ScheduledTaskBinding status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
 	// Fetch the associated ScheduledTaskClass
 	scheduledTaskClass := &openchoreov1alpha1.ScheduledTaskClass{}
 	if err := r.Get(ctx, client.ObjectKey{
@@ -135,15 +153,19 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, scheduledTaskBinding
 		return controllerutil.SetControllerReference(scheduledTaskBinding, release, r.Scheme)
 	})
 	if err != nil {
+		controller.MarkFalseCondition(scheduledTaskBinding, ConditionReady, ReasonReleaseUpdateFailed, err.Error())
 		logger.Error(err, "Failed to reconcile Release", "Release", release.Name)
 		return ctrl.Result{}, err
 	}
 	if op == controllerutil.OperationResultCreated ||
 		op == controllerutil.OperationResultUpdated {
 		logger.Info("Successfully reconciled Release", "Release", release.Name, "Operation", op)
-		// TODO: Update ScheduledTaskBinding status and requeue for further processing
+		controller.MarkFalseCondition(scheduledTaskBinding, ConditionReady, ReasonResourceHealthProgressing,
+			"Release updated, waiting for the resources to be deployed")
 		return ctrl.Result{Requeue: true}, nil
 	}
+
+	setReadyStatus(scheduledTaskBinding, release)
 	return ctrl.Result{}, nil
 }
 
@@ -190,6 +212,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ScheduledTaskBinding{}).
+		Owns(&openchoreov1alpha1.Release{}).
 		Watches(
 			&openchoreov1alpha1.ScheduledTaskClass{},
 			handler.EnqueueRequestsFromMapFunc(r.listScheduledTaskBindingsForScheduledTaskClass),
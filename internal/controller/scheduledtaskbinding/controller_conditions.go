@@ -0,0 +1,34 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtaskbinding
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates that the ScheduledTaskBinding is ready and functioning
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonResourcesActive indicates all resources are deployed and healthy
+	ReasonResourcesActive controller.ConditionReason = "ResourcesActive"
+
+	// Reasons for the Ready condition type when status is False - Resource Health Issues
+
+	// ReasonResourceHealthProgressing indicates one or more resources are being deployed/updated
+	ReasonResourceHealthProgressing controller.ConditionReason = "ResourceHealthProgressing"
+	// ReasonResourceHealthDegraded indicates one or more resources are in error state
+	ReasonResourceHealthDegraded controller.ConditionReason = "ResourceHealthDegraded"
+
+	// Reasons for the Ready condition type when status is False - Release Issues
+
+	// ReasonReleaseUpdateFailed indicates failure to create or update the Release
+	ReasonReleaseUpdateFailed controller.ConditionReason = "ReleaseUpdateFailed"
+)
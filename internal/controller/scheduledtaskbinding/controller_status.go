@@ -0,0 +1,43 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtaskbinding
+
+import (
+	"fmt"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// setReadyStatus sets the Ready condition of the ScheduledTaskBinding from the health of the resources of its Release.
+func setReadyStatus(scheduledTaskBinding *openchoreov1alpha1.ScheduledTaskBinding, release *openchoreov1alpha1.Release) {
+	total := len(release.Status.Resources)
+	if total == 0 {
+		controller.MarkTrueCondition(scheduledTaskBinding, ConditionReady, ReasonResourcesActive, "No resources to deploy")
+		return
+	}
+
+	healthy, degraded := 0, 0
+	for _, resource := range release.Status.Resources {
+		switch resource.HealthStatus {
+		case openchoreov1alpha1.HealthStatusHealthy, openchoreov1alpha1.HealthStatusSuspended:
+			// A suspended CronJob is deployed as requested
+			healthy++
+		case openchoreov1alpha1.HealthStatusDegraded:
+			degraded++
+		}
+	}
+
+	switch {
+	case healthy == total:
+		controller.MarkTrueCondition(scheduledTaskBinding, ConditionReady, ReasonResourcesActive,
+			fmt.Sprintf("All %d resources are deployed and healthy", total))
+	case degraded > 0 && healthy+degraded == total:
+		controller.MarkFalseCondition(scheduledTaskBinding, ConditionReady, ReasonResourceHealthDegraded,
+			fmt.Sprintf("Resources status: %d/%d degraded", degraded, total))
+	default:
+		controller.MarkFalseCondition(scheduledTaskBinding, ConditionReady, ReasonResourceHealthProgressing,
+			fmt.Sprintf("Resources status: %d/%d progressing", total-healthy-degraded, total))
+	}
+}
@@ -38,6 +38,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=resourcebindings,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -212,20 +213,33 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, serviceBinding *openc
 		return ctrl.Result{}, nil
 	}
 
-	// Resolve API connections
-	resolvedConnections, err := r.resolveAPIConnections(ctx, serviceBinding)
+	// Resolve connections
+	resolvedConnections, err := r.resolveConnections(ctx, serviceBinding)
 	if err != nil {
-		logger.Error(err, "Failed to resolve API connections")
+		controller.MarkFalseCondition(serviceBinding, ConditionReady, ReasonConnectionResolutionFailed, err.Error())
+		logger.Error(err, "Failed to resolve connections")
+		return ctrl.Result{}, err
+	}
+
+	connectionSecretReferences, err := r.fetchConnectionSecretReferences(ctx, serviceBinding, dataPlane, resolvedConnections)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			controller.MarkFalseCondition(serviceBinding, ConditionReady, ReasonSecretReferenceNotFound, err.Error())
+			logger.Error(err, "Connection SecretReference not found")
+			return ctrl.Result{}, nil
+		}
+		controller.MarkFalseCondition(serviceBinding, ConditionReady, ReasonConnectionResolutionFailed, err.Error())
 		return ctrl.Result{}, err
 	}
 
 	rCtx := render.Context{
-		ServiceBinding:            serviceBinding,
-		ServiceClass:              serviceClass,
-		APIClasses:                apiClasses,
-		ResolvedConnections:       resolvedConnections,
-		DataPlane:                 dataPlane,
-		ImagePullSecretReferences: imagePullSecretReferences,
+		ServiceBinding:             serviceBinding,
+		ServiceClass:               serviceClass,
+		APIClasses:                 apiClasses,
+		ResolvedConnections:        resolvedConnections,
+		DataPlane:                  dataPlane,
+		ImagePullSecretReferences:  imagePullSecretReferences,
+		ConnectionSecretReferences: connectionSecretReferences,
 	}
 	release := r.makeRelease(rCtx)
 	if len(rCtx.Errors()) > 0 {
@@ -306,6 +320,11 @@ func (r *Reconciler) makeRelease(rCtx render.Context) *openchoreov1alpha1.Releas
 		}
 	}
 
+	// Add the ConfigMap of the files injected for connections
+	if res := render.ConnectionConfigMap(rCtx); res != nil {
+		resources = append(resources, *res)
+	}
+
 	// Add Deployment resource
 	if res := render.Deployment(rCtx); res != nil {
 		resources = append(resources, *res)
@@ -355,6 +374,10 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&openchoreov1alpha1.ServiceClass{},
 			handler.EnqueueRequestsFromMapFunc(r.listServiceBindingsForServiceClass),
 		).
+		Watches(
+			&openchoreov1alpha1.ResourceBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.listServiceBindingsForResourceBinding),
+		).
 		Named("servicebinding").
 		Complete(r)
 }
@@ -376,44 +399,6 @@ func (r *Reconciler) makeLabels(serviceBinding *openchoreov1alpha1.ServiceBindin
 	return result
 }
 
-func (r *Reconciler) resolveAPIConnections(ctx context.Context, serviceBinding *openchoreov1alpha1.ServiceBinding) (map[string]interface{}, error) {
-	results := make(map[string]interface{})
-
-	wls := serviceBinding.Spec.WorkloadSpec
-	for connectionName, connection := range wls.Connections {
-		if connection.Type != openchoreov1alpha1.ConnectionTypeAPI {
-			continue // Skip non-API connections for now
-		}
-
-		// Extract parameters
-		targetComponentName := connection.Params["componentName"]
-		targetEndpointName := connection.Params["endpoint"]
-
-		// Find target binding
-		targetBinding, err := r.findTargetServiceBinding(ctx, serviceBinding.Namespace, targetComponentName, serviceBinding.Spec.Environment)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find target binding for connection %s: %w", connectionName, err)
-		}
-
-		// Extract endpoint from binding status
-		var endpointAccess *openchoreov1alpha1.EndpointAccess
-		for _, ep := range targetBinding.Status.Endpoints {
-			if ep.Name == targetEndpointName {
-				endpointAccess = ep.Project // For POC, assume project-level access
-				break
-			}
-		}
-
-		if endpointAccess == nil {
-			return nil, fmt.Errorf("endpoint %s not found in target binding %s", targetEndpointName, targetComponentName)
-		}
-
-		// Build result map with template variables
-		results[connectionName] = endpointAccess
-	}
-	return results, nil
-}
-
 func (r *Reconciler) findTargetServiceBinding(ctx context.Context, namespace, componentName, environment string) (*openchoreov1alpha1.ServiceBinding, error) {
 	// List all ServiceBindings in the namespace
 	bindingList := &openchoreov1alpha1.ServiceBindingList{}
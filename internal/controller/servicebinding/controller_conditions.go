@@ -47,6 +47,8 @@ const (
 	ReasonSecretReferenceNotFound controller.ConditionReason = "SecretReferenceNotFound"
 	// ReasonInvalidConfiguration indicates the binding configuration is invalid
 	ReasonInvalidConfiguration controller.ConditionReason = "InvalidConfiguration"
+	// ReasonConnectionResolutionFailed indicates a workload connection can't be resolved in the environment
+	ReasonConnectionResolutionFailed controller.ConditionReason = "ConnectionResolutionFailed"
 
 	// Reasons for the Ready condition type when status is False - Release Issues
 
@@ -7,15 +7,18 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/connection"
 )
 
 type Context struct {
 	ServiceBinding            *openchoreov1alpha1.ServiceBinding
 	ServiceClass              *openchoreov1alpha1.ServiceClass
 	APIClasses                map[string]*openchoreov1alpha1.APIClass
-	ResolvedConnections       map[string]interface{}
+	ResolvedConnections       map[string]*connection.Injection
 	DataPlane                 *openchoreov1alpha1.DataPlane
 	ImagePullSecretReferences map[string]*openchoreov1alpha1.SecretReference
+	// SecretReferences that connection properties are read from
+	ConnectionSecretReferences map[string]*openchoreov1alpha1.SecretReference
 	// Stores the errors encountered during rendering.
 	errs []error
 }
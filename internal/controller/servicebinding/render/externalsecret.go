@@ -10,11 +10,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/connection"
 	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
 	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
 )
 
-// ExternalSecrets generates ExternalSecret resources for image pull secrets
+// ExternalSecrets generates ExternalSecret resources for image pull secrets and
+// the secrets connection properties are read from
 func ExternalSecrets(rCtx Context) []*openchoreov1alpha1.Resource {
 	var resources []*openchoreov1alpha1.Resource
 
@@ -23,20 +25,15 @@ func ExternalSecrets(rCtx Context) []*openchoreov1alpha1.Resource {
 		return resources
 	}
 
-	// Skip if no image pull secrets configured
-	if len(rCtx.DataPlane.Spec.ImagePullSecretRefs) == 0 {
-		return resources
-	}
-
 	namespace := makeNamespaceName(rCtx)
+	rendered := make(map[string]bool)
 
-	for _, secretRefName := range rCtx.DataPlane.Spec.ImagePullSecretRefs {
-		// Get the SecretReference from context
-		secretRef, exists := rCtx.ImagePullSecretReferences[secretRefName]
-		if !exists {
-			rCtx.AddError(fmt.Errorf("image pull SecretReference %q not found", secretRefName))
-			continue
+	addExternalSecret := func(secretRef *openchoreov1alpha1.SecretReference) {
+		// A SecretReference used for image pulls and connections is only rendered once
+		if rendered[secretRef.Name] {
+			return
 		}
+		rendered[secretRef.Name] = true
 
 		externalSecret := makeExternalSecret(rCtx, secretRef, namespace)
 		if externalSecret != nil {
@@ -51,12 +48,31 @@ func ExternalSecrets(rCtx Context) []*openchoreov1alpha1.Resource {
 		}
 	}
 
+	for _, secretRefName := range rCtx.DataPlane.Spec.ImagePullSecretRefs {
+		// Get the SecretReference from context
+		secretRef, exists := rCtx.ImagePullSecretReferences[secretRefName]
+		if !exists {
+			rCtx.AddError(fmt.Errorf("image pull SecretReference %q not found", secretRefName))
+			continue
+		}
+		addExternalSecret(secretRef)
+	}
+
+	for _, secretRefName := range connection.SecretReferences(rCtx.ResolvedConnections) {
+		secretRef, exists := rCtx.ConnectionSecretReferences[secretRefName]
+		if !exists {
+			rCtx.AddError(fmt.Errorf("connection SecretReference %q not found", secretRefName))
+			continue
+		}
+		addExternalSecret(secretRef)
+	}
+
 	return resources
 }
 
 func makeExternalSecret(rCtx Context, secretRef *openchoreov1alpha1.SecretReference,
 	namespace string) *esov1.ExternalSecret {
-	secretName := makeImagePullSecretName(rCtx, secretRef.Name)
+	secretName := makeSecretName(rCtx, secretRef.Name)
 
 	// Use refresh interval from SecretReference if specified, otherwise let ESO use its default
 	var refreshInterval *metav1.Duration
@@ -84,11 +100,8 @@ func makeExternalSecret(rCtx Context, secretRef *openchoreov1alpha1.SecretRefere
 			Target: esov1.ExternalSecretTarget{
 				Name: secretName,
 				Template: &esov1.ExternalSecretTemplate{
-					Type: secretRef.Spec.Template.Type,
-					Metadata: esov1.ExternalSecretTemplateMetadata{
-						Labels:      secretRef.Spec.Template.Metadata.Labels,
-						Annotations: secretRef.Spec.Template.Metadata.Annotations,
-					},
+					Type:     secretRef.Spec.Template.Type,
+					Metadata: makeExternalSecretTemplateMetadata(secretRef),
 				},
 				CreationPolicy: esov1.CreatePolicyOwner,
 				DeletionPolicy: esov1.DeletionPolicyDelete,
@@ -113,13 +126,24 @@ func makeExternalSecret(rCtx Context, secretRef *openchoreov1alpha1.SecretRefere
 	return externalSecret
 }
 
+// makeExternalSecretTemplateMetadata copies the optional metadata of the SecretReference template
+func makeExternalSecretTemplateMetadata(secretRef *openchoreov1alpha1.SecretReference) esov1.ExternalSecretTemplateMetadata {
+	if secretRef.Spec.Template.Metadata == nil {
+		return esov1.ExternalSecretTemplateMetadata{}
+	}
+	return esov1.ExternalSecretTemplateMetadata{
+		Labels:      secretRef.Spec.Template.Metadata.Labels,
+		Annotations: secretRef.Spec.Template.Metadata.Annotations,
+	}
+}
+
 func makeExternalSecretResourceID(rCtx Context, secretRefName string) string {
 	return fmt.Sprintf("%s-externalsecret-%s", rCtx.ServiceBinding.Name, secretRefName)
 }
 
-// makeImagePullSecretName generates a K8s-compliant name for image pull secrets
+// makeSecretName generates a K8s-compliant name for the secret created from a SecretReference
 // Includes ServiceBinding name to prevent collisions when multiple components
 // in the same namespace reference the same SecretReference
-func makeImagePullSecretName(rCtx Context, secretRefName string) string {
+func makeSecretName(rCtx Context, secretRefName string) string {
 	return dpkubernetes.GenerateK8sName(rCtx.ServiceBinding.Name, secretRefName)
 }
@@ -0,0 +1,140 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webapplicationbinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/connection"
+)
+
+// resolveConnections resolves the connections of the workload in the environment of the binding
+// and renders what gets injected for each of them.
+func (r *Reconciler) resolveConnections(ctx context.Context, webApplicationBinding *openchoreov1alpha1.WebApplicationBinding) (map[string]*connection.Injection, error) {
+	results := make(map[string]*connection.Injection)
+
+	wls := webApplicationBinding.Spec.WorkloadSpec
+	names := make([]string, 0, len(wls.Connections))
+	for name := range wls.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, connectionName := range names {
+		conn := wls.Connections[connectionName]
+		if err := connection.Validate(conn); err != nil {
+			return nil, fmt.Errorf("invalid connection %s: %w", connectionName, err)
+		}
+
+		var resolved *connection.Resolved
+		if conn.Type == openchoreov1alpha1.ConnectionTypeAPI {
+			endpointAccess, err := r.resolveAPIConnection(ctx, webApplicationBinding, connectionName, conn)
+			if err != nil {
+				return nil, err
+			}
+			resolved = connection.FromEndpoint(endpointAccess)
+		} else {
+			resourceName := conn.Params[connection.ParamResource]
+			resourceBinding, err := connection.FindResourceBinding(ctx, r.Client, webApplicationBinding.Namespace,
+				conn.Type, resourceName, webApplicationBinding.Spec.Environment)
+			if err != nil {
+				return nil, err
+			}
+			if resourceBinding == nil {
+				return nil, fmt.Errorf("no %s resource binding found for resource %s of connection %s in environment %s",
+					conn.Type, resourceName, connectionName, webApplicationBinding.Spec.Environment)
+			}
+			if resolved, err = connection.FromResourceBinding(resourceBinding); err != nil {
+				return nil, fmt.Errorf("failed to resolve connection %s: %w", connectionName, err)
+			}
+		}
+
+		injection, err := connection.Inject(conn, resolved)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inject connection %s: %w", connectionName, err)
+		}
+		results[connectionName] = injection
+	}
+	return results, nil
+}
+
+// resolveAPIConnection resolves an API connection to the endpoint of the target component in the same environment
+func (r *Reconciler) resolveAPIConnection(ctx context.Context, webApplicationBinding *openchoreov1alpha1.WebApplicationBinding,
+	connectionName string, conn openchoreov1alpha1.WorkloadConnection) (*openchoreov1alpha1.EndpointAccess, error) {
+	// Extract parameters
+	targetComponentName := conn.Params["componentName"]
+	targetEndpointName := conn.Params["endpoint"]
+
+	// Find target binding
+	targetBinding, err := r.findTargetServiceBinding(ctx, webApplicationBinding.Namespace, targetComponentName, webApplicationBinding.Spec.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find target binding for connection %s: %w", connectionName, err)
+	}
+
+	// Extract endpoint from binding status
+	for _, ep := range targetBinding.Status.Endpoints {
+		if ep.Name == targetEndpointName && ep.Project != nil {
+			return ep.Project, nil // For POC, assume project-level access
+		}
+	}
+	return nil, fmt.Errorf("endpoint %s not found in target binding %s", targetEndpointName, targetComponentName)
+}
+
+// fetchConnectionSecretReferences fetches the SecretReferences the injected connection properties are read from.
+// The secrets are created by the secret store of the DataPlane, so one must be configured.
+func (r *Reconciler) fetchConnectionSecretReferences(ctx context.Context, webApplicationBinding *openchoreov1alpha1.WebApplicationBinding,
+	dataPlane *openchoreov1alpha1.DataPlane, injections map[string]*connection.Injection) (map[string]*openchoreov1alpha1.SecretReference, error) {
+	secretRefNames := connection.SecretReferences(injections)
+	if len(secretRefNames) == 0 {
+		return nil, nil
+	}
+	if dataPlane == nil || dataPlane.Spec.SecretStoreRef == nil {
+		return nil, fmt.Errorf("connections read secret properties but no secret store is configured for the data plane")
+	}
+
+	secretReferences := make(map[string]*openchoreov1alpha1.SecretReference, len(secretRefNames))
+	for _, secretRefName := range secretRefNames {
+		secretRef := &openchoreov1alpha1.SecretReference{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: webApplicationBinding.Namespace, Name: secretRefName}, secretRef); err != nil {
+			return nil, err
+		}
+		secretReferences[secretRefName] = secretRef
+	}
+	return secretReferences, nil
+}
+
+// listWebApplicationBindingsForResourceBinding finds the WebApplicationBindings deployed to the environment of the ResourceBinding
+func (r *Reconciler) listWebApplicationBindingsForResourceBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	resourceBinding, ok := obj.(*openchoreov1alpha1.ResourceBinding)
+	if !ok {
+		return nil
+	}
+
+	webApplicationBindingList := &openchoreov1alpha1.WebApplicationBindingList{}
+	if err := r.List(ctx, webApplicationBindingList, client.InNamespace(resourceBinding.Namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, webApplicationBinding := range webApplicationBindingList.Items {
+		if webApplicationBinding.Spec.Environment != resourceBinding.Spec.Environment {
+			continue
+		}
+		for _, conn := range webApplicationBinding.Spec.WorkloadSpec.Connections {
+			if conn.Type == resourceBinding.Spec.Type && conn.Params[connection.ParamResource] == resourceBinding.Spec.ResourceName {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKey{Namespace: webApplicationBinding.Namespace, Name: webApplicationBinding.Name},
+				})
+				break
+			}
+		}
+	}
+	return requests
+}
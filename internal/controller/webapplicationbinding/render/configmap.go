@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+// ConnectionConfigMap creates the ConfigMap holding the plain files injected for connections.
+// Returns nil if no plain files are injected.
+func ConnectionConfigMap(rCtx Context) *openchoreov1alpha1.Resource {
+	_, _, data := makeConnectionFiles(rCtx)
+	if len(data) == 0 {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      makeConnectionConfigMapName(rCtx),
+			Namespace: makeNamespaceName(rCtx),
+			Labels:    makeWebApplicationLabels(rCtx),
+		},
+		Data: data,
+	}
+
+	rawExt := &runtime.RawExtension{}
+	rawExt.Object = configMap
+
+	return &openchoreov1alpha1.Resource{
+		ID:     makeConnectionConfigMapResourceID(rCtx),
+		Object: rawExt,
+	}
+}
+
+func makeConnectionConfigMapName(rCtx Context) string {
+	return dpkubernetes.GenerateK8sName(rCtx.WebApplicationBinding.Name, "connections")
+}
+
+func makeConnectionConfigMapResourceID(rCtx Context) string {
+	return rCtx.WebApplicationBinding.Name + "-connection-configmap"
+}
@@ -7,6 +7,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/connection"
 )
 
 type Context struct {
@@ -14,9 +15,11 @@ type Context struct {
 	WebApplicationClass       *openchoreov1alpha1.WebApplicationClass
 	Component                 *openchoreov1alpha1.Component
 	Environment               *openchoreov1alpha1.Environment
-	ResolvedConnections       map[string]interface{}
+	ResolvedConnections       map[string]*connection.Injection
 	DataPlane                 *openchoreov1alpha1.DataPlane
 	ImagePullSecretReferences map[string]*openchoreov1alpha1.SecretReference
+	// SecretReferences that connection properties are read from
+	ConnectionSecretReferences map[string]*openchoreov1alpha1.SecretReference
 
 	// Stores the errors encountered during rendering.
 	errs []error
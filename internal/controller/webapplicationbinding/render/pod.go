@@ -4,14 +4,10 @@
 package render
 
 import (
-	"bytes"
-	"fmt"
-	"sort"
-	"text/template"
-
 	corev1 "k8s.io/api/core/v1"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/connection"
 )
 
 func makeWebApplicationPodSpec(rCtx Context) *corev1.PodSpec {
@@ -30,6 +26,11 @@ func makeWebApplicationPodSpec(rCtx Context) *corev1.PodSpec {
 	// mainContainer.VolumeMounts = append(mainContainer.VolumeMounts, secretCSIMounts...)
 	// ps.Volumes = append(ps.Volumes, secretCSIVolumes...)
 
+	// Add the files injected for connections
+	connectionVolumes, connectionMounts := makeConnectionFileVolumes(rCtx)
+	mainContainer.VolumeMounts = append(mainContainer.VolumeMounts, connectionMounts...)
+	ps.Volumes = append(ps.Volumes, connectionVolumes...)
+
 	ps.Containers = []corev1.Container{*mainContainer}
 
 	// Add imagePullSecrets from DataPlane configuration
@@ -95,77 +96,21 @@ func makeEnvironmentVariables(rCtx Context) []corev1.EnvVar {
 }
 
 func makeConnectionEnvironmentVariables(rCtx Context) []corev1.EnvVar {
-	var k8sEnvVars []corev1.EnvVar
-
-	wls := rCtx.WebApplicationBinding.Spec.WorkloadSpec
-
-	// Get connection names and sort them for deterministic ordering
-	connectionNames := make([]string, 0, len(wls.Connections))
-	for name := range wls.Connections {
-		connectionNames = append(connectionNames, name)
-	}
-	sort.Strings(connectionNames)
-
-	// Process connections in sorted order
-	for _, connectionName := range connectionNames {
-		connection := wls.Connections[connectionName]
-
-		if connection.Type != openchoreov1alpha1.ConnectionTypeAPI {
-			continue // TODO: Only handle API connections for POC
-		}
-
-		// Get pre-resolved endpoint access from controller
-		resolvedEndpoint, exists := rCtx.ResolvedConnections[connectionName]
-		if !exists {
-			rCtx.AddError(fmt.Errorf("no resolved endpoint for connection %s", connectionName))
-			continue
-		}
-
-		// Convert interface{} to *EndpointAccess
-		endpointAccess, ok := resolvedEndpoint.(*openchoreov1alpha1.EndpointAccess)
-		if !ok {
-			rCtx.AddError(fmt.Errorf("invalid resolved endpoint type for connection %s", connectionName))
-			continue
-		}
-
-		// Process each environment variable injection
-		for _, envVar := range connection.Inject.Env {
-			resolvedValue := processEndpointTemplate(envVar.Value, endpointAccess)
-			k8sEnvVars = append(k8sEnvVars, corev1.EnvVar{
-				Name:  envVar.Name,
-				Value: resolvedValue,
-			})
-		}
-	}
-
-	return k8sEnvVars
+	return connection.EnvVars(rCtx.ResolvedConnections, func(secretRef string) string {
+		return makeSecretName(rCtx, secretRef)
+	})
 }
 
-func processEndpointTemplate(templateStr string, endpoint *openchoreov1alpha1.EndpointAccess) string {
-	// Create a map with lowercase field names for user convenience
-	data := map[string]string{
-		"host":     endpoint.Host,
-		"port":     fmt.Sprintf("%d", endpoint.Port),
-		"scheme":   endpoint.Scheme,
-		"basePath": endpoint.BasePath,
-		"uri":      endpoint.URI,
-		"url":      endpoint.URI, // Common alias for uri
-	}
-
-	// Parse and execute the template
-	tmpl, err := template.New("connection").Parse(templateStr)
-	if err != nil {
-		// If template parsing fails, return the original string
-		return templateStr
-	}
-
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		// If template execution fails, return the original string
-		return templateStr
-	}
+// makeConnectionFileVolumes creates the volumes and mounts of the files injected for connections
+func makeConnectionFileVolumes(rCtx Context) ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes, mounts, _ := makeConnectionFiles(rCtx)
+	return volumes, mounts
+}
 
-	return buf.String()
+func makeConnectionFiles(rCtx Context) ([]corev1.Volume, []corev1.VolumeMount, map[string]string) {
+	return connection.Files(rCtx.ResolvedConnections, makeConnectionConfigMapName(rCtx), func(secretRef string) string {
+		return makeSecretName(rCtx, secretRef)
+	})
 }
 
 // makeImagePullSecrets creates imagePullSecret references for the pod spec
@@ -184,7 +129,7 @@ func makeImagePullSecrets(rCtx Context) []corev1.LocalObjectReference {
 			continue
 		}
 
-		secretName := makeImagePullSecretName(rCtx, secretRef.Name)
+		secretName := makeSecretName(rCtx, secretRef.Name)
 		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{
 			Name: secretName,
 		})
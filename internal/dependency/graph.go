@@ -0,0 +1,221 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dependency builds the dependency graph of components from the connections declared by their workloads.
+package dependency
+
+import (
+	"fmt"
+	"sort"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// paramProjectName is the connection parameter naming the project of the target component.
+	// Defaults to the project of the consuming component.
+	paramProjectName = "projectName"
+	// paramComponentName is the connection parameter naming the target component.
+	paramComponentName = "componentName"
+	// paramEndpoint is the connection parameter naming the endpoint of the target component.
+	paramEndpoint = "endpoint"
+)
+
+// Reasons a connection is dangling
+const (
+	ReasonMissingComponentParam = "MissingComponentParam"
+	ReasonComponentNotFound     = "ComponentNotFound"
+	ReasonEndpointNotFound      = "EndpointNotFound"
+)
+
+// Workload is the workload of a component contributing to the graph.
+type Workload struct {
+	Project   string
+	Component string
+	Spec      openchoreov1alpha1.WorkloadTemplateSpec
+}
+
+// ComponentRef identifies a component in the graph.
+type ComponentRef struct {
+	Project   string `json:"project"`
+	Component string `json:"component"`
+}
+
+func (r ComponentRef) String() string {
+	return r.Project + "/" + r.Component
+}
+
+// Node is a component in the graph together with the endpoints it exposes.
+type Node struct {
+	Project   string   `json:"project"`
+	Component string   `json:"component"`
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
+// Ref returns the reference of the component of the node.
+func (n Node) Ref() ComponentRef {
+	return ComponentRef{Project: n.Project, Component: n.Component}
+}
+
+// Edge is a connection from a component to an endpoint of another component.
+type Edge struct {
+	From       ComponentRef `json:"from"`
+	To         ComponentRef `json:"to"`
+	Connection string       `json:"connection"`
+	Endpoint   string       `json:"endpoint,omitempty"`
+	// Dangling is set when the target component or endpoint doesn't exist in the graph
+	Dangling bool   `json:"dangling,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Graph is the dependency graph of a set of components.
+type Graph struct {
+	// Environment is the environment the graph was built for, empty for the graph of the workload definitions
+	Environment string `json:"environment,omitempty"`
+	Nodes       []Node `json:"nodes"`
+	Edges       []Edge `json:"edges"`
+}
+
+// Build builds the dependency graph of the workloads. Connections to components or endpoints
+// that are not part of the workloads are kept as dangling edges.
+func Build(environment string, workloads []Workload) *Graph {
+	g := &Graph{Environment: environment, Nodes: []Node{}, Edges: []Edge{}}
+
+	endpoints := make(map[ComponentRef]map[string]bool, len(workloads))
+	for _, w := range workloads {
+		ref := ComponentRef{Project: w.Project, Component: w.Component}
+		names := sortedKeys(w.Spec.Endpoints)
+		endpoints[ref] = make(map[string]bool, len(names))
+		for _, name := range names {
+			endpoints[ref][name] = true
+		}
+		g.Nodes = append(g.Nodes, Node{Project: w.Project, Component: w.Component, Endpoints: names})
+	}
+
+	for _, w := range workloads {
+		from := ComponentRef{Project: w.Project, Component: w.Component}
+		for _, name := range sortedKeys(w.Spec.Connections) {
+			conn := w.Spec.Connections[name]
+			if conn.Type != openchoreov1alpha1.ConnectionTypeAPI {
+				continue
+			}
+
+			to := ComponentRef{Project: conn.Params[paramProjectName], Component: conn.Params[paramComponentName]}
+			if to.Project == "" {
+				to.Project = w.Project
+			}
+			edge := Edge{From: from, To: to, Connection: name, Endpoint: conn.Params[paramEndpoint]}
+
+			targetEndpoints, found := endpoints[to]
+			switch {
+			case to.Component == "":
+				edge.Dangling = true
+				edge.Reason = ReasonMissingComponentParam
+				edge.Message = fmt.Sprintf("connection %q doesn't specify the %s parameter", name, paramComponentName)
+			case !found:
+				edge.Dangling = true
+				edge.Reason = ReasonComponentNotFound
+				edge.Message = fmt.Sprintf("component %s is not %s", to, g.scope())
+			case edge.Endpoint != "" && !targetEndpoints[edge.Endpoint]:
+				edge.Dangling = true
+				edge.Reason = ReasonEndpointNotFound
+				edge.Message = fmt.Sprintf("component %s does not expose endpoint %q%s", to, edge.Endpoint, g.inEnvironment())
+			}
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+
+	g.sort()
+	return g
+}
+
+// ForProject returns the subgraph of the components of the project, including the components
+// of other projects they depend on or that depend on them.
+func (g *Graph) ForProject(project string) *Graph {
+	sub := &Graph{Environment: g.Environment, Nodes: []Node{}, Edges: []Edge{}}
+
+	include := make(map[ComponentRef]bool)
+	for _, e := range g.Edges {
+		if e.From.Project == project || e.To.Project == project {
+			sub.Edges = append(sub.Edges, e)
+			include[e.From] = true
+			include[e.To] = true
+		}
+	}
+	for _, n := range g.Nodes {
+		if n.Project == project || include[n.Ref()] {
+			sub.Nodes = append(sub.Nodes, n)
+		}
+	}
+	return sub
+}
+
+// Dangling returns the edges whose target component or endpoint doesn't exist.
+func (g *Graph) Dangling() []Edge {
+	var edges []Edge
+	for _, e := range g.Edges {
+		if e.Dangling {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// DependenciesOf returns the edges of the connections declared by the component.
+func (g *Graph) DependenciesOf(ref ComponentRef) []Edge {
+	var edges []Edge
+	for _, e := range g.Edges {
+		if e.From == ref {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// hasNode reports whether the component is a node of the graph.
+func (g *Graph) hasNode(ref ComponentRef) bool {
+	for _, n := range g.Nodes {
+		if n.Ref() == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// scope describes where the components of the graph were looked up.
+func (g *Graph) scope() string {
+	if g.Environment == "" {
+		return "defined"
+	}
+	return fmt.Sprintf("deployed in environment %q", g.Environment)
+}
+
+func (g *Graph) inEnvironment() string {
+	if g.Environment == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in environment %q", g.Environment)
+}
+
+func (g *Graph) sort() {
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Ref().String() < g.Nodes[j].Ref().String()
+	})
+	sort.SliceStable(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From.String() < b.From.String()
+		}
+		return a.Connection < b.Connection
+	})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"strings"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func workload(project, component string, endpoints []string, connections map[string]map[string]string) Workload {
+	spec := openchoreov1alpha1.WorkloadTemplateSpec{
+		Endpoints:   map[string]openchoreov1alpha1.WorkloadEndpoint{},
+		Connections: map[string]openchoreov1alpha1.WorkloadConnection{},
+	}
+	for _, e := range endpoints {
+		spec.Endpoints[e] = openchoreov1alpha1.WorkloadEndpoint{Port: 8080}
+	}
+	for name, params := range connections {
+		spec.Connections[name] = openchoreov1alpha1.WorkloadConnection{Type: openchoreov1alpha1.ConnectionTypeAPI, Params: params}
+	}
+	return Workload{Project: project, Component: component, Spec: spec}
+}
+
+func shopWorkloads() []Workload {
+	return []Workload{
+		workload("shop", "frontend", []string{"http"}, map[string]map[string]string{
+			"cart":    {"componentName": "cart", "endpoint": "grpc"},
+			"catalog": {"projectName": "catalog", "componentName": "products", "endpoint": "grpc"},
+			"ads":     {"componentName": "ads", "endpoint": "grpc"},
+		}),
+		workload("shop", "cart", []string{"grpc"}, map[string]map[string]string{
+			"cache": {"componentName": "redis", "endpoint": "tcp"},
+		}),
+		workload("shop", "redis", []string{"redis"}, nil),
+		workload("catalog", "products", []string{"grpc"}, nil),
+		workload("catalog", "search", []string{"http"}, nil),
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	g := Build("staging", shopWorkloads())
+
+	if len(g.Nodes) != 5 {
+		t.Fatalf("expected 5 nodes, got %d", len(g.Nodes))
+	}
+	if len(g.Edges) != 4 {
+		t.Fatalf("expected 4 edges, got %d", len(g.Edges))
+	}
+
+	dangling := g.Dangling()
+	if len(dangling) != 2 {
+		t.Fatalf("expected 2 dangling edges, got %+v", dangling)
+	}
+	reasons := map[string]string{}
+	for _, e := range dangling {
+		reasons[e.Connection] = e.Reason
+	}
+	if reasons["cache"] != ReasonEndpointNotFound {
+		t.Errorf("expected cache connection to be dangling with %s, got %q", ReasonEndpointNotFound, reasons["cache"])
+	}
+	if reasons["ads"] != ReasonComponentNotFound {
+		t.Errorf("expected ads connection to be dangling with %s, got %q", ReasonComponentNotFound, reasons["ads"])
+	}
+
+	deps := g.DependenciesOf(ComponentRef{Project: "shop", Component: "frontend"})
+	if len(deps) != 3 {
+		t.Fatalf("expected 3 dependencies of frontend, got %d", len(deps))
+	}
+	if deps[2].To != (ComponentRef{Project: "catalog", Component: "products"}) {
+		t.Errorf("expected cross project dependency on catalog/products, got %s", deps[2].To)
+	}
+}
+
+func TestBuildMissingComponentParam(t *testing.T) {
+	t.Parallel()
+
+	g := Build("", []Workload{
+		workload("shop", "frontend", nil, map[string]map[string]string{"broken": {"endpoint": "http"}}),
+	})
+
+	if len(g.Edges) != 1 || g.Edges[0].Reason != ReasonMissingComponentParam {
+		t.Fatalf("expected a dangling edge with %s, got %+v", ReasonMissingComponentParam, g.Edges)
+	}
+}
+
+func TestForProject(t *testing.T) {
+	t.Parallel()
+
+	g := Build("", shopWorkloads()).ForProject("catalog")
+
+	// products is used by shop/frontend, search is part of the project
+	var nodes []string
+	for _, n := range g.Nodes {
+		nodes = append(nodes, n.Ref().String())
+	}
+	if strings.Join(nodes, ",") != "catalog/products,catalog/search,shop/frontend" {
+		t.Errorf("unexpected nodes %v", nodes)
+	}
+	if len(g.Edges) != 1 || g.Edges[0].Connection != "catalog" {
+		t.Errorf("expected only the catalog connection, got %+v", g.Edges)
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	g := Build("staging", shopWorkloads())
+
+	dot := g.DOT()
+	for _, want := range []string{
+		`subgraph "cluster_shop" {`,
+		`"shop/frontend" -> "catalog/products" [label="catalog (grpc)"];`,
+		`"shop/frontend" -> "shop/ads" [label="ads (grpc)", style=dashed, color=red];`,
+		`"shop/ads" [style=dashed, color=red];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output doesn't contain %q:\n%s", want, dot)
+		}
+	}
+
+	mermaid := g.Mermaid()
+	for _, want := range []string{
+		"flowchart LR",
+		`subgraph project_shop["shop"]`,
+		`-.->|"ads (grpc)"|`,
+		"class ",
+	} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("Mermaid output doesn't contain %q:\n%s", want, mermaid)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]Format{"": FormatJSON, "DOT": FormatDOT, "mermaid": FormatMermaid} {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("svg"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is an output format of the graph.
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatDOT     Format = "dot"
+	FormatMermaid Format = "mermaid"
+)
+
+// ParseFormat parses a graph output format, defaulting to JSON.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "":
+		return FormatJSON, nil
+	case FormatJSON, FormatDOT, FormatMermaid:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q, must be one of json, dot, mermaid", s)
+	}
+}
+
+// DOT renders the graph in the Graphviz DOT language. Components are grouped by project
+// and dangling connections are drawn dashed in red.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, project := range g.projects() {
+		fmt.Fprintf(&b, "  subgraph %s {\n", dotQuote("cluster_"+project))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(project))
+		for _, n := range g.Nodes {
+			if n.Project == project {
+				fmt.Fprintf(&b, "    %s [label=%s];\n", dotQuote(n.Ref().String()), dotQuote(n.Component))
+			}
+		}
+		b.WriteString("  }\n")
+	}
+
+	for _, ref := range g.missing() {
+		fmt.Fprintf(&b, "  %s [style=dashed, color=red];\n", dotQuote(ref.String()))
+	}
+
+	for _, e := range g.Edges {
+		attrs := "label=" + dotQuote(edgeLabel(e))
+		if e.Dangling {
+			attrs += ", style=dashed, color=red"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(e.From.String()), dotQuote(e.To.String()), attrs)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. Components are grouped by project
+// and dangling connections are drawn dotted with missing components highlighted.
+func (g *Graph) Mermaid() string {
+	ids := make(map[ComponentRef]string)
+	id := func(ref ComponentRef) string {
+		if _, ok := ids[ref]; !ok {
+			ids[ref] = fmt.Sprintf("n%d", len(ids))
+		}
+		return ids[ref]
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for _, project := range g.projects() {
+		fmt.Fprintf(&b, "  subgraph %s[%s]\n", mermaidID("project", project), mermaidQuote(project))
+		for _, n := range g.Nodes {
+			if n.Project == project {
+				fmt.Fprintf(&b, "    %s[%s]\n", id(n.Ref()), mermaidQuote(n.Component))
+			}
+		}
+		b.WriteString("  end\n")
+	}
+
+	missing := g.missing()
+	for _, ref := range missing {
+		fmt.Fprintf(&b, "  %s[%s]\n", id(ref), mermaidQuote(ref.String()))
+	}
+
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.Dangling {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", id(e.From), arrow, mermaidQuote(edgeLabel(e)), id(e.To))
+	}
+
+	if len(missing) > 0 {
+		b.WriteString("  classDef missing stroke:#d00,stroke-dasharray:5 5\n")
+		ref := make([]string, 0, len(missing))
+		for _, m := range missing {
+			ref = append(ref, id(m))
+		}
+		fmt.Fprintf(&b, "  class %s missing\n", strings.Join(ref, ","))
+	}
+
+	return b.String()
+}
+
+// projects returns the projects of the nodes in order.
+func (g *Graph) projects() []string {
+	var projects []string
+	seen := make(map[string]bool)
+	for _, n := range g.Nodes {
+		if !seen[n.Project] {
+			seen[n.Project] = true
+			projects = append(projects, n.Project)
+		}
+	}
+	return projects
+}
+
+// missing returns the targets of dangling connections that are not nodes of the graph.
+func (g *Graph) missing() []ComponentRef {
+	var refs []ComponentRef
+	seen := make(map[ComponentRef]bool)
+	for _, e := range g.Edges {
+		if !e.Dangling || seen[e.To] || g.hasNode(e.To) {
+			continue
+		}
+		seen[e.To] = true
+		refs = append(refs, e.To)
+	}
+	return refs
+}
+
+func edgeLabel(e Edge) string {
+	if e.Endpoint == "" {
+		return e.Connection
+	}
+	return fmt.Sprintf("%s (%s)", e.Connection, e.Endpoint)
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+// mermaidID returns an identifier made of characters that are valid in Mermaid ids.
+func mermaidID(prefix, s string) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteByte('_')
+	for _, r := range s {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
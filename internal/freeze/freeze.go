@@ -0,0 +1,131 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package freeze evaluates deployment freeze windows defined on environments.
+package freeze
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ActiveWindow describes a freeze window that is in effect at the evaluated time.
+type ActiveWindow struct {
+	Name   string
+	Reason string
+	Start  time.Time
+	End    time.Time
+}
+
+const (
+	// maxScheduleIterations bounds the search for overlapping recurrences of a single schedule.
+	maxScheduleIterations = 1000
+
+	// MaxOverrideRecords is the number of override records retained in the environment status.
+	MaxOverrideRecords = 50
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Evaluate returns the freeze window active at now, or nil if deployments are allowed.
+// When several windows are active, the one ending last is returned.
+func Evaluate(windows []openchoreov1alpha1.FreezeWindow, now time.Time) (*ActiveWindow, error) {
+	var active *ActiveWindow
+	for i := range windows {
+		start, end, ok, err := activeBetween(&windows[i], now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid freeze window %q: %w", windows[i].Name, err)
+		}
+		if !ok {
+			continue
+		}
+		if active == nil || end.After(active.End) {
+			active = &ActiveWindow{
+				Name:   windows[i].Name,
+				Reason: windows[i].Reason,
+				Start:  start,
+				End:    end,
+			}
+		}
+	}
+	return active, nil
+}
+
+// HasOverride reports whether an override was recorded for the given component during the active window.
+func HasOverride(records []openchoreov1alpha1.FreezeOverrideRecord, active *ActiveWindow, project, component string) bool {
+	for _, r := range records {
+		if r.Window == active.Name && r.Project == project && r.Component == component && !r.Timestamp.Time.Before(active.Start) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppendOverride appends an override record, keeping only the most recent MaxOverrideRecords entries.
+func AppendOverride(records []openchoreov1alpha1.FreezeOverrideRecord, record openchoreov1alpha1.FreezeOverrideRecord) []openchoreov1alpha1.FreezeOverrideRecord {
+	records = append(records, record)
+	if len(records) > MaxOverrideRecords {
+		records = records[len(records)-MaxOverrideRecords:]
+	}
+	return records
+}
+
+// activeBetween reports whether the window is active at now and, if so, the bounds of the current occurrence.
+func activeBetween(w *openchoreov1alpha1.FreezeWindow, now time.Time) (time.Time, time.Time, bool, error) {
+	if w.Schedule != "" {
+		return scheduleActiveBetween(w, now)
+	}
+
+	if w.Start == nil || w.End == nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("either schedule or start and end must be set")
+	}
+	if !w.End.After(w.Start.Time) {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("end must be after start")
+	}
+	if now.Before(w.Start.Time) || !now.Before(w.End.Time) {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	return w.Start.Time, w.End.Time, true, nil
+}
+
+func scheduleActiveBetween(w *openchoreov1alpha1.FreezeWindow, now time.Time) (time.Time, time.Time, bool, error) {
+	if w.Duration == nil || w.Duration.Duration <= 0 {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("a positive duration is required for scheduled windows")
+	}
+
+	loc := time.UTC
+	if w.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return time.Time{}, time.Time{}, false, fmt.Errorf("invalid time zone: %w", err)
+		}
+	}
+
+	sched, err := cronParser.Parse(w.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	duration := w.Duration.Duration
+	// Walk the recurrences that started within the last duration; overlapping
+	// occurrences are merged so the freeze spans from the earliest start to the latest end.
+	var first, end time.Time
+	active := false
+	start := sched.Next(now.In(loc).Add(-duration - time.Second))
+	for i := 0; i < maxScheduleIterations && !start.After(now); i++ {
+		if candidate := start.Add(duration); candidate.After(now) {
+			if !active {
+				first = start
+			}
+			end = candidate
+			active = true
+		}
+		start = sched.Next(start)
+	}
+	return first, end, active, nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package freeze
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	// Friday 2025-06-06 19:30 UTC
+	now := time.Date(2025, 6, 6, 19, 30, 0, 0, time.UTC)
+	hours := func(h int) *metav1.Duration { return &metav1.Duration{Duration: time.Duration(h) * time.Hour} }
+	at := func(t time.Time) *metav1.Time { return &metav1.Time{Time: t} }
+
+	tests := []struct {
+		name       string
+		windows    []openchoreov1alpha1.FreezeWindow
+		wantActive string
+		wantEnd    time.Time
+		wantErr    bool
+	}{
+		{
+			name:    "no windows",
+			windows: nil,
+		},
+		{
+			name: "recurring window active",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "weekend", Reason: "weekend freeze", Schedule: "0 18 * * 5", Duration: hours(62)},
+			},
+			wantActive: "weekend",
+			wantEnd:    time.Date(2025, 6, 9, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "recurring window not active",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "nightly", Reason: "batch jobs", Schedule: "0 1 * * *", Duration: hours(2)},
+			},
+		},
+		{
+			name: "recurring window honours time zone",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				// 21:00 in Europe/Berlin (UTC+2 in June) is 19:00 UTC
+				{Name: "evening", Reason: "on-call handover", Schedule: "0 21 * * *", Duration: hours(1), TimeZone: "Europe/Berlin"},
+			},
+			wantActive: "evening",
+			wantEnd:    time.Date(2025, 6, 6, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "absolute window active",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{
+					Name:   "launch",
+					Reason: "product launch",
+					Start:  at(now.Add(-time.Hour)),
+					End:    at(now.Add(time.Hour)),
+				},
+			},
+			wantActive: "launch",
+			wantEnd:    now.Add(time.Hour),
+		},
+		{
+			name: "absolute window expired",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{
+					Name:   "launch",
+					Reason: "product launch",
+					Start:  at(now.Add(-2 * time.Hour)),
+					End:    at(now),
+				},
+			},
+		},
+		{
+			name: "latest ending window wins",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "short", Reason: "a", Start: at(now.Add(-time.Hour)), End: at(now.Add(time.Hour))},
+				{Name: "long", Reason: "b", Start: at(now.Add(-time.Hour)), End: at(now.Add(3 * time.Hour))},
+			},
+			wantActive: "long",
+			wantEnd:    now.Add(3 * time.Hour),
+		},
+		{
+			name: "invalid schedule",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "bad", Reason: "x", Schedule: "not a cron", Duration: hours(1)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "schedule without duration",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "bad", Reason: "x", Schedule: "0 18 * * 5"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown time zone",
+			windows: []openchoreov1alpha1.FreezeWindow{
+				{Name: "bad", Reason: "x", Schedule: "0 18 * * 5", Duration: hours(1), TimeZone: "Mars/Olympus"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Evaluate(tt.windows, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantActive == "" {
+				if got != nil {
+					t.Fatalf("expected no active window, got %q", got.Name)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("expected active window %q, got nil", tt.wantActive)
+			}
+			if got.Name != tt.wantActive {
+				t.Errorf("active window = %q, want %q", got.Name, tt.wantActive)
+			}
+			if !got.End.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", got.End, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestHasOverride(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 6, 6, 18, 0, 0, 0, time.UTC)
+	active := &ActiveWindow{Name: "weekend", Start: start, End: start.Add(62 * time.Hour)}
+	record := func(window, component string, ts time.Time) openchoreov1alpha1.FreezeOverrideRecord {
+		return openchoreov1alpha1.FreezeOverrideRecord{
+			Window:    window,
+			Project:   "shop",
+			Component: component,
+			Timestamp: metav1.NewTime(ts),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		records []openchoreov1alpha1.FreezeOverrideRecord
+		want    bool
+	}{
+		{name: "no records", want: false},
+		{name: "matching record", records: []openchoreov1alpha1.FreezeOverrideRecord{record("weekend", "cart", start.Add(time.Hour))}, want: true},
+		{name: "other component", records: []openchoreov1alpha1.FreezeOverrideRecord{record("weekend", "checkout", start.Add(time.Hour))}, want: false},
+		{name: "other window", records: []openchoreov1alpha1.FreezeOverrideRecord{record("launch", "cart", start.Add(time.Hour))}, want: false},
+		{name: "previous occurrence", records: []openchoreov1alpha1.FreezeOverrideRecord{record("weekend", "cart", start.Add(-7*24*time.Hour))}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := HasOverride(tt.records, active, "shop", "cart"); got != tt.want {
+				t.Errorf("HasOverride() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendOverrideRetainsMostRecent(t *testing.T) {
+	t.Parallel()
+
+	var records []openchoreov1alpha1.FreezeOverrideRecord
+	for i := 0; i < MaxOverrideRecords+5; i++ {
+		records = AppendOverride(records, openchoreov1alpha1.FreezeOverrideRecord{Target: fmt.Sprintf("t-%d", i)})
+	}
+	if len(records) != MaxOverrideRecords {
+		t.Fatalf("len(records) = %d, want %d", len(records), MaxOverrideRecords)
+	}
+	if records[0].Target != "t-5" {
+		t.Errorf("oldest retained record = %q, want %q", records[0].Target, "t-5")
+	}
+}
@@ -40,4 +40,7 @@ const (
 	LabelKeyReleaseNamespace = "openchoreo.dev/release-namespace"
 
 	LabelValueManagedBy = "openchoreo-control-plane"
+
+	// LabelKeyRolloutTrack distinguishes the stable, canary and preview pods of a progressive rollout.
+	LabelKeyRolloutTrack = "openchoreo.dev/rollout-track"
 )
@@ -17,11 +17,22 @@ import (
 type Config struct {
 	Server     ServerConfig     `koanf:"server"`
 	OpenSearch OpenSearchConfig `koanf:"opensearch"`
+	Loki       LokiConfig       `koanf:"loki"`
+	Prometheus PrometheusConfig `koanf:"prometheus"`
 	Auth       AuthConfig       `koanf:"auth"`
 	Logging    LoggingConfig    `koanf:"logging"`
 	LogLevel   string           `koanf:"loglevel"`
+	// LogBackend is the backend the logs are read from, either "opensearch" or "loki".
+	// Traces are always read from OpenSearch.
+	LogBackend string `koanf:"logbackend"`
 }
 
+// Log backends the observer can read logs from
+const (
+	LogBackendOpenSearch = "opensearch"
+	LogBackendLoki       = "loki"
+)
+
 // ServerConfig holds HTTP server configuration
 type ServerConfig struct {
 	Port            int           `koanf:"port"`
@@ -42,6 +53,23 @@ type OpenSearchConfig struct {
 	LegacyPattern string        `koanf:"legacy.pattern"`
 }
 
+// LokiConfig holds Grafana Loki connection configuration
+type LokiConfig struct {
+	Address  string        `koanf:"address"`
+	Username string        `koanf:"username"`
+	Password string        `koanf:"password"`
+	TenantID string        `koanf:"tenant.id"`
+	Timeout  time.Duration `koanf:"timeout"`
+}
+
+// PrometheusConfig holds the connection configuration of the Prometheus-compatible metrics backend
+type PrometheusConfig struct {
+	Address  string        `koanf:"address"`
+	Username string        `koanf:"username"`
+	Password string        `koanf:"password"`
+	Timeout  time.Duration `koanf:"timeout"`
+}
+
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
 	JWTSecret    string `koanf:"jwt.secret"`
@@ -55,6 +83,11 @@ type LoggingConfig struct {
 	DefaultLogLimit      int `koanf:"default.log.limit"`
 	DefaultBuildLogLimit int `koanf:"default.build.log.limit"`
 	MaxLogLinesPerFile   int `koanf:"max.log.lines.per.file"`
+	// FollowPollInterval is the interval at which followed logs are polled from the log store
+	FollowPollInterval time.Duration `koanf:"follow.poll.interval"`
+	// FollowOverlap is the time before the newest entry sent that each poll of followed logs reads again,
+	// so that entries indexed after newer ones are still sent
+	FollowOverlap time.Duration `koanf:"follow.overlap"`
 }
 
 // Load loads configuration from environment variables and defaults
@@ -83,6 +116,16 @@ func Load() (*Config, error) {
 		"OPENSEARCH_INDEX_PREFIX":         "opensearch.index.prefix",
 		"OPENSEARCH_INDEX_PATTERN":        "opensearch.index.pattern",
 		"OPENSEARCH_LEGACY_PATTERN":       "opensearch.legacy.pattern",
+		"LOKI_ADDRESS":                    "loki.address",
+		"LOKI_USERNAME":                   "loki.username",
+		"LOKI_PASSWORD":                   "loki.password",
+		"LOKI_TENANT_ID":                  "loki.tenant.id",
+		"LOKI_TIMEOUT":                    "loki.timeout",
+		"LOG_BACKEND":                     "logbackend",
+		"PROMETHEUS_ADDRESS":              "prometheus.address",
+		"PROMETHEUS_USERNAME":             "prometheus.username",
+		"PROMETHEUS_PASSWORD":             "prometheus.password",
+		"PROMETHEUS_TIMEOUT":              "prometheus.timeout",
 		"AUTH_JWT_SECRET":                 "auth.jwt.secret",
 		"AUTH_ENABLE_AUTH":                "auth.enable.auth",
 		"AUTH_REQUIRED_ROLE":              "auth.required.role",
@@ -90,6 +133,8 @@ func Load() (*Config, error) {
 		"LOGGING_DEFAULT_LOG_LIMIT":       "logging.default.log.limit",
 		"LOGGING_DEFAULT_BUILD_LOG_LIMIT": "logging.default.build.log.limit",
 		"LOGGING_MAX_LOG_LINES_PER_FILE":  "logging.max.log.lines.per.file",
+		"LOGGING_FOLLOW_POLL_INTERVAL":    "logging.follow.poll.interval",
+		"LOGGING_FOLLOW_OVERLAP":          "logging.follow.overlap",
 		"LOG_LEVEL":                       "loglevel",
 		"PORT":                            "server.port",           // Common alias
 		"JWT_SECRET":                      "auth.jwt.secret",       // Common alias
@@ -164,6 +209,14 @@ func getDefaults() map[string]interface{} {
 			"index.pattern":  "kubernetes-*",
 			"legacy.pattern": "choreo*",
 		},
+		"loki": map[string]interface{}{
+			"address": "http://localhost:3100",
+			"timeout": "60s",
+		},
+		"prometheus": map[string]interface{}{
+			"address": "http://localhost:9090",
+			"timeout": "60s",
+		},
 		"auth": map[string]interface{}{
 			"enable.auth":   false,
 			"jwt.secret":    "default-secret",
@@ -174,8 +227,11 @@ func getDefaults() map[string]interface{} {
 			"default.log.limit":       100,
 			"default.build.log.limit": 3000,
 			"max.log.lines.per.file":  600000,
+			"follow.poll.interval":    "2s",
+			"follow.overlap":          "10s",
 		},
-		"loglevel": "info",
+		"loglevel":   "info",
+		"logbackend": LogBackendOpenSearch,
 	}
 }
 
@@ -192,9 +248,34 @@ func (c *Config) validate() error {
 		return fmt.Errorf("opensearch timeout must be positive")
 	}
 
+	switch c.LogBackend {
+	case "", LogBackendOpenSearch:
+	case LogBackendLoki:
+		if c.Loki.Address == "" {
+			return fmt.Errorf("loki address is required")
+		}
+		if c.Loki.Timeout <= 0 {
+			return fmt.Errorf("loki timeout must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid log backend: %q, must be %q or %q", c.LogBackend, LogBackendOpenSearch, LogBackendLoki)
+	}
+
+	if c.Prometheus.Address != "" && c.Prometheus.Timeout <= 0 {
+		return fmt.Errorf("prometheus timeout must be positive")
+	}
+
 	if c.Logging.MaxLogLimit <= 0 {
 		return fmt.Errorf("max log limit must be positive")
 	}
 
+	if c.Logging.FollowPollInterval < 0 {
+		return fmt.Errorf("follow poll interval must not be negative")
+	}
+
+	if c.Logging.FollowOverlap < 0 {
+		return fmt.Errorf("follow overlap must not be negative")
+	}
+
 	return nil
 }
@@ -52,6 +52,47 @@ func TestLoad_WithDefaults(t *testing.T) {
 	if cfg.Logging.MaxLogLimit != 10000 {
 		t.Errorf("Expected max log limit 10000, got %d", cfg.Logging.MaxLogLimit)
 	}
+
+	if cfg.LogBackend != LogBackendOpenSearch {
+		t.Errorf("Expected default log backend %q, got %s", LogBackendOpenSearch, cfg.LogBackend)
+	}
+
+	if cfg.Prometheus.Address != "http://localhost:9090" {
+		t.Errorf("Expected default Prometheus address, got %s", cfg.Prometheus.Address)
+	}
+}
+
+func TestLoad_WithLokiBackend(t *testing.T) {
+	os.Setenv("LOG_BACKEND", "loki")
+	os.Setenv("LOKI_ADDRESS", "http://loki-gateway.observability:80")
+	os.Setenv("LOKI_TENANT_ID", "data-plane-1")
+
+	defer func() {
+		for _, env := range []string{"LOG_BACKEND", "LOKI_ADDRESS", "LOKI_TENANT_ID"} {
+			os.Unsetenv(env)
+		}
+	}()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cfg.LogBackend != LogBackendLoki {
+		t.Errorf("Expected log backend %q from env, got %s", LogBackendLoki, cfg.LogBackend)
+	}
+
+	if cfg.Loki.Address != "http://loki-gateway.observability:80" {
+		t.Errorf("Expected Loki address from env, got %s", cfg.Loki.Address)
+	}
+
+	if cfg.Loki.TenantID != "data-plane-1" {
+		t.Errorf("Expected Loki tenant 'data-plane-1' from env, got %s", cfg.Loki.TenantID)
+	}
+
+	if cfg.Loki.Timeout != 60*time.Second {
+		t.Errorf("Expected default Loki timeout 60s, got %v", cfg.Loki.Timeout)
+	}
 }
 
 func TestLoad_WithEnvironmentVariables(t *testing.T) {
@@ -63,13 +104,14 @@ func TestLoad_WithEnvironmentVariables(t *testing.T) {
 	os.Setenv("OPENSEARCH_PASSWORD", "testpass")
 	os.Setenv("AUTH_ENABLE_AUTH", "true")
 	os.Setenv("LOGGING_MAX_LOG_LIMIT", "5000")
+	os.Setenv("PROMETHEUS_ADDRESS", "http://prometheus.example.com:9090")
 
 	defer func() {
 		// Clean up environment variables
 		envVars := []string{
 			"SERVER_PORT", "LOG_LEVEL", "OPENSEARCH_ADDRESS",
 			"OPENSEARCH_USERNAME", "OPENSEARCH_PASSWORD",
-			"AUTH_ENABLE_AUTH", "LOGGING_MAX_LOG_LIMIT",
+			"AUTH_ENABLE_AUTH", "LOGGING_MAX_LOG_LIMIT", "PROMETHEUS_ADDRESS",
 		}
 		for _, env := range envVars {
 			os.Unsetenv(env)
@@ -109,6 +151,10 @@ func TestLoad_WithEnvironmentVariables(t *testing.T) {
 	if cfg.Logging.MaxLogLimit != 5000 {
 		t.Errorf("Expected max log limit 5000 from env, got %d", cfg.Logging.MaxLogLimit)
 	}
+
+	if cfg.Prometheus.Address != "http://prometheus.example.com:9090" {
+		t.Errorf("Expected Prometheus address from env, got %s", cfg.Prometheus.Address)
+	}
 }
 
 func TestValidate(t *testing.T) {
@@ -197,6 +243,83 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid loki config",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Loki: LokiConfig{
+					Address: "http://loki:3100",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit: 1000,
+				},
+				LogBackend: LogBackendLoki,
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing loki address",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Loki: LokiConfig{
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit: 1000,
+				},
+				LogBackend: LogBackendLoki,
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid log backend",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit: 1000,
+				},
+				LogBackend: "elasticsearch",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid prometheus timeout",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit: 1000,
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "invalid max log limit",
 			config: Config{
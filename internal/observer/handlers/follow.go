@@ -0,0 +1,213 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// logStreamHeartbeatInterval is the interval of the comments sent to keep idle log streams open through proxies
+const logStreamHeartbeatInterval = 15 * time.Second
+
+// followFunc follows a log query, sending its new entries and gaps to the sink until the context is done
+type followFunc func(ctx context.Context, sink service.LogSink) error
+
+// logStreamEvent is an event of a log stream, whose data is a log entry or a gap
+type logStreamEvent struct {
+	name string
+	data interface{}
+}
+
+// FollowComponentLogs handles POST /api/logs/component/{componentId}/follow
+func (h *Handler) FollowComponentLogs(w http.ResponseWriter, r *http.Request) {
+	componentID := httputil.GetPathParam(r, "componentId")
+	if componentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgComponentIDRequired)
+		return
+	}
+
+	var req ComponentLogsRequest
+	if !h.bindFollowRequest(w, r, &req, &req.StartTime) {
+		return
+	}
+
+	// Build query parameters
+	params := types.ComponentQueryParams{
+		QueryParams: types.QueryParams{
+			StartTime:     req.StartTime,
+			SearchPhrase:  req.SearchPhrase,
+			LogLevels:     req.LogLevels,
+			ComponentID:   componentID,
+			EnvironmentID: req.EnvironmentID,
+			Namespace:     req.Namespace,
+			Versions:      req.Versions,
+			VersionIDs:    req.VersionIDs,
+			LogType:       types.ExtractLogType(req.LogType),
+		},
+		BuildID:   req.BuildID,
+		BuildUUID: req.BuildUUID,
+	}
+
+	h.streamLogs(w, r, func(ctx context.Context, sink service.LogSink) error {
+		return h.service.FollowComponentLogs(ctx, params, sink)
+	})
+}
+
+// FollowProjectLogs handles POST /api/logs/project/{projectId}/follow
+func (h *Handler) FollowProjectLogs(w http.ResponseWriter, r *http.Request) {
+	projectID := httputil.GetPathParam(r, "projectId")
+	if projectID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgProjectIDRequired)
+		return
+	}
+
+	var req ProjectLogsRequest
+	if !h.bindFollowRequest(w, r, &req, &req.StartTime) {
+		return
+	}
+
+	// Build query parameters
+	params := types.QueryParams{
+		StartTime:     req.StartTime,
+		SearchPhrase:  req.SearchPhrase,
+		LogLevels:     req.LogLevels,
+		ProjectID:     projectID,
+		EnvironmentID: req.EnvironmentID,
+		Versions:      req.Versions,
+		VersionIDs:    req.VersionIDs,
+		LogType:       types.ExtractLogType(req.LogType),
+	}
+
+	h.streamLogs(w, r, func(ctx context.Context, sink service.LogSink) error {
+		return h.service.FollowProjectLogs(ctx, params, req.ComponentIDs, sink)
+	})
+}
+
+// FollowGatewayLogs handles POST /api/logs/gateway/follow
+func (h *Handler) FollowGatewayLogs(w http.ResponseWriter, r *http.Request) {
+	var req GatewayLogsRequest
+	if !h.bindFollowRequest(w, r, &req, &req.StartTime) {
+		return
+	}
+
+	// Build query parameters
+	params := types.GatewayQueryParams{
+		QueryParams: types.QueryParams{
+			StartTime:    req.StartTime,
+			SearchPhrase: req.SearchPhrase,
+			LogType:      types.ExtractLogType(req.LogType),
+		},
+		OrganizationID:    req.OrganizationID,
+		APIIDToVersionMap: req.APIIDToVersionMap,
+		GatewayVHosts:     req.GatewayVHosts,
+	}
+
+	h.streamLogs(w, r, func(ctx context.Context, sink service.LogSink) error {
+		return h.service.FollowGatewayLogs(ctx, params, sink)
+	})
+}
+
+// bindFollowRequest binds the body of a follow request and validates its start time, which is optional.
+// The end time, limit and sort order of the body don't apply to followed logs.
+func (h *Handler) bindFollowRequest(w http.ResponseWriter, r *http.Request, req interface{}, startTime *string) bool {
+	if err := httputil.BindJSON(r, req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return false
+	}
+
+	if *startTime != "" {
+		if _, err := time.Parse(time.RFC3339, *startTime); err != nil {
+			h.logger.Debug("Invalid startTime parameter", "requestBody", req, "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest,
+				fmt.Sprintf("startTime must be in RFC3339 format (e.g., 2024-01-01T00:00:00Z): %v", err))
+			return false
+		}
+	}
+
+	return true
+}
+
+// streamLogs streams the entries of a followed log query as Server-Sent Events. Each entry is a log event whose
+// data is the entry, entries skipped by following are reported by a gap event, and an error event ends the stream
+// if following the logs fails.
+func (h *Handler) streamLogs(w http.ResponseWriter, r *http.Request, follow followFunc) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	rc := http.NewResponseController(w)
+	// Streams are long-lived, so the write timeout of the server does not apply to them
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Warn("Failed to clear the write deadline of the log stream", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		h.logger.Error("Log stream is not supported by the response writer", "error", err)
+		return
+	}
+
+	events := make(chan logStreamEvent)
+	send := func(event logStreamEvent) error {
+		select {
+		case events <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- follow(ctx, service.LogSink{
+			Entry: func(entry types.LogEntry) error { return send(logStreamEvent{name: "log", data: entry}) },
+			Gap:   func(gap types.LogGap) error { return send(logStreamEvent{name: "gap", data: gap}) },
+		})
+	}()
+
+	heartbeat := time.NewTicker(logStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event := <-events:
+			data, err := json.Marshal(event.data)
+			if err != nil {
+				h.logger.Error("Failed to encode log stream event", "event", event.name, "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.name, data); err != nil {
+				return
+			}
+		case err := <-done:
+			if err != nil {
+				h.logger.Error("Failed to follow logs", "error", err)
+				data, _ := json.Marshal(ErrorResponse{Error: ErrorTypeInternalError, Code: ErrorCodeInternalError, Message: ErrorMsgFailedToRetrieveLogs})
+				_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				_ = rc.Flush()
+			}
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
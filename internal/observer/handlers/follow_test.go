@@ -0,0 +1,112 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// staticLogStore returns its entries for every component logs query, recording the parameters of the queries
+type staticLogStore struct {
+	service.LogStore
+	entries []types.LogEntry
+	params  chan types.ComponentQueryParams
+}
+
+func (s *staticLogStore) GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*service.LogResponse, error) {
+	select {
+	case s.params <- params:
+	default:
+	}
+	return &service.LogResponse{Logs: s.entries, TotalCount: len(s.entries)}, nil
+}
+
+func newFollowTestServer(t *testing.T, store service.LogStore) *httptest.Server {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := &config.Config{Logging: config.LoggingConfig{FollowPollInterval: 10 * time.Millisecond}}
+	handler := NewHandler(service.NewLoggingService(store, nil, cfg, logger), nil, logger)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/logs/component/{componentId}/follow", handler.FollowComponentLogs)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFollowComponentLogs(t *testing.T) {
+	timestamp := time.Now().Add(-time.Second).UTC().Truncate(time.Millisecond)
+	store := &staticLogStore{
+		entries: []types.LogEntry{{Timestamp: timestamp, Log: "ERROR connection refused", LogLevel: "ERROR", PodID: "pod-1"}},
+		params:  make(chan types.ComponentQueryParams, 1),
+	}
+	server := newFollowTestServer(t, store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	body := `{"startTime":"` + timestamp.Add(-time.Minute).Format(time.RFC3339) +
+		`","environmentId":"dev","namespace":"dp-org-proj-dev","logLevels":["ERROR"],"searchPhrase":"refused"}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/logs/component/comp-1/follow", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to follow logs: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected an event stream, got %s", ct)
+	}
+
+	params := <-store.params
+	if params.ComponentID != "comp-1" || params.EnvironmentID != "dev" || params.SearchPhrase != "refused" ||
+		len(params.LogLevels) != 1 || params.SortOrder != "asc" {
+		t.Errorf("Expected the filters of the request in the store query, got %+v", params)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	event, err := reader.ReadString('\n')
+	if err != nil || event != "event: log\n" {
+		t.Fatalf("Expected a log event, got %q (%v)", event, err)
+	}
+	data, _ := reader.ReadString('\n')
+	var entry types.LogEntry
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &entry); err != nil {
+		t.Fatalf("Failed to decode the log event: %v", err)
+	}
+	if entry.Log != "ERROR connection refused" || !entry.Timestamp.Equal(timestamp) {
+		t.Errorf("Expected the entry of the store, got %+v", entry)
+	}
+}
+
+func TestFollowComponentLogs_InvalidStartTime(t *testing.T) {
+	server := newFollowTestServer(t, &staticLogStore{})
+
+	resp, err := http.Post(server.URL+"/api/logs/component/comp-1/follow", "application/json",
+		strings.NewReader(`{"startTime":"yesterday"}`))
+	if err != nil {
+		t.Fatalf("Failed to follow logs: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+}
@@ -9,8 +9,9 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/httputil"
-	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 const (
@@ -30,24 +31,28 @@ const (
 	ErrorCodeInternalError    = "OBS-L-25"
 
 	// Error messages
-	ErrorMsgComponentIDRequired    = "Component ID is required"
-	ErrorMsgProjectIDRequired      = "Project ID is required"
-	ErrorMsgOrganizationIDRequired = "Organization ID is required"
-	ErrorMsgInvalidRequestFormat   = "Invalid request format"
-	ErrorMsgFailedToRetrieveLogs   = "Failed to retrieve logs"
+	ErrorMsgComponentIDRequired     = "Component ID is required"
+	ErrorMsgProjectIDRequired       = "Project ID is required"
+	ErrorMsgOrganizationIDRequired  = "Organization ID is required"
+	ErrorMsgEnvironmentIDRequired   = "Environment ID is required"
+	ErrorMsgInvalidRequestFormat    = "Invalid request format"
+	ErrorMsgFailedToRetrieveLogs    = "Failed to retrieve logs"
+	ErrorMsgFailedToRetrieveMetrics = "Failed to retrieve metrics"
 )
 
-// Handler contains the HTTP handlers for the logging API
+// Handler contains the HTTP handlers for the logging and metrics API
 type Handler struct {
-	service *service.LoggingService
-	logger  *slog.Logger
+	service        *service.LoggingService
+	metricsService *service.MetricsService
+	logger         *slog.Logger
 }
 
 // NewHandler creates a new handler instance
-func NewHandler(service *service.LoggingService, logger *slog.Logger) *Handler {
+func NewHandler(service *service.LoggingService, metricsService *service.MetricsService, logger *slog.Logger) *Handler {
 	return &Handler{
-		service: service,
-		logger:  logger,
+		service:        service,
+		metricsService: metricsService,
+		logger:         logger,
 	}
 }
 
@@ -109,6 +114,17 @@ type OrganizationLogsRequest struct {
 	PodLabels map[string]string `json:"podLabels,omitempty"`
 }
 
+// ComponentMetricsRequest represents the request body for component metrics
+type ComponentMetricsRequest struct {
+	StartTime     string            `json:"startTime" validate:"required"`
+	EndTime       string            `json:"endTime" validate:"required"`
+	EnvironmentID string            `json:"environmentId" validate:"required"`
+	ProjectID     string            `json:"projectId,omitempty"`
+	Namespace     string            `json:"namespace,omitempty"`
+	PodLabels     map[string]string `json:"podLabels,omitempty"`
+	Step          string            `json:"step,omitempty"`
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error   string `json:"error"`
@@ -140,8 +156,8 @@ func (h *Handler) GetComponentLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.ComponentQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := types.ComponentQueryParams{
+		QueryParams: types.QueryParams{
 			StartTime:     req.StartTime,
 			EndTime:       req.EndTime,
 			SearchPhrase:  req.SearchPhrase,
@@ -153,7 +169,7 @@ func (h *Handler) GetComponentLogs(w http.ResponseWriter, r *http.Request) {
 			Namespace:     req.Namespace,
 			Versions:      req.Versions,
 			VersionIDs:    req.VersionIDs,
-			LogType:       opensearch.ExtractLogType(req.LogType),
+			LogType:       types.ExtractLogType(req.LogType),
 		},
 		BuildID:   req.BuildID,
 		BuildUUID: req.BuildUUID,
@@ -195,7 +211,7 @@ func (h *Handler) GetProjectLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.QueryParams{
+	params := types.QueryParams{
 		StartTime:     req.StartTime,
 		EndTime:       req.EndTime,
 		SearchPhrase:  req.SearchPhrase,
@@ -206,7 +222,7 @@ func (h *Handler) GetProjectLogs(w http.ResponseWriter, r *http.Request) {
 		EnvironmentID: req.EnvironmentID,
 		Versions:      req.Versions,
 		VersionIDs:    req.VersionIDs,
-		LogType:       opensearch.ExtractLogType(req.LogType),
+		LogType:       types.ExtractLogType(req.LogType),
 	}
 
 	// Execute query
@@ -239,14 +255,14 @@ func (h *Handler) GetGatewayLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.GatewayQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := types.GatewayQueryParams{
+		QueryParams: types.QueryParams{
 			StartTime:    req.StartTime,
 			EndTime:      req.EndTime,
 			SearchPhrase: req.SearchPhrase,
 			Limit:        req.Limit,
 			SortOrder:    req.SortOrder,
-			LogType:      opensearch.ExtractLogType(req.LogType),
+			LogType:      types.ExtractLogType(req.LogType),
 		},
 		OrganizationID:    req.OrganizationID,
 		APIIDToVersionMap: req.APIIDToVersionMap,
@@ -289,7 +305,7 @@ func (h *Handler) GetOrganizationLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.QueryParams{
+	params := types.QueryParams{
 		StartTime:      req.StartTime,
 		EndTime:        req.EndTime,
 		SearchPhrase:   req.SearchPhrase,
@@ -300,7 +316,7 @@ func (h *Handler) GetOrganizationLogs(w http.ResponseWriter, r *http.Request) {
 		Namespace:      req.Namespace,
 		Versions:       req.Versions,
 		VersionIDs:     req.VersionIDs,
-		LogType:        opensearch.ExtractLogType(req.LogType),
+		LogType:        types.ExtractLogType(req.LogType),
 		OrganizationID: orgID, // Add the organization ID from URL parameter
 	}
 
@@ -318,7 +334,7 @@ func (h *Handler) GetOrganizationLogs(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) GetComponentTraces(w http.ResponseWriter, r *http.Request) {
 	// Bind JSON request body
-	var req opensearch.ComponentTracesRequestParams
+	var req types.ComponentTracesRequestParams
 	if err := httputil.BindJSON(r, &req); err != nil {
 		h.logger.Error("Failed to bind request", "error", err)
 		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
@@ -373,6 +389,67 @@ func (h *Handler) GetComponentTraces(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, result)
 }
 
+// GetComponentMetrics handles POST /api/metrics/component/{componentId}
+func (h *Handler) GetComponentMetrics(w http.ResponseWriter, r *http.Request) {
+	componentID := httputil.GetPathParam(r, "componentId")
+	if componentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgComponentIDRequired)
+		return
+	}
+
+	var req ComponentMetricsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+
+	// Input validations
+	if err := validateTimes(req.StartTime, req.EndTime); err != nil {
+		h.logger.Debug("Invalid/missing request parameters", "requestBody", req, "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, err.Error())
+		return
+	}
+
+	if req.EnvironmentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgEnvironmentIDRequired)
+		return
+	}
+
+	// Times are validated above
+	startTime, _ := time.Parse(time.RFC3339, req.StartTime)
+	endTime, _ := time.Parse(time.RFC3339, req.EndTime)
+	step, err := validateStep(req.Step, startTime, endTime)
+	if err != nil {
+		h.logger.Debug("Invalid step parameter", "requestBody", req, "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, err.Error())
+		return
+	}
+
+	// Build query parameters
+	params := prometheus.ComponentMetricsParams{
+		ComponentID:   componentID,
+		EnvironmentID: req.EnvironmentID,
+		ProjectID:     req.ProjectID,
+		Namespace:     req.Namespace,
+		PodLabels:     req.PodLabels,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Step:          step,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.metricsService.GetComponentMetrics(ctx, params)
+	if err != nil {
+		h.logger.Error("Failed to get component metrics", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, ErrorMsgFailedToRetrieveMetrics)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
 // Health handles GET /health
 func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
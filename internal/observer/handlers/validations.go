@@ -21,6 +21,37 @@ func validateLimit(limit int) error {
 	return nil
 }
 
+// Bounds of the resolution step of metrics queries
+const (
+	minMetricsStep       = 15 * time.Second
+	defaultMetricsPoints = 100
+	maxMetricsPoints     = 11000
+)
+
+// Validates the resolution step of a metrics query over a time range and returns it. The step defaults to a
+// hundredth of the range, and can't be less than 15s nor produce more than 11000 points, the limit of Prometheus.
+func validateStep(step string, startTime, endTime time.Time) (time.Duration, error) {
+	timeRange := endTime.Sub(startTime)
+	if step == "" {
+		return max(timeRange/defaultMetricsPoints, minMetricsStep).Truncate(time.Second), nil
+	}
+
+	parsedStep, err := time.ParseDuration(step)
+	if err != nil {
+		return 0, fmt.Errorf("step must be a duration (e.g., 30s, 5m): %w", err)
+	}
+
+	if parsedStep < minMetricsStep {
+		return 0, fmt.Errorf("step must be at least %s", minMetricsStep)
+	}
+
+	if timeRange/parsedStep > maxMetricsPoints {
+		return 0, fmt.Errorf("step %s is too small for the time range, which cannot exceed %d points", step, maxMetricsPoints)
+	}
+
+	return parsedStep, nil
+}
+
 // Validates that the sortOrder is either "asc" or "desc"
 func validateSortOrder(sortOrder string) error {
 	if sortOrder != "asc" && sortOrder != "desc" {
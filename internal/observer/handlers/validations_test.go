@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"testing"
+	"time"
 )
 
 func TestValidateLimit(t *testing.T) {
@@ -283,3 +284,77 @@ func TestValidateTimes(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStep(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		step     string
+		timeSpan time.Duration
+		want     time.Duration
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "Default step - hundredth of the range",
+			timeSpan: 24 * time.Hour,
+			want:     14*time.Minute + 24*time.Second,
+		},
+		{
+			name:     "Default step - minimum for short ranges",
+			timeSpan: 5 * time.Minute,
+			want:     15 * time.Second,
+		},
+		{
+			name:     "Valid step",
+			step:     "1m",
+			timeSpan: time.Hour,
+			want:     time.Minute,
+		},
+		{
+			name:     "Invalid step - malformed",
+			step:     "one minute",
+			timeSpan: time.Hour,
+			wantErr:  true,
+			errMsg:   "step must be a duration (e.g., 30s, 5m): time: invalid duration \"one minute\"",
+		},
+		{
+			name:     "Invalid step - below minimum",
+			step:     "5s",
+			timeSpan: time.Hour,
+			wantErr:  true,
+			errMsg:   "step must be at least 15s",
+		},
+		{
+			name:     "Invalid step - too many points",
+			step:     "15s",
+			timeSpan: 7 * 24 * time.Hour,
+			wantErr:  true,
+			errMsg:   "step 15s is too small for the time range, which cannot exceed 11000 points",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateStep(tt.step, start, start.Add(tt.timeSpan))
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("validateStep() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("validateStep() error = %v, want %v", err.Error(), tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("validateStep() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("validateStep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
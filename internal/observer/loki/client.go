@@ -0,0 +1,125 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+// maxErrorBodySize bounds the size of the error messages read from Loki
+const maxErrorBodySize = 4096
+
+// Client queries the HTTP API of Grafana Loki
+type Client struct {
+	address    string
+	config     *config.LokiConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a new Loki client with the provided configuration
+func NewClient(cfg *config.LokiConfig, logger *slog.Logger) *Client {
+	return &Client{
+		address:    strings.TrimSuffix(cfg.Address, "/"),
+		config:     cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		logger:     logger,
+	}
+}
+
+// QueryRange runs a LogQL log query over a time range, returning at most limit log lines in the given direction.
+// The range defaults to the last hour when the start or end time is zero.
+func (c *Client) QueryRange(
+	ctx context.Context, query string, start, end time.Time, limit int, direction string,
+) (*QueryResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if !start.IsZero() {
+		params.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	}
+	if !end.IsZero() {
+		params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if direction != "" {
+		params.Set("direction", direction)
+	}
+
+	c.logger.Debug("Executing query", "query", query)
+
+	res, err := c.get(ctx, "/loki/api/v1/query_range?"+params.Encode())
+	if err != nil {
+		c.logger.Error("Query request failed", "error", err)
+		return nil, fmt.Errorf("query request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		message := readErrorMessage(res.Body)
+		c.logger.Error("Query request returned error",
+			"status", res.Status,
+			"error", message)
+		return nil, fmt.Errorf("query request failed with status: %s: %s", res.Status, message)
+	}
+
+	var response QueryResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		c.logger.Error("Failed to parse query response", "error", err)
+		return nil, fmt.Errorf("failed to parse query response: %w", err)
+	}
+	if response.Data.ResultType != "" && response.Data.ResultType != "streams" {
+		return nil, fmt.Errorf("unexpected query result type: %s", response.Data.ResultType)
+	}
+
+	c.logger.Debug("Query completed", "streams", len(response.Data.Result))
+	return &response, nil
+}
+
+// Ready checks that Loki is ready to serve queries
+func (c *Client) Ready(ctx context.Context) error {
+	res, err := c.get(ctx, "/ready")
+	if err != nil {
+		return fmt.Errorf("readiness check failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("readiness check failed with status: %s: %s", res.Status, readErrorMessage(res.Body))
+	}
+	return nil
+}
+
+// get sends a GET request to Loki, authenticated as configured and scoped to the tenant if there is one
+func (c *Client) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.address+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+	if c.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", c.config.TenantID)
+	}
+	return c.httpClient.Do(req)
+}
+
+// readErrorMessage reads the error message of a failed request from its body
+func readErrorMessage(body io.Reader) string {
+	message, _ := io.ReadAll(io.LimitReader(body, maxErrorBodySize))
+	return strings.TrimSpace(string(message))
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+const testQueryResponse = `{
+  "status": "success",
+  "data": {
+    "resultType": "streams",
+    "result": [
+      {
+        "stream": {"component_name": "comp-1", "pod": "pod-a"},
+        "values": [["1704067200000000000", "first"], ["1704067202000000000", "third"]]
+      },
+      {
+        "stream": {"component_name": "comp-1", "pod": "pod-b"},
+        "values": [["1704067201000000000", "second"]]
+      }
+    ],
+    "stats": {"summary": {"execTime": 0.0125}}
+  }
+}`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, cfg config.LokiConfig) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg.Address = server.URL
+	cfg.Timeout = 5 * time.Second
+	return NewClient(&cfg, slog.Default())
+}
+
+func TestClient_QueryRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	var req *http.Request
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		_, _ = w.Write([]byte(testQueryResponse))
+	}, config.LokiConfig{Username: "user", Password: "pass", TenantID: "tenant-1"})
+
+	response, err := client.QueryRange(context.Background(), `{component_name="comp-1"}`, start, end, 50, DirectionForward)
+	if err != nil {
+		t.Fatalf("QueryRange() error = %v", err)
+	}
+
+	if req.URL.Path != "/loki/api/v1/query_range" {
+		t.Errorf("Expected query_range path, got %s", req.URL.Path)
+	}
+	query := req.URL.Query()
+	expected := map[string]string{
+		"query":     `{component_name="comp-1"}`,
+		"start":     "1704067200000000000",
+		"end":       "1704070800000000000",
+		"limit":     "50",
+		"direction": DirectionForward,
+	}
+	for key, want := range expected {
+		if got := query.Get(key); got != want {
+			t.Errorf("Expected %s parameter %q, got %q", key, want, got)
+		}
+	}
+	if got := req.Header.Get("X-Scope-OrgID"); got != "tenant-1" {
+		t.Errorf("Expected tenant header tenant-1, got %q", got)
+	}
+	if username, password, ok := req.BasicAuth(); !ok || username != "user" || password != "pass" {
+		t.Errorf("Expected basic auth user:pass, got %s:%s", username, password)
+	}
+
+	entries := response.Entries(DirectionForward)
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+	for i, line := range []string{"first", "second", "third"} {
+		if entries[i].Line != line {
+			t.Errorf("Expected entry %d to be %q, got %q", i, line, entries[i].Line)
+		}
+	}
+	if entries[1].Labels["pod"] != "pod-b" {
+		t.Errorf("Expected the labels of the stream of the entry, got %v", entries[1].Labels)
+	}
+	if !entries[0].Timestamp.Equal(start) {
+		t.Errorf("Expected timestamp %s, got %s", start, entries[0].Timestamp)
+	}
+	if backward := response.Entries(DirectionBackward); backward[0].Line != "third" {
+		t.Errorf("Expected the newest entry first, got %q", backward[0].Line)
+	}
+	if response.ExecTimeMillis() != 12 {
+		t.Errorf("Expected exec time 12ms, got %d", response.ExecTimeMillis())
+	}
+}
+
+func TestClient_QueryRangeError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Scope-OrgID") != "" {
+			t.Errorf("Expected no tenant header without a tenant ID")
+		}
+		http.Error(w, "parse error: unexpected IDENTIFIER", http.StatusBadRequest)
+	}, config.LokiConfig{})
+
+	_, err := client.QueryRange(context.Background(), "{", time.Time{}, time.Time{}, 0, "")
+	if err == nil {
+		t.Fatal("Expected an error for a failed query")
+	}
+	if !strings.Contains(err.Error(), "400") || !strings.Contains(err.Error(), "parse error") {
+		t.Errorf("Expected the status and message of the failed query, got %v", err)
+	}
+}
+
+func TestClient_Ready(t *testing.T) {
+	ready := true
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ready" {
+			t.Errorf("Expected /ready path, got %s", r.URL.Path)
+		}
+		if !ready {
+			http.Error(w, "Ingester not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ready"))
+	}, config.LokiConfig{})
+
+	if err := client.Ready(context.Background()); err != nil {
+		t.Errorf("Ready() error = %v", err)
+	}
+	ready = false
+	if err := client.Ready(context.Background()); err == nil {
+		t.Error("Expected an error when Loki is not ready")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// invalidLabelChars matches the characters that are not allowed in Loki label names
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// LabelName returns the Loki label name of a Kubernetes label key. Log shippers replace the characters that are
+// not allowed in label names with underscores, e.g. component-name becomes component_name.
+func LabelName(key string) string {
+	name := invalidLabelChars.ReplaceAllString(key, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// Query builds a LogQL log query from a stream selector and a pipeline of line and label filters
+type Query struct {
+	matchers []string
+	pipeline []string
+}
+
+// NewQuery creates an empty query
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// WithLabel selects the streams whose label has the given value
+func (q *Query) WithLabel(name, value string) *Query {
+	q.matchers = append(q.matchers, fmt.Sprintf("%s=%s", LabelName(name), strconv.Quote(value)))
+	return q
+}
+
+// WithLabelIn selects the streams whose label has one of the given values
+func (q *Query) WithLabelIn(name string, values []string) *Query {
+	if len(values) > 0 {
+		q.matchers = append(q.matchers, fmt.Sprintf("%s=~%s", LabelName(name), strconv.Quote(anyOf(values))))
+	}
+	return q
+}
+
+// Contains keeps the log lines containing the text
+func (q *Query) Contains(text string) *Query {
+	if text != "" {
+		q.pipeline = append(q.pipeline, "|= "+strconv.Quote(text))
+	}
+	return q
+}
+
+// ContainsAny keeps the log lines containing one of the texts
+func (q *Query) ContainsAny(texts []string) *Query {
+	if len(texts) > 0 {
+		q.pipeline = append(q.pipeline, "|~ "+strconv.Quote(anyOf(texts)))
+	}
+	return q
+}
+
+// ContainsAnyFold keeps the log lines containing one of the texts, ignoring case
+func (q *Query) ContainsAnyFold(texts []string) *Query {
+	if len(texts) > 0 {
+		q.pipeline = append(q.pipeline, "|~ "+strconv.Quote("(?i)"+anyOf(texts)))
+	}
+	return q
+}
+
+// WithAnyLabelIn keeps the log lines whose labels have one of the given values for at least one of the labels
+func (q *Query) WithAnyLabelIn(labels map[string][]string) *Query {
+	var filters []string
+	// Sort the labels for the query to be deterministic
+	for _, name := range slices.Sorted(maps.Keys(labels)) {
+		if values := labels[name]; len(values) > 0 {
+			filters = append(filters, fmt.Sprintf("%s=~%s", LabelName(name), strconv.Quote(anyOf(values))))
+		}
+	}
+	if len(filters) > 0 {
+		q.pipeline = append(q.pipeline, "| "+strings.Join(filters, " or "))
+	}
+	return q
+}
+
+// String returns the LogQL expression of the query
+func (q *Query) String() string {
+	expr := "{" + strings.Join(q.matchers, ", ") + "}"
+	if len(q.pipeline) > 0 {
+		expr += " " + strings.Join(q.pipeline, " ")
+	}
+	return expr
+}
+
+// anyOf returns a regular expression matching any of the texts
+func anyOf(texts []string) string {
+	quoted := make([]string, 0, len(texts))
+	for _, text := range texts {
+		quoted = append(quoted, regexp.QuoteMeta(text))
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return "(" + strings.Join(quoted, "|") + ")"
+}
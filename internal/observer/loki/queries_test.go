@@ -0,0 +1,75 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"testing"
+)
+
+func TestLabelName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "component-name", want: "component_name"},
+		{key: "version_id", want: "version_id"},
+		{key: "app.kubernetes.io/name", want: "app_kubernetes_io_name"},
+		{key: "1st-label", want: "_1st_label"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := LabelName(tt.key); got != tt.want {
+				t.Errorf("LabelName(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name:  "label matchers",
+			query: NewQuery().WithLabel("component-name", "comp-1").WithLabel("environment-name", "dev"),
+			want:  `{component_name="comp-1", environment_name="dev"}`,
+		},
+		{
+			name:  "label in values",
+			query: NewQuery().WithLabel("project-name", "proj").WithLabelIn("component-name", []string{"a", "b.c"}),
+			want:  `{project_name="proj", component_name=~"(a|b\\.c)"}`,
+		},
+		{
+			name:  "empty filters are skipped",
+			query: NewQuery().WithLabel("target", "gateway").WithLabelIn("component-name", nil).Contains("").ContainsAny(nil),
+			want:  `{target="gateway"}`,
+		},
+		{
+			name: "line filters",
+			query: NewQuery().WithLabel("target", "gateway").
+				Contains(`"apiPath":"/org`).
+				ContainsAny([]string{"x", "y"}).
+				ContainsAnyFold([]string{"ERROR"}),
+			want: `{target="gateway"} |= "\"apiPath\":\"/org" |~ "(x|y)" |~ "(?i)ERROR"`,
+		},
+		{
+			name: "any label in values",
+			query: NewQuery().WithLabel("component-name", "comp-1").WithAnyLabelIn(map[string][]string{
+				"version_id": {"id-1"},
+				"version":    {"v1", "v2"},
+				"unused":     nil,
+			}),
+			want: `{component_name="comp-1"} | version=~"(v1|v2)" or version_id=~"id-1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Errorf("Query.String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
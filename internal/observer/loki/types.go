@@ -0,0 +1,76 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Directions in which Loki returns the log lines of a query
+const (
+	DirectionBackward = "backward"
+	DirectionForward  = "forward"
+)
+
+// QueryResponse represents the response of a Loki range query of log lines
+type QueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string   `json:"resultType"`
+		Result     []Stream `json:"result"`
+		Stats      struct {
+			Summary struct {
+				// ExecTime is the time spent executing the query in seconds
+				ExecTime float64 `json:"execTime"`
+			} `json:"summary"`
+		} `json:"stats"`
+	} `json:"data"`
+}
+
+// Stream represents the log lines of a stream, the lines sharing the same labels
+type Stream struct {
+	Labels map[string]string `json:"stream"`
+	// Values are the timestamps in nanoseconds since the epoch and the log lines
+	Values [][2]string `json:"values"`
+}
+
+// Entry represents a log line along with the labels of its stream
+type Entry struct {
+	Timestamp time.Time
+	Line      string
+	Labels    map[string]string
+}
+
+// Entries returns the log lines of all streams of the response ordered by their timestamps,
+// from the newest to the oldest if the direction is backward
+func (r *QueryResponse) Entries(direction string) []Entry {
+	var entries []Entry
+	for _, stream := range r.Data.Result {
+		for _, value := range stream.Values {
+			nanos, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			entries = append(entries, Entry{
+				Timestamp: time.Unix(0, nanos).UTC(),
+				Line:      value[1],
+				Labels:    stream.Labels,
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if direction == DirectionForward {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	return entries
+}
+
+// ExecTimeMillis returns the time Loki spent executing the query in milliseconds
+func (r *QueryResponse) ExecTimeMillis() int {
+	return int(r.Data.Stats.Summary.ExecTime * 1000)
+}
@@ -5,15 +5,17 @@ package opensearch
 
 import (
 	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 // ParseSpanEntry converts a search hit of a span to a SpanEntry struct
-func ParseSpanEntry(hit Hit) Span {
+func ParseSpanEntry(hit Hit) types.Span {
 	source := hit.Source
 
 	// Handle nil source map
 	if source == nil {
-		return Span{}
+		return types.Span{}
 	}
 
 	// Convert durationInNanos - handle both int64 and float64 cases
@@ -59,7 +61,7 @@ func ParseSpanEntry(hit Hit) Span {
 		return ""
 	}
 
-	entry := Span{
+	entry := types.Span{
 		DurationInNanos: durationInNanos,
 		EndTime:         endTime,
 		Name:            getString("name"),
@@ -6,13 +6,15 @@ package opensearch
 import (
 	"testing"
 	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 func TestParseSpanEntry(t *testing.T) {
 	tests := []struct {
 		name     string
 		hit      Hit
-		expected Span
+		expected types.Span
 	}{
 		{
 			name: "complete span entry",
@@ -26,7 +28,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-10-28T11:13:56.585406208Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "b72e731db5edfd1df2658bd78f751862",
 				SpanID:          "614f55c7ccbfffdc",
 				Name:            "database-query",
@@ -47,7 +49,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-10-28T11:13:56.785508125Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace123",
 				SpanID:          "span456",
 				Name:            "api-call",
@@ -68,7 +70,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-10-28T12:00:00.15Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace789",
 				SpanID:          "span012",
 				Name:            "processing",
@@ -87,7 +89,7 @@ func TestParseSpanEntry(t *testing.T) {
 					// Missing durationInNanos, startTime, endTime
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-minimal",
 				SpanID:          "span-minimal",
 				Name:            "minimal-span",
@@ -108,7 +110,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         nil,
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-null",
 				SpanID:          "span-null",
 				Name:            "null-span",
@@ -129,7 +131,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-13-45T25:70:70Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-invalid-time",
 				SpanID:          "span-invalid-time",
 				Name:            "invalid-time-span",
@@ -150,7 +152,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         true,
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-non-string-time",
 				SpanID:          "span-non-string-time",
 				Name:            "non-string-time-span",
@@ -171,7 +173,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-10-28T15:00:00Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-zero",
 				SpanID:          "span-zero",
 				Name:            "zero-duration-span",
@@ -192,7 +194,7 @@ func TestParseSpanEntry(t *testing.T) {
 					"endTime":         "2025-10-28T16:00:09.223372036Z",
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "trace-large",
 				SpanID:          "span-large",
 				Name:            "large-duration-span",
@@ -240,7 +242,7 @@ func TestParseSpanEntry_SafeHandling(t *testing.T) {
 	safeTests := []struct {
 		name     string
 		hit      Hit
-		expected Span
+		expected types.Span
 	}{
 		{
 			name: "missing required string fields",
@@ -250,7 +252,7 @@ func TestParseSpanEntry_SafeHandling(t *testing.T) {
 					"durationInNanos": int64(100000),
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "",
 				SpanID:          "",
 				Name:            "",
@@ -268,7 +270,7 @@ func TestParseSpanEntry_SafeHandling(t *testing.T) {
 					"name":    true,
 				},
 			},
-			expected: Span{
+			expected: types.Span{
 				TraceID:         "",
 				SpanID:          "",
 				Name:            "",
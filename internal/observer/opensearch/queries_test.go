@@ -7,13 +7,14 @@ import (
 	"testing"
 
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 func TestQueryBuilder_BuildComponentLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := ComponentQueryParams{
-		QueryParams: QueryParams{
+	params := types.ComponentQueryParams{
+		QueryParams: types.QueryParams{
 			StartTime:     "2024-01-01T00:00:00Z",
 			EndTime:       "2024-01-01T23:59:59Z",
 			SearchPhrase:  "error",
@@ -76,7 +77,7 @@ func TestQueryBuilder_BuildComponentLogsQuery(t *testing.T) {
 func TestQueryBuilder_BuildProjectLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := QueryParams{
+	params := types.QueryParams{
 		StartTime:     "2024-01-01T00:00:00Z",
 		EndTime:       "2024-01-01T23:59:59Z",
 		SearchPhrase:  "info",
@@ -115,8 +116,8 @@ func TestQueryBuilder_BuildProjectLogsQuery(t *testing.T) {
 func TestQueryBuilder_BuildGatewayLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := GatewayQueryParams{
-		QueryParams: QueryParams{
+	params := types.GatewayQueryParams{
+		QueryParams: types.QueryParams{
 			StartTime:    "2024-01-01T00:00:00Z",
 			EndTime:      "2024-01-01T23:59:59Z",
 			SearchPhrase: "gateway",
@@ -288,12 +289,12 @@ func TestQueryBuilder_BuildComponentTracesQuery(t *testing.T) {
 
 	tests := []struct {
 		name   string
-		params ComponentTracesRequestParams
+		params types.ComponentTracesRequestParams
 		want   map[string]interface{}
 	}{
 		{
 			name: "Basic component traces query",
-			params: ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "test-service",
 				StartTime:   "2024-01-01T00:00:00Z",
 				EndTime:     "2024-01-01T23:59:59Z",
@@ -338,7 +339,7 @@ func TestQueryBuilder_BuildComponentTracesQuery(t *testing.T) {
 		},
 		{
 			name: "Component traces query with default limit",
-			params: ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "another-service",
 				StartTime:   "2024-02-01T10:00:00Z",
 				EndTime:     "2024-02-01T20:00:00Z",
@@ -383,7 +384,7 @@ func TestQueryBuilder_BuildComponentTracesQuery(t *testing.T) {
 		},
 		{
 			name: "Component traces query with special characters in service name",
-			params: ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "my-service-123_test",
 				StartTime:   "2024-03-15T08:30:00Z",
 				EndTime:     "2024-03-15T18:30:00Z",
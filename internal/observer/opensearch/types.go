@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 // SearchResponse represents the response from an OpenSearch search query
@@ -50,72 +51,6 @@ type FieldMapping struct {
 	Properties map[string]FieldMapping `json:"properties,omitempty"`
 }
 
-// LogEntry represents a parsed log entry from OpenSearch
-type LogEntry struct {
-	Timestamp     time.Time         `json:"timestamp"`
-	Log           string            `json:"log"`
-	LogLevel      string            `json:"logLevel"`
-	ComponentID   string            `json:"componentId"`
-	EnvironmentID string            `json:"environmentId"`
-	ProjectID     string            `json:"projectId"`
-	Version       string            `json:"version"`
-	VersionID     string            `json:"versionId"`
-	Namespace     string            `json:"namespace"`
-	PodID         string            `json:"podId"`
-	ContainerName string            `json:"containerName"`
-	Labels        map[string]string `json:"labels"`
-}
-
-// TraceResponse represents the response structure for trace queries
-type TraceResponse struct {
-	Spans      []Span `json:"spans"`
-	TotalCount int    `json:"totalCount"`
-	Took       int    `json:"tookMs"`
-}
-
-// Span represents a parsed span entry from OpenSearch
-type Span struct {
-	DurationInNanos int64     `json:"durationInNanos"`
-	EndTime         time.Time `json:"endTime"`
-	Name            string    `json:"name"`
-	SpanID          string    `json:"spanId"`
-	StartTime       time.Time `json:"startTime"`
-	TraceID         string    `json:"traceId"`
-}
-
-// QueryParams holds common query parameters
-type QueryParams struct {
-	StartTime      string   `json:"startTime"`
-	EndTime        string   `json:"endTime"`
-	SearchPhrase   string   `json:"searchPhrase"`
-	LogLevels      []string `json:"logLevels"`
-	Limit          int      `json:"limit"`
-	SortOrder      string   `json:"sortOrder"`
-	ComponentID    string   `json:"componentId,omitempty"`
-	EnvironmentID  string   `json:"environmentId,omitempty"`
-	ProjectID      string   `json:"projectId,omitempty"`
-	OrganizationID string   `json:"organizationId,omitempty"`
-	Namespace      string   `json:"namespace,omitempty"`
-	Versions       []string `json:"versions,omitempty"`
-	VersionIDs     []string `json:"versionIds,omitempty"`
-	LogType        string   `json:"logType,omitempty"`
-}
-
-// ComponentQueryParams holds component-specific query parameters
-type ComponentQueryParams struct {
-	QueryParams
-	BuildID   string `json:"buildId,omitempty"`
-	BuildUUID string `json:"buildUuid,omitempty"`
-}
-
-// GatewayQueryParams holds gateway-specific query parameters
-type GatewayQueryParams struct {
-	QueryParams
-	OrganizationID    string            `json:"organizationId"`
-	APIIDToVersionMap map[string]string `json:"apiIdToVersionMap"`
-	GatewayVHosts     []string          `json:"gatewayVHosts"`
-}
-
 // buildSearchBody converts a query map to an io.Reader for the search request
 func buildSearchBody(query map[string]interface{}) io.Reader {
 	body, _ := json.Marshal(query)
@@ -143,9 +78,9 @@ func parseMappingResponse(body io.Reader) (*MappingResponse, error) {
 }
 
 // ParseLogEntry converts a search hit to a LogEntry struct
-func ParseLogEntry(hit Hit) LogEntry {
+func ParseLogEntry(hit Hit) types.LogEntry {
 	source := hit.Source
-	entry := LogEntry{
+	entry := types.LogEntry{
 		Labels: make(map[string]string),
 	}
 
@@ -159,7 +94,7 @@ func ParseLogEntry(hit Hit) LogEntry {
 	// Parse log content
 	if log, ok := source["log"].(string); ok {
 		entry.Log = log
-		entry.LogLevel = extractLogLevel(log)
+		entry.LogLevel = types.ExtractLogLevel(log)
 	}
 
 	// Parse Kubernetes metadata
@@ -196,43 +131,3 @@ func getStringValue(m map[string]interface{}, key string) string {
 	}
 	return ""
 }
-
-// extractLogLevel extracts log level from log content using common patterns
-func extractLogLevel(log string) string {
-	log = strings.ToUpper(log)
-
-	logLevels := []string{"ERROR", "FATAL", "SEVERE", "WARN", "WARNING", "INFO", "DEBUG", "UNDEFINED"}
-
-	for _, level := range logLevels {
-		if strings.Contains(log, level) {
-			// Normalize WARN/WARNING to WARN
-			if level == "WARNING" {
-				return "WARN"
-			}
-			return level
-		}
-	}
-
-	return "UNDEFINED" // Default to INFO if no level found
-}
-
-// ExtractLogType determines the log type from query parameters or defaults to RUNTIME
-func ExtractLogType(logType string) string {
-	switch strings.ToUpper(logType) {
-	case labels.QueryParamLogTypeBuild:
-		return labels.QueryParamLogTypeBuild
-	case labels.QueryParamLogTypeRuntime:
-		return labels.QueryParamLogTypeRuntime
-	default:
-		return labels.QueryParamLogTypeRuntime // Default to RUNTIME if no valid type specified
-	}
-}
-
-// ComponentTracesRequestParams holds request body parameters for component traces
-type ComponentTracesRequestParams struct {
-	EndTime     string `json:"endTime"`
-	Limit       int    `json:"limit,omitempty"`
-	ServiceName string `json:"serviceName"`
-	SortOrder   string `json:"sortOrder,omitempty"`
-	StartTime   string `json:"startTime"`
-}
@@ -0,0 +1,122 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+// maxErrorBodySize is the maximum size of an error response body included in errors
+const maxErrorBodySize = 4096
+
+// Client queries a Prometheus-compatible metrics backend over its HTTP API
+type Client struct {
+	address    string
+	config     *config.PrometheusConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a new Prometheus client with the provided configuration
+func NewClient(cfg *config.PrometheusConfig, logger *slog.Logger) *Client {
+	return &Client{
+		address:    strings.TrimSuffix(cfg.Address, "/"),
+		config:     cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		logger:     logger,
+	}
+}
+
+// Query evaluates a PromQL expression at a point in time
+func (c *Client) Query(ctx context.Context, query string, ts time.Time) (*QueryResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("time", formatTime(ts))
+	return c.query(ctx, "/api/v1/query", params, "vector")
+}
+
+// QueryRange evaluates a PromQL expression over a time range with the given resolution step
+func (c *Client) QueryRange(
+	ctx context.Context, query string, start, end time.Time, step time.Duration,
+) (*QueryResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", formatTime(start))
+	params.Set("end", formatTime(end))
+	params.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+	return c.query(ctx, "/api/v1/query_range", params, "matrix")
+}
+
+// query posts the form of a query to an API path, checking the type of its result
+func (c *Client) query(ctx context.Context, path string, params url.Values, resultType string) (*QueryResponse, error) {
+	c.logger.Debug("Executing query", "path", path, "query", params.Get("query"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.address+path, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Query request failed", "error", err)
+		return nil, fmt.Errorf("query request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query response: %w", err)
+	}
+
+	var response QueryResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("query request failed with status: %s: %s", res.Status, errorMessage(body))
+		}
+		c.logger.Error("Failed to parse query response", "error", err)
+		return nil, fmt.Errorf("failed to parse query response: %w", err)
+	}
+	// Prometheus returns the errors of queries in the body of the error responses
+	if res.StatusCode != http.StatusOK || response.Status != "success" {
+		c.logger.Error("Query request returned error",
+			"status", res.Status,
+			"errorType", response.ErrorType,
+			"error", response.Error)
+		return nil, fmt.Errorf("query request failed with status: %s: %s: %s", res.Status, response.ErrorType, response.Error)
+	}
+	if response.Data.ResultType != resultType {
+		return nil, fmt.Errorf("unexpected query result type: %s", response.Data.ResultType)
+	}
+
+	c.logger.Debug("Query completed", "series", len(response.Data.Result))
+	return &response, nil
+}
+
+// formatTime formats a time as the Unix time in seconds accepted by the query APIs
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+}
+
+// errorMessage returns the error message of a failed request from its body
+func errorMessage(body []byte) string {
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, cfg config.PrometheusConfig) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg.Address = server.URL
+	cfg.Timeout = 5 * time.Second
+	return NewClient(&cfg, slog.Default())
+}
+
+func TestClient_QueryRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var form map[string][]string
+	var username, password string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/query_range" {
+			t.Errorf("Expected POST /api/v1/query_range, got %s %s", r.Method, r.URL.Path)
+		}
+		_ = r.ParseForm()
+		form = r.PostForm
+		username, password, _ = r.BasicAuth()
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[` +
+			`{"metric":{},"values":[[1704067200,"0.5"],[1704067230,"NaN"],[1704067260,"1.25"]]}]}}`))
+	}, config.PrometheusConfig{Username: "user", Password: "pass"})
+
+	response, err := client.QueryRange(context.Background(), "up", start, start.Add(time.Minute), 30*time.Second)
+	if err != nil {
+		t.Fatalf("QueryRange() error = %v", err)
+	}
+
+	expected := map[string]string{"query": "up", "start": "1704067200", "end": "1704067260", "step": "30"}
+	for key, want := range expected {
+		if got := form[key]; len(got) != 1 || got[0] != want {
+			t.Errorf("Expected %s parameter %q, got %v", key, want, got)
+		}
+	}
+	if username != "user" || password != "pass" {
+		t.Errorf("Expected basic auth user:pass, got %s:%s", username, password)
+	}
+
+	samples := response.Samples()
+	if len(samples) != 2 {
+		t.Fatalf("Expected the NaN sample to be left out, got %v", samples)
+	}
+	if !samples[1].Timestamp.Equal(start.Add(time.Minute)) || samples[1].Value != 1.25 {
+		t.Errorf("Expected sample 1.25 at %s, got %+v", start.Add(time.Minute), samples[1])
+	}
+}
+
+func TestClient_Query(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{},"value":[1704067200.5,"42"]}]}}`))
+	}, config.PrometheusConfig{})
+
+	response, err := client.Query(context.Background(), "sum(up)", time.Now())
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if response.Scalar() != 42 {
+		t.Errorf("Expected scalar 42, got %v", response.Scalar())
+	}
+	if (&QueryResponse{}).Scalar() != 0 {
+		t.Error("Expected the scalar of an empty result to be zero")
+	}
+}
+
+func TestClient_QueryError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error at char 4"}`))
+	}, config.PrometheusConfig{})
+
+	_, err := client.Query(context.Background(), "sum(", time.Now())
+	if err == nil {
+		t.Fatal("Expected an error for a failed query")
+	}
+	if !strings.Contains(err.Error(), "bad_data") || !strings.Contains(err.Error(), "parse error") {
+		t.Errorf("Expected the error of the failed query, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+)
+
+// Metrics the component metrics are computed from. The container metrics are those of cAdvisor and the pod metrics
+// those of kube-state-metrics. The HTTP metrics are the server request duration histogram of the OpenTelemetry
+// semantic conventions, scraped with the namespace and pod labels of the pods serving the requests.
+const (
+	cpuUsageMetric          = "container_cpu_usage_seconds_total"
+	memoryUsageMetric       = "container_memory_working_set_bytes"
+	resourceRequestsMetric  = "kube_pod_container_resource_requests"
+	resourceLimitsMetric    = "kube_pod_container_resource_limits"
+	podReadyMetric          = "kube_pod_status_ready"
+	podLabelsMetric         = "kube_pod_labels"
+	httpRequestCountMetric  = "http_server_request_duration_seconds_count"
+	httpRequestBucketMetric = "http_server_request_duration_seconds_bucket"
+	httpStatusCodeLabel     = "http_response_status_code"
+)
+
+// Resources of the resource requests and limits of containers
+const (
+	ResourceCPU    = "cpu"
+	ResourceMemory = "memory"
+)
+
+// invalidLabelChars matches the characters that are not allowed in Prometheus label names
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// PodLabelName returns the name of the kube_pod_labels label of a Kubernetes label key, e.g. label_component_name
+// for component-name
+func PodLabelName(key string) string {
+	return "label_" + invalidLabelChars.ReplaceAllString(key, "_")
+}
+
+// QueryBuilder builds the PromQL queries of the metrics of the pods of a component
+type QueryBuilder struct {
+	// pods is the vector of the pods of the component, joined with the metrics to keep the series of its pods
+	pods string
+}
+
+// NewQueryBuilder creates a query builder for the pods of the component of the parameters
+func NewQueryBuilder(params ComponentMetricsParams) *QueryBuilder {
+	matchers := []string{
+		matcher(PodLabelName(labels.ComponentID), params.ComponentID),
+		matcher(PodLabelName(labels.EnvironmentID), params.EnvironmentID),
+	}
+	if params.ProjectID != "" {
+		matchers = append(matchers, matcher(PodLabelName(labels.ProjectID), params.ProjectID))
+	}
+	if params.Namespace != "" {
+		matchers = append(matchers, matcher("namespace", params.Namespace))
+	}
+	// Sort the labels for the queries to be deterministic
+	for _, key := range slices.Sorted(maps.Keys(params.PodLabels)) {
+		matchers = append(matchers, matcher(PodLabelName(key), params.PodLabels[key]))
+	}
+	return &QueryBuilder{
+		pods: fmt.Sprintf("max by (namespace, pod) (%s{%s})", podLabelsMetric, strings.Join(matchers, ", ")),
+	}
+}
+
+// CPUUsage returns the query of the CPU cores used by the containers of the pods
+func (qb *QueryBuilder) CPUUsage(window time.Duration) string {
+	return qb.sum(fmt.Sprintf(`rate(%s{container!="", container!="POD"}[%s])`, cpuUsageMetric, duration(window)))
+}
+
+// MemoryUsage returns the query of the working set bytes of the containers of the pods
+func (qb *QueryBuilder) MemoryUsage() string {
+	return qb.sum(fmt.Sprintf(`%s{container!="", container!="POD"}`, memoryUsageMetric))
+}
+
+// ResourceRequests returns the query of the total requests of a resource of the containers of the pods
+func (qb *QueryBuilder) ResourceRequests(resource string) string {
+	return qb.sum(fmt.Sprintf("%s{%s}", resourceRequestsMetric, matcher("resource", resource)))
+}
+
+// ResourceLimits returns the query of the total limits of a resource of the containers of the pods
+func (qb *QueryBuilder) ResourceLimits(resource string) string {
+	return qb.sum(fmt.Sprintf("%s{%s}", resourceLimitsMetric, matcher("resource", resource)))
+}
+
+// ReadyReplicas returns the query of the number of ready pods
+func (qb *QueryBuilder) ReadyReplicas() string {
+	return qb.sum(fmt.Sprintf(`%s{condition="true"}`, podReadyMetric))
+}
+
+// HTTPRequestRate returns the query of the requests per second served by the pods
+func (qb *QueryBuilder) HTTPRequestRate(window time.Duration) string {
+	return qb.sum(fmt.Sprintf("rate(%s[%s])", httpRequestCountMetric, duration(window)))
+}
+
+// HTTPRequestCount returns the query of the number of requests served by the pods in a window
+func (qb *QueryBuilder) HTTPRequestCount(window time.Duration) string {
+	return qb.sum(fmt.Sprintf("increase(%s[%s])", httpRequestCountMetric, duration(window)))
+}
+
+// HTTPErrorRatePercent returns the query of the percentage of requests that failed with a server error in a window
+func (qb *QueryBuilder) HTTPErrorRatePercent(window time.Duration) string {
+	errors := qb.sum(fmt.Sprintf(`increase(%s{%s=~"5.."}[%s])`, httpRequestCountMetric, httpStatusCodeLabel, duration(window)))
+	return fmt.Sprintf("100 * (%s or vector(0)) / %s", errors, qb.HTTPRequestCount(window))
+}
+
+// HTTPLatencyQuantile returns the query of a quantile of the request durations in a window, in milliseconds
+func (qb *QueryBuilder) HTTPLatencyQuantile(quantile float64, window time.Duration) string {
+	buckets := fmt.Sprintf("rate(%s[%s]) * on (namespace, pod) group_left() %s", httpRequestBucketMetric, duration(window), qb.pods)
+	return fmt.Sprintf("1000 * histogram_quantile(%s, sum by (le) (%s))", strconv.FormatFloat(quantile, 'f', -1, 64), buckets)
+}
+
+// sum returns the query of the sum of the series of a vector of the pods
+func (qb *QueryBuilder) sum(vector string) string {
+	return fmt.Sprintf("sum(%s * on (namespace, pod) group_left() %s)", vector, qb.pods)
+}
+
+// matcher returns a label matcher for the exact value
+func matcher(name, value string) string {
+	return name + "=" + strconv.Quote(value)
+}
+
+// duration formats a duration as a PromQL duration in seconds
+func duration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
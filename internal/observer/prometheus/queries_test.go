@@ -0,0 +1,77 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPodLabelName(t *testing.T) {
+	if got := PodLabelName("component-name"); got != "label_component_name" {
+		t.Errorf("PodLabelName() = %q, want label_component_name", got)
+	}
+	if got := PodLabelName("app.kubernetes.io/name"); got != "label_app_kubernetes_io_name" {
+		t.Errorf("PodLabelName() = %q, want label_app_kubernetes_io_name", got)
+	}
+}
+
+func TestQueryBuilder(t *testing.T) {
+	qb := NewQueryBuilder(ComponentMetricsParams{
+		ComponentID:   "comp-1",
+		EnvironmentID: "dev",
+		ProjectID:     "proj-1",
+		Namespace:     "dp-org-proj-dev",
+		PodLabels:     map[string]string{"version": "v2", "app": "web"},
+	})
+	pods := `max by (namespace, pod) (kube_pod_labels{label_component_name="comp-1", label_environment_name="dev", ` +
+		`label_project_name="proj-1", namespace="dp-org-proj-dev", label_app="web", label_version="v2"})`
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "cpu usage",
+			query: qb.CPUUsage(2 * time.Minute),
+			want:  `sum(rate(container_cpu_usage_seconds_total{container!="", container!="POD"}[120s]) * on (namespace, pod) group_left() ` + pods + `)`,
+		},
+		{
+			name:  "memory usage",
+			query: qb.MemoryUsage(),
+			want:  `sum(container_memory_working_set_bytes{container!="", container!="POD"} * on (namespace, pod) group_left() ` + pods + `)`,
+		},
+		{
+			name:  "memory limits",
+			query: qb.ResourceLimits(ResourceMemory),
+			want:  `sum(kube_pod_container_resource_limits{resource="memory"} * on (namespace, pod) group_left() ` + pods + `)`,
+		},
+		{
+			name:  "ready replicas",
+			query: qb.ReadyReplicas(),
+			want:  `sum(kube_pod_status_ready{condition="true"} * on (namespace, pod) group_left() ` + pods + `)`,
+		},
+		{
+			name:  "error rate",
+			query: qb.HTTPErrorRatePercent(time.Hour),
+			want: `100 * (sum(increase(http_server_request_duration_seconds_count{http_response_status_code=~"5.."}[3600s]) ` +
+				`* on (namespace, pod) group_left() ` + pods + `) or vector(0)) / ` +
+				`sum(increase(http_server_request_duration_seconds_count[3600s]) * on (namespace, pod) group_left() ` + pods + `)`,
+		},
+		{
+			name:  "latency quantile",
+			query: qb.HTTPLatencyQuantile(0.99, time.Minute),
+			want: `1000 * histogram_quantile(0.99, sum by (le) (rate(http_server_request_duration_seconds_bucket[60s]) ` +
+				`* on (namespace, pod) group_left() ` + pods + `))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.query != tt.want {
+				t.Errorf("Expected query\n%s\ngot\n%s", tt.want, tt.query)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ComponentMetricsParams holds the parameters of a component metrics query
+type ComponentMetricsParams struct {
+	ComponentID   string
+	EnvironmentID string
+	ProjectID     string
+	Namespace     string
+	PodLabels     map[string]string
+	StartTime     time.Time
+	EndTime       time.Time
+	Step          time.Duration
+}
+
+// QueryResponse represents the response of the Prometheus query APIs
+type QueryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Data      struct {
+		ResultType string   `json:"resultType"`
+		Result     []Series `json:"result"`
+	} `json:"data"`
+}
+
+// Series is a time series of a query result. Instant queries return a single value, range queries a list of values.
+type Series struct {
+	Metric map[string]string `json:"metric"`
+	Value  *Sample           `json:"value,omitempty"`
+	Values []Sample          `json:"values,omitempty"`
+}
+
+// Sample is a value of a time series at a point in time
+type Sample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// UnmarshalJSON parses a sample from the [<unix time>, "<value>"] form returned by Prometheus
+func (s *Sample) UnmarshalJSON(data []byte) error {
+	var pair [2]json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return fmt.Errorf("invalid sample: %w", err)
+	}
+	var seconds float64
+	if err := json.Unmarshal(pair[0], &seconds); err != nil {
+		return fmt.Errorf("invalid sample time: %w", err)
+	}
+	var value string
+	if err := json.Unmarshal(pair[1], &value); err != nil {
+		return fmt.Errorf("invalid sample value: %w", err)
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sample value: %w", err)
+	}
+	s.Timestamp = time.UnixMilli(int64(math.Round(seconds * 1000))).UTC()
+	s.Value = parsed
+	return nil
+}
+
+// Samples returns the values of the first series of a range query. The queries of the observer aggregate their
+// series, so their results have at most one series. Values that are not numbers, e.g. the quantiles of empty
+// histograms, are left out.
+func (r *QueryResponse) Samples() []Sample {
+	samples := []Sample{}
+	if len(r.Data.Result) == 0 {
+		return samples
+	}
+	for _, sample := range r.Data.Result[0].Values {
+		if isFinite(sample.Value) {
+			samples = append(samples, sample)
+		}
+	}
+	return samples
+}
+
+// Scalar returns the value of the first series of an instant query, which is zero if there is no value
+func (r *QueryResponse) Scalar() float64 {
+	if len(r.Data.Result) == 0 || r.Data.Result[0].Value == nil || !isFinite(r.Data.Result[0].Value.Value) {
+		return 0
+	}
+	return r.Data.Result[0].Value.Value
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// defaultFollowPollInterval is the poll interval of followed logs when none is configured
+	defaultFollowPollInterval = 2 * time.Second
+	// followPageLimit is the maximum number of entries read from the log store by a query of a poll
+	followPageLimit = 1000
+	// followMaxFailures is the number of consecutive failed polls after which following stops
+	followMaxFailures = 3
+)
+
+// logQuery reads the logs of the followed query in the time range of the given parameters
+type logQuery func(ctx context.Context, params types.QueryParams) (*LogResponse, error)
+
+// LogSink receives the entries of followed logs, and the gaps where entries were skipped
+type LogSink struct {
+	Entry func(types.LogEntry) error
+	Gap   func(types.LogGap) error
+}
+
+// FollowComponentLogs follows the logs of a component from the start time of the parameters, sending the new
+// entries in the order of their time until the context is done or sending fails. Entries indexed late are sent
+// once found, after newer entries.
+func (s *LoggingService) FollowComponentLogs(
+	ctx context.Context, params types.ComponentQueryParams, sink LogSink,
+) error {
+	s.logger.Info("Following component logs",
+		"component_id", params.ComponentID,
+		"environment_id", params.EnvironmentID,
+		"start_time", params.StartTime)
+
+	return s.followLogs(ctx, params.QueryParams, func(ctx context.Context, query types.QueryParams) (*LogResponse, error) {
+		componentParams := params
+		componentParams.QueryParams = query
+		return s.logStore.GetComponentLogs(ctx, componentParams)
+	}, sink)
+}
+
+// FollowProjectLogs follows the logs of a project, optionally of some of its components
+func (s *LoggingService) FollowProjectLogs(
+	ctx context.Context, params types.QueryParams, componentIDs []string, sink LogSink,
+) error {
+	s.logger.Info("Following project logs",
+		"project_id", params.ProjectID,
+		"environment_id", params.EnvironmentID,
+		"start_time", params.StartTime)
+
+	return s.followLogs(ctx, params, func(ctx context.Context, query types.QueryParams) (*LogResponse, error) {
+		return s.logStore.GetProjectLogs(ctx, query, componentIDs)
+	}, sink)
+}
+
+// FollowGatewayLogs follows the gateway logs of the APIs of an organization
+func (s *LoggingService) FollowGatewayLogs(
+	ctx context.Context, params types.GatewayQueryParams, sink LogSink,
+) error {
+	s.logger.Info("Following gateway logs",
+		"organization_id", params.OrganizationID,
+		"start_time", params.StartTime)
+
+	return s.followLogs(ctx, params.QueryParams, func(ctx context.Context, query types.QueryParams) (*LogResponse, error) {
+		gatewayParams := params
+		gatewayParams.QueryParams = query
+		return s.logStore.GetGatewayLogs(ctx, gatewayParams)
+	}, sink)
+}
+
+// followLogs polls a log query for the entries after a cursor, which starts at the start time of the parameters,
+// or at the current time if there is none
+func (s *LoggingService) followLogs(
+	ctx context.Context, params types.QueryParams, query logQuery, sink LogSink,
+) error {
+	start := time.Now()
+	if params.StartTime != "" {
+		parsed, err := time.Parse(time.RFC3339, params.StartTime)
+		if err != nil {
+			return fmt.Errorf("invalid start time format: %w", err)
+		}
+		start = parsed
+	}
+	cursor := newLogCursor(start, s.config.Logging.FollowOverlap)
+
+	interval := s.config.Logging.FollowPollInterval
+	if interval <= 0 {
+		interval = defaultFollowPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		if err := s.pollLogs(ctx, params, query, cursor, sink); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var sendErr sendError
+			if errors.As(err, &sendErr) {
+				return sendErr.err
+			}
+			failures++
+			s.logger.Warn("Failed to poll followed logs", "error", err, "failures", failures)
+			if failures >= followMaxFailures {
+				return fmt.Errorf("failed to poll logs: %w", err)
+			}
+		} else {
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollLogs sends the entries of the log query from the overlap of the cursor that were not sent yet, reading them
+// in pages of followPageLimit entries
+func (s *LoggingService) pollLogs(
+	ctx context.Context, params types.QueryParams, query logQuery, cursor *logCursor, sink LogSink,
+) error {
+	params.EndTime = time.Now().UTC().Format(time.RFC3339Nano)
+	params.SortOrder = "asc"
+	params.Limit = followPageLimit
+
+	from := cursor.from()
+	for {
+		params.StartTime = from.UTC().Format(time.RFC3339Nano)
+		result, err := query(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		next := from
+		for _, entry := range result.Logs {
+			if entry.Timestamp.After(next) {
+				next = entry.Timestamp
+			}
+			if !cursor.add(entry) {
+				continue
+			}
+			if err := sink.Entry(entry); err != nil {
+				return sendError{err: err}
+			}
+		}
+
+		// A full page may be followed by more entries, read from the time of its last entry
+		if len(result.Logs) < followPageLimit {
+			break
+		}
+		// A full page of entries at a single time can't be paged past, so the entries at that time beyond the
+		// page are skipped, and the stream is told about them once
+		if !next.After(from) {
+			if cursor.addGap(from) {
+				s.logger.Warn("Skipping log entries sharing a time beyond a page", "time", from, "page_limit", followPageLimit)
+				gap := types.LogGap{
+					Timestamp: from,
+					Message:   fmt.Sprintf("log entries beyond the first %d at this time were skipped", followPageLimit),
+				}
+				if err := sink.Gap(gap); err != nil {
+					return sendError{err: err}
+				}
+			}
+			next = from.Add(time.Nanosecond)
+		}
+		from = next
+	}
+
+	cursor.prune()
+	return nil
+}
+
+// sendError is the error of sending an entry, which stops following the logs
+type sendError struct {
+	err error
+}
+
+func (e sendError) Error() string {
+	return e.err.Error()
+}
+
+// logCursor tracks the position of a followed log query: the time of the newest entry sent, with the entries
+// sent and the gaps reported in the overlap before it, which the next poll reads again to find the entries
+// indexed late
+type logCursor struct {
+	start   time.Time
+	time    time.Time
+	overlap time.Duration
+	// seen holds the times of the entries sent in the overlap, by their keys
+	seen map[string]time.Time
+	// gaps holds the times of the gaps reported in the overlap
+	gaps map[time.Time]bool
+}
+
+func newLogCursor(start time.Time, overlap time.Duration) *logCursor {
+	return &logCursor{start: start, time: start, overlap: overlap, seen: make(map[string]time.Time), gaps: make(map[time.Time]bool)}
+}
+
+// from returns the time the next poll reads from: the start of the overlap, but not before the start time
+func (c *logCursor) from() time.Time {
+	from := c.time.Add(-c.overlap)
+	if from.Before(c.start) {
+		return c.start
+	}
+	return from
+}
+
+// add records an entry, returning false if it was already sent
+func (c *logCursor) add(entry types.LogEntry) bool {
+	key := logEntryKey(entry)
+	if _, ok := c.seen[key]; ok || entry.Timestamp.Before(c.start) {
+		return false
+	}
+	c.seen[key] = entry.Timestamp
+	if entry.Timestamp.After(c.time) {
+		c.time = entry.Timestamp
+	}
+	return true
+}
+
+// addGap records a gap at a time, returning false if it was already reported
+func (c *logCursor) addGap(at time.Time) bool {
+	at = at.UTC()
+	if c.gaps[at] {
+		return false
+	}
+	c.gaps[at] = true
+	return true
+}
+
+// prune forgets the entries and the gaps before the overlap, which are no longer read
+func (c *logCursor) prune() {
+	from := c.from()
+	for key, at := range c.seen {
+		if at.Before(from) {
+			delete(c.seen, key)
+		}
+	}
+	for at := range c.gaps {
+		if at.Before(from) {
+			delete(c.gaps, at)
+		}
+	}
+}
+
+// logEntryKey identifies a log entry by its time, container and line
+func logEntryKey(entry types.LogEntry) string {
+	return strconv.FormatInt(entry.Timestamp.UnixNano(), 10) + "/" + entry.PodID + "/" + entry.ContainerName + "/" + entry.Log
+}
@@ -0,0 +1,323 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// memoryLogStore is a log store of the entries added to it, which returns the entries in the time range of a query
+type memoryLogStore struct {
+	mu      sync.Mutex
+	entries []types.LogEntry
+	err     error
+	queries int
+}
+
+var _ LogStore = (*memoryLogStore)(nil)
+
+func (m *memoryLogStore) add(entries ...types.LogEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, entries...)
+}
+
+func (m *memoryLogStore) query(params types.QueryParams) (*LogResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queries++
+	if m.err != nil {
+		return nil, m.err
+	}
+	start, _ := time.Parse(time.RFC3339, params.StartTime)
+	end, _ := time.Parse(time.RFC3339, params.EndTime)
+	logs := []types.LogEntry{}
+	for _, entry := range m.entries {
+		if !entry.Timestamp.Before(start) && !entry.Timestamp.After(end) {
+			logs = append(logs, entry)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].Timestamp.Before(logs[j].Timestamp) })
+	if len(logs) > params.Limit {
+		logs = logs[:params.Limit]
+	}
+	return &LogResponse{Logs: logs, TotalCount: len(logs)}, nil
+}
+
+func (m *memoryLogStore) GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*LogResponse, error) {
+	return m.query(params.QueryParams)
+}
+
+func (m *memoryLogStore) GetProjectLogs(ctx context.Context, params types.QueryParams, componentIDs []string) (*LogResponse, error) {
+	return m.query(params)
+}
+
+func (m *memoryLogStore) GetGatewayLogs(ctx context.Context, params types.GatewayQueryParams) (*LogResponse, error) {
+	return m.query(params.QueryParams)
+}
+
+func (m *memoryLogStore) GetOrganizationLogs(ctx context.Context, params types.QueryParams, podLabels map[string]string) (*LogResponse, error) {
+	return m.query(params)
+}
+
+func (m *memoryLogStore) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func newFollowTestService(store LogStore) *LoggingService {
+	cfg := &config.Config{Logging: config.LoggingConfig{FollowPollInterval: 5 * time.Millisecond}}
+	return NewLoggingService(store, nil, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func logEntryAt(timestamp time.Time, log string) types.LogEntry {
+	return types.LogEntry{Timestamp: timestamp, Log: log, PodID: "pod-1", ContainerName: "main"}
+}
+
+// followEntries follows the component logs of the store, returning channels of the entries and the gaps sent and
+// of the error following returns
+func followEntries(ctx context.Context, service *LoggingService, start time.Time) (<-chan types.LogEntry, <-chan types.LogGap, <-chan error) {
+	entries := make(chan types.LogEntry, 2000)
+	gaps := make(chan types.LogGap, 10)
+	done := make(chan error, 1)
+	go func() {
+		params := types.ComponentQueryParams{QueryParams: types.QueryParams{StartTime: start.Format(time.RFC3339)}}
+		done <- service.FollowComponentLogs(ctx, params, LogSink{
+			Entry: func(entry types.LogEntry) error {
+				entries <- entry
+				return nil
+			},
+			Gap: func(gap types.LogGap) error {
+				gaps <- gap
+				return nil
+			},
+		})
+	}()
+	return entries, gaps, done
+}
+
+// waitForQueries waits until the store was queried more than the given number of times
+func waitForQueries(store *memoryLogStore, count int) {
+	for {
+		store.mu.Lock()
+		queries := store.queries
+		store.mu.Unlock()
+		if queries > count {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func receiveLogs(t *testing.T, entries <-chan types.LogEntry, count int) []string {
+	t.Helper()
+	var logs []string
+	for len(logs) < count {
+		select {
+		case entry := <-entries:
+			logs = append(logs, entry.Log)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for log entries, got %v", logs)
+		}
+	}
+	return logs
+}
+
+func TestFollowLogs(t *testing.T) {
+	start := time.Now().Add(-time.Minute).Truncate(time.Second)
+	store := &memoryLogStore{}
+	store.add(
+		logEntryAt(start.Add(-time.Second), "before start"),
+		logEntryAt(start.Add(2*time.Second), "second"),
+		logEntryAt(start.Add(time.Second), "first"),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, _, done := followEntries(ctx, newFollowTestService(store), start)
+
+	if logs := receiveLogs(t, entries, 2); logs[0] != "first" || logs[1] != "second" {
+		t.Errorf("Expected the entries after the start in order, got %v", logs)
+	}
+
+	// New entries, including one at the time of the newest entry sent, are sent once
+	store.add(
+		logEntryAt(start.Add(2*time.Second), "second again"),
+		logEntryAt(start.Add(3*time.Second), "third"),
+	)
+	if logs := receiveLogs(t, entries, 2); logs[0] != "second again" || logs[1] != "third" {
+		t.Errorf("Expected the new entries, got %v", logs)
+	}
+
+	// Wait for more polls, which must not send the entries again
+	waitForQueries(store, 10)
+	select {
+	case entry := <-entries:
+		t.Errorf("Expected no duplicate entries, got %q", entry.Log)
+	default:
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected following to stop without error, got %v", err)
+	}
+}
+
+func TestFollowLogs_Pages(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	store := &memoryLogStore{}
+	count := followPageLimit*2 + 10
+	for i := 0; i < count; i++ {
+		store.add(logEntryAt(start.Add(time.Duration(i)*time.Millisecond), fmt.Sprintf("line %d", i)))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, _, _ := followEntries(ctx, newFollowTestService(store), start)
+
+	logs := receiveLogs(t, entries, count)
+	for i, log := range logs {
+		if log != fmt.Sprintf("line %d", i) {
+			t.Fatalf("Expected entry %d to be line %d, got %q", i, i, log)
+		}
+	}
+}
+
+func TestFollowLogs_Burst(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	store := &memoryLogStore{}
+	store.add(logEntryAt(start, "first"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, _, _ := followEntries(ctx, newFollowTestService(store), start)
+	receiveLogs(t, entries, 1)
+
+	// A burst of more than a page of entries within a second after catching up
+	count := followPageLimit*3 + 10
+	var burst []types.LogEntry
+	for i := 0; i < count; i++ {
+		burst = append(burst, logEntryAt(start.Add(time.Duration(i+1)*100*time.Microsecond), fmt.Sprintf("line %d", i)))
+	}
+	store.add(burst...)
+
+	logs := receiveLogs(t, entries, count)
+	for i, log := range logs {
+		if log != fmt.Sprintf("line %d", i) {
+			t.Fatalf("Expected entry %d to be line %d, got %q", i, i, log)
+		}
+	}
+}
+
+func TestFollowLogs_SameTimeBeyondPage(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	store := &memoryLogStore{}
+	for i := 0; i < followPageLimit+5; i++ {
+		store.add(logEntryAt(start, fmt.Sprintf("same %d", i)))
+	}
+	store.add(logEntryAt(start.Add(time.Second), "next"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, gaps, _ := followEntries(ctx, newFollowTestService(store), start)
+
+	// Following moves on past the entries that don't fit in a page, reporting them as a gap once
+	logs := receiveLogs(t, entries, followPageLimit+1)
+	if logs[followPageLimit] != "next" {
+		t.Errorf("Expected the entry after the full page, got %q", logs[followPageLimit])
+	}
+	select {
+	case gap := <-gaps:
+		if !gap.Timestamp.Equal(start) || gap.Message == "" {
+			t.Errorf("Expected a gap at the start, got %+v", gap)
+		}
+	default:
+		t.Fatal("Expected a gap for the skipped entries")
+	}
+	waitForQueries(store, 10)
+	select {
+	case gap := <-gaps:
+		t.Errorf("Expected the gap to be reported once, got another at %v", gap.Timestamp)
+	default:
+	}
+}
+
+func TestFollowLogs_LateEntries(t *testing.T) {
+	start := time.Now().Add(-time.Minute).Truncate(time.Second)
+	store := &memoryLogStore{}
+	store.add(logEntryAt(start.Add(2*time.Second), "newest"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := newFollowTestService(store)
+	service.config.Logging.FollowOverlap = 10 * time.Second
+	entries, _, _ := followEntries(ctx, service, start)
+	receiveLogs(t, entries, 1)
+
+	// An entry indexed after a newer one was sent is sent within the overlap, and only once
+	store.add(
+		logEntryAt(start.Add(time.Second), "indexed late"),
+		logEntryAt(start.Add(-time.Second), "before start"),
+	)
+	if logs := receiveLogs(t, entries, 1); logs[0] != "indexed late" {
+		t.Errorf("Expected the entry indexed late, got %v", logs)
+	}
+	waitForQueries(store, 10)
+	select {
+	case entry := <-entries:
+		t.Errorf("Expected no more entries, got %q", entry.Log)
+	default:
+	}
+}
+
+func TestFollowLogs_Errors(t *testing.T) {
+	t.Run("store failures", func(t *testing.T) {
+		store := &memoryLogStore{err: errors.New("store unavailable")}
+		_, _, done := followEntries(context.Background(), newFollowTestService(store), time.Now())
+
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Fatal("Expected an error after consecutive failed polls")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for following to fail")
+		}
+		if store.queries != followMaxFailures {
+			t.Errorf("Expected %d polls, got %d", followMaxFailures, store.queries)
+		}
+	})
+
+	t.Run("send failure", func(t *testing.T) {
+		start := time.Now().Add(-time.Minute)
+		store := &memoryLogStore{}
+		store.add(logEntryAt(start.Add(time.Second), "first"))
+
+		sendErr := errors.New("client disconnected")
+		params := types.QueryParams{StartTime: start.Format(time.RFC3339)}
+		err := newFollowTestService(store).FollowProjectLogs(context.Background(), params, nil, LogSink{
+			Entry: func(types.LogEntry) error { return sendErr },
+		})
+		if !errors.Is(err, sendErr) {
+			t.Errorf("Expected the send error, got %v", err)
+		}
+	})
+
+	t.Run("invalid start time", func(t *testing.T) {
+		params := types.QueryParams{StartTime: "yesterday"}
+		err := newFollowTestService(&memoryLogStore{}).FollowProjectLogs(context.Background(), params, nil, LogSink{})
+		if err == nil {
+			t.Error("Expected an error for an invalid start time")
+		}
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/loki"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// Loki labels of the Kubernetes metadata of the log streams
+const (
+	lokiNamespaceLabel = "namespace"
+	lokiPodLabel       = "pod"
+	lokiContainerLabel = "container"
+)
+
+// LokiClient interface for testing
+type LokiClient interface {
+	QueryRange(ctx context.Context, query string, start, end time.Time, limit int, direction string) (*loki.QueryResponse, error)
+	Ready(ctx context.Context) error
+}
+
+// LokiStore reads logs from Grafana Loki with LogQL queries. The Kubernetes labels of the logs are expected as
+// stream labels, with the names log shippers give them, e.g. component_name for component-name.
+type LokiStore struct {
+	client LokiClient
+	logger *slog.Logger
+}
+
+var _ LogStore = (*LokiStore)(nil)
+
+// NewLokiStore creates a store reading logs with the given Loki client
+func NewLokiStore(client LokiClient, logger *slog.Logger) *LokiStore {
+	return &LokiStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetComponentLogs retrieves the runtime logs of a component in an environment, or the logs of its builds
+func (s *LokiStore) GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*LogResponse, error) {
+	query := loki.NewQuery().WithLabel(labels.ComponentID, params.ComponentID)
+	if params.LogType == labels.QueryParamLogTypeBuild {
+		query.WithLabel(labels.Target, labels.TargetBuild)
+		if params.BuildID != "" {
+			query.WithLabel(labels.BuildID, params.BuildID)
+		}
+		if params.BuildUUID != "" {
+			query.WithLabel(labels.BuildUUID, params.BuildUUID)
+		}
+	} else {
+		query.WithLabel(labels.EnvironmentID, params.EnvironmentID)
+	}
+	if params.Namespace != "" {
+		query.WithLabel(lokiNamespaceLabel, params.Namespace)
+	}
+	query.Contains(params.SearchPhrase).
+		ContainsAnyFold(params.LogLevels).
+		WithAnyLabelIn(map[string][]string{
+			labels.Version:   params.Versions,
+			labels.VersionID: params.VersionIDs,
+		})
+
+	return s.queryLogs(ctx, "component", query, params.QueryParams)
+}
+
+// GetProjectLogs retrieves the logs of a project in an environment, optionally of some of its components
+func (s *LokiStore) GetProjectLogs(ctx context.Context, params types.QueryParams, componentIDs []string) (*LogResponse, error) {
+	query := loki.NewQuery().
+		WithLabel(labels.ProjectID, params.ProjectID).
+		WithLabel(labels.EnvironmentID, params.EnvironmentID).
+		WithLabelIn(labels.ComponentID, componentIDs).
+		Contains(params.SearchPhrase).
+		ContainsAnyFold(params.LogLevels)
+
+	return s.queryLogs(ctx, "project", query, params)
+}
+
+// GetGatewayLogs retrieves the gateway logs of the APIs of an organization
+func (s *LokiStore) GetGatewayLogs(ctx context.Context, params types.GatewayQueryParams) (*LogResponse, error) {
+	query := loki.NewQuery().
+		WithLabel(labels.Target, labels.TargetGateway).
+		Contains(params.SearchPhrase)
+	if params.OrganizationID != "" {
+		query.Contains(fmt.Sprintf("\"apiPath\":\"/%s", params.OrganizationID))
+	}
+
+	vhosts := make([]string, 0, len(params.GatewayVHosts))
+	for _, vhost := range params.GatewayVHosts {
+		vhosts = append(vhosts, fmt.Sprintf("\"gwHost\":%q", vhost))
+	}
+	query.ContainsAny(vhosts)
+
+	apiIDs := make([]string, 0, len(params.APIIDToVersionMap))
+	for apiID := range params.APIIDToVersionMap {
+		apiIDs = append(apiIDs, fmt.Sprintf("\"apiUuid\":%q", apiID))
+	}
+	query.ContainsAny(apiIDs)
+
+	return s.queryLogs(ctx, "gateway", query, params.QueryParams)
+}
+
+// GetOrganizationLogs retrieves the logs of an organization, filtered by the labels of their pods
+func (s *LokiStore) GetOrganizationLogs(ctx context.Context, params types.QueryParams, podLabels map[string]string) (*LogResponse, error) {
+	query := loki.NewQuery().WithLabel(labels.OrganizationUUID, params.OrganizationID)
+	if params.EnvironmentID != "" {
+		query.WithLabel(labels.EnvironmentID, params.EnvironmentID)
+	}
+	if params.Namespace != "" {
+		query.WithLabel(lokiNamespaceLabel, params.Namespace)
+	}
+	for key, value := range podLabels {
+		query.WithLabel(key, value)
+	}
+	query.Contains(params.SearchPhrase).ContainsAnyFold(params.LogLevels)
+
+	return s.queryLogs(ctx, "organization", query, params)
+}
+
+// HealthCheck checks that Loki is ready to serve queries
+func (s *LokiStore) HealthCheck(ctx context.Context) error {
+	if err := s.client.Ready(ctx); err != nil {
+		return fmt.Errorf("loki health check failed: %w", err)
+	}
+	return nil
+}
+
+// queryLogs runs a log query over the time range of the parameters and parses its log lines
+func (s *LokiStore) queryLogs(ctx context.Context, kind string, query *loki.Query, params types.QueryParams) (*LogResponse, error) {
+	start, err := parseQueryTime(params.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time format: %w", err)
+	}
+	end, err := parseQueryTime(params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time format: %w", err)
+	}
+	direction := loki.DirectionBackward
+	if strings.EqualFold(params.SortOrder, "asc") {
+		direction = loki.DirectionForward
+	}
+
+	response, err := s.client.QueryRange(ctx, query.String(), start, end, params.Limit, direction)
+	if err != nil {
+		s.logger.Error("Failed to execute "+kind+" logs query", "error", err)
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	entries := response.Entries(direction)
+	if params.Limit > 0 && len(entries) > params.Limit {
+		entries = entries[:params.Limit]
+	}
+	logs := make([]types.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		logs = append(logs, parseLokiEntry(entry))
+	}
+
+	s.logger.Info(strings.ToUpper(kind[:1])+kind[1:]+" logs retrieved", "count", len(logs))
+
+	// Loki doesn't count the lines matching a query, so the total is the number of lines returned
+	return &LogResponse{
+		Logs:       logs,
+		TotalCount: len(logs),
+		Took:       response.ExecTimeMillis(),
+	}, nil
+}
+
+// parseLokiEntry converts a Loki log line to a LogEntry struct
+func parseLokiEntry(entry loki.Entry) types.LogEntry {
+	label := func(key string) string {
+		return entry.Labels[loki.LabelName(key)]
+	}
+	logEntry := types.LogEntry{
+		Timestamp:     entry.Timestamp,
+		Log:           entry.Line,
+		LogLevel:      types.ExtractLogLevel(entry.Line),
+		ComponentID:   label(labels.ComponentID),
+		EnvironmentID: label(labels.EnvironmentID),
+		ProjectID:     label(labels.ProjectID),
+		Version:       label(labels.Version),
+		VersionID:     label(labels.VersionID),
+		Namespace:     entry.Labels[lokiNamespaceLabel],
+		PodID:         entry.Labels[lokiPodLabel],
+		ContainerName: entry.Labels[lokiContainerLabel],
+		Labels:        make(map[string]string, len(entry.Labels)),
+	}
+	for k, v := range entry.Labels {
+		logEntry.Labels[k] = v
+	}
+	return logEntry
+}
+
+// parseQueryTime parses an RFC 3339 time of a query, which is zero if it is empty
+func parseQueryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/loki"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const testLokiResponse = `{
+  "status": "success",
+  "data": {
+    "resultType": "streams",
+    "result": [
+      {
+        "stream": {
+          "component_name": "comp-1",
+          "environment_name": "dev",
+          "project_name": "proj-1",
+          "version": "v1",
+          "version_id": "version-1",
+          "namespace": "dp-org-proj-dev",
+          "pod": "comp-1-abc",
+          "container": "main"
+        },
+        "values": [
+          ["1704067200000000000", "INFO starting server"],
+          ["1704067260000000000", "ERROR connection refused"]
+        ]
+      }
+    ],
+    "stats": {"summary": {"execTime": 0.004}}
+  }
+}`
+
+// newTestLokiStore creates a store querying a local Loki stand-in, which records the query parameters it receives
+func newTestLokiStore(t *testing.T) (*LokiStore, *[]map[string][]string) {
+	t.Helper()
+	var queries []map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loki/api/v1/query_range":
+			queries = append(queries, r.URL.Query())
+			_, _ = w.Write([]byte(testLokiResponse))
+		case "/ready":
+			_, _ = w.Write([]byte("ready"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := loki.NewClient(&config.LokiConfig{Address: server.URL, Timeout: 5 * time.Second}, logger)
+	return NewLokiStore(client, logger), &queries
+}
+
+func TestLokiStore_Queries(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     func(ctx context.Context, store *LokiStore) (*LogResponse, error)
+		wantQuery string
+	}{
+		{
+			name: "component runtime logs",
+			query: func(ctx context.Context, store *LokiStore) (*LogResponse, error) {
+				return store.GetComponentLogs(ctx, types.ComponentQueryParams{
+					QueryParams: types.QueryParams{
+						StartTime:     "2024-01-01T00:00:00Z",
+						EndTime:       "2024-01-01T01:00:00Z",
+						ComponentID:   "comp-1",
+						EnvironmentID: "dev",
+						Namespace:     "dp-org-proj-dev",
+						SearchPhrase:  "refused",
+						LogLevels:     []string{"ERROR"},
+						Versions:      []string{"v1"},
+						Limit:         100,
+						LogType:       labels.QueryParamLogTypeRuntime,
+					},
+				})
+			},
+			wantQuery: `{component_name="comp-1", environment_name="dev", namespace="dp-org-proj-dev"} ` +
+				`|= "refused" |~ "(?i)ERROR" | version=~"v1"`,
+		},
+		{
+			name: "component build logs",
+			query: func(ctx context.Context, store *LokiStore) (*LogResponse, error) {
+				return store.GetComponentLogs(ctx, types.ComponentQueryParams{
+					QueryParams: types.QueryParams{
+						ComponentID: "comp-1",
+						Limit:       100,
+						LogType:     labels.QueryParamLogTypeBuild,
+					},
+					BuildID:   "build-1",
+					BuildUUID: "uuid-1",
+				})
+			},
+			wantQuery: `{component_name="comp-1", target="build", build_name="build-1", uuid="uuid-1"}`,
+		},
+		{
+			name: "project logs",
+			query: func(ctx context.Context, store *LokiStore) (*LogResponse, error) {
+				return store.GetProjectLogs(ctx, types.QueryParams{
+					ProjectID:     "proj-1",
+					EnvironmentID: "dev",
+					Limit:         100,
+				}, []string{"comp-1", "comp-2"})
+			},
+			wantQuery: `{project_name="proj-1", environment_name="dev", component_name=~"(comp-1|comp-2)"}`,
+		},
+		{
+			name: "gateway logs",
+			query: func(ctx context.Context, store *LokiStore) (*LogResponse, error) {
+				return store.GetGatewayLogs(ctx, types.GatewayQueryParams{
+					QueryParams:       types.QueryParams{Limit: 100},
+					OrganizationID:    "org-1",
+					GatewayVHosts:     []string{"gateway.dev"},
+					APIIDToVersionMap: map[string]string{"api-1": "v1"},
+				})
+			},
+			wantQuery: `{target="gateway"} |= "\"apiPath\":\"/org-1" |~ "\"gwHost\":\"gateway\\.dev\"" |~ "\"apiUuid\":\"api-1\""`,
+		},
+		{
+			name: "organization logs",
+			query: func(ctx context.Context, store *LokiStore) (*LogResponse, error) {
+				return store.GetOrganizationLogs(ctx, types.QueryParams{
+					OrganizationID: "org-1",
+					EnvironmentID:  "dev",
+					Limit:          100,
+				}, map[string]string{"app": "web"})
+			},
+			wantQuery: `{organization_name="org-1", environment_name="dev", app="web"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, queries := newTestLokiStore(t)
+
+			response, err := tt.query(context.Background(), store)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(*queries) != 1 {
+				t.Fatalf("Expected one Loki query, got %d", len(*queries))
+			}
+			if got := (*queries)[0]["query"][0]; got != tt.wantQuery {
+				t.Errorf("Expected query\n%s\ngot\n%s", tt.wantQuery, got)
+			}
+			if got := (*queries)[0]["direction"][0]; got != loki.DirectionBackward {
+				t.Errorf("Expected backward direction by default, got %s", got)
+			}
+			if response.TotalCount != 2 {
+				t.Errorf("Expected 2 logs, got %d", response.TotalCount)
+			}
+		})
+	}
+}
+
+func TestLokiStore_LogEntries(t *testing.T) {
+	store, queries := newTestLokiStore(t)
+
+	response, err := store.GetComponentLogs(context.Background(), types.ComponentQueryParams{
+		QueryParams: types.QueryParams{
+			StartTime:     "2024-01-01T00:00:00Z",
+			EndTime:       "2024-01-01T01:00:00Z",
+			ComponentID:   "comp-1",
+			EnvironmentID: "dev",
+			Limit:         1,
+			SortOrder:     "asc",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	query := (*queries)[0]
+	if query["start"][0] != "1704067200000000000" || query["end"][0] != "1704070800000000000" {
+		t.Errorf("Expected the time range in nanoseconds, got %s to %s", query["start"][0], query["end"][0])
+	}
+	if query["direction"][0] != loki.DirectionForward {
+		t.Errorf("Expected forward direction for ascending order, got %s", query["direction"][0])
+	}
+
+	if len(response.Logs) != 1 {
+		t.Fatalf("Expected the logs to be limited to 1, got %d", len(response.Logs))
+	}
+	entry := response.Logs[0]
+	expected := types.LogEntry{
+		Timestamp:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Log:           "INFO starting server",
+		LogLevel:      "INFO",
+		ComponentID:   "comp-1",
+		EnvironmentID: "dev",
+		ProjectID:     "proj-1",
+		Version:       "v1",
+		VersionID:     "version-1",
+		Namespace:     "dp-org-proj-dev",
+		PodID:         "comp-1-abc",
+		ContainerName: "main",
+	}
+	if entry.Labels["pod"] != "comp-1-abc" {
+		t.Errorf("Expected the stream labels of the entry, got %v", entry.Labels)
+	}
+	entry.Labels = nil
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("Expected log entry %+v, got %+v", expected, entry)
+	}
+	if response.Took != 4 {
+		t.Errorf("Expected took 4ms, got %d", response.Took)
+	}
+}
+
+func TestLokiStore_Errors(t *testing.T) {
+	store, _ := newTestLokiStore(t)
+
+	_, err := store.GetProjectLogs(context.Background(), types.QueryParams{StartTime: "yesterday"}, nil)
+	if err == nil {
+		t.Error("Expected an error for an invalid start time")
+	}
+	if err := store.HealthCheck(context.Background()); err != nil {
+		t.Errorf("Expected Loki to be healthy, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
+)
+
+// minRateWindow is the minimum window of the rates of counters, which spans at least two scrapes of the metrics
+const minRateWindow = time.Minute
+
+// PrometheusClient interface for testing
+type PrometheusClient interface {
+	Query(ctx context.Context, query string, ts time.Time) (*prometheus.QueryResponse, error)
+	QueryRange(ctx context.Context, query string, start, end time.Time, step time.Duration) (*prometheus.QueryResponse, error)
+}
+
+// MetricsService provides the metrics of components from a Prometheus-compatible backend
+type MetricsService struct {
+	client PrometheusClient
+	logger *slog.Logger
+}
+
+// ComponentMetricsResponse represents the response structure for component metrics queries
+type ComponentMetricsResponse struct {
+	CPU      ResourceMetrics     `json:"cpu"`
+	Memory   ResourceMetrics     `json:"memory"`
+	Replicas []prometheus.Sample `json:"replicas"`
+	HTTP     HTTPMetrics         `json:"http"`
+}
+
+// ResourceMetrics holds the usage of a resource by the containers of a component, with their requests and limits.
+// CPU is in cores and memory in bytes.
+type ResourceMetrics struct {
+	Usage    []prometheus.Sample `json:"usage"`
+	Requests []prometheus.Sample `json:"requests"`
+	Limits   []prometheus.Sample `json:"limits"`
+}
+
+// HTTPMetrics holds the HTTP request metrics of a component. The series are sampled at each step of the time range,
+// and the totals are computed over the whole range.
+type HTTPMetrics struct {
+	RequestRate      []prometheus.Sample `json:"requestRate"`
+	ErrorRate        []prometheus.Sample `json:"errorRatePercentSeries"`
+	LatencyP50       []prometheus.Sample `json:"latencyP50MsSeries"`
+	LatencyP95       []prometheus.Sample `json:"latencyP95MsSeries"`
+	LatencyP99       []prometheus.Sample `json:"latencyP99MsSeries"`
+	RequestCount     float64             `json:"requestCount"`
+	ErrorRatePercent float64             `json:"errorRatePercent"`
+	LatencyP50Ms     float64             `json:"latencyP50Ms"`
+	LatencyP95Ms     float64             `json:"latencyP95Ms"`
+	LatencyP99Ms     float64             `json:"latencyP99Ms"`
+}
+
+// NewMetricsService creates a new metrics service instance querying the given client
+func NewMetricsService(client PrometheusClient, logger *slog.Logger) *MetricsService {
+	return &MetricsService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetComponentMetrics retrieves the resource usage, replicas and HTTP metrics of a component in an environment
+func (s *MetricsService) GetComponentMetrics(
+	ctx context.Context, params prometheus.ComponentMetricsParams,
+) (*ComponentMetricsResponse, error) {
+	s.logger.Info("Getting component metrics",
+		"component_id", params.ComponentID,
+		"environment_id", params.EnvironmentID,
+		"start_time", params.StartTime,
+		"end_time", params.EndTime,
+		"step", params.Step)
+
+	qb := prometheus.NewQueryBuilder(params)
+	rateWindow := max(params.Step, minRateWindow)
+	rangeWindow := max(params.EndTime.Sub(params.StartTime), minRateWindow)
+
+	response := &ComponentMetricsResponse{}
+	series := []struct {
+		query  string
+		target *[]prometheus.Sample
+	}{
+		{qb.CPUUsage(rateWindow), &response.CPU.Usage},
+		{qb.ResourceRequests(prometheus.ResourceCPU), &response.CPU.Requests},
+		{qb.ResourceLimits(prometheus.ResourceCPU), &response.CPU.Limits},
+		{qb.MemoryUsage(), &response.Memory.Usage},
+		{qb.ResourceRequests(prometheus.ResourceMemory), &response.Memory.Requests},
+		{qb.ResourceLimits(prometheus.ResourceMemory), &response.Memory.Limits},
+		{qb.ReadyReplicas(), &response.Replicas},
+		{qb.HTTPRequestRate(rateWindow), &response.HTTP.RequestRate},
+		{qb.HTTPErrorRatePercent(rateWindow), &response.HTTP.ErrorRate},
+		{qb.HTTPLatencyQuantile(0.5, rateWindow), &response.HTTP.LatencyP50},
+		{qb.HTTPLatencyQuantile(0.95, rateWindow), &response.HTTP.LatencyP95},
+		{qb.HTTPLatencyQuantile(0.99, rateWindow), &response.HTTP.LatencyP99},
+	}
+	for _, sr := range series {
+		result, err := s.client.QueryRange(ctx, sr.query, params.StartTime, params.EndTime, params.Step)
+		if err != nil {
+			s.logger.Error("Failed to execute metrics range query", "query", sr.query, "error", err)
+			return nil, fmt.Errorf("failed to execute range query: %w", err)
+		}
+		*sr.target = result.Samples()
+	}
+
+	totals := []struct {
+		query  string
+		target *float64
+	}{
+		{qb.HTTPRequestCount(rangeWindow), &response.HTTP.RequestCount},
+		{qb.HTTPErrorRatePercent(rangeWindow), &response.HTTP.ErrorRatePercent},
+		{qb.HTTPLatencyQuantile(0.5, rangeWindow), &response.HTTP.LatencyP50Ms},
+		{qb.HTTPLatencyQuantile(0.95, rangeWindow), &response.HTTP.LatencyP95Ms},
+		{qb.HTTPLatencyQuantile(0.99, rangeWindow), &response.HTTP.LatencyP99Ms},
+	}
+	for _, total := range totals {
+		result, err := s.client.Query(ctx, total.query, params.EndTime)
+		if err != nil {
+			s.logger.Error("Failed to execute metrics query", "query", total.query, "error", err)
+			return nil, fmt.Errorf("failed to execute query: %w", err)
+		}
+		*total.target = result.Scalar()
+	}
+
+	s.logger.Info("Component metrics retrieved",
+		"component_id", params.ComponentID,
+		"request_count", response.HTTP.RequestCount)
+
+	return response, nil
+}
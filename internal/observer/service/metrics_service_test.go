@@ -0,0 +1,141 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
+)
+
+// newTestMetricsService creates a service querying a local Prometheus stand-in, which answers each query with the
+// result of the first of the given query fragments it contains
+func newTestMetricsService(t *testing.T, results [][2]string) (*MetricsService, *[]string) {
+	t.Helper()
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		query := r.PostForm.Get("query")
+		queries = append(queries, query)
+
+		value := ""
+		for _, result := range results {
+			if strings.Contains(query, result[0]) {
+				value = result[1]
+				break
+			}
+		}
+		switch r.URL.Path {
+		case "/api/v1/query_range":
+			result := ""
+			if value != "" {
+				result = `{"metric":{},"values":[[1704067200,"` + value + `"],[1704067260,"` + value + `"]]}`
+			}
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[` + result + `]}}`))
+		case "/api/v1/query":
+			result := ""
+			if value != "" {
+				result = `{"metric":{},"value":[1704067260,"` + value + `"]}`
+			}
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[` + result + `]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := prometheus.NewClient(&config.PrometheusConfig{Address: server.URL, Timeout: 5 * time.Second}, logger)
+	return NewMetricsService(client, logger), &queries
+}
+
+func TestMetricsService_GetComponentMetrics(t *testing.T) {
+	service, queries := newTestMetricsService(t, [][2]string{
+		{"increase(http_server_request_duration_seconds_count{", "2.5"},
+		{"histogram_quantile(0.99", "180"},
+		{"increase(http_server_request_duration_seconds_count[", "400"},
+		{"container_cpu_usage_seconds_total", "0.25"},
+		{`resource="memory"`, "268435456"},
+		{"kube_pod_status_ready", "3"},
+	})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	response, err := service.GetComponentMetrics(context.Background(), prometheus.ComponentMetricsParams{
+		ComponentID:   "comp-1",
+		EnvironmentID: "dev",
+		Namespace:     "dp-org-proj-dev",
+		StartTime:     start,
+		EndTime:       start.Add(time.Minute),
+		Step:          30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(*queries) != 17 {
+		t.Errorf("Expected 17 queries, got %d", len(*queries))
+	}
+	for _, query := range *queries {
+		if !strings.Contains(query, `label_component_name="comp-1", label_environment_name="dev", namespace="dp-org-proj-dev"`) {
+			t.Errorf("Expected the query to select the pods of the component, got %s", query)
+		}
+	}
+
+	if len(response.CPU.Usage) != 2 || response.CPU.Usage[0].Value != 0.25 {
+		t.Errorf("Expected the CPU usage series, got %+v", response.CPU.Usage)
+	}
+	if len(response.Memory.Limits) != 2 || response.Memory.Limits[0].Value != 268435456 {
+		t.Errorf("Expected the memory limits series, got %+v", response.Memory.Limits)
+	}
+	if len(response.Replicas) != 2 || response.Replicas[1].Value != 3 {
+		t.Errorf("Expected the replicas series, got %+v", response.Replicas)
+	}
+	if response.CPU.Limits == nil || len(response.CPU.Limits) != 0 {
+		t.Errorf("Expected an empty series for missing CPU limits, got %+v", response.CPU.Limits)
+	}
+
+	// The totals used by the rollout analysis
+	if response.HTTP.RequestCount != 400 {
+		t.Errorf("Expected request count 400, got %v", response.HTTP.RequestCount)
+	}
+	if response.HTTP.ErrorRatePercent != 2.5 {
+		t.Errorf("Expected error rate 2.5%%, got %v", response.HTTP.ErrorRatePercent)
+	}
+	if response.HTTP.LatencyP99Ms != 180 {
+		t.Errorf("Expected p99 latency 180ms, got %v", response.HTTP.LatencyP99Ms)
+	}
+	if response.HTTP.LatencyP50Ms != 0 {
+		t.Errorf("Expected p50 latency 0 without data, got %v", response.HTTP.LatencyP50Ms)
+	}
+}
+
+func TestMetricsService_QueryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"unavailable","error":"no store available"}`))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := prometheus.NewClient(&config.PrometheusConfig{Address: server.URL, Timeout: 5 * time.Second}, logger)
+	start := time.Now().Add(-time.Hour)
+	_, err := NewMetricsService(client, logger).GetComponentMetrics(context.Background(), prometheus.ComponentMetricsParams{
+		ComponentID:   "comp-1",
+		EnvironmentID: "dev",
+		StartTime:     start,
+		EndTime:       start.Add(time.Hour),
+		Step:          time.Minute,
+	})
+	if err == nil {
+		t.Fatal("Expected an error when Prometheus is unavailable")
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// OpenSearchClient interface for testing
+type OpenSearchClient interface {
+	Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error)
+	GetIndexMapping(ctx context.Context, index string) (*opensearch.MappingResponse, error)
+	HealthCheck(ctx context.Context) error
+}
+
+// OpenSearchStore reads logs and traces from OpenSearch, searching the daily indices of the queried time range
+type OpenSearchStore struct {
+	osClient     OpenSearchClient
+	queryBuilder *opensearch.QueryBuilder
+	logger       *slog.Logger
+}
+
+var (
+	_ LogStore   = (*OpenSearchStore)(nil)
+	_ TraceStore = (*OpenSearchStore)(nil)
+)
+
+// NewOpenSearchStore creates a store reading from the indices with the given prefix
+func NewOpenSearchStore(osClient OpenSearchClient, indexPrefix string, logger *slog.Logger) *OpenSearchStore {
+	return &OpenSearchStore{
+		osClient:     osClient,
+		queryBuilder: opensearch.NewQueryBuilder(indexPrefix),
+		logger:       logger,
+	}
+}
+
+// GetComponentLogs retrieves logs for a specific component using V2 wildcard search
+func (s *OpenSearchStore) GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*LogResponse, error) {
+	// Generate indices based on time range
+	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		s.logger.Error("Failed to generate indices", "error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Build query with wildcard search
+	query := s.queryBuilder.BuildComponentLogsQuery(params)
+
+	// Execute search
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		s.logger.Error("Failed to execute component logs search", "error", err)
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	// Parse log entries
+	logs := make([]types.LogEntry, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		entry := opensearch.ParseLogEntry(hit)
+		logs = append(logs, entry)
+	}
+
+	s.logger.Info("Component logs retrieved",
+		"count", len(logs),
+		"total", response.Hits.Total.Value)
+
+	return &LogResponse{
+		Logs:       logs,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
+
+// GetProjectLogs retrieves logs for a specific project using V2 wildcard search
+func (s *OpenSearchStore) GetProjectLogs(ctx context.Context, params types.QueryParams, componentIDs []string) (*LogResponse, error) {
+	// Generate indices based on time range
+	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		s.logger.Error("Failed to generate indices", "error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Build query with wildcard search
+	query := s.queryBuilder.BuildProjectLogsQuery(params, componentIDs)
+
+	// Execute search
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		s.logger.Error("Failed to execute project logs search", "error", err)
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	// Parse log entries
+	logs := make([]types.LogEntry, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		entry := opensearch.ParseLogEntry(hit)
+		logs = append(logs, entry)
+	}
+
+	s.logger.Info("Project logs retrieved",
+		"count", len(logs),
+		"total", response.Hits.Total.Value)
+
+	return &LogResponse{
+		Logs:       logs,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
+
+// GetGatewayLogs retrieves gateway logs using V2 wildcard search
+func (s *OpenSearchStore) GetGatewayLogs(ctx context.Context, params types.GatewayQueryParams) (*LogResponse, error) {
+	// Generate indices based on time range
+	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		s.logger.Error("Failed to generate indices", "error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Build query with wildcard search
+	query := s.queryBuilder.BuildGatewayLogsQuery(params)
+
+	// Execute search
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		s.logger.Error("Failed to execute gateway logs search", "error", err)
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	// Parse log entries
+	logs := make([]types.LogEntry, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		entry := opensearch.ParseLogEntry(hit)
+		logs = append(logs, entry)
+	}
+
+	s.logger.Info("Gateway logs retrieved",
+		"count", len(logs),
+		"total", response.Hits.Total.Value)
+
+	return &LogResponse{
+		Logs:       logs,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
+
+// GetOrganizationLogs retrieves logs for an organization with custom filters
+func (s *OpenSearchStore) GetOrganizationLogs(ctx context.Context, params types.QueryParams, podLabels map[string]string) (*LogResponse, error) {
+	// Generate indices based on time range
+	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		s.logger.Error("Failed to generate indices", "error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Build organization-specific query
+	query := s.queryBuilder.BuildOrganizationLogsQuery(params, podLabels)
+
+	// Execute search
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		s.logger.Error("Failed to execute organization logs search", "error", err)
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	// Parse log entries
+	logs := make([]types.LogEntry, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		entry := opensearch.ParseLogEntry(hit)
+		logs = append(logs, entry)
+	}
+
+	s.logger.Info("Organization logs retrieved",
+		"count", len(logs),
+		"total", response.Hits.Total.Value)
+
+	return &LogResponse{
+		Logs:       logs,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
+
+// GetComponentTraces retrieves the spans of a component from the OpenTelemetry span index
+func (s *OpenSearchStore) GetComponentTraces(ctx context.Context, params types.ComponentTracesRequestParams) (*types.TraceResponse, error) {
+	// Build component traces query
+	query := s.queryBuilder.BuildComponentTracesQuery(params)
+
+	// Execute search
+	response, err := s.osClient.Search(ctx, []string{"otel-v1-apm-span"}, query)
+	if err != nil {
+		s.logger.Error("Failed to execute component traces search", "error", err)
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	// Parse log entries
+	traces := make([]types.Span, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		span := opensearch.ParseSpanEntry(hit)
+		traces = append(traces, span)
+	}
+
+	s.logger.Info("Component traces retrieved",
+		"count", len(traces),
+		"total", response.Hits.Total.Value)
+
+	return &types.TraceResponse{
+		Spans:      traces,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
+
+// HealthCheck checks that OpenSearch is reachable
+func (s *OpenSearchStore) HealthCheck(ctx context.Context) error {
+	if err := s.osClient.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("opensearch health check failed: %w", err)
+	}
+	return nil
+}
@@ -5,256 +5,96 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/config"
-	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
-// OpenSearchClient interface for testing
-type OpenSearchClient interface {
-	Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error)
-	GetIndexMapping(ctx context.Context, index string) (*opensearch.MappingResponse, error)
-	HealthCheck(ctx context.Context) error
-}
-
 // LoggingService provides logging functionality
 type LoggingService struct {
-	osClient     OpenSearchClient
-	queryBuilder *opensearch.QueryBuilder
-	config       *config.Config
-	logger       *slog.Logger
+	logStore   LogStore
+	traceStore TraceStore
+	config     *config.Config
+	logger     *slog.Logger
 }
 
 // LogResponse represents the response structure for log queries
 type LogResponse struct {
-	Logs       []opensearch.LogEntry `json:"logs"`
-	TotalCount int                   `json:"totalCount"`
-	Took       int                   `json:"tookMs"`
+	Logs       []types.LogEntry `json:"logs"`
+	TotalCount int              `json:"totalCount"`
+	Took       int              `json:"tookMs"`
 }
 
-// NewLoggingService creates a new logging service instance
-func NewLoggingService(osClient OpenSearchClient, cfg *config.Config, logger *slog.Logger) *LoggingService {
+// NewLoggingService creates a new logging service instance reading logs and traces from the given stores
+func NewLoggingService(logStore LogStore, traceStore TraceStore, cfg *config.Config, logger *slog.Logger) *LoggingService {
 	return &LoggingService{
-		osClient:     osClient,
-		queryBuilder: opensearch.NewQueryBuilder(cfg.OpenSearch.IndexPrefix),
-		config:       cfg,
-		logger:       logger,
+		logStore:   logStore,
+		traceStore: traceStore,
+		config:     cfg,
+		logger:     logger,
 	}
 }
 
-// GetComponentLogs retrieves logs for a specific component using V2 wildcard search
-func (s *LoggingService) GetComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams) (*LogResponse, error) {
+// GetComponentLogs retrieves logs for a specific component
+func (s *LoggingService) GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*LogResponse, error) {
 	s.logger.Info("Getting component logs",
 		"component_id", params.ComponentID,
 		"environment_id", params.EnvironmentID,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
-	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildComponentLogsQuery(params)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute component logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
-	}
-
-	s.logger.Info("Component logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
-
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return s.logStore.GetComponentLogs(ctx, params)
 }
 
-// GetProjectLogs retrieves logs for a specific project using V2 wildcard search
-func (s *LoggingService) GetProjectLogs(ctx context.Context, params opensearch.QueryParams, componentIDs []string) (*LogResponse, error) {
+// GetProjectLogs retrieves logs for a specific project
+func (s *LoggingService) GetProjectLogs(ctx context.Context, params types.QueryParams, componentIDs []string) (*LogResponse, error) {
 	s.logger.Info("Getting project logs",
 		"project_id", params.ProjectID,
 		"environment_id", params.EnvironmentID,
 		"component_ids", componentIDs,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
-	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildProjectLogsQuery(params, componentIDs)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute project logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
-	}
-
-	s.logger.Info("Project logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
-
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return s.logStore.GetProjectLogs(ctx, params, componentIDs)
 }
 
-// GetGatewayLogs retrieves gateway logs using V2 wildcard search
-func (s *LoggingService) GetGatewayLogs(ctx context.Context, params opensearch.GatewayQueryParams) (*LogResponse, error) {
+// GetGatewayLogs retrieves gateway logs
+func (s *LoggingService) GetGatewayLogs(ctx context.Context, params types.GatewayQueryParams) (*LogResponse, error) {
 	s.logger.Info("Getting gateway logs",
 		"organization_id", params.OrganizationID,
 		"gateway_vhosts", params.GatewayVHosts,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
-	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildGatewayLogsQuery(params)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute gateway logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
-	}
-
-	s.logger.Info("Gateway logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
-
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return s.logStore.GetGatewayLogs(ctx, params)
 }
 
 // GetOrganizationLogs retrieves logs for an organization with custom filters
-func (s *LoggingService) GetOrganizationLogs(ctx context.Context, params opensearch.QueryParams, podLabels map[string]string) (*LogResponse, error) {
+func (s *LoggingService) GetOrganizationLogs(ctx context.Context, params types.QueryParams, podLabels map[string]string) (*LogResponse, error) {
 	s.logger.Info("Getting organization logs",
 		"organization_id", params.OrganizationID,
 		"environment_id", params.EnvironmentID,
 		"pod_labels", podLabels,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
-	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build organization-specific query
-	query := s.queryBuilder.BuildOrganizationLogsQuery(params, podLabels)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute organization logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
-	}
-
-	s.logger.Info("Organization logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
-
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return s.logStore.GetOrganizationLogs(ctx, params, podLabels)
 }
 
-func (s *LoggingService) GetComponentTraces(ctx context.Context, params opensearch.ComponentTracesRequestParams) (*opensearch.TraceResponse, error) {
+// GetComponentTraces retrieves the spans of a component
+func (s *LoggingService) GetComponentTraces(ctx context.Context, params types.ComponentTracesRequestParams) (*types.TraceResponse, error) {
 	s.logger.Info("Getting component traces",
 		"serviceName", params.ServiceName)
 
-	// Build component traces query
-	query := s.queryBuilder.BuildComponentTracesQuery(params)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, []string{"otel-v1-apm-span"}, query)
-	if err != nil {
-		s.logger.Error("Failed to execute component traces search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	traces := make([]opensearch.Span, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		span := opensearch.ParseSpanEntry(hit)
-		traces = append(traces, span)
-	}
-
-	s.logger.Info("Component traces retrieved",
-		"count", len(traces),
-		"total", response.Hits.Total.Value)
-
-	return &opensearch.TraceResponse{
-		Spans:      traces,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return s.traceStore.GetComponentTraces(ctx, params)
 }
 
-// HealthCheck performs a health check on the service
+// HealthCheck performs a health check on the log store of the service
 func (s *LoggingService) HealthCheck(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	if err := s.osClient.HealthCheck(ctx); err != nil {
+	if err := s.logStore.HealthCheck(ctx); err != nil {
 		s.logger.Error("Health check failed", "error", err)
-		return fmt.Errorf("opensearch health check failed: %w", err)
+		return err
 	}
 
 	s.logger.Debug("Health check passed")
@@ -14,6 +14,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
 // MockOpenSearchClient implements a mock OpenSearch client for testing
@@ -53,9 +54,8 @@ func newMockLoggingService() *LoggingService {
 
 	// Create service with a mock client - we'll replace the client in tests
 	return &LoggingService{
-		queryBuilder: opensearch.NewQueryBuilder(cfg.OpenSearch.IndexPrefix),
-		config:       cfg,
-		logger:       logger,
+		config: cfg,
+		logger: logger,
 	}
 }
 
@@ -114,10 +114,10 @@ func TestLoggingService_GetComponentLogs(t *testing.T) {
 	mockClient := &MockOpenSearchClient{
 		searchResponse: mockResponse,
 	}
-	service.osClient = mockClient
+	service.logStore = NewOpenSearchStore(mockClient, service.config.OpenSearch.IndexPrefix, service.logger)
 
-	params := opensearch.ComponentQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := types.ComponentQueryParams{
+		QueryParams: types.QueryParams{
 			StartTime:     "2024-01-01T00:00:00Z",
 			EndTime:       "2024-01-01T23:59:59Z",
 			SearchPhrase:  "error",
@@ -213,9 +213,9 @@ func TestLoggingService_GetProjectLogs(t *testing.T) {
 	mockClient := &MockOpenSearchClient{
 		searchResponse: mockResponse,
 	}
-	service.osClient = mockClient
+	service.logStore = NewOpenSearchStore(mockClient, service.config.OpenSearch.IndexPrefix, service.logger)
 
-	params := opensearch.QueryParams{
+	params := types.QueryParams{
 		StartTime:     "2024-01-01T00:00:00Z",
 		EndTime:       "2024-01-01T23:59:59Z",
 		ProjectID:     "proj-123",
@@ -272,7 +272,7 @@ func TestLoggingService_HealthCheck(t *testing.T) {
 			mockClient := &MockOpenSearchClient{
 				healthError: tt.healthError,
 			}
-			service.osClient = mockClient
+			service.logStore = NewOpenSearchStore(mockClient, service.config.OpenSearch.IndexPrefix, service.logger)
 
 			ctx := context.Background()
 			err := service.HealthCheck(ctx)
@@ -376,15 +376,15 @@ func TestParseLogEntry(t *testing.T) {
 func TestLoggingService_GetComponentTraces(t *testing.T) {
 	tests := []struct {
 		name           string
-		params         opensearch.ComponentTracesRequestParams
+		params         types.ComponentTracesRequestParams
 		mockResponse   *opensearch.SearchResponse
 		mockError      error
-		expectedResult *opensearch.TraceResponse
+		expectedResult *types.TraceResponse
 		expectedError  bool
 	}{
 		{
 			name: "successful trace retrieval",
-			params: opensearch.ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "test-service",
 				StartTime:   "2024-01-01T00:00:00Z",
 				EndTime:     "2024-01-01T23:59:59Z",
@@ -431,8 +431,8 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 				Took:     25,
 				TimedOut: false,
 			},
-			expectedResult: &opensearch.TraceResponse{
-				Spans: []opensearch.Span{
+			expectedResult: &types.TraceResponse{
+				Spans: []types.Span{
 					{
 						TraceID:         "trace-123",
 						SpanID:          "span-456",
@@ -457,7 +457,7 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 		},
 		{
 			name: "empty trace results",
-			params: opensearch.ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "non-existent-service",
 				StartTime:   "2024-01-01T00:00:00Z",
 				EndTime:     "2024-01-01T23:59:59Z",
@@ -483,8 +483,8 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 				Took:     10,
 				TimedOut: false,
 			},
-			expectedResult: &opensearch.TraceResponse{
-				Spans:      []opensearch.Span{},
+			expectedResult: &types.TraceResponse{
+				Spans:      []types.Span{},
 				TotalCount: 0,
 				Took:       10,
 			},
@@ -492,7 +492,7 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 		},
 		{
 			name: "opensearch error",
-			params: opensearch.ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "test-service",
 				StartTime:   "2024-01-01T00:00:00Z",
 				EndTime:     "2024-01-01T23:59:59Z",
@@ -505,7 +505,7 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 		},
 		{
 			name: "trace with missing optional fields",
-			params: opensearch.ComponentTracesRequestParams{
+			params: types.ComponentTracesRequestParams{
 				ServiceName: "test-service",
 				StartTime:   "2024-01-01T00:00:00Z",
 				EndTime:     "2024-01-01T23:59:59Z",
@@ -540,8 +540,8 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 				Took:     5,
 				TimedOut: false,
 			},
-			expectedResult: &opensearch.TraceResponse{
-				Spans: []opensearch.Span{
+			expectedResult: &types.TraceResponse{
+				Spans: []types.Span{
 					{
 						TraceID:         "trace-125",
 						SpanID:          "span-458",
@@ -567,16 +567,13 @@ func TestLoggingService_GetComponentTraces(t *testing.T) {
 			}
 
 			// Create service with mock client
-			service := &LoggingService{
-				osClient:     mockClient,
-				queryBuilder: opensearch.NewQueryBuilder("otel-v1-apm-span-"),
-				config: &config.Config{
-					OpenSearch: config.OpenSearchConfig{
-						IndexPrefix: "otel-v1-apm-span-",
-					},
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			store := NewOpenSearchStore(mockClient, "otel-v1-apm-span-", logger)
+			service := NewLoggingService(store, store, &config.Config{
+				OpenSearch: config.OpenSearchConfig{
+					IndexPrefix: "otel-v1-apm-span-",
 				},
-				logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
-			}
+			}, logger)
 
 			// Call the method
 			result, err := service.GetComponentTraces(context.Background(), tt.params)
@@ -669,18 +666,15 @@ func TestLoggingService_GetComponentTraces_QueryBuilding(t *testing.T) {
 		},
 	}
 
-	service := &LoggingService{
-		osClient:     mockClient,
-		queryBuilder: opensearch.NewQueryBuilder("otel-v1-apm-span-"),
-		config: &config.Config{
-			OpenSearch: config.OpenSearchConfig{
-				IndexPrefix: "otel-v1-apm-span-",
-			},
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := NewOpenSearchStore(mockClient, "otel-v1-apm-span-", logger)
+	service := NewLoggingService(store, store, &config.Config{
+		OpenSearch: config.OpenSearchConfig{
+			IndexPrefix: "otel-v1-apm-span-",
 		},
-		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
-	}
+	}, logger)
 
-	params := opensearch.ComponentTracesRequestParams{
+	params := types.ComponentTracesRequestParams{
 		ServiceName: "my-test-service",
 		StartTime:   "2024-01-01T00:00:00Z",
 		EndTime:     "2024-01-01T23:59:59Z",
@@ -0,0 +1,24 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// LogStore reads the logs of components, projects, gateways and organizations from a log backend
+type LogStore interface {
+	GetComponentLogs(ctx context.Context, params types.ComponentQueryParams) (*LogResponse, error)
+	GetProjectLogs(ctx context.Context, params types.QueryParams, componentIDs []string) (*LogResponse, error)
+	GetGatewayLogs(ctx context.Context, params types.GatewayQueryParams) (*LogResponse, error)
+	GetOrganizationLogs(ctx context.Context, params types.QueryParams, podLabels map[string]string) (*LogResponse, error)
+	HealthCheck(ctx context.Context) error
+}
+
+// TraceStore reads the spans of components from a trace backend
+type TraceStore interface {
+	GetComponentTraces(ctx context.Context, params types.ComponentTracesRequestParams) (*types.TraceResponse, error)
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package types defines the query parameters and results shared by the log and trace backends of the observer
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+)
+
+// QueryParams holds common query parameters
+type QueryParams struct {
+	StartTime      string   `json:"startTime"`
+	EndTime        string   `json:"endTime"`
+	SearchPhrase   string   `json:"searchPhrase"`
+	LogLevels      []string `json:"logLevels"`
+	Limit          int      `json:"limit"`
+	SortOrder      string   `json:"sortOrder"`
+	ComponentID    string   `json:"componentId,omitempty"`
+	EnvironmentID  string   `json:"environmentId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	OrganizationID string   `json:"organizationId,omitempty"`
+	Namespace      string   `json:"namespace,omitempty"`
+	Versions       []string `json:"versions,omitempty"`
+	VersionIDs     []string `json:"versionIds,omitempty"`
+	LogType        string   `json:"logType,omitempty"`
+}
+
+// ComponentQueryParams holds component-specific query parameters
+type ComponentQueryParams struct {
+	QueryParams
+	BuildID   string `json:"buildId,omitempty"`
+	BuildUUID string `json:"buildUuid,omitempty"`
+}
+
+// GatewayQueryParams holds gateway-specific query parameters
+type GatewayQueryParams struct {
+	QueryParams
+	OrganizationID    string            `json:"organizationId"`
+	APIIDToVersionMap map[string]string `json:"apiIdToVersionMap"`
+	GatewayVHosts     []string          `json:"gatewayVHosts"`
+}
+
+// LogGap reports log entries skipped by a followed log stream
+type LogGap struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// LogEntry represents a log entry read from a log backend
+type LogEntry struct {
+	Timestamp     time.Time         `json:"timestamp"`
+	Log           string            `json:"log"`
+	LogLevel      string            `json:"logLevel"`
+	ComponentID   string            `json:"componentId"`
+	EnvironmentID string            `json:"environmentId"`
+	ProjectID     string            `json:"projectId"`
+	Version       string            `json:"version"`
+	VersionID     string            `json:"versionId"`
+	Namespace     string            `json:"namespace"`
+	PodID         string            `json:"podId"`
+	ContainerName string            `json:"containerName"`
+	Labels        map[string]string `json:"labels"`
+}
+
+// TraceResponse represents the response structure for trace queries
+type TraceResponse struct {
+	Spans      []Span `json:"spans"`
+	TotalCount int    `json:"totalCount"`
+	Took       int    `json:"tookMs"`
+}
+
+// Span represents a span read from a trace backend
+type Span struct {
+	DurationInNanos int64     `json:"durationInNanos"`
+	EndTime         time.Time `json:"endTime"`
+	Name            string    `json:"name"`
+	SpanID          string    `json:"spanId"`
+	StartTime       time.Time `json:"startTime"`
+	TraceID         string    `json:"traceId"`
+}
+
+// ComponentTracesRequestParams holds request body parameters for component traces
+type ComponentTracesRequestParams struct {
+	EndTime     string `json:"endTime"`
+	Limit       int    `json:"limit,omitempty"`
+	ServiceName string `json:"serviceName"`
+	SortOrder   string `json:"sortOrder,omitempty"`
+	StartTime   string `json:"startTime"`
+}
+
+// ExtractLogLevel extracts log level from log content using common patterns
+func ExtractLogLevel(log string) string {
+	log = strings.ToUpper(log)
+
+	logLevels := []string{"ERROR", "FATAL", "SEVERE", "WARN", "WARNING", "INFO", "DEBUG", "UNDEFINED"}
+
+	for _, level := range logLevels {
+		if strings.Contains(log, level) {
+			// Normalize WARN/WARNING to WARN
+			if level == "WARNING" {
+				return "WARN"
+			}
+			return level
+		}
+	}
+
+	return "UNDEFINED" // Default to INFO if no level found
+}
+
+// ExtractLogType determines the log type from query parameters or defaults to RUNTIME
+func ExtractLogType(logType string) string {
+	switch strings.ToUpper(logType) {
+	case labels.QueryParamLogTypeBuild:
+		return labels.QueryParamLogTypeBuild
+	case labels.QueryParamLogTypeRuntime:
+		return labels.QueryParamLogTypeRuntime
+	default:
+		return labels.QueryParamLogTypeRuntime // Default to RUNTIME if no valid type specified
+	}
+}
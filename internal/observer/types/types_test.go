@@ -0,0 +1,35 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "testing"
+
+func TestExtractLogLevel(t *testing.T) {
+	tests := map[string]string{
+		"2025-01-01 ERROR failed to connect": "ERROR",
+		"[warning] disk almost full":         "WARN",
+		"WARN retrying":                      "WARN",
+		"info: started":                      "INFO",
+		"listening on :8080":                 "UNDEFINED",
+	}
+	for log, want := range tests {
+		if got := ExtractLogLevel(log); got != want {
+			t.Errorf("ExtractLogLevel(%q) = %q, want %q", log, got, want)
+		}
+	}
+}
+
+func TestExtractLogType(t *testing.T) {
+	tests := map[string]string{
+		"build":   "BUILD",
+		"RUNTIME": "RUNTIME",
+		"":        "RUNTIME",
+		"audit":   "RUNTIME",
+	}
+	for logType, want := range tests {
+		if got := ExtractLogType(logType); got != want {
+			t.Errorf("ExtractLogType(%q) = %q, want %q", logType, got, want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+)
+
+// Source is the interface a mutating operation was requested through
+type Source string
+
+const (
+	SourceAPI Source = "api"
+	SourceMCP Source = "mcp"
+)
+
+// Action is the kind of mutation performed on a resource
+type Action string
+
+const (
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionApply    Action = "apply"
+	ActionDelete   Action = "delete"
+	ActionPromote  Action = "promote"
+	ActionRollback Action = "rollback"
+	ActionBuild    Action = "build"
+)
+
+// Outcome is the result of an audited operation
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	// OutcomeDenied is recorded for operations rejected by authorization. Requests failing
+	// authentication are rejected by the auth middleware before they reach an audited handler.
+	OutcomeDenied  Outcome = "denied"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Resource identifies the resource an operation was performed on
+type Resource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name,omitempty"`
+}
+
+// Record is the audit record of a mutating operation
+type Record struct {
+	Time      time.Time `json:"time"`
+	Principal string    `json:"principal"`
+	Source    Source    `json:"source"`
+	Action    Action    `json:"action"`
+	Resource  Resource  `json:"resource"`
+	Org       string    `json:"org,omitempty"`
+	Project   string    `json:"project,omitempty"`
+	// PayloadHash is the SHA-256 hash of the request payload, so that records can be correlated
+	// with requests without storing their possibly sensitive content
+	PayloadHash string  `json:"payloadHash,omitempty"`
+	Outcome     Outcome `json:"outcome"`
+	StatusCode  int     `json:"statusCode,omitempty"`
+	Error       string  `json:"error,omitempty"`
+	LatencyMs   int64   `json:"latencyMs"`
+}
+
+// Filter selects audit records. Empty fields match all records.
+type Filter struct {
+	Org          string
+	Since        time.Time
+	Until        time.Time
+	Principal    string
+	ResourceKind string
+	ResourceName string
+	// Limit is the maximum number of records returned, newest first
+	Limit int
+}
+
+// Matches reports whether the record is selected by the filter
+func (f Filter) Matches(r *Record) bool {
+	switch {
+	case f.Org != "" && r.Org != f.Org:
+		return false
+	case !f.Since.IsZero() && r.Time.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && r.Time.After(f.Until):
+		return false
+	case f.Principal != "" && r.Principal != f.Principal:
+		return false
+	case f.ResourceKind != "" && r.Resource.Kind != f.ResourceKind:
+		return false
+	case f.ResourceName != "" && r.Resource.Name != f.ResourceName:
+		return false
+	}
+	return true
+}
+
+// Sink receives the audit records
+type Sink interface {
+	Write(ctx context.Context, record *Record) error
+}
+
+// Querier queries the recorded audit records
+type Querier interface {
+	// Query returns the records selected by the filter, newest first
+	Query(ctx context.Context, filter Filter) ([]*Record, error)
+}
+
+// Auditor writes audit records to its sinks and queries them. A nil Auditor discards all records.
+type Auditor struct {
+	sinks   []Sink
+	querier Querier
+	logger  *slog.Logger
+}
+
+// New creates an auditor writing to the sinks. The querier is typically one of the sinks.
+func New(querier Querier, logger *slog.Logger, sinks ...Sink) *Auditor {
+	return &Auditor{sinks: sinks, querier: querier, logger: logger}
+}
+
+// Record completes the record with the principal of the context and writes it to all sinks.
+// Sink failures are logged, they don't fail the audited operation.
+func (a *Auditor) Record(ctx context.Context, record *Record) {
+	if a == nil {
+		return
+	}
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	if record.Principal == "" {
+		record.Principal = "anonymous"
+		if principal := auth.GetPrincipal(ctx); principal != nil {
+			record.Principal = principal.Name
+		}
+	}
+	for _, sink := range a.sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			a.logger.Error("Failed to write audit record", "error", err, "action", record.Action, "resource", record.Resource)
+		}
+	}
+}
+
+// Query returns the records selected by the filter, newest first
+func (a *Auditor) Query(ctx context.Context, filter Filter) ([]*Record, error) {
+	if a == nil || a.querier == nil {
+		return nil, errors.New("audit records can't be queried")
+	}
+	return a.querier.Query(ctx, filter)
+}
+
+// Close closes the sinks that hold resources
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+	var errs []error
+	for _, sink := range a.sinks {
+		if closer, ok := sink.(interface{ Close() error }); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HashPayload returns the hex encoded SHA-256 hash of the payload, or an empty string if there is none
+func HashPayload(payload []byte) string {
+	if len(payload) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashValue returns the hash of the JSON encoding of the value
+func HashValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return HashPayload(data)
+}
+
+// OutcomeOf returns the outcome of an operation that completed with the error
+func OutcomeOf(err error) Outcome {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, auth.ErrForbidden):
+		return OutcomeDenied
+	default:
+		return OutcomeFailure
+	}
+}
+
+// OutcomeOfStatus returns the outcome of an HTTP request completed with the status code
+func OutcomeOfStatus(statusCode int) Outcome {
+	switch {
+	case statusCode < 400:
+		return OutcomeSuccess
+	case statusCode == 401 || statusCode == 403:
+		return OutcomeDenied
+	default:
+		return OutcomeFailure
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+)
+
+func TestFilterMatches(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	record := &Record{
+		Time:      now,
+		Principal: "alice",
+		Resource:  Resource{Kind: "Component", Name: "cart"},
+		Org:       "acme",
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{name: "empty filter", filter: Filter{}, want: true},
+		{name: "all fields match", filter: Filter{Org: "acme", Principal: "alice", ResourceKind: "Component", ResourceName: "cart"}, want: true},
+		{name: "other org", filter: Filter{Org: "globex"}},
+		{name: "other principal", filter: Filter{Principal: "bob"}},
+		{name: "other kind", filter: Filter{ResourceKind: "Project"}},
+		{name: "other name", filter: Filter{ResourceName: "checkout"}},
+		{name: "within the time range", filter: Filter{Since: now.Add(-time.Minute), Until: now.Add(time.Minute)}, want: true},
+		{name: "at the bounds of the time range", filter: Filter{Since: now, Until: now}, want: true},
+		{name: "before since", filter: Filter{Since: now.Add(time.Second)}},
+		{name: "after until", filter: Filter{Until: now.Add(-time.Second)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(record); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type failingSink struct{}
+
+func (failingSink) Write(context.Context, *Record) error {
+	return errors.New("sink unavailable")
+}
+
+func TestAuditorRecord(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(10)
+	auditor := New(store, slog.New(slog.NewTextHandler(io.Discard, nil)), failingSink{}, store)
+
+	// A failing sink doesn't keep the record from the others
+	auditor.Record(ctx, &Record{Action: ActionCreate})
+	auditor.Record(auth.WithPrincipal(ctx, &auth.Principal{Name: "alice"}), &Record{Action: ActionDelete})
+	auditor.Record(ctx, &Record{Action: ActionUpdate, Principal: "mcp-client"})
+
+	records, err := auditor.Query(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("Query() = %v", err)
+	}
+	var principals []string
+	for _, r := range records {
+		if r.Time.IsZero() {
+			t.Errorf("record %s has no time", r.Action)
+		}
+		principals = append(principals, r.Principal)
+	}
+	if fmt.Sprint(principals) != "[mcp-client alice anonymous]" {
+		t.Errorf("principals = %v, want [mcp-client alice anonymous]", principals)
+	}
+
+	// A nil auditor discards the records
+	var disabled *Auditor
+	disabled.Record(ctx, &Record{Action: ActionCreate})
+	if _, err := disabled.Query(ctx, Filter{}); err == nil {
+		t.Error("Query() of a nil auditor = nil, want an error")
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	errorTests := []struct {
+		err  error
+		want Outcome
+	}{
+		{err: nil, want: OutcomeSuccess},
+		{err: fmt.Errorf("create component: %w", auth.ErrForbidden), want: OutcomeDenied},
+		{err: errors.New("conflict"), want: OutcomeFailure},
+	}
+	for _, tt := range errorTests {
+		if got := OutcomeOf(tt.err); got != tt.want {
+			t.Errorf("OutcomeOf(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+
+	statusTests := []struct {
+		status int
+		want   Outcome
+	}{
+		{status: http.StatusOK, want: OutcomeSuccess},
+		{status: http.StatusCreated, want: OutcomeSuccess},
+		{status: http.StatusUnauthorized, want: OutcomeDenied},
+		{status: http.StatusForbidden, want: OutcomeDenied},
+		{status: http.StatusNotFound, want: OutcomeFailure},
+		{status: http.StatusInternalServerError, want: OutcomeFailure},
+	}
+	for _, tt := range statusTests {
+		if got := OutcomeOfStatus(tt.status); got != tt.want {
+			t.Errorf("OutcomeOfStatus(%d) = %s, want %s", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestHashPayload(t *testing.T) {
+	if got := HashPayload(nil); got != "" {
+		t.Errorf("HashPayload(nil) = %q, want empty", got)
+	}
+	const helloHash = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if got := HashPayload([]byte("hello")); got != helloHash {
+		t.Errorf("HashPayload(hello) = %q, want %q", got, helloHash)
+	}
+	if HashValue(map[string]string{"name": "cart"}) != HashPayload([]byte(`{"name":"cart"}`)) {
+		t.Error("HashValue() differs from the hash of the JSON encoding")
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+)
+
+// FileSink appends the audit records as JSON lines to a file. The file is rotated when it exceeds
+// its maximum size, keeping a number of backups named <path>.1 (the newest) to <path>.<maxBackups>.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens the audit file for appending. A maxSize of zero disables the rotation.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	s := &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(_ context.Context, r *Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return errors.New("audit file is closed")
+	}
+	// A failed rotation doesn't drop the record, the file grows beyond its maximum size
+	// until a later rotation succeeds
+	var rotateErr error
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if rotateErr = s.rotate(); s.file == nil {
+			return rotateErr
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	return errors.Join(rotateErr, err)
+}
+
+// rotate shifts the backups, moves the current file to the first backup and opens a new file.
+// If the backups can't be shifted, the current file is reopened so that the sink keeps writing.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	s.file = nil
+
+	if err := s.shiftBackups(); err != nil {
+		return errors.Join(fmt.Errorf("failed to rotate audit file: %w", err), s.open())
+	}
+	return s.open()
+}
+
+// shiftBackups moves the current file to the first backup, or removes it if no backups are kept
+func (s *FileSink) shiftBackups() error {
+	if s.maxBackups == 0 {
+		return os.Remove(s.path)
+	}
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(s.path, s.backup(1))
+}
+
+func (s *FileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// Query implements Querier. It reads the current file and its backups.
+func (s *FileSink) Query(ctx context.Context, filter Filter) ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []*Record
+	// The current file holds the newest records, followed by the backups in order
+	paths := []string{s.path}
+	for i := 1; i <= s.maxBackups; i++ {
+		paths = append(paths, s.backup(i))
+	}
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		records, err := readRecords(path, filter)
+		if err != nil {
+			return nil, err
+		}
+		slices.Reverse(records)
+		result = append(result, records...)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			return result[:filter.Limit], nil
+		}
+	}
+	return result, nil
+}
+
+// readRecords reads the records of an audit file selected by the filter, oldest first
+func readRecords(path string, filter Filter) ([]*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer file.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			// Skip lines that were partially written
+			continue
+		}
+		if filter.Matches(&record) {
+			records = append(records, &record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit file: %w", err)
+	}
+	return records, nil
+}
+
+// Close closes the audit file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
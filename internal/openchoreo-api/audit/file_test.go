@@ -0,0 +1,142 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// recordSize returns the size of the JSON line of a record of the test sinks
+func recordSize(t *testing.T, name string) int64 {
+	t.Helper()
+	line, err := json.Marshal(&Record{Resource: Resource{Kind: "Component", Name: name}})
+	if err != nil {
+		t.Fatalf("failed to encode the record: %v", err)
+	}
+	return int64(len(line)) + 1
+}
+
+func newTestFileSink(t *testing.T, maxRecords, maxBackups int) (*FileSink, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path, int64(maxRecords)*recordSize(t, "a"), maxBackups)
+	if err != nil {
+		t.Fatalf("NewFileSink() = %v", err)
+	}
+	t.Cleanup(func() { _ = sink.Close() })
+	return sink, path
+}
+
+func writeRecords(t *testing.T, sink *FileSink, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if err := sink.Write(context.Background(), &Record{Resource: Resource{Kind: "Component", Name: name}}); err != nil {
+			t.Fatalf("Write(%s) = %v", name, err)
+		}
+	}
+}
+
+func queryNames(t *testing.T, sink *FileSink, filter Filter) string {
+	t.Helper()
+	records, err := sink.Query(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("Query() = %v", err)
+	}
+	return names(records)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	sink, path := newTestFileSink(t, 2, 2)
+	writeRecords(t, sink, "a", "b", "c", "d", "e", "f", "g")
+
+	// Each file holds two records, the oldest records are dropped with the oldest backup
+	for file, want := range map[string]int64{path: 1, path + ".1": 2, path + ".2": 2} {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatalf("failed to stat %s: %v", file, err)
+		}
+		if info.Size() != want*recordSize(t, "a") {
+			t.Errorf("%s holds %d bytes, want %d records", file, info.Size(), want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("backup beyond maxBackups exists: %v", err)
+	}
+
+	if got := queryNames(t, sink, Filter{}); got != "[g f e d c]" {
+		t.Errorf("Query() = %s, want [g f e d c]", got)
+	}
+	if got := queryNames(t, sink, Filter{Limit: 3}); got != "[g f e]" {
+		t.Errorf("Query() with a limit = %s, want [g f e]", got)
+	}
+	if got := queryNames(t, sink, Filter{ResourceName: "d"}); got != "[d]" {
+		t.Errorf("Query() of a name = %s, want [d]", got)
+	}
+}
+
+func TestFileSinkWithoutBackups(t *testing.T) {
+	sink, _ := newTestFileSink(t, 2, 0)
+	writeRecords(t, sink, "a", "b", "c")
+	if got := queryNames(t, sink, Filter{}); got != "[c]" {
+		t.Errorf("Query() = %s, want [c]", got)
+	}
+}
+
+func TestFileSinkKeepsWritingWhenRotationFails(t *testing.T) {
+	sink, path := newTestFileSink(t, 1, 1)
+	writeRecords(t, sink, "a")
+
+	// A directory in place of the backup makes the rotation fail
+	if err := os.MkdirAll(filepath.Join(path+".1", "blocked"), 0o700); err != nil {
+		t.Fatalf("failed to create the directory: %v", err)
+	}
+	if err := sink.Write(context.Background(), &Record{Resource: Resource{Kind: "Component", Name: "b"}}); err == nil {
+		t.Error("Write() with a failing rotation = nil, want an error")
+	}
+	records, err := readRecords(path, Filter{})
+	if err != nil {
+		t.Fatalf("readRecords() = %v", err)
+	}
+	if got := names(records); got != "[a b]" {
+		t.Errorf("audit file after the failed rotation = %s, want [a b]", got)
+	}
+
+	// The next rotation succeeds once the backup can be written
+	if err := os.RemoveAll(path + ".1"); err != nil {
+		t.Fatalf("failed to remove the directory: %v", err)
+	}
+	writeRecords(t, sink, "c")
+	if got := queryNames(t, sink, Filter{}); got != "[c b a]" {
+		t.Errorf("Query() after the rotation = %s, want [c b a]", got)
+	}
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Errorf("backup after the rotation: %v", err)
+	}
+}
+
+func TestFileSinkSkipsPartialLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	if err := os.WriteFile(path, []byte(`{"resource":{"kind":"Component","name":"a"}}`+"\n"+`{"resource":{"ki`), 0o600); err != nil {
+		t.Fatalf("failed to write the audit file: %v", err)
+	}
+	sink, err := NewFileSink(path, 0, 0)
+	if err != nil {
+		t.Fatalf("NewFileSink() = %v", err)
+	}
+	defer sink.Close()
+	if got := queryNames(t, sink, Filter{}); got != "[a]" {
+		t.Errorf("Query() = %s, want [a]", got)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+	if err := sink.Write(context.Background(), &Record{}); err == nil {
+		t.Error("Write() after Close() = nil, want an error")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+
+	"golang.org/x/exp/slog"
+)
+
+// LogSink writes the audit records as structured log entries
+type LogSink struct {
+	logger *slog.Logger
+}
+
+// NewLogSink creates a sink logging to the logger
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger.With("audit", true)}
+}
+
+// Write implements Sink.
+func (s *LogSink) Write(ctx context.Context, r *Record) error {
+	attrs := []any{
+		"principal", r.Principal,
+		"source", r.Source,
+		"action", r.Action,
+		"resourceKind", r.Resource.Kind,
+		"resourceName", r.Resource.Name,
+		"org", r.Org,
+		"project", r.Project,
+		"payloadHash", r.PayloadHash,
+		"outcome", r.Outcome,
+		"latencyMs", r.LatencyMs,
+	}
+	if r.StatusCode != 0 {
+		attrs = append(attrs, "statusCode", r.StatusCode)
+	}
+	if r.Error != "" {
+		attrs = append(attrs, "error", r.Error)
+	}
+	s.logger.Info("Audit", attrs...)
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore keeps the most recent audit records in memory so that they can be queried
+// when no file sink is configured. The records are lost when the server restarts.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records []*Record
+	next    int
+	full    bool
+}
+
+// NewMemoryStore creates a store holding up to capacity records
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{records: make([]*Record, capacity)}
+}
+
+// Write implements Sink.
+func (s *MemoryStore) Write(_ context.Context, r *Record) error {
+	if len(s.records) == 0 {
+		return nil
+	}
+	record := *r
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[s.next] = &record
+	s.next = (s.next + 1) % len(s.records)
+	if s.next == 0 {
+		s.full = true
+	}
+	return nil
+}
+
+// Query implements Querier.
+func (s *MemoryStore) Query(_ context.Context, filter Filter) ([]*Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	count := s.next
+	if s.full {
+		count = len(s.records)
+	}
+	var result []*Record
+	for i := 1; i <= count; i++ {
+		r := s.records[(s.next-i+len(s.records))%len(s.records)]
+		if !filter.Matches(r) {
+			continue
+		}
+		record := *r
+		result = append(result, &record)
+		if filter.Limit > 0 && len(result) == filter.Limit {
+			break
+		}
+	}
+	return result, nil
+}
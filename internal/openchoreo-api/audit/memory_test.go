@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func names(records []*Record) string {
+	var result []string
+	for _, r := range records {
+		result = append(result, r.Resource.Name)
+	}
+	return fmt.Sprint(result)
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(3)
+
+	write := func(name, org string) {
+		t.Helper()
+		if err := store.Write(ctx, &Record{Resource: Resource{Kind: "Component", Name: name}, Org: org}); err != nil {
+			t.Fatalf("Write() = %v", err)
+		}
+	}
+	query := func(filter Filter) string {
+		t.Helper()
+		records, err := store.Query(ctx, filter)
+		if err != nil {
+			t.Fatalf("Query() = %v", err)
+		}
+		return names(records)
+	}
+
+	if got := query(Filter{}); got != "[]" {
+		t.Errorf("Query() of an empty store = %s, want []", got)
+	}
+	write("a", "acme")
+	write("b", "globex")
+	if got := query(Filter{}); got != "[b a]" {
+		t.Errorf("Query() = %s, want [b a]", got)
+	}
+
+	// The oldest records are overwritten when the store is full
+	write("c", "acme")
+	write("d", "acme")
+	write("e", "globex")
+	if got := query(Filter{}); got != "[e d c]" {
+		t.Errorf("Query() of a full store = %s, want [e d c]", got)
+	}
+	if got := query(Filter{Org: "acme"}); got != "[d c]" {
+		t.Errorf("Query() of an org = %s, want [d c]", got)
+	}
+	if got := query(Filter{Limit: 2}); got != "[e d]" {
+		t.Errorf("Query() with a limit = %s, want [e d]", got)
+	}
+
+	// Queried records are copies
+	records, _ := store.Query(ctx, Filter{Limit: 1})
+	records[0].Resource.Name = "changed"
+	if got := query(Filter{Limit: 1}); got != "[e]" {
+		t.Errorf("Query() after changing a result = %s, want [e]", got)
+	}
+}
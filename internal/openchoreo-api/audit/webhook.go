@@ -0,0 +1,109 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slog"
+)
+
+const (
+	webhookQueueSize = 1000
+	webhookTimeout   = 10 * time.Second
+)
+
+// WebhookSink posts the audit records as JSON to a webhook. Records are delivered asynchronously,
+// so that a slow webhook doesn't delay the audited requests. Records are dropped when the queue is full.
+type WebhookSink struct {
+	url        string
+	httpClient *http.Client
+	logger     *slog.Logger
+
+	// mu guards the queue against writes after it is closed
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Record
+	done   chan struct{}
+}
+
+// NewWebhookSink creates a sink posting to the URL and starts delivering the records
+func NewWebhookSink(url string, logger *slog.Logger) *WebhookSink {
+	s := &WebhookSink{
+		url:        url,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+		logger:     logger,
+		queue:      make(chan Record, webhookQueueSize),
+		done:       make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write implements Sink.
+func (s *WebhookSink) Write(_ context.Context, r *Record) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return errors.New("audit webhook is closed")
+	}
+	select {
+	case s.queue <- *r:
+		return nil
+	default:
+		return errors.New("audit webhook queue is full, record dropped")
+	}
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for record := range s.queue {
+		if err := s.post(&record); err != nil {
+			s.logger.Error("Failed to deliver audit record to webhook", "error", err,
+				"action", record.Action, "resource", record.Resource, "principal", record.Principal)
+		}
+	}
+}
+
+func (s *WebhookSink) post(r *Record) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close stops accepting records and waits for the queued records to be delivered
+func (s *WebhookSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/exp/slog"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []*Record
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", r.Header.Get("Content-Type"))
+		}
+		var record Record
+		if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+			t.Errorf("failed to decode the record: %v", err)
+		}
+		if record.Resource.Name == "rejected" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		mu.Lock()
+		received = append(received, &record)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	sink := NewWebhookSink(server.URL, slog.New(slog.NewTextHandler(&logs, nil)))
+	ctx := context.Background()
+	for _, name := range []string{"a", "rejected", "b"} {
+		if err := sink.Write(ctx, &Record{Resource: Resource{Kind: "Component", Name: name}}); err != nil {
+			t.Fatalf("Write(%s) = %v", name, err)
+		}
+	}
+
+	// Close waits for the queued records to be delivered
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+	if got := names(received); got != "[a b]" {
+		t.Errorf("delivered records = %s, want [a b]", got)
+	}
+	if !strings.Contains(logs.String(), "webhook returned status 500") {
+		t.Errorf("logs = %q, want the failed delivery", logs.String())
+	}
+
+	if err := sink.Write(ctx, &Record{}); err == nil {
+		t.Error("Write() after Close() = nil, want an error")
+	}
+	if err := sink.Close(); err != nil {
+		t.Errorf("second Close() = %v", err)
+	}
+}
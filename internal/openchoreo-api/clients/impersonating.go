@@ -0,0 +1,191 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+)
+
+// maxImpersonatedClients bounds the number of cached clients of impersonated principals
+const maxImpersonatedClients = 1000
+
+// NewImpersonatingK8sClient creates a client that impersonates the principal of the request context
+// toward the Kubernetes API, so that the Kubernetes RBAC of the caller applies to the requests made on its behalf.
+// Requests without a principal, such as those made when authentication is disabled, use the server's own credentials.
+func NewImpersonatingK8sClient() (client.Client, error) {
+	base, err := NewK8sClient()
+	if err != nil {
+		return nil, err
+	}
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
+	}
+	return &impersonatingClient{
+		Client:  base,
+		config:  config,
+		clients: make(map[string]client.WithWatch),
+	}, nil
+}
+
+type impersonatingClient struct {
+	// Client is the client with the server's own credentials
+	client.Client
+	config *rest.Config
+
+	mu      sync.Mutex
+	clients map[string]client.WithWatch
+}
+
+// forContext returns the client impersonating the principal of the context
+func (c *impersonatingClient) forContext(ctx context.Context) (client.WithWatch, error) {
+	principal := auth.GetPrincipal(ctx)
+	if principal == nil {
+		return c.Client.(client.WithWatch), nil
+	}
+
+	groups := slices.Clone(principal.Groups)
+	slices.Sort(groups)
+	key := principal.Name + "\x00" + strings.Join(groups, "\x00")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cl, ok := c.clients[key]; ok {
+		return cl, nil
+	}
+
+	config := rest.CopyConfig(c.config)
+	config.Impersonate = rest.ImpersonationConfig{UserName: principal.Name, Groups: groups}
+	cl, err := client.NewWithWatch(config, client.Options{Scheme: c.Scheme(), Mapper: c.RESTMapper()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client impersonating %s: %w", principal.Name, err)
+	}
+	if len(c.clients) >= maxImpersonatedClients {
+		clear(c.clients)
+	}
+	c.clients[key] = cl
+	return cl, nil
+}
+
+func (c *impersonatingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.Get(ctx, key, obj, opts...)
+}
+
+func (c *impersonatingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.List(ctx, list, opts...)
+}
+
+func (c *impersonatingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.Create(ctx, obj, opts...)
+}
+
+func (c *impersonatingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.Delete(ctx, obj, opts...)
+}
+
+func (c *impersonatingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.Update(ctx, obj, opts...)
+}
+
+func (c *impersonatingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *impersonatingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *impersonatingClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	cl, err := c.forContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cl.Watch(ctx, list, opts...)
+}
+
+func (c *impersonatingClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *impersonatingClient) SubResource(subResource string) client.SubResourceClient {
+	return &impersonatingSubResourceClient{client: c, subResource: subResource}
+}
+
+// impersonatingSubResourceClient resolves the impersonating client on each request,
+// since the principal is only known from the request context
+type impersonatingSubResourceClient struct {
+	client      *impersonatingClient
+	subResource string
+}
+
+func (c *impersonatingSubResourceClient) Get(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	cl, err := c.client.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.SubResource(c.subResource).Get(ctx, obj, subResource, opts...)
+}
+
+func (c *impersonatingSubResourceClient) Create(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	cl, err := c.client.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.SubResource(c.subResource).Create(ctx, obj, subResource, opts...)
+}
+
+func (c *impersonatingSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	cl, err := c.client.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.SubResource(c.subResource).Update(ctx, obj, opts...)
+}
+
+func (c *impersonatingSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	cl, err := c.client.forContext(ctx)
+	if err != nil {
+		return err
+	}
+	return cl.SubResource(c.subResource).Patch(ctx, obj, patch, opts...)
+}
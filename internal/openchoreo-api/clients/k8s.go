@@ -7,12 +7,16 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
+// NewK8sClient creates a client with the server's own credentials. The client supports watches,
+// so that it can be asserted to client.WithWatch by services that stream resource changes.
+// Its scheme holds the OpenChoreo and the built-in Kubernetes types.
 func NewK8sClient() (client.Client, error) {
 	config, err := ctrl.GetConfig()
 	if err != nil {
@@ -20,9 +24,12 @@ func NewK8sClient() (client.Client, error) {
 	}
 
 	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to add Kubernetes scheme: %w", err)
+	}
 	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
 	}
 
-	return client.New(config, client.Options{Scheme: scheme})
+	return client.NewWithWatch(config, client.Options{Scheme: scheme})
 }
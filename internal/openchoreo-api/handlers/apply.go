@@ -6,43 +6,170 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
+// Operations reported for applied resources
+const (
+	applyCreated   = "created"
+	applyUpdated   = "updated"
+	applyUnchanged = "unchanged"
+	applyFailed    = "failed"
+	applySkipped   = "skipped"
+)
+
+// maxApplyResources is the maximum number of resources of a single apply request
+const maxApplyResources = 500
+
+// atomicSkipMessage explains why a resource of an atomic apply was not applied
+const atomicSkipMessage = "Not applied because another resource of the atomic apply failed"
+
 // ApplyResourceResponse represents the response for apply operations
 type ApplyResourceResponse struct {
 	APIVersion string `json:"apiVersion"`
 	Kind       string `json:"kind"`
 	Name       string `json:"name"`
 	Namespace  string `json:"namespace,omitempty"`
-	Operation  string `json:"operation"` // "created" or "updated" or "unchanged"
+	Operation  string `json:"operation"` // "created", "updated", "unchanged", "failed" or "skipped"
+	// Diff lists the changes the apply makes to the stored resource, in dry runs only
+	Diff  []FieldChange `json:"diff,omitempty"`
+	Error string        `json:"error,omitempty"`
+	Code  string        `json:"code,omitempty"`
+}
+
+// ApplyBatchResponse represents the response for apply operations of several resources
+type ApplyBatchResponse struct {
+	DryRun  bool                    `json:"dryRun"`
+	Atomic  bool                    `json:"atomic"`
+	Applied int                     `json:"applied"`
+	Failed  int                     `json:"failed"`
+	Results []ApplyResourceResponse `json:"results"`
+}
+
+// applyOptions are the query parameters of apply requests
+type applyOptions struct {
+	dryRun       bool
+	force        bool
+	atomic       bool
+	fieldManager string
 }
 
-// ApplyResource handles POST /api/v1/apply - forwards resource to Kubernetes API like kubectl apply
+// ApplyResource handles POST /api/v1/apply - forwards resources to Kubernetes API like kubectl apply --server-side.
+// The body is a single resource, a JSON array or List of resources, or a multi-document YAML bundle.
+// A single resource is answered with its result, several resources with the result of each one.
 func (h *Handler) ApplyResource(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Parse the raw resource payload
-	var resourceObj map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&resourceObj); err != nil {
+	resources, batch, err := decodeApplyRequest(r.Body)
+	if err != nil {
 		h.logger.Error("Failed to decode apply request", "error", err)
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", services.CodeInvalidInput)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body: "+err.Error(), services.CodeInvalidInput)
 		return
 	}
 
+	query := r.URL.Query()
+	opts := applyOptions{
+		dryRun:       query.Get("dryRun") == "true",
+		force:        query.Get("force") == "true",
+		atomic:       query.Get("atomic") == "true",
+		fieldManager: applyFieldManager(auth.GetPrincipal(ctx)),
+	}
+
+	if !batch {
+		result := h.applyResource(ctx, resources[0], opts)
+		if result.Operation == applyFailed {
+			writeErrorResponse(w, applyErrorStatus(result.Code), result.Error, result.Code)
+			return
+		}
+		writeSuccessResponse(w, http.StatusOK, result)
+		return
+	}
+
+	response := h.applyResources(ctx, resources, opts)
+	h.logger.Info("Resources applied", "applied", response.Applied, "failed", response.Failed,
+		"dryRun", opts.dryRun, "atomic", opts.atomic)
+
+	w.Header().Set("Content-Type", "application/json")
+	if response.Failed == 0 {
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(models.SuccessResponse(response)) // Ignore encoding errors for response
+		return
+	}
+	status := http.StatusMultiStatus
+	if opts.atomic {
+		status = http.StatusConflict
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(models.APIResponse[ApplyBatchResponse]{ // Ignore encoding errors for response
+		Success: false,
+		Data:    response,
+		Error:   fmt.Sprintf("%d of %d resources failed to apply", response.Failed, len(resources)),
+		Code:    services.CodeApplyFailed,
+	})
+}
+
+// applyResources applies the resources in order and returns the result of each one.
+// In atomic mode all resources are first applied as a dry run, and none is applied if one of them fails.
+// Resources applied before a later failure of the real apply are not rolled back.
+func (h *Handler) applyResources(ctx context.Context, resources []map[string]any, opts applyOptions) ApplyBatchResponse {
+	response := ApplyBatchResponse{DryRun: opts.dryRun, Atomic: opts.atomic, Results: make([]ApplyResourceResponse, len(resources))}
+
+	if opts.atomic && !opts.dryRun {
+		check := opts
+		check.dryRun = true
+		failed := false
+		for i, resource := range resources {
+			response.Results[i] = h.applyResource(ctx, runtime.DeepCopyJSON(resource), check)
+			failed = failed || response.Results[i].Operation == applyFailed
+		}
+		if failed {
+			for i := range response.Results {
+				skipIfApplicable(&response.Results[i])
+			}
+			response.Failed = countFailed(response.Results)
+			return response
+		}
+	}
+
+	for i, resource := range resources {
+		if opts.atomic && response.Failed > 0 {
+			response.Results[i] = applyResult(resource, applySkipped, atomicSkipMessage)
+			continue
+		}
+		response.Results[i] = h.applyResource(ctx, resource, opts)
+		if response.Results[i].Operation == applyFailed {
+			response.Failed++
+		} else {
+			response.Applied++
+		}
+	}
+	return response
+}
+
+// applyResource validates, authorizes and applies a single resource
+func (h *Handler) applyResource(ctx context.Context, resourceObj map[string]any, opts applyOptions) ApplyResourceResponse {
 	// Validate resource using shared validation
 	kind, apiVersion, name, err := validateResourceRequest(resourceObj)
 	if err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
-		return
+		result := applyResult(resourceObj, applyFailed, err.Error())
+		result.Code = services.CodeInvalidInput
+		return result
 	}
 
 	// Convert to unstructured object
@@ -52,68 +179,219 @@ func (h *Handler) ApplyResource(w http.ResponseWriter, r *http.Request) {
 	if err := h.handleResourceNamespace(unstructuredObj, apiVersion, kind); err != nil {
 		h.logger.Error("Failed to handle resource namespace",
 			"kind", kind, "name", name, "error", err)
-		writeErrorResponse(w, http.StatusBadRequest,
-			"Failed to handle resource namespace: "+err.Error(), services.CodeInvalidInput)
-		return
+		result := applyResult(resourceObj, applyFailed, "Failed to handle resource namespace: "+err.Error())
+		result.Code = services.CodeInvalidInput
+		return result
+	}
+
+	action, scope := resourceAuthorizationScope(unstructuredObj)
+	if err := h.auth.Authorize(ctx, action, scope); err != nil {
+		h.logger.Warn("Apply is not authorized", "kind", kind, "name", name, "error", err)
+		result := applyResult(resourceObj, applyFailed, "Not allowed to "+string(action)+" in "+scope.String())
+		result.Code = services.CodeForbidden
+		return result
 	}
 
 	// Apply the resource to Kubernetes
-	operation, err := h.applyToKubernetes(ctx, unstructuredObj)
+	operation, diff, err := h.applyToKubernetes(ctx, unstructuredObj, opts)
 	if err != nil {
 		h.logger.Error("Failed to apply resource to Kubernetes",
 			"kind", kind, "name", name, "error", err)
-		writeErrorResponse(w, http.StatusInternalServerError,
-			"Failed to apply resource: "+err.Error(), services.CodeInternalError)
-		return
-	}
-
-	// Return success response
-	response := ApplyResourceResponse{
-		APIVersion: apiVersion,
-		Kind:       kind,
-		Name:       name,
-		Namespace:  unstructuredObj.GetNamespace(), // Use the actual namespace set on the object
-		Operation:  operation,
+		result := applyResult(resourceObj, applyFailed, "Failed to apply resource: "+err.Error())
+		result.Code = applyErrorCode(err)
+		return result
 	}
 
 	h.logger.Info("Resource applied successfully",
-		"kind", kind, "name", name, "namespace", unstructuredObj.GetNamespace(), "operation", operation)
-	writeSuccessResponse(w, http.StatusOK, response)
+		"kind", kind, "name", name, "namespace", unstructuredObj.GetNamespace(), "operation", operation,
+		"dryRun", opts.dryRun)
+	result := applyResult(resourceObj, operation, "")
+	if opts.dryRun {
+		result.Diff = diff
+	}
+	return result
 }
 
-// applyToKubernetes applies the resource to Kubernetes cluster using server-side apply
-func (h *Handler) applyToKubernetes(ctx context.Context, obj *unstructured.Unstructured) (string, error) {
+// applyToKubernetes applies the resource to Kubernetes cluster using server-side apply.
+// It returns the operation performed and the changes made to the stored resource.
+func (h *Handler) applyToKubernetes(ctx context.Context, obj *unstructured.Unstructured, opts applyOptions) (string, []FieldChange, error) {
 	// Get the Kubernetes client from services
 	k8sClient := h.services.GetKubernetesClient()
 
-	// Create a unique field manager for choreoctl
-	fieldManager := "choreoctl"
-
 	// Check if the resource already exists using shared helper
-	_, err := h.getExistingResource(ctx, obj)
+	var before map[string]any
+	existing, err := h.getExistingResource(ctx, obj)
 	if err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			return "", err
+			return "", nil, err
 		}
-		// Resource doesn't exist, create it
-		if err := k8sClient.Create(ctx, obj); err != nil {
-			return "", err
+	} else {
+		before = existing.Object
+	}
+
+	patchOptions := []client.PatchOption{client.FieldOwner(opts.fieldManager)}
+	if opts.force {
+		patchOptions = append(patchOptions, client.ForceOwnership)
+	}
+	if opts.dryRun {
+		patchOptions = append(patchOptions, client.DryRunAll)
+	}
+	obj.SetManagedFields(nil)
+	if err := k8sClient.Patch(ctx, obj, client.Apply, patchOptions...); err != nil {
+		return "", nil, err
+	}
+
+	diff := diffObjects(before, obj.Object)
+	switch {
+	case before == nil:
+		return applyCreated, diff, nil
+	case len(diff) == 0:
+		return applyUnchanged, nil, nil
+	default:
+		return applyUpdated, diff, nil
+	}
+}
+
+// applyFieldManager returns the field manager of the resources applied by the principal,
+// so that the fields set by different callers are owned by different managers
+func applyFieldManager(principal *auth.Principal) string {
+	const prefix = "openchoreo-api"
+	if principal == nil || principal.Name == "" {
+		return prefix
+	}
+	manager := prefix + ":" + principal.Name
+	if len(manager) > 128 { // Maximum length of field managers
+		manager = manager[:128]
+	}
+	return manager
+}
+
+// decodeApplyRequest decodes the resources of an apply request body.
+// It returns whether the body holds a batch of resources rather than a single one.
+func decodeApplyRequest(body io.Reader) ([]map[string]any, bool, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(body, 4096)
+	var documents []any
+	for {
+		var document any
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, false, err
+		}
+		if document != nil {
+			documents = append(documents, document)
 		}
-		return "created", nil
 	}
 
-	// Resource exists, perform server-side apply (patch)
-	patch := client.Apply
-	patchOptions := []client.PatchOption{
-		client.ForceOwnership,
-		client.FieldOwner(fieldManager),
+	var resources []map[string]any
+	for _, document := range documents {
+		items, err := applyDocumentResources(document)
+		if err != nil {
+			return nil, false, err
+		}
+		resources = append(resources, items...)
+	}
+	if len(resources) == 0 {
+		return nil, false, fmt.Errorf("no resources found")
+	}
+	if len(resources) > maxApplyResources {
+		return nil, false, fmt.Errorf("at most %d resources can be applied at once, got %d", maxApplyResources, len(resources))
 	}
 
-	if err := k8sClient.Patch(ctx, obj, patch, patchOptions...); err != nil {
-		return "", err
+	batch := len(documents) > 1 || len(resources) > 1
+	if object, ok := documents[0].(map[string]any); !ok || object["kind"] == "List" {
+		batch = true
 	}
+	return resources, batch, nil
+}
 
-	return "updated", nil
+// applyDocumentResources returns the resources of a document: the document itself, or the items of a list
+func applyDocumentResources(document any) ([]map[string]any, error) {
+	var items []any
+	switch value := document.(type) {
+	case map[string]any:
+		if value["kind"] != "List" {
+			return []map[string]any{value}, nil
+		}
+		items, _ = value["items"].([]any)
+	case []any:
+		items = value
+	default:
+		return nil, fmt.Errorf("expected a resource or a list of resources, got %T", document)
+	}
+
+	resources := make([]map[string]any, 0, len(items))
+	for i, item := range items {
+		resource, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("item %d is not a resource", i)
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// applyResult returns the result of an apply operation on the resource
+func applyResult(resourceObj map[string]any, operation, message string) ApplyResourceResponse {
+	obj := &unstructured.Unstructured{Object: resourceObj}
+	return ApplyResourceResponse{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(), // Use the actual namespace set on the object
+		Operation:  operation,
+		Error:      message,
+	}
+}
+
+// skipIfApplicable marks a result of an aborted atomic apply as skipped, unless the resource failed itself
+func skipIfApplicable(result *ApplyResourceResponse) {
+	if result.Operation == applyFailed {
+		return
+	}
+	result.Operation = applySkipped
+	result.Diff = nil
+	result.Error = atomicSkipMessage
+}
+
+// countFailed returns the number of failed results
+func countFailed(results []ApplyResourceResponse) int {
+	failed := 0
+	for _, result := range results {
+		if result.Operation == applyFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// applyErrorCode returns the error code of a Kubernetes apply error
+func applyErrorCode(err error) string {
+	switch {
+	case apierrors.IsConflict(err):
+		return services.CodeFieldConflict
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err), meta.IsNoMatchError(err):
+		return services.CodeInvalidInput
+	case apierrors.IsForbidden(err):
+		return services.CodeForbidden
+	default:
+		return services.CodeInternalError
+	}
+}
+
+// applyErrorStatus returns the HTTP status of a failed apply of a single resource
+func applyErrorStatus(code string) int {
+	switch code {
+	case services.CodeInvalidInput:
+		return http.StatusBadRequest
+	case services.CodeForbidden:
+		return http.StatusForbidden
+	case services.CodeFieldConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 // DeleteResourceResponse represents the response for delete operations
@@ -155,6 +433,11 @@ func (h *Handler) DeleteResource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	action, scope := resourceAuthorizationScope(unstructuredObj)
+	if !h.authorize(w, r, action, scope) {
+		return
+	}
+
 	// Delete the resource from Kubernetes
 	operation, err := h.deleteFromKubernetes(ctx, unstructuredObj)
 	if err != nil {
@@ -201,6 +484,26 @@ func (h *Handler) deleteFromKubernetes(ctx context.Context, obj *unstructured.Un
 	return "deleted", nil
 }
 
+// resourceAuthorizationScope returns the action and scope required to apply or delete a resource.
+// Organizations and other cluster level resources require admin rights, project resources edit rights in the project,
+// and the remaining resources of an organization admin rights in the organization.
+func resourceAuthorizationScope(obj *unstructured.Unstructured) (auth.Action, auth.Scope) {
+	if obj.GetKind() == "Organization" {
+		return auth.ActionAdmin, auth.Scope{Org: obj.GetName()}
+	}
+	org := obj.GetNamespace()
+	if org == "" {
+		return auth.ActionAdmin, auth.Scope{}
+	}
+	if obj.GetKind() == "Project" {
+		return auth.ActionEdit, auth.Scope{Org: org, Project: obj.GetName()}
+	}
+	if project, _, _ := unstructured.NestedString(obj.Object, "spec", "owner", "projectName"); project != "" {
+		return auth.ActionEdit, auth.Scope{Org: org, Project: project}
+	}
+	return auth.ActionAdmin, auth.Scope{Org: org}
+}
+
 // handleResourceNamespace handles namespace logic for both cluster-scoped and namespaced resources
 func (h *Handler) handleResourceNamespace(obj *unstructured.Unstructured, apiVersion, kind string) error {
 	// Parse the GroupVersion from apiVersion
@@ -0,0 +1,117 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldChange is a change of a field of a resource made by an apply
+type FieldChange struct {
+	// Path is the path of the field, such as spec.owner.projectName or spec.endpoints[0].port
+	Path string `json:"path"`
+	// Operation is "add", "remove" or "replace"
+	Operation string `json:"operation"`
+	Old       any    `json:"old,omitempty"`
+	New       any    `json:"new,omitempty"`
+}
+
+// ignoredMetadataFields are the metadata fields maintained by the API server, left out of diffs
+var ignoredMetadataFields = []string{"managedFields", "resourceVersion", "generation", "creationTimestamp", "uid", "selfLink"}
+
+// diffObjects returns the changes between two versions of a resource, ignoring its status and
+// the metadata maintained by the API server. A nil before is a resource that did not exist,
+// whose top level fields are all added.
+func diffObjects(before, after map[string]any) []FieldChange {
+	if before == nil {
+		before = map[string]any{}
+	}
+	var changes []FieldChange
+	diffValues("", comparableObject(before), comparableObject(after), &changes)
+	return changes
+}
+
+// comparableObject returns the parts of a resource that are compared by diffs
+func comparableObject(obj map[string]any) map[string]any {
+	result := make(map[string]any, len(obj))
+	for key, value := range obj {
+		if key != "status" {
+			result[key] = value
+		}
+	}
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		trimmed := make(map[string]any, len(metadata))
+		for key, value := range metadata {
+			trimmed[key] = value
+		}
+		for _, field := range ignoredMetadataFields {
+			delete(trimmed, field)
+		}
+		result["metadata"] = trimmed
+	}
+	return result
+}
+
+// diffValues appends the changes between two values at the path
+func diffValues(path string, before, after any, changes *[]FieldChange) {
+	switch {
+	case before == nil && after == nil:
+		return
+	case before == nil:
+		*changes = append(*changes, FieldChange{Path: path, Operation: "add", New: after})
+		return
+	case after == nil:
+		*changes = append(*changes, FieldChange{Path: path, Operation: "remove", Old: before})
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		keys := make([]string, 0, len(beforeMap)+len(afterMap))
+		for key := range beforeMap {
+			keys = append(keys, key)
+		}
+		for key := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffValues(joinFieldPath(path, key), beforeMap[key], afterMap[key], changes)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]any)
+	afterList, afterIsList := after.([]any)
+	if beforeIsList && afterIsList {
+		for i := 0; i < max(len(beforeList), len(afterList)); i++ {
+			var beforeItem, afterItem any
+			if i < len(beforeList) {
+				beforeItem = beforeList[i]
+			}
+			if i < len(afterList) {
+				afterItem = afterList[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), beforeItem, afterItem, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, FieldChange{Path: path, Operation: "replace", Old: before, New: after})
+	}
+}
+
+// joinFieldPath returns the path of a field of the object at the path
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeApplyRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantNames []string
+		wantBatch bool
+		wantErr   string
+	}{
+		{
+			name:      "single JSON resource",
+			body:      `{"apiVersion":"openchoreo.dev/v1alpha1","kind":"Project","metadata":{"name":"a"}}`,
+			wantNames: []string{"a"},
+		},
+		{
+			name:      "JSON array",
+			body:      `[{"kind":"Project","metadata":{"name":"a"}},{"kind":"Component","metadata":{"name":"b"}}]`,
+			wantNames: []string{"a", "b"},
+			wantBatch: true,
+		},
+		{
+			name:      "List with one item",
+			body:      `{"apiVersion":"v1","kind":"List","items":[{"kind":"Project","metadata":{"name":"a"}}]}`,
+			wantNames: []string{"a"},
+			wantBatch: true,
+		},
+		{
+			name:      "multi-document YAML",
+			body:      "kind: Project\nmetadata:\n  name: a\n---\n# comment only\n---\nkind: Component\nmetadata:\n  name: b\n",
+			wantNames: []string{"a", "b"},
+			wantBatch: true,
+		},
+		{
+			name:      "single YAML document",
+			body:      "kind: Project\nmetadata:\n  name: a\n",
+			wantNames: []string{"a"},
+		},
+		{
+			name:    "empty body",
+			body:    "",
+			wantErr: "no resources found",
+		},
+		{
+			name:    "scalar document",
+			body:    `"project"`,
+			wantErr: "expected a resource",
+		},
+		{
+			name:    "list item that is not a resource",
+			body:    `[{"kind":"Project"}, 42]`,
+			wantErr: "item 1 is not a resource",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, batch, err := decodeApplyRequest(strings.NewReader(tt.body))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if batch != tt.wantBatch {
+				t.Errorf("batch = %v, want %v", batch, tt.wantBatch)
+			}
+			var names []string
+			for _, resource := range resources {
+				names = append(names, resource["metadata"].(map[string]any)["name"].(string))
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestDiffObjects(t *testing.T) {
+	before := map[string]any{
+		"kind": "Component",
+		"metadata": map[string]any{
+			"name":            "api",
+			"resourceVersion": "1",
+			"labels":          map[string]any{"team": "a"},
+		},
+		"spec": map[string]any{
+			"type":      "Service",
+			"endpoints": []any{map[string]any{"port": int64(80)}},
+		},
+		"status": map[string]any{"phase": "Ready"},
+	}
+	after := map[string]any{
+		"kind": "Component",
+		"metadata": map[string]any{
+			"name":            "api",
+			"resourceVersion": "2",
+		},
+		"spec": map[string]any{
+			"type":      "Service",
+			"endpoints": []any{map[string]any{"port": int64(8080)}, map[string]any{"port": int64(9090)}},
+			"replicas":  int64(2),
+		},
+		"status": map[string]any{"phase": "Pending"},
+	}
+
+	want := []FieldChange{
+		{Path: "metadata.labels", Operation: "remove", Old: map[string]any{"team": "a"}},
+		{Path: "spec.endpoints[0].port", Operation: "replace", Old: int64(80), New: int64(8080)},
+		{Path: "spec.endpoints[1]", Operation: "add", New: map[string]any{"port": int64(9090)}},
+		{Path: "spec.replicas", Operation: "add", New: int64(2)},
+	}
+	if got := diffObjects(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffObjects() = %#v, want %#v", got, want)
+	}
+
+	if got := diffObjects(after, after); len(got) != 0 {
+		t.Errorf("diffObjects() of equal objects = %#v, want no changes", got)
+	}
+
+	created := diffObjects(nil, map[string]any{"kind": "Project", "metadata": map[string]any{"name": "p", "uid": "x"}})
+	wantCreated := []FieldChange{
+		{Path: "kind", Operation: "add", New: "Project"},
+		{Path: "metadata", Operation: "add", New: map[string]any{"name": "p"}},
+	}
+	if !reflect.DeepEqual(created, wantCreated) {
+		t.Errorf("diffObjects() of a new object = %#v, want %#v", created, wantCreated)
+	}
+}
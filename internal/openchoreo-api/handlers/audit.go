@@ -0,0 +1,160 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	defaultAuditQueryLimit = 100
+	// maxAuditedBodySize limits the request bodies that audited handlers buffer to hash them
+	maxAuditedBodySize = 10 << 20
+)
+
+// GetAuditRecords handles GET /api/v1/orgs/{orgName}/audit
+func (h *Handler) GetAuditRecords(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := audit.Filter{
+		Org:          r.PathValue("orgName"),
+		Principal:    query.Get("actor"),
+		ResourceKind: query.Get("resourceKind"),
+		ResourceName: query.Get("resourceName"),
+		Limit:        defaultAuditQueryLimit,
+	}
+	var err error
+	if filter.Since, err = parseTimeParam(query.Get("since")); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid since time, expected RFC 3339", services.CodeInvalidInput)
+		return
+	}
+	if filter.Until, err = parseTimeParam(query.Get("until")); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid until time, expected RFC 3339", services.CodeInvalidInput)
+		return
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit <= 0 {
+			writeErrorResponse(w, http.StatusBadRequest, "Invalid limit, expected a positive number", services.CodeInvalidInput)
+			return
+		}
+	}
+
+	records, err := h.auditor.Query(ctx, filter)
+	if err != nil {
+		h.logger.Error("Failed to query audit records", "error", err, "org", filter.Org)
+		writeErrorResponse(w, http.StatusInternalServerError, "Failed to query audit records", services.CodeInternalError)
+		return
+	}
+	if records == nil {
+		records = []*audit.Record{}
+	}
+
+	writeListResponse(w, records, len(records), 1, len(records))
+}
+
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// audited wraps a mutating handler with the recording of an audit record.
+// The resource kind is taken from the payload of apply and delete requests if empty.
+func (h *Handler) audited(action audit.Action, kind string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAuditedBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				writeErrorResponse(w, http.StatusRequestEntityTooLarge, "Request body too large", services.CodeInvalidInput)
+				return
+			}
+			writeErrorResponse(w, http.StatusBadRequest, "Failed to read request body", services.CodeInvalidInput)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next(recorder, r)
+
+		record := &audit.Record{
+			Source:      audit.SourceAPI,
+			Action:      action,
+			Resource:    audit.Resource{Kind: kind},
+			Org:         r.PathValue("orgName"),
+			Project:     r.PathValue("projectName"),
+			PayloadHash: audit.HashPayload(body),
+			Outcome:     audit.OutcomeOfStatus(recorder.statusCode),
+			StatusCode:  recorder.statusCode,
+			LatencyMs:   time.Since(start).Milliseconds(),
+		}
+		auditedResource(record, r, body)
+		h.auditor.Record(r.Context(), record)
+	}
+}
+
+// auditedResource completes the resource of the record from the request path and payload
+func auditedResource(record *audit.Record, r *http.Request, body []byte) {
+	var payload map[string]any
+	_ = json.Unmarshal(body, &payload) // Resources are identified by the path alone if the payload is not an object
+
+	if record.Resource.Kind == "" {
+		// Apply and delete requests carry a Kubernetes resource, or a batch of resources to apply
+		if payload == nil || payload["kind"] == "List" {
+			record.Resource = audit.Resource{Kind: "List"}
+			return
+		}
+		obj := &unstructured.Unstructured{Object: payload}
+		record.Resource = audit.Resource{Kind: obj.GetKind(), Name: obj.GetName()}
+		record.Org = obj.GetNamespace()
+		if obj.GetKind() == "Organization" {
+			record.Org = obj.GetName()
+		}
+		record.Project, _, _ = unstructured.NestedString(payload, "spec", "owner", "projectName")
+		return
+	}
+
+	// Create requests name the new resource in the payload, the others in the path
+	if name, ok := payload["name"].(string); ok && name != "" {
+		record.Resource.Name = name
+		return
+	}
+	// ComponentDeployments are named after their component and environment
+	if component, env := r.PathValue("componentName"), r.PathValue("environmentName"); component != "" && env != "" {
+		record.Resource.Name = component + "-" + env
+		return
+	}
+	for _, param := range []string{"ctName", "traitName", "workflowName", "bindingName", "componentName", "projectName"} {
+		if name := r.PathValue(param); name != "" {
+			record.Resource.Name = name
+			return
+		}
+	}
+}
+
+// statusRecorder records the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+)
+
+func TestAudited(t *testing.T) {
+	tests := []struct {
+		name         string
+		action       audit.Action
+		kind         string
+		pattern      string
+		path         string
+		body         string
+		status       int
+		wantResource audit.Resource
+		wantOrg      string
+		wantProject  string
+		wantOutcome  audit.Outcome
+	}{
+		{
+			name:         "create names the resource in the payload",
+			action:       audit.ActionCreate,
+			kind:         "Project",
+			pattern:      "POST /api/v1/orgs/{orgName}/projects",
+			path:         "/api/v1/orgs/acme/projects",
+			body:         `{"name":"shop"}`,
+			status:       http.StatusCreated,
+			wantResource: audit.Resource{Kind: "Project", Name: "shop"},
+			wantOrg:      "acme",
+			wantOutcome:  audit.OutcomeSuccess,
+		},
+		{
+			name:         "deployment named after its component and environment",
+			action:       audit.ActionPromote,
+			kind:         "ComponentDeployment",
+			pattern:      "POST /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/deployment",
+			path:         "/api/v1/orgs/acme/projects/shop/components/cart/environments/staging/deployment",
+			status:       http.StatusOK,
+			wantResource: audit.Resource{Kind: "ComponentDeployment", Name: "cart-staging"},
+			wantOrg:      "acme",
+			wantProject:  "shop",
+			wantOutcome:  audit.OutcomeSuccess,
+		},
+		{
+			name:         "resource named in the path",
+			action:       audit.ActionDelete,
+			kind:         "Component",
+			pattern:      "DELETE /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}",
+			path:         "/api/v1/orgs/acme/projects/shop/components/cart",
+			status:       http.StatusForbidden,
+			wantResource: audit.Resource{Kind: "Component", Name: "cart"},
+			wantOrg:      "acme",
+			wantProject:  "shop",
+			wantOutcome:  audit.OutcomeDenied,
+		},
+		{
+			name:         "applied resource",
+			action:       audit.ActionApply,
+			pattern:      "POST /api/v1/apply",
+			path:         "/api/v1/apply",
+			body:         `{"kind":"Component","metadata":{"name":"cart","namespace":"acme"},"spec":{"owner":{"projectName":"shop"}}}`,
+			status:       http.StatusOK,
+			wantResource: audit.Resource{Kind: "Component", Name: "cart"},
+			wantOrg:      "acme",
+			wantProject:  "shop",
+			wantOutcome:  audit.OutcomeSuccess,
+		},
+		{
+			name:         "applied organization",
+			action:       audit.ActionApply,
+			pattern:      "POST /api/v1/apply",
+			path:         "/api/v1/apply",
+			body:         `{"kind":"Organization","metadata":{"name":"acme"}}`,
+			status:       http.StatusOK,
+			wantResource: audit.Resource{Kind: "Organization", Name: "acme"},
+			wantOrg:      "acme",
+			wantOutcome:  audit.OutcomeSuccess,
+		},
+		{
+			name:         "applied batch",
+			action:       audit.ActionApply,
+			pattern:      "POST /api/v1/apply",
+			path:         "/api/v1/apply",
+			body:         "kind: Project\n---\nkind: Component\n",
+			status:       http.StatusInternalServerError,
+			wantResource: audit.Resource{Kind: "List"},
+			wantOutcome:  audit.OutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := audit.NewMemoryStore(10)
+			h := &Handler{auditor: audit.New(store, slog.New(slog.NewTextHandler(io.Discard, nil)), store)}
+
+			var handledBody string
+			mux := http.NewServeMux()
+			mux.HandleFunc(tt.pattern, h.audited(tt.action, tt.kind, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				handledBody = string(body)
+				w.WriteHeader(tt.status)
+			}))
+			method, _, _ := strings.Cut(tt.pattern, " ")
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(method, tt.path, strings.NewReader(tt.body)))
+
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if handledBody != tt.body {
+				t.Errorf("handler read body %q, want %q", handledBody, tt.body)
+			}
+			records, err := store.Query(context.Background(), audit.Filter{})
+			if err != nil || len(records) != 1 {
+				t.Fatalf("Query() = %v, %v, want one record", records, err)
+			}
+			r := records[0]
+			if r.Action != tt.action || r.Source != audit.SourceAPI || r.Resource != tt.wantResource ||
+				r.Org != tt.wantOrg || r.Project != tt.wantProject {
+				t.Errorf("record = %+v, want %s of %+v in %s/%s", r, tt.action, tt.wantResource, tt.wantOrg, tt.wantProject)
+			}
+			if r.Outcome != tt.wantOutcome || r.StatusCode != tt.status {
+				t.Errorf("outcome = %s (%d), want %s (%d)", r.Outcome, r.StatusCode, tt.wantOutcome, tt.status)
+			}
+			if r.PayloadHash != audit.HashPayload([]byte(tt.body)) {
+				t.Errorf("payload hash = %q, want the hash of the body", r.PayloadHash)
+			}
+			if r.Principal != "anonymous" {
+				t.Errorf("principal = %q, want anonymous", r.Principal)
+			}
+		})
+	}
+}
+
+func TestAuditedRejectsLargeBodies(t *testing.T) {
+	store := audit.NewMemoryStore(10)
+	h := &Handler{auditor: audit.New(store, slog.New(slog.NewTextHandler(io.Discard, nil)), store)}
+	handled := false
+	handler := h.audited(audit.ActionApply, "", func(http.ResponseWriter, *http.Request) { handled = true })
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/api/v1/apply", strings.NewReader(strings.Repeat("x", maxAuditedBodySize+1))))
+	if rec.Code != http.StatusRequestEntityTooLarge || handled {
+		t.Errorf("status = %d, handled = %v, want %d without handling", rec.Code, handled, http.StatusRequestEntityTooLarge)
+	}
+}
@@ -4,9 +4,11 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 // There is only one buildplane per org
@@ -48,14 +50,24 @@ func (h *Handler) ListBuildPlanes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list build planes
-	buildPlanes, err := h.services.BuildPlaneService.ListBuildPlanes(ctx, orgName)
+	page, err := h.services.BuildPlaneService.ListBuildPlanes(ctx, orgName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		log.Error("Failed to list build planes", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list build planes", "INTERNAL_ERROR")
 		return
 	}
 
 	// Success response with build planes list
-	writeSuccessResponse(w, http.StatusOK, buildPlanes)
+	writeListPage(w, page, opts)
 }
@@ -4,9 +4,11 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 func (h *Handler) ListBuildTemplates(w http.ResponseWriter, r *http.Request) {
@@ -21,16 +23,26 @@ func (h *Handler) ListBuildTemplates(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list build templates
-	templates, err := h.services.BuildService.ListBuildTemplates(ctx, orgName)
+	page, err := h.services.BuildService.ListBuildTemplates(ctx, orgName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		log.Error("Failed to list build templates", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list build templates", "INTERNAL_ERROR")
 		return
 	}
 
 	// Success response
-	writeListResponse(w, templates, len(templates), 1, len(templates))
+	writeListPage(w, page, opts)
 }
 
 func (h *Handler) TriggerBuild(w http.ResponseWriter, r *http.Request) {
@@ -101,14 +113,24 @@ func (h *Handler) ListBuilds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list builds
-	builds, err := h.services.BuildService.ListBuilds(ctx, orgName, projectName, componentName)
+	page, err := h.services.BuildService.ListBuilds(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		log.Error("Failed to list builds", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list builds", "INTERNAL_ERROR")
 		return
 	}
 
 	// Success response
-	writeListResponse(w, builds, len(builds), 1, len(builds))
+	writeListPage(w, page, opts)
 }
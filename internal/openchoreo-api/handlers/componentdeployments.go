@@ -0,0 +1,353 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+func (h *Handler) ListComponentDeployments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListComponentDeployments handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.ComponentDeploymentService.ListComponentDeployments(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to list ComponentDeployments", "error", err)
+			return
+		}
+		logger.Error("Failed to list ComponentDeployments", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeListPage(w, page, opts)
+}
+
+func (h *Handler) GetComponentDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentDeployment handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	cd, err := h.services.ComponentDeploymentService.GetComponentDeployment(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to get ComponentDeployment", "error", err)
+			return
+		}
+		logger.Error("Failed to get ComponentDeployment", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, cd.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, cd)
+}
+
+func (h *Handler) PutComponentDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("PutComponentDeployment handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	// Parse request body
+	var req models.ComponentDeploymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	attributeFreezeOverride(r, req.FreezeOverride)
+	req.ResourceVersion = precondition
+
+	cd, created, err := h.services.ComponentDeploymentService.PutComponentDeployment(ctx, orgName, projectName, componentName, environmentName, &req)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) || writeResourceWriteError(w, err, precondition) {
+			logger.Warn("ComponentDeployment update was rejected", "environment", environmentName, "error", err)
+			return
+		}
+		logger.Error("Failed to write ComponentDeployment", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentDeployment written successfully", "org", orgName, "project", projectName,
+		"component", componentName, "environment", environmentName, "created", created)
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	setETag(w, cd.ResourceVersion)
+	writeSuccessResponse(w, status, cd)
+}
+
+func (h *Handler) DeleteComponentDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DeleteComponentDeployment handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	query := r.URL.Query()
+	precondition, err := resourceVersionPrecondition(r, query.Get("resourceVersion"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	var override *models.FreezeOverride
+	if justification := strings.TrimSpace(query.Get("freezeOverride")); justification != "" {
+		override = &models.FreezeOverride{Justification: justification}
+	}
+	attributeFreezeOverride(r, override)
+
+	err = h.services.ComponentDeploymentService.DeleteComponentDeployment(ctx, orgName, projectName, componentName, environmentName,
+		precondition, override)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) || writeResourceWriteError(w, err, precondition) {
+			logger.Warn("ComponentDeployment delete was rejected", "environment", environmentName, "error", err)
+			return
+		}
+		logger.Error("Failed to delete ComponentDeployment", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentDeployment deleted successfully", "org", orgName, "project", projectName,
+		"component", componentName, "environment", environmentName)
+	writeSuccessResponse[any](w, http.StatusOK, nil)
+}
+
+// RollbackComponentDeployment aborts the progressive rollout in progress of a ComponentDeployment, restoring its
+// stable revision. A completed rollout is not rolled back.
+func (h *Handler) RollbackComponentDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("RollbackComponentDeployment handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	precondition, err := resourceVersionPrecondition(r, "")
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	cd, err := h.services.ComponentDeploymentService.RollbackComponentDeployment(ctx, orgName, projectName, componentName,
+		environmentName, precondition)
+	if err != nil {
+		if errors.Is(err, services.ErrNoRolloutInProgress) {
+			logger.Warn("No rollout to roll back", "environment", environmentName)
+			writeErrorResponse(w, http.StatusConflict, "No rollout is in progress", services.CodeNoRolloutInProgress)
+			return
+		}
+		if writeComponentDeploymentError(w, err) || writeResourceWriteError(w, err, precondition) {
+			logger.Warn("ComponentDeployment rollback was rejected", "environment", environmentName, "error", err)
+			return
+		}
+		logger.Error("Failed to roll back ComponentDeployment", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentDeployment rolled back successfully", "org", orgName, "project", projectName,
+		"component", componentName, "environment", environmentName)
+	setETag(w, cd.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, cd)
+}
+
+func (h *Handler) ListComponentEnvSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListComponentEnvSnapshots handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.ComponentDeploymentService.ListComponentEnvSnapshots(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to list ComponentEnvSnapshots", "error", err)
+			return
+		}
+		logger.Error("Failed to list ComponentEnvSnapshots", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeListPage(w, page, opts)
+}
+
+func (h *Handler) GetComponentEnvSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentEnvSnapshot handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	snapshot, err := h.services.ComponentDeploymentService.GetComponentEnvSnapshot(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to get ComponentEnvSnapshot", "error", err)
+			return
+		}
+		logger.Error("Failed to get ComponentEnvSnapshot", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, snapshot.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, snapshot)
+}
+
+func (h *Handler) ListReleases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListReleases handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.ComponentDeploymentService.ListReleases(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to list Releases", "error", err)
+			return
+		}
+		logger.Error("Failed to list Releases", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeListPage(w, page, opts)
+}
+
+func (h *Handler) GetRelease(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetRelease handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	environmentName := r.PathValue("environmentName")
+
+	release, err := h.services.ComponentDeploymentService.GetRelease(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if writeComponentDeploymentError(w, err) {
+			logger.Warn("Failed to get Release", "error", err)
+			return
+		}
+		logger.Error("Failed to get Release", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, release.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, release)
+}
+
+// componentPath returns the organization, project and component names of the request path.
+// It writes a bad request response and returns false when one is missing.
+func componentPath(w http.ResponseWriter, r *http.Request) (string, string, string, bool) {
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name and component name are required", services.CodeInvalidInput)
+		return "", "", "", false
+	}
+	return orgName, projectName, componentName, true
+}
+
+// writeComponentDeploymentError writes the not found and invalid list options responses of the ComponentDeployment,
+// ComponentEnvSnapshot and Release endpoints. It returns false for other errors.
+func writeComponentDeploymentError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, services.ErrInvalidListOptions):
+		writeListOptionsError(w, err)
+	case errors.Is(err, services.ErrProjectNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrComponentNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+	case errors.Is(err, services.ErrEnvironmentNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+	case errors.Is(err, services.ErrComponentDeploymentNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "ComponentDeployment not found", services.CodeComponentDeploymentNotFound)
+	case errors.Is(err, services.ErrComponentEnvSnapshotNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "ComponentEnvSnapshot not found", services.CodeComponentEnvSnapshotNotFound)
+	case errors.Is(err, services.ErrReleaseNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Release not found", services.CodeReleaseNotFound)
+	default:
+		return false
+	}
+	return true
+}
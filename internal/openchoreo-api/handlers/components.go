@@ -74,9 +74,19 @@ func (h *Handler) ListComponents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list components
-	components, err := h.services.ComponentService.ListComponents(ctx, orgName, projectName)
+	page, err := h.services.ComponentService.ListComponents(ctx, orgName, projectName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		if errors.Is(err, services.ErrProjectNotFound) {
 			logger.Warn("Project not found", "org", orgName, "project", projectName)
 			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
@@ -87,13 +97,8 @@ func (h *Handler) ListComponents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to slice of values for the list response
-	componentValues := make([]*models.ComponentResponse, len(components))
-	copy(componentValues, components)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed components successfully", "org", orgName, "project", projectName, "count", len(components))
-	writeListResponse(w, componentValues, len(components), 1, len(components))
+	logger.Debug("Listed components successfully", "org", orgName, "project", projectName, "count", len(page.Items))
+	writeListPage(w, page, opts)
 }
 
 func (h *Handler) GetComponent(w http.ResponseWriter, r *http.Request) {
@@ -214,6 +219,13 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 	// Sanitize input
 	req.Sanitize()
 
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), "INVALID_REQUEST")
+		return
+	}
+	attributeFreezeOverride(r, req.FreezeOverride)
+
 	promoteReq := &services.PromoteComponentPayload{
 		PromoteComponentRequest: req,
 		ComponentName:           componentName,
@@ -249,15 +261,37 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Source binding not found", services.CodeBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Target environment not found", "org", orgName, "environment", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentFrozen) {
+			logger.Warn("Target environment is frozen", "org", orgName, "environment", req.TargetEnvironment, "error", err)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeEnvironmentFrozen)
+			return
+		}
 		logger.Error("Failed to promote component", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
+	// Warn about dependencies of the component that are not deployed in the target environment
+	var warnings []string
+	unmet, err := h.services.DependencyService.GetUnmetDependencies(ctx, orgName, projectName, componentName, req.TargetEnvironment)
+	if err != nil {
+		logger.Warn("Failed to check component dependencies", "error", err)
+	} else if len(unmet) > 0 {
+		warnings = dependencyWarnings(unmet)
+		logger.Warn("Promoted component has dependencies that are not deployed in the target environment",
+			"org", orgName, "project", projectName, "component", componentName, "environment", req.TargetEnvironment,
+			"warnings", warnings)
+	}
+
 	// Success response
 	logger.Debug("Component promoted successfully", "org", orgName, "project", projectName, "component", componentName,
 		"source", req.SourceEnvironment, "target", req.TargetEnvironment, "bindingsCount", len(bindings))
-	writeListResponse(w, bindings, len(bindings), 1, len(bindings))
+	writeListResponseWithWarnings(w, bindings, warnings)
 }
 
 func (h *Handler) UpdateComponentBinding(w http.ResponseWriter, r *http.Request) {
@@ -291,6 +325,7 @@ func (h *Handler) UpdateComponentBinding(w http.ResponseWriter, r *http.Request)
 		writeErrorResponse(w, http.StatusBadRequest, err.Error(), "INVALID_REQUEST")
 		return
 	}
+	attributeFreezeOverride(r, req.FreezeOverride)
 
 	// Call service to update component binding
 	binding, err := h.services.ComponentService.UpdateComponentBinding(ctx, orgName, projectName, componentName, bindingName, &req)
@@ -310,6 +345,16 @@ func (h *Handler) UpdateComponentBinding(w http.ResponseWriter, r *http.Request)
 			writeErrorResponse(w, http.StatusNotFound, "Binding not found", services.CodeBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Binding environment not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentFrozen) {
+			logger.Warn("Binding environment is frozen", "org", orgName, "binding", bindingName, "error", err)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeEnvironmentFrozen)
+			return
+		}
 		logger.Error("Failed to update component binding", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -408,3 +453,93 @@ func (h *Handler) GetBuildObserverURL(w http.ResponseWriter, r *http.Request) {
 	logger.Debug("Retrieved build observer URL successfully", "org", orgName, "project", projectName, "component", componentName)
 	writeSuccessResponse(w, http.StatusOK, observerResponse)
 }
+
+// UpdateComponentTraits replaces the traits of a component
+func (h *Handler) UpdateComponentTraits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("UpdateComponentTraits handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.ComponentTraitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	req.ResourceVersion = precondition
+
+	component, err := h.services.ComponentService.UpdateComponentTraits(ctx, orgName, projectName, componentName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrTraitNotFound) {
+			logger.Warn("Trait not found", "org", orgName, "error", err)
+			writeErrorResponse(w, http.StatusNotFound, err.Error(), services.CodeTraitNotFound)
+			return
+		}
+		if writeComponentDeploymentError(w, err) || writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Component traits update was rejected", "component", componentName, "error", err)
+			return
+		}
+		logger.Error("Failed to update component traits", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Component traits updated successfully", "org", orgName, "project", projectName,
+		"component", componentName, "traits", len(req.Traits))
+	setETag(w, component.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, component)
+}
+
+// DeleteComponent deletes a component and undeploys it from all environments
+func (h *Handler) DeleteComponent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DeleteComponent handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	precondition, err := resourceVersionPrecondition(r, query.Get("resourceVersion"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	var override *models.FreezeOverride
+	if justification := strings.TrimSpace(query.Get("freezeOverride")); justification != "" {
+		override = &models.FreezeOverride{Justification: justification}
+	}
+	attributeFreezeOverride(r, override)
+
+	if err := h.services.ComponentService.DeleteComponent(ctx, orgName, projectName, componentName, precondition, override); err != nil {
+		if writeComponentDeploymentError(w, err) || writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Component delete was rejected", "component", componentName, "error", err)
+			return
+		}
+		logger.Error("Failed to delete component", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Component deleted successfully", "org", orgName, "project", projectName, "component", componentName)
+	writeSuccessResponse[any](w, http.StatusOK, nil)
+}
@@ -4,6 +4,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -25,21 +26,26 @@ func (h *Handler) ListComponentTypes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list ComponentTypes
-	cts, err := h.services.ComponentTypeService.ListComponentTypes(ctx, orgName)
+	page, err := h.services.ComponentTypeService.ListComponentTypes(ctx, orgName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		logger.Error("Failed to list ComponentTypes", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	// Convert to slice of values for the list response
-	ctValues := make([]*models.ComponentTypeResponse, len(cts))
-	copy(ctValues, cts)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed ComponentTypes successfully", "org", orgName, "count", len(cts))
-	writeListResponse(w, ctValues, len(cts), 1, len(cts))
+	logger.Debug("Listed ComponentTypes successfully", "org", orgName, "count", len(page.Items))
+	writeListPage(w, page, opts)
 }
 
 func (h *Handler) GetComponentTypeSchema(w http.ResponseWriter, r *http.Request) {
@@ -73,3 +79,189 @@ func (h *Handler) GetComponentTypeSchema(w http.ResponseWriter, r *http.Request)
 	logger.Debug("Retrieved ComponentType schema successfully", "org", orgName, "name", ctName)
 	writeSuccessResponse(w, http.StatusOK, schema)
 }
+
+func (h *Handler) GetComponentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentType handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("ctName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and ComponentType name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and ComponentType name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to get ComponentType
+	resource, err := h.services.ComponentTypeService.GetComponentType(ctx, orgName, name)
+	if err != nil {
+		if errors.Is(err, services.ErrComponentTypeNotFound) {
+			logger.Warn("ComponentType not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "ComponentType not found", services.CodeComponentTypeNotFound)
+			return
+		}
+		logger.Error("Failed to get ComponentType", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) CreateComponentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreateComponentType handler called")
+
+	// Extract organization name from URL path
+	orgName := r.PathValue("orgName")
+	if orgName == "" {
+		logger.Warn("Organization name is required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.ComponentTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to create ComponentType
+	resource, err := h.services.ComponentTypeService.CreateComponentType(ctx, orgName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrComponentTypeAlreadyExists) {
+			logger.Warn("ComponentType already exists", "org", orgName, "name", req.Name)
+			writeErrorResponse(w, http.StatusConflict, "ComponentType already exists", services.CodeComponentTypeExists)
+			return
+		}
+		if writeResourceWriteError(w, err, "") {
+			logger.Warn("ComponentType was rejected", "org", orgName, "name", req.Name, "error", err)
+			return
+		}
+		logger.Error("Failed to create ComponentType", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentType created successfully", "org", orgName, "name", resource.Name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusCreated, resource)
+}
+
+func (h *Handler) UpdateComponentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("UpdateComponentType handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("ctName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and ComponentType name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and ComponentType name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.ComponentTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if req.Name == "" {
+		req.Name = name
+	}
+	if req.Name != name {
+		writeErrorResponse(w, http.StatusBadRequest, "The name of the request does not match the path", services.CodeInvalidInput)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	req.ResourceVersion = precondition
+
+	// Call service to update ComponentType
+	resource, err := h.services.ComponentTypeService.UpdateComponentType(ctx, orgName, name, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrComponentTypeNotFound) {
+			logger.Warn("ComponentType not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "ComponentType not found", services.CodeComponentTypeNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("ComponentType update was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to update ComponentType", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentType updated successfully", "org", orgName, "name", name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) DeleteComponentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DeleteComponentType handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("ctName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and ComponentType name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and ComponentType name are required", services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, r.URL.Query().Get("resourceVersion"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to delete ComponentType
+	if err := h.services.ComponentTypeService.DeleteComponentType(ctx, orgName, name, precondition); err != nil {
+		if errors.Is(err, services.ErrComponentTypeNotFound) {
+			logger.Warn("ComponentType not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "ComponentType not found", services.CodeComponentTypeNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("ComponentType delete was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to delete ComponentType", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("ComponentType deleted successfully", "org", orgName, "name", name)
+	writeSuccessResponse[any](w, http.StatusOK, nil)
+}
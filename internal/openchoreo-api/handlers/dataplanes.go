@@ -21,14 +21,24 @@ func (h *Handler) ListDataPlanes(w http.ResponseWriter, r *http.Request) {
 		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", services.CodeInvalidInput)
 		return
 	}
-	dataplanes, err := h.services.DataPlaneService.ListDataPlanes(ctx, orgName)
+	opts, err := parseListOptions(r)
 	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.DataPlaneService.ListDataPlanes(ctx, orgName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		h.logger.Error("Failed to list dataplanes", "error", err, "org", orgName)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list dataplanes", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, dataplanes, len(dataplanes), 1, len(dataplanes))
+	writeListPage(w, page, opts)
 }
 
 // GetDataPlane handles GET /api/v1/orgs/{orgName}/dataplanes/{dpName}
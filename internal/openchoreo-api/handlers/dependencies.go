@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/dependency"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// GetOrganizationDependencies returns the dependency graph of all components of an organization
+func (h *Handler) GetOrganizationDependencies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetOrganizationDependencies handler called")
+
+	orgName := r.PathValue("orgName")
+	if orgName == "" {
+		logger.Warn("Organization name is required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", "INVALID_PARAMS")
+		return
+	}
+
+	format, err := dependency.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	environment := r.URL.Query().Get("environment")
+
+	graph, err := h.services.DependencyService.GetOrganizationDependencyGraph(ctx, orgName, environment)
+	if err != nil {
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		logger.Error("Failed to get dependency graph", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeDependencyGraph(w, graph, format)
+}
+
+// GetProjectDependencies returns the dependency graph of the components of a project
+func (h *Handler) GetProjectDependencies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetProjectDependencies handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	if orgName == "" || projectName == "" {
+		logger.Warn("Organization name and project name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and project name are required", "INVALID_PARAMS")
+		return
+	}
+
+	format, err := dependency.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	environment := r.URL.Query().Get("environment")
+
+	graph, err := h.services.DependencyService.GetProjectDependencyGraph(ctx, orgName, projectName, environment)
+	if err != nil {
+		if errors.Is(err, services.ErrProjectNotFound) {
+			logger.Warn("Project not found", "org", orgName, "project", projectName)
+			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		logger.Error("Failed to get dependency graph", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeDependencyGraph(w, graph, format)
+}
+
+// writeDependencyGraph writes the graph in the requested format.
+// DOT and Mermaid are written as plain text so that they can be piped to the rendering tools.
+func writeDependencyGraph(w http.ResponseWriter, graph *dependency.Graph, format dependency.Format) {
+	switch format {
+	case dependency.FormatDOT:
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(graph.DOT()))
+	case dependency.FormatMermaid:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(graph.Mermaid()))
+	default:
+		writeSuccessResponse(w, http.StatusOK, graph)
+	}
+}
+
+// dependencyWarnings describes the dependencies of a component that are not deployed.
+func dependencyWarnings(edges []dependency.Edge) []string {
+	warnings := make([]string, 0, len(edges))
+	for _, e := range edges {
+		warnings = append(warnings, fmt.Sprintf("connection %q: %s", e.Connection, e.Message))
+	}
+	return warnings
+}
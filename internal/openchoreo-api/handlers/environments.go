@@ -22,14 +22,24 @@ func (h *Handler) ListEnvironments(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	environments, err := h.services.EnvironmentService.ListEnvironments(ctx, orgName)
+	opts, err := parseListOptions(r)
 	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.EnvironmentService.ListEnvironments(ctx, orgName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		h.logger.Error("Failed to list environments", "error", err, "org", orgName)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list environments", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, environments, len(environments), 1, len(environments))
+	writeListPage(w, page, opts)
 }
 
 // GetEnvironment handles GET /api/v1/orgs/{orgName}/environments/{envName}
@@ -0,0 +1,139 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// eventHeartbeatInterval is the interval of the comments sent to keep idle event streams open through proxies
+const eventHeartbeatInterval = 15 * time.Second
+
+// GetProjectEvents returns the status of the components of a project and the builds, releases and
+// deployments that belong to them. With watch=true, status changes are streamed as Server-Sent Events.
+func (h *Handler) GetProjectEvents(w http.ResponseWriter, r *http.Request) {
+	logger := logger.GetLogger(r.Context())
+	logger.Debug("GetProjectEvents handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	if orgName == "" || projectName == "" {
+		logger.Warn("Organization name and project name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and project name are required", "INVALID_PARAMS")
+		return
+	}
+
+	h.serveEvents(w, r, services.EventScope{OrgName: orgName, ProjectName: projectName})
+}
+
+// GetComponentEvents returns the status of a component and the builds, releases and deployments that
+// belong to it. With watch=true, status changes are streamed as Server-Sent Events.
+func (h *Handler) GetComponentEvents(w http.ResponseWriter, r *http.Request) {
+	logger := logger.GetLogger(r.Context())
+	logger.Debug("GetComponentEvents handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization, project and component names are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization, project and component names are required", "INVALID_PARAMS")
+		return
+	}
+
+	h.serveEvents(w, r, services.EventScope{OrgName: orgName, ProjectName: projectName, ComponentName: componentName})
+}
+
+// serveEvents lists the events of the scope, or streams them when the request watches
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request, scope services.EventScope) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+
+	if kinds := r.URL.Query().Get("kinds"); kinds != "" {
+		scope.Kinds = strings.Split(kinds, ",")
+	}
+
+	if r.URL.Query().Get("watch") != "true" {
+		events, err := h.services.EventService.ListEvents(ctx, scope)
+		if err != nil {
+			h.writeEventsError(w, r, err)
+			return
+		}
+		writeListResponse(w, events, len(events), 1, len(events))
+		return
+	}
+
+	events, err := h.services.EventService.WatchEvents(ctx, scope)
+	if err != nil {
+		h.writeEventsError(w, r, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// Streams are long-lived, so the write timeout of the server does not apply to them
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn("Failed to clear the write deadline of the event stream", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		logger.Error("Event stream is not supported by the response writer", "error", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				logger.Error("Failed to encode event", "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResourceVersion, event.Kind, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (h *Handler) writeEventsError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidEventKind):
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+	case errors.Is(err, services.ErrProjectNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrComponentNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+	default:
+		logger.GetLogger(r.Context()).Error("Failed to get events", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+	}
+}
@@ -8,123 +8,188 @@ import (
 	"os"
 	"strings"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/exp/slog"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/health"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/mcphandlers"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/idempotency"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/ratelimit"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/pkg/mcp"
 )
 
 // Handler holds the services and provides HTTP handlers
 type Handler struct {
-	services *services.Services
-	logger   *slog.Logger
+	services    *services.Services
+	auth        *auth.Auth
+	auditor     *audit.Auditor
+	idempotency *idempotency.Idempotency
+	rateLimiter *ratelimit.Limiter
+	health      *health.Registry
+	logger      *slog.Logger
+
+	// openAPIDocument is the encoded OpenAPI document of the routes, set by Routes
+	openAPIDocument []byte
 }
 
-// New creates a new Handler instance
-func New(services *services.Services, logger *slog.Logger) *Handler {
+// New creates a new Handler instance. Requests are not authenticated if auth is nil,
+// mutations are not audited if auditor is nil, idempotency keys are ignored if idempotency is nil,
+// the rate of requests is not limited if rateLimiter is nil, and the probes check nothing if health is nil.
+func New(services *services.Services, auth *auth.Auth, auditor *audit.Auditor, idempotency *idempotency.Idempotency,
+	rateLimiter *ratelimit.Limiter, health *health.Registry, logger *slog.Logger) *Handler {
 	return &Handler{
-		services: services,
-		logger:   logger,
+		services:    services,
+		auth:        auth,
+		auditor:     auditor,
+		idempotency: idempotency,
+		rateLimiter: rateLimiter,
+		health:      health,
+		logger:      logger,
 	}
 }
 
 // Routes sets up all HTTP routes and returns the configured handler
 func (h *Handler) Routes() http.Handler {
-	mux := http.NewServeMux()
+	mux := newRouter()
 
-	// Health endpoints
+	// Health endpoints: liveness, readiness and startup probes
 	mux.HandleFunc("GET /health", h.Health)
 	mux.HandleFunc("GET /ready", h.Ready)
+	mux.HandleFunc("GET /startup", h.Startup)
+
+	// Prometheus metrics of the server
+	mux.Handle("GET /metrics", promhttp.Handler())
 
 	// API versioning
-	v1 := "/api/v1"
+	v1 := apiPrefix
+
+	// OpenAPI document of the API, built from the routes registered here
+	mux.HandleFunc("GET "+openAPIPath, h.GetOpenAPI)
 
 	// Apply endpoint (similar to kubectl apply)
-	mux.HandleFunc("POST "+v1+"/apply", h.ApplyResource)
+	mux.HandleFunc("POST "+v1+"/apply", h.audited(audit.ActionApply, "", h.ApplyResource))
 
 	// Delete endpoint (similar to kubectl delete)
-	mux.HandleFunc("DELETE "+v1+"/delete", h.DeleteResource)
+	mux.HandleFunc("DELETE "+v1+"/delete", h.audited(audit.ActionDelete, "", h.DeleteResource))
 
 	// Organization endpoints
 	mux.HandleFunc("GET "+v1+"/orgs", h.ListOrganizations)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}", h.GetOrganization)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}", h.authorized(auth.ActionView, h.GetOrganization))
+
+	// Audit endpoints
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/audit", h.authorized(auth.ActionAdmin, h.GetAuditRecords))
+
+	// Dependency graph endpoints
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/dependencies", h.authorized(auth.ActionView, h.GetOrganizationDependencies))
 
 	// DataPlane endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes", h.ListDataPlanes)
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/dataplanes", h.CreateDataPlane)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes/{dpName}", h.GetDataPlane)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes", h.authorized(auth.ActionView, h.ListDataPlanes))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/dataplanes", h.audited(audit.ActionCreate, "DataPlane", h.authorized(auth.ActionAdmin, h.CreateDataPlane)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes/{dpName}", h.authorized(auth.ActionView, h.GetDataPlane))
 
 	// Environment endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/environments", h.ListEnvironments)
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/environments", h.CreateEnvironment)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/environments/{envName}", h.GetEnvironment)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/environments", h.authorized(auth.ActionView, h.ListEnvironments))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/environments", h.audited(audit.ActionCreate, "Environment", h.authorized(auth.ActionAdmin, h.CreateEnvironment)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/environments/{envName}", h.authorized(auth.ActionView, h.GetEnvironment))
 
 	// BuildPlane endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/buildplanes", h.ListBuildPlanes)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/build-templates", h.ListBuildTemplates)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/buildplanes", h.authorized(auth.ActionView, h.ListBuildPlanes))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/build-templates", h.authorized(auth.ActionView, h.ListBuildTemplates))
 
 	// ComponentType endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types", h.ListComponentTypes)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types/{ctName}/schema", h.GetComponentTypeSchema)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types", h.authorized(auth.ActionView, h.ListComponentTypes))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/component-types", h.audited(audit.ActionCreate, "ComponentType", h.authorized(auth.ActionAdmin, h.CreateComponentType)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types/{ctName}", h.authorized(auth.ActionView, h.GetComponentType))
+	mux.HandleFunc("PUT "+v1+"/orgs/{orgName}/component-types/{ctName}", h.audited(audit.ActionUpdate, "ComponentType", h.authorized(auth.ActionAdmin, h.UpdateComponentType)))
+	mux.HandleFunc("DELETE "+v1+"/orgs/{orgName}/component-types/{ctName}", h.audited(audit.ActionDelete, "ComponentType", h.authorized(auth.ActionAdmin, h.DeleteComponentType)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types/{ctName}/schema", h.authorized(auth.ActionView, h.GetComponentTypeSchema))
 
 	// Workflow endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows", h.ListWorkflows)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows/{workflowName}/schema", h.GetWorkflowSchema)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows", h.authorized(auth.ActionView, h.ListWorkflows))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/workflows", h.audited(audit.ActionCreate, "Workflow", h.authorized(auth.ActionAdmin, h.CreateWorkflow)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows/{workflowName}", h.authorized(auth.ActionView, h.GetWorkflow))
+	mux.HandleFunc("PUT "+v1+"/orgs/{orgName}/workflows/{workflowName}", h.audited(audit.ActionUpdate, "Workflow", h.authorized(auth.ActionAdmin, h.UpdateWorkflow)))
+	mux.HandleFunc("DELETE "+v1+"/orgs/{orgName}/workflows/{workflowName}", h.audited(audit.ActionDelete, "Workflow", h.authorized(auth.ActionAdmin, h.DeleteWorkflow)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows/{workflowName}/schema", h.authorized(auth.ActionView, h.GetWorkflowSchema))
 
 	// Trait endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/traits", h.ListTraits)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/traits/{traitName}/schema", h.GetTraitSchema)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/traits", h.authorized(auth.ActionView, h.ListTraits))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/traits", h.audited(audit.ActionCreate, "Trait", h.authorized(auth.ActionAdmin, h.CreateTrait)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/traits/{traitName}", h.authorized(auth.ActionView, h.GetTrait))
+	mux.HandleFunc("PUT "+v1+"/orgs/{orgName}/traits/{traitName}", h.audited(audit.ActionUpdate, "Trait", h.authorized(auth.ActionAdmin, h.UpdateTrait)))
+	mux.HandleFunc("DELETE "+v1+"/orgs/{orgName}/traits/{traitName}", h.audited(audit.ActionDelete, "Trait", h.authorized(auth.ActionAdmin, h.DeleteTrait)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/traits/{traitName}/schema", h.authorized(auth.ActionView, h.GetTraitSchema))
 
 	// Project endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects", h.ListProjects)
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects", h.CreateProject)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}", h.GetProject)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/deployment-pipeline", h.GetProjectDeploymentPipeline)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects", h.authorized(auth.ActionView, h.ListProjects))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects", h.audited(audit.ActionCreate, "Project", h.authorized(auth.ActionAdmin, h.CreateProject)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}", h.authorized(auth.ActionView, h.GetProject))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/deployment-pipeline", h.authorized(auth.ActionView, h.GetProjectDeploymentPipeline))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/dependencies", h.authorized(auth.ActionView, h.GetProjectDependencies))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/events", h.authorized(auth.ActionView, h.GetProjectEvents))
 
 	// Component endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.ListComponents)
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.CreateComponent)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.GetComponent)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.authorized(auth.ActionView, h.ListComponents))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.audited(audit.ActionCreate, "Component", h.authorized(auth.ActionEdit, h.CreateComponent)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.authorized(auth.ActionView, h.GetComponent))
+	mux.HandleFunc("DELETE "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.audited(audit.ActionDelete, "Component", h.authorized(auth.ActionEdit, h.DeleteComponent)))
+	mux.HandleFunc("PUT "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/traits", h.audited(audit.ActionUpdate, "Component", h.authorized(auth.ActionEdit, h.UpdateComponentTraits)))
 
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings", h.GetComponentBinding)
-	mux.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings/{bindingName}", h.UpdateComponentBinding)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings", h.authorized(auth.ActionView, h.GetComponentBinding))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/events", h.authorized(auth.ActionView, h.GetComponentEvents))
+	mux.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings/{bindingName}", h.audited(audit.ActionUpdate, "ComponentBinding", h.authorized(auth.ActionEdit, h.UpdateComponentBinding)))
 
 	// This is the promotion endpoint...
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promote", h.PromoteComponent)
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promote", h.audited(audit.ActionPromote, "Component", h.authorized(auth.ActionEdit, h.PromoteComponent)))
+
+	// Release train endpoints. Release trains are created with apply, and promoted through the API so that
+	// freeze overrides are authorized.
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/release-trains/{trainName}/promote", h.audited(audit.ActionPromote, "ReleaseTrain", h.authorized(auth.ActionEdit, h.PromoteReleaseTrain)))
+
+	// Deployment endpoints of ComponentType based components. Snapshots and releases are managed by the controllers.
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-deployments", h.authorized(auth.ActionView, h.ListComponentDeployments))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-deployments/{environmentName}", h.authorized(auth.ActionView, h.GetComponentDeployment))
+	mux.HandleFunc("PUT "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-deployments/{environmentName}", h.audited(audit.ActionUpdate, "ComponentDeployment", h.authorized(auth.ActionEdit, h.PutComponentDeployment)))
+	mux.HandleFunc("DELETE "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-deployments/{environmentName}", h.audited(audit.ActionDelete, "ComponentDeployment", h.authorized(auth.ActionEdit, h.DeleteComponentDeployment)))
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-deployments/{environmentName}/rollback", h.audited(audit.ActionRollback, "ComponentDeployment", h.authorized(auth.ActionEdit, h.RollbackComponentDeployment)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-env-snapshots", h.authorized(auth.ActionView, h.ListComponentEnvSnapshots))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-env-snapshots/{environmentName}", h.authorized(auth.ActionView, h.GetComponentEnvSnapshot))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/releases", h.authorized(auth.ActionView, h.ListReleases))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/releases/{environmentName}", h.authorized(auth.ActionView, h.GetRelease))
 
 	// Build endpoints
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.TriggerBuild)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.ListBuilds)
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.audited(audit.ActionBuild, "Component", h.authorized(auth.ActionEdit, h.TriggerBuild)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.authorized(auth.ActionView, h.ListBuilds))
 
 	// Observer URL endpoints
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url", h.GetComponentObserverURL)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url", h.GetBuildObserverURL)
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url", h.authorized(auth.ActionView, h.GetComponentObserverURL))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url", h.authorized(auth.ActionView, h.GetBuildObserverURL))
+
+	// Observability endpoints, backed by the observers of the data planes and build planes
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/logs", h.authorized(auth.ActionView, h.GetComponentLogs))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/traces", h.authorized(auth.ActionView, h.GetComponentTraces))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds/{buildName}/logs", h.authorized(auth.ActionView, h.GetBuildLogs))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/releases/{environmentName}/health", h.authorized(auth.ActionView, h.GetReleaseHealth))
 
 	// Workload endpoints
-	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.CreateWorkload)
-	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.GetWorkloads)
+	mux.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.audited(audit.ActionCreate, "Workload", h.authorized(auth.ActionEdit, h.CreateWorkload)))
+	mux.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.authorized(auth.ActionView, h.GetWorkloads))
 
 	// MCP endpoint
 	toolsets := getMCPServerToolsets(h)
-	mux.Handle("/mcp", mcp.NewHTTPServer(toolsets))
-
-	// Apply middleware
-	return logger.LoggerMiddleware(h.logger)(mux)
-}
+	mux.Handle("/mcp", h.auth.MCPMiddleware()(mcp.NewHTTPServer(toolsets, h.rateLimiter.MCPMiddleware())))
 
-// Health handles health check requests
-func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("OK")) // Ignore write errors for health checks
-}
+	h.setOpenAPIDocument(mux.patterns)
 
-// Ready handles readiness check requests
-func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
-	// Add readiness checks (K8s connections, etc.)
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("Ready")) // Ignore write errors for health checks
+	// Apply middleware. The MCP endpoint authenticates and limits its requests itself.
+	// Rate limits and idempotency keys are per principal, so they are handled after authentication.
+	limited := h.rateLimiter.Middleware("/health", "/ready", "/startup", "/metrics", "/mcp", openAPIPath)(h.idempotency.Middleware()(mux))
+	authenticated := h.auth.Middleware("/health", "/ready", "/startup", "/metrics", "/mcp", openAPIPath)(limited)
+	return logger.LoggerMiddleware(h.logger)(authenticated)
 }
 
 func getMCPServerToolsets(h *Handler) *mcp.Toolsets {
@@ -132,17 +197,15 @@ func getMCPServerToolsets(h *Handler) *mcp.Toolsets {
 	toolsetsEnv := os.Getenv("MCP_TOOLSETS")
 	if toolsetsEnv == "" {
 		// Default to all toolsets if not specified
-		toolsetsEnv = string(mcp.ToolsetOrganization) + "," +
-			string(mcp.ToolsetProject) + "," +
-			string(mcp.ToolsetComponent) + "," +
-			string(mcp.ToolsetBuild) + "," +
-			string(mcp.ToolsetDeployment) + "," +
-			string(mcp.ToolsetInfrastructure) + "," +
-			string(mcp.ToolsetSchema)
+		toolsets := make([]string, 0, len(mcp.AllToolsets))
+		for _, ts := range mcp.AllToolsets {
+			toolsets = append(toolsets, string(ts))
+		}
+		toolsetsEnv = strings.Join(toolsets, ",")
 	}
 
 	// Parse toolsets
-	toolsetsMap := parseToolsets(toolsetsEnv)
+	toolsetsMap := mcp.ParseToolsets(toolsetsEnv)
 
 	// Log enabled toolsets
 	enabledToolsets := make([]string, 0, len(toolsetsMap))
@@ -152,7 +215,7 @@ func getMCPServerToolsets(h *Handler) *mcp.Toolsets {
 	h.logger.Info("Initializing MCP server",
 		slog.Any("enabled_toolsets", enabledToolsets))
 
-	handler := &mcphandlers.MCPHandler{Services: h.services}
+	handler := &mcphandlers.MCPHandler{Services: h.services, Auth: h.auth, Auditor: h.auditor}
 
 	// Create toolsets struct and enable based on configuration
 	toolsets := &mcp.Toolsets{}
@@ -180,25 +243,23 @@ func getMCPServerToolsets(h *Handler) *mcp.Toolsets {
 		case mcp.ToolsetSchema:
 			toolsets.SchemaToolset = handler
 			h.logger.Debug("Enabled MCP toolset", slog.String("toolset", "schema"))
+		case mcp.ToolsetPlatform:
+			toolsets.PlatformToolset = handler
+			h.logger.Debug("Enabled MCP toolset", slog.String("toolset", "platform"))
+		case mcp.ToolsetObservability:
+			toolsets.ObservabilityToolset = handler
+			h.logger.Debug("Enabled MCP toolset", slog.String("toolset", "observability"))
 		default:
 			h.logger.Warn("Unknown toolset type", slog.String("toolset", string(toolsetType)))
 		}
 	}
-	return toolsets
-}
-
-func parseToolsets(toolsetsStr string) map[mcp.ToolsetType]bool {
-	toolsetsMap := make(map[mcp.ToolsetType]bool)
-	if toolsetsStr == "" {
-		return toolsetsMap
-	}
-
-	toolsets := strings.Split(toolsetsStr, ",")
-	for _, ts := range toolsets {
-		ts = strings.TrimSpace(ts)
-		if ts != "" {
-			toolsetsMap[mcp.ToolsetType(ts)] = true
-		}
+	// Clients can subscribe to the status changes of the resources of the enabled toolsets
+	toolsets.ResourceWatcher = handler
+	// Failed tool calls report the error codes of the services
+	toolsets.ErrorCodes = handler
+	// The structured arguments of the write tools are described with the schemas of the CRDs
+	if h.services.SchemaService != nil {
+		toolsets.Schemas = handler
 	}
-	return toolsetsMap
+	return toolsets
 }
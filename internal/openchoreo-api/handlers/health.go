@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/health"
+)
+
+// Health handles liveness probe requests
+func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
+	h.writeProbe(w, r, health.ProbeLiveness, "OK")
+}
+
+// Ready handles readiness probe requests. The server is ready when its critical dependencies are usable.
+func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
+	h.writeProbe(w, r, health.ProbeReadiness, "Ready")
+}
+
+// Startup handles startup probe requests. The server has started once it has been ready.
+func (h *Handler) Startup(w http.ResponseWriter, r *http.Request) {
+	h.writeProbe(w, r, health.ProbeStartup, "Started")
+}
+
+// writeProbe writes the outcome of a probe: the report of its checks with ?verbose=true,
+// and the message or the failed status otherwise. Failed probes are answered with 503.
+func (h *Handler) writeProbe(w http.ResponseWriter, r *http.Request, probe health.Probe, message string) {
+	report := h.health.Run(r.Context(), probe)
+	statusCode := http.StatusOK
+	if !report.OK() {
+		statusCode = http.StatusServiceUnavailable
+		message = string(report.Status)
+		for _, check := range report.Checks {
+			if check.Critical && check.Status != health.StatusOK {
+				h.logger.Warn("Health check failed", "probe", probe, "check", check.Name, "error", check.Error)
+			}
+		}
+	}
+
+	if r.URL.Query().Get("verbose") == "true" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(report) // Ignore encoding errors for health checks
+		return
+	}
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(message)) // Ignore write errors for health checks
+}
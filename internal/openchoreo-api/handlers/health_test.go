@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/health"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+func TestProbes(t *testing.T) {
+	registry := health.NewRegistry(time.Second, 0)
+	registry.Register(health.Check{Name: "crds", Critical: true, Func: func(context.Context) error {
+		return errors.New("custom resource definitions of openchoreo.dev/v1alpha1 are not installed: Project")
+	}})
+	routes := New(&services.Services{}, nil, nil, nil, nil, registry, slog.New(slog.NewTextHandler(io.Discard, nil))).Routes()
+
+	for path, want := range map[string]int{
+		"/health":  http.StatusOK,
+		"/ready":   http.StatusServiceUnavailable,
+		"/startup": http.StatusServiceUnavailable,
+	} {
+		rec := httptest.NewRecorder()
+		routes.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != want {
+			t.Errorf("GET %s = %d %s, want %d", path, rec.Code, rec.Body, want)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	routes.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready?verbose=true", nil))
+	var report health.Report
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("failed to decode the verbose report %s: %v", rec.Body, err)
+	}
+	if report.Probe != health.ProbeReadiness || report.Status != health.StatusFailed ||
+		len(report.Checks) != 1 || report.Checks[0].Name != "crds" || report.Checks[0].Error == "" {
+		t.Errorf("verbose report = %+v, want the failed crds check", report)
+	}
+}
@@ -5,9 +5,16 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 // writeSuccessResponse writes a successful API response
@@ -36,3 +43,139 @@ func writeListResponse[T any](w http.ResponseWriter, items []T, total, page, pag
 	response := models.ListSuccessResponse(items, total, page, pageSize)
 	_ = json.NewEncoder(w).Encode(response) // Ignore encoding errors for response
 }
+
+// writeListResponseWithWarnings writes a list response together with warnings about the performed operation
+func writeListResponseWithWarnings[T any](w http.ResponseWriter, items []T, warnings []string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	response := models.ListSuccessResponse(items, len(items), 1, len(items))
+	response.Warnings = warnings
+	_ = json.NewEncoder(w).Encode(response) // Ignore encoding errors for response
+}
+
+// authorized wraps a handler with the authorization of the action in the organization and project of the request path
+func (h *Handler) authorized(action auth.Action, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		scope := auth.Scope{Org: r.PathValue("orgName"), Project: r.PathValue("projectName")}
+		if !h.authorize(w, r, action, scope) {
+			return
+		}
+		next(w, r)
+	}
+}
+
+// authorize checks that the principal of the request is allowed to perform the action in the scope.
+// It writes a forbidden response and returns false otherwise.
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, action auth.Action, scope auth.Scope) bool {
+	if err := h.auth.Authorize(r.Context(), action, scope); err != nil {
+		logger.GetLogger(r.Context()).Warn("Request is not authorized", "error", err)
+		writeErrorResponse(w, http.StatusForbidden, "Not allowed to "+string(action)+" in "+scope.String(), services.CodeForbidden)
+		return false
+	}
+	return true
+}
+
+// parseListOptions parses the pagination, filtering, sorting and field selection query parameters of list requests
+func parseListOptions(r *http.Request) (*models.ListOptions, error) {
+	query := r.URL.Query()
+	opts := &models.ListOptions{
+		Continue:      query.Get("continue"),
+		LabelSelector: query.Get("labelSelector"),
+		FieldSelector: query.Get("fieldSelector"),
+		Sort:          query.Get("sort"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		var err error
+		if opts.Limit, err = strconv.Atoi(limit); err != nil || opts.Limit <= 0 {
+			return nil, fmt.Errorf("invalid limit %q", limit)
+		}
+	}
+	if fields := query.Get("fields"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				opts.Fields = append(opts.Fields, field)
+			}
+		}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// writeListPage writes a page of a list response, restricting the items to the selected fields
+func writeListPage[T any](w http.ResponseWriter, page *models.ListPage[T], opts *models.ListOptions) {
+	response, err := models.NewListPageResponse(page, opts)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, "Failed to select fields", services.CodeInternalError)
+		return
+	}
+	writeSuccessResponse(w, http.StatusOK, response)
+}
+
+// writeListOptionsError writes the error response of invalid list options
+func writeListOptionsError(w http.ResponseWriter, err error) {
+	writeErrorResponse(w, http.StatusBadRequest, "Invalid list options: "+err.Error(), services.CodeInvalidInput)
+}
+
+// setETag sets the ETag header of a response to the resource version of the returned resource
+func setETag(w http.ResponseWriter, resourceVersion string) {
+	if resourceVersion != "" {
+		w.Header().Set("ETag", strconv.Quote(resourceVersion))
+	}
+}
+
+// resourceVersionPrecondition returns the resource version a write is based on, given by the If-Match header
+// or by the resource version of the request body. Both must agree when both are set. An empty version means
+// that the write is unconditional.
+func resourceVersionPrecondition(r *http.Request, bodyVersion string) (string, error) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return bodyVersion, nil
+	}
+	if strings.Contains(ifMatch, ",") {
+		return "", fmt.Errorf("If-Match must contain a single entity tag")
+	}
+	version, err := strconv.Unquote(strings.TrimPrefix(ifMatch, "W/"))
+	if err != nil {
+		return "", fmt.Errorf("invalid If-Match entity tag %q", ifMatch)
+	}
+	if bodyVersion != "" && bodyVersion != version {
+		return "", fmt.Errorf("If-Match %q does not match the resourceVersion %q of the request", version, bodyVersion)
+	}
+	return version, nil
+}
+
+// writeResourceWriteError writes the error response of a write that was rejected because the resource has changed
+// since the given precondition, or because the resource is invalid. It returns false for other errors.
+func writeResourceWriteError(w http.ResponseWriter, err error, precondition string) bool {
+	switch {
+	case errors.Is(err, services.ErrResourceVersionConflict):
+		if precondition != "" {
+			writeErrorResponse(w, http.StatusPreconditionFailed, "The resource has been modified since version "+precondition,
+				services.CodePreconditionFailed)
+		} else {
+			writeErrorResponse(w, http.StatusConflict, "The resource has been modified concurrently, retry the request",
+				services.CodeResourceVersionConflict)
+		}
+		return true
+	case errors.Is(err, services.ErrInvalidResource):
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return true
+	case errors.Is(err, services.ErrEnvironmentFrozen):
+		writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeEnvironmentFrozen)
+		return true
+	}
+	return false
+}
+
+// attributeFreezeOverride sets the authenticated caller of the request as the principal of a freeze override
+func attributeFreezeOverride(r *http.Request, override *models.FreezeOverride) {
+	if override == nil {
+		return
+	}
+	if principal := auth.GetPrincipal(r.Context()); principal != nil {
+		override.Principal = principal.Name
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+func TestResourceVersionPrecondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifMatch     string
+		bodyVersion string
+		want        string
+		wantErr     string
+	}{
+		{name: "unconditional"},
+		{name: "body version", bodyVersion: "7", want: "7"},
+		{name: "If-Match", ifMatch: `"7"`, want: "7"},
+		{name: "weak If-Match", ifMatch: `W/"7"`, want: "7"},
+		{name: "If-Match any", ifMatch: "*", bodyVersion: "7", want: "7"},
+		{name: "If-Match agreeing with the body", ifMatch: `"7"`, bodyVersion: "7", want: "7"},
+		{name: "If-Match disagreeing with the body", ifMatch: `"8"`, bodyVersion: "7", wantErr: "does not match"},
+		{name: "several entity tags", ifMatch: `"7", "8"`, wantErr: "single entity tag"},
+		{name: "unquoted entity tag", ifMatch: "7", wantErr: "invalid If-Match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+			got, err := resourceVersionPrecondition(r, tt.bodyVersion)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("precondition = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteResourceWriteError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		precondition string
+		wantHandled  bool
+		wantStatus   int
+		wantCode     string
+	}{
+		{
+			name:         "conflict with a precondition",
+			err:          fmt.Errorf("%w: changed", services.ErrResourceVersionConflict),
+			precondition: "7",
+			wantHandled:  true,
+			wantStatus:   http.StatusPreconditionFailed,
+			wantCode:     services.CodePreconditionFailed,
+		},
+		{
+			name:        "conflict of a concurrent write",
+			err:         services.ErrResourceVersionConflict,
+			wantHandled: true,
+			wantStatus:  http.StatusConflict,
+			wantCode:    services.CodeResourceVersionConflict,
+		},
+		{
+			name:        "invalid resource",
+			err:         fmt.Errorf("%w: bad spec", services.ErrInvalidResource),
+			wantHandled: true,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    services.CodeInvalidInput,
+		},
+		{
+			name:        "frozen environment",
+			err:         services.ErrEnvironmentFrozen,
+			wantHandled: true,
+			wantStatus:  http.StatusConflict,
+			wantCode:    services.CodeEnvironmentFrozen,
+		},
+		{name: "not found", err: services.ErrTraitNotFound},
+		{name: "other error", err: errors.New("boom")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handled := writeResourceWriteError(rec, tt.err, tt.precondition)
+			if handled != tt.wantHandled {
+				t.Fatalf("handled = %v, want %v", handled, tt.wantHandled)
+			}
+			if !handled {
+				if rec.Body.Len() != 0 {
+					t.Errorf("unhandled error wrote %q", rec.Body.String())
+				}
+				return
+			}
+			if rec.Code != tt.wantStatus || errorCode(t, rec) != tt.wantCode {
+				t.Errorf("response = %d %s, want %d %s", rec.Code, errorCode(t, rec), tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestTraitWritePreconditions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&openchoreov1alpha1.Trait{ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "acme"}},
+	).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	h := &Handler{services: &services.Services{TraitService: services.NewTraitService(k8sClient, logger)}, logger: logger}
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v1/orgs/{orgName}/traits/{traitName}", h.UpdateTrait)
+	mux.HandleFunc("DELETE /api/v1/orgs/{orgName}/traits/{traitName}", h.DeleteTrait)
+
+	current := func() string {
+		trait := &openchoreov1alpha1.Trait{}
+		if err := k8sClient.Get(t.Context(), client.ObjectKey{Name: "ingress", Namespace: "acme"}, trait); err != nil {
+			t.Fatalf("failed to get the trait: %v", err)
+		}
+		return trait.ResourceVersion
+	}
+	serve := func(method, path, body, ifMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if ifMatch != "" {
+			r.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, r)
+		return rec
+	}
+	stale := current()
+
+	rec := serve(http.MethodPut, "/api/v1/orgs/acme/traits/ingress", `{}`, strconv.Quote(stale))
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != strconv.Quote(current()) {
+		t.Fatalf("update at the current version = %d with ETag %q, want 200 with the new version", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		ifMatch    string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:   "update at a stale If-Match",
+			method: http.MethodPut, path: "/api/v1/orgs/acme/traits/ingress", body: `{}`, ifMatch: strconv.Quote(stale),
+			wantStatus: http.StatusPreconditionFailed, wantCode: services.CodePreconditionFailed,
+		},
+		{
+			name:   "update at a stale body version",
+			method: http.MethodPut, path: "/api/v1/orgs/acme/traits/ingress", body: `{"resourceVersion":"` + stale + `"}`,
+			wantStatus: http.StatusPreconditionFailed, wantCode: services.CodePreconditionFailed,
+		},
+		{
+			name:   "If-Match disagreeing with the body version",
+			method: http.MethodPut, path: "/api/v1/orgs/acme/traits/ingress", body: `{"resourceVersion":"1"}`, ifMatch: `"2"`,
+			wantStatus: http.StatusBadRequest, wantCode: services.CodeInvalidInput,
+		},
+		{
+			name:   "update of a missing trait",
+			method: http.MethodPut, path: "/api/v1/orgs/acme/traits/missing", body: `{}`, ifMatch: `"1"`,
+			wantStatus: http.StatusNotFound, wantCode: services.CodeTraitNotFound,
+		},
+		{
+			name:   "delete at a stale version",
+			method: http.MethodDelete, path: "/api/v1/orgs/acme/traits/ingress?resourceVersion=" + stale,
+			wantStatus: http.StatusPreconditionFailed, wantCode: services.CodePreconditionFailed,
+		},
+		{
+			name:   "delete of a missing trait",
+			method: http.MethodDelete, path: "/api/v1/orgs/acme/traits/missing", ifMatch: `"1"`,
+			wantStatus: http.StatusNotFound, wantCode: services.CodeTraitNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(tt.method, tt.path, tt.body, tt.ifMatch)
+			if rec.Code != tt.wantStatus || errorCode(t, rec) != tt.wantCode {
+				t.Errorf("response = %d %s, want %d %s", rec.Code, errorCode(t, rec), tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+
+	if rec := serve(http.MethodDelete, "/api/v1/orgs/acme/traits/ingress", "", strconv.Quote(current())); rec.Code != http.StatusOK {
+		t.Errorf("delete at the current version = %d, want 200", rec.Code)
+	}
+}
+
+// errorCode returns the code of the error response of a recorder
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var response struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode the error response %q: %v", rec.Body.String(), err)
+	}
+	return response.Code
+}
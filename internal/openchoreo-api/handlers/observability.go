@@ -0,0 +1,268 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// GetComponentLogs returns the runtime logs of a component in an environment, read from the observer of its data plane.
+// With follow=true, the new log entries are streamed as Server-Sent Events.
+func (h *Handler) GetComponentLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentLogs handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	query, err := parseLogQuery(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid log query: "+err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	if r.URL.Query().Get("follow") == "true" {
+		stream, err := h.services.ObservabilityService.FollowComponentLogs(ctx, orgName, projectName, componentName, r.PathValue("environmentName"), query)
+		if err != nil {
+			h.writeObservabilityError(w, r, "Failed to follow component logs", err)
+			return
+		}
+		streamLogs(w, r, stream)
+		return
+	}
+
+	logs, err := h.services.ObservabilityService.GetComponentLogs(ctx, orgName, projectName, componentName, r.PathValue("environmentName"), query)
+	if err != nil {
+		h.writeObservabilityError(w, r, "Failed to get component logs", err)
+		return
+	}
+	writeSuccessResponse(w, http.StatusOK, logs)
+}
+
+// GetBuildLogs returns the logs of a build of a component, read from the observer of the build plane
+func (h *Handler) GetBuildLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetBuildLogs handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	query, err := parseLogQuery(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid log query: "+err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	logs, err := h.services.ObservabilityService.GetBuildLogs(ctx, orgName, projectName, componentName, r.PathValue("buildName"), query)
+	if err != nil {
+		h.writeObservabilityError(w, r, "Failed to get build logs", err)
+		return
+	}
+	writeSuccessResponse(w, http.StatusOK, logs)
+}
+
+// GetComponentTraces returns the spans of the traces of a component in an environment
+func (h *Handler) GetComponentTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentTraces handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+	query := &models.TraceQuery{SortOrder: r.URL.Query().Get("sortOrder")}
+	err := parseTimeRange(r, &query.TimeRange)
+	if err == nil {
+		query.Limit, err = parseObservabilityLimit(r)
+	}
+	if err == nil {
+		err = query.Validate()
+	}
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid trace query: "+err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	traces, err := h.services.ObservabilityService.GetComponentTraces(ctx, orgName, projectName, componentName, r.PathValue("environmentName"), query)
+	if err != nil {
+		h.writeObservabilityError(w, r, "Failed to get component traces", err)
+		return
+	}
+	writeSuccessResponse(w, http.StatusOK, traces)
+}
+
+// GetReleaseHealth returns the health of the resources of the release of a component in an environment
+func (h *Handler) GetReleaseHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetReleaseHealth handler called")
+
+	orgName, projectName, componentName, ok := componentPath(w, r)
+	if !ok {
+		return
+	}
+
+	health, err := h.services.ObservabilityService.GetReleaseHealth(ctx, orgName, projectName, componentName, r.PathValue("environmentName"))
+	if err != nil {
+		h.writeObservabilityError(w, r, "Failed to get release health", err)
+		return
+	}
+	writeSuccessResponse(w, http.StatusOK, health)
+}
+
+// streamLogs streams the events of a log stream as Server-Sent Events: a log event per entry and a gap event per gap
+// of skipped entries, ending with an error event if the stream fails
+func streamLogs(w http.ResponseWriter, r *http.Request, stream *services.LogStream) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+
+	rc := http.NewResponseController(w)
+	// Streams are long-lived, so the write timeout of the server does not apply to them
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn("Failed to clear the write deadline of the log stream", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		logger.Error("Log stream is not supported by the response writer", "error", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-stream.Events:
+			if !ok {
+				if err := stream.Err(); err != nil {
+					data, _ := json.Marshal(models.ErrorResponse("Observer is unavailable", services.CodeObserverUnavailable))
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+					_ = rc.Flush()
+				}
+				return
+			}
+			name, payload := "log", interface{}(event.Entry)
+			if event.Gap != nil {
+				name, payload = "gap", event.Gap
+			}
+			data, err := json.Marshal(payload)
+			if err != nil {
+				logger.Error("Failed to encode log stream event", "event", name, "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// parseLogQuery parses the query parameters of log requests. Levels may be repeated or comma separated.
+func parseLogQuery(r *http.Request) (*models.LogQuery, error) {
+	values := r.URL.Query()
+	query := &models.LogQuery{
+		Search:    values.Get("search"),
+		SortOrder: values.Get("sortOrder"),
+	}
+	for _, levels := range values["level"] {
+		for _, level := range strings.Split(levels, ",") {
+			if level = strings.TrimSpace(level); level != "" {
+				query.Levels = append(query.Levels, level)
+			}
+		}
+	}
+	if err := parseTimeRange(r, &query.TimeRange); err != nil {
+		return nil, err
+	}
+	var err error
+	if query.Limit, err = parseObservabilityLimit(r); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// parseTimeRange parses the RFC 3339 startTime and endTime query parameters
+func parseTimeRange(r *http.Request, timeRange *models.TimeRange) error {
+	for name, t := range map[string]*time.Time{"startTime": &timeRange.StartTime, "endTime": &timeRange.EndTime} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("%s must be an RFC 3339 time, such as 2025-01-02T15:04:05Z", name)
+		}
+		*t = parsed
+	}
+	return nil
+}
+
+// parseObservabilityLimit parses the limit query parameter of log and trace requests
+func parseObservabilityLimit(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("invalid limit %q", value)
+	}
+	return limit, nil
+}
+
+// writeObservabilityError writes the error response of a failed log, trace or release health request
+func (h *Handler) writeObservabilityError(w http.ResponseWriter, r *http.Request, message string, err error) {
+	logger := logger.GetLogger(r.Context())
+	switch {
+	case writeComponentDeploymentError(w, err):
+		logger.Warn(message, "error", err)
+	case errors.Is(err, services.ErrBuildNotFound):
+		logger.Warn(message, "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Build not found", services.CodeBuildNotFound)
+	case errors.Is(err, services.ErrDataPlaneNotFound):
+		logger.Warn(message, "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "DataPlane not found", services.CodeDataPlaneNotFound)
+	case errors.Is(err, services.ErrObserverNotConfigured):
+		logger.Warn(message, "error", err)
+		writeErrorResponse(w, http.StatusNotFound, "Observability is not configured for the plane", services.CodeObserverNotConfigured)
+	case errors.Is(err, services.ErrObserverUnavailable):
+		logger.Error(message, "error", err)
+		writeErrorResponse(w, http.StatusBadGateway, "Observer is unavailable", services.CodeObserverUnavailable)
+	default:
+		logger.Error(message, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+	}
+}
@@ -0,0 +1,491 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dependency"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/idempotency"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/openapi"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	apiPrefix   = "/api/v1"
+	openAPIPath = apiPrefix + "/openapi.json"
+)
+
+// router is a ServeMux that records the patterns of the registered routes,
+// from which the OpenAPI document of the API is built
+type router struct {
+	*http.ServeMux
+	patterns []string
+}
+
+func newRouter() *router {
+	return &router{ServeMux: http.NewServeMux()}
+}
+
+func (r *router) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	r.patterns = append(r.patterns, pattern)
+	r.ServeMux.HandleFunc(pattern, handler)
+}
+
+func (r *router) Handle(pattern string, handler http.Handler) {
+	r.patterns = append(r.patterns, pattern)
+	r.ServeMux.Handle(pattern, handler)
+}
+
+// GetOpenAPI serves the OpenAPI document of the API
+func (h *Handler) GetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if h.openAPIDocument == nil {
+		writeErrorResponse(w, http.StatusInternalServerError, "OpenAPI document is not available", services.CodeInternalError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(h.openAPIDocument)
+}
+
+// buildOpenAPIDocument builds the OpenAPI document of the API routes among the given route patterns.
+// Every API route must be described in apiEndpoints, and every description must belong to a route.
+func buildOpenAPIDocument(patterns []string) (*openapi.Document, error) {
+	reflector := openapi.NewReflector()
+	reflector.DefineKubernetesTypes()
+
+	builder := openapi.NewBuilder(openapi.Info{
+		Title:       "OpenChoreo API",
+		Description: "API of the OpenChoreo control plane",
+		Version:     "v1",
+	}, reflector)
+
+	documented := make(map[string]bool, len(apiEndpoints))
+	for _, pattern := range sortedPatterns(patterns) {
+		method, path, ok := strings.Cut(pattern, " ")
+		if !ok || !strings.HasPrefix(path, apiPrefix+"/") {
+			continue
+		}
+		endpoint, ok := apiEndpoints[pattern]
+		if !ok {
+			return nil, fmt.Errorf("route %q is not described in the OpenAPI endpoints", pattern)
+		}
+		if method != http.MethodGet {
+			endpoint.Query = append(slices.Clip(endpoint.Query), idempotencyKeyParam)
+		}
+		if err := builder.Add(method, path, endpoint); err != nil {
+			return nil, err
+		}
+		documented[pattern] = true
+	}
+	for pattern := range apiEndpoints {
+		if !documented[pattern] {
+			return nil, fmt.Errorf("OpenAPI endpoint %q has no route", pattern)
+		}
+	}
+
+	doc := builder.Document()
+	if err := openapi.Validate(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// setOpenAPIDocument builds and encodes the OpenAPI document served by GetOpenAPI
+func (h *Handler) setOpenAPIDocument(patterns []string) {
+	doc, err := buildOpenAPIDocument(patterns)
+	if err != nil {
+		h.logger.Error("Failed to build the OpenAPI document", "error", err)
+		return
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		h.logger.Error("Failed to encode the OpenAPI document", "error", err)
+		return
+	}
+	h.openAPIDocument = data
+}
+
+var (
+	listQuery = []openapi.Parameter{
+		openapi.IntegerParam("limit", fmt.Sprintf("Maximum number of items of the page, at most %d", models.MaxListLimit)),
+		openapi.StringParam("continue", "Token of the page to return, from the continue field of the previous page"),
+		openapi.StringParam("labelSelector", "Kubernetes label selector of the items"),
+		openapi.StringParam("fieldSelector", "Selector of the items on their fields, such as status=Ready or type!=Service"),
+		openapi.StringParam("sort", "Field to sort the items by, prefixed with - for descending order"),
+		openapi.StringParam("fields", "Comma separated fields to return of each item"),
+	}
+	dependencyQuery = []openapi.Parameter{
+		openapi.EnumParam("format", "Format of the graph", string(dependency.FormatJSON), string(dependency.FormatDOT), string(dependency.FormatMermaid)),
+		openapi.StringParam("environment", "Environment whose deployed workloads the graph is built from"),
+	}
+	eventsQuery = []openapi.Parameter{
+		openapi.EnumParam("watch", "Stream the status changes as Server-Sent Events", "true", "false"),
+		openapi.StringParam("kinds", "Comma separated kinds of resources to report: "+strings.Join(models.EventKinds, ", ")),
+	}
+	// logQuery are the query parameters of log requests, the time range first as build logs are not bounded by time
+	logQuery = []openapi.Parameter{
+		openapi.StringParam("startTime", "RFC 3339 start of the time range, an hour before its end by default"),
+		openapi.StringParam("endTime", "RFC 3339 end of the time range, the current time by default"),
+		openapi.EnumParam("level", "Log level of the entries, may be repeated or comma separated", models.LogLevels...),
+		openapi.StringParam("search", "Phrase the entries contain"),
+		openapi.IntegerParam("limit", fmt.Sprintf("Maximum number of entries, %d by default and at most %d", models.DefaultObservabilityLimit, models.MaxObservabilityLimit)),
+		openapi.EnumParam("sortOrder", "Order of the entries, newest first by default", models.SortOrders...),
+	}
+	componentLogQuery = append(slices.Clip(logQuery),
+		openapi.EnumParam("follow", "Stream the new entries from the start time, the current time by default, as Server-Sent Events", "true", "false"))
+	traceQuery = []openapi.Parameter{
+		logQuery[0], logQuery[1],
+		openapi.IntegerParam("limit", fmt.Sprintf("Maximum number of spans, %d by default and at most %d", models.DefaultObservabilityLimit, models.MaxObservabilityLimit)),
+		openapi.EnumParam("sortOrder", "Order of the spans, newest first by default", models.SortOrders...),
+	}
+	dependencyTextContent = []string{"text/vnd.graphviz", "text/plain"}
+	resourceVersionParam  = openapi.StringParam("resourceVersion", "Only delete the resource at this version, same as If-Match")
+	idempotencyKeyParam   = openapi.HeaderParam(idempotency.HeaderKey,
+		"Key making retries of the request safe. The response of the first request with the key is returned for its retries.")
+)
+
+// componentPrefix is the path of a component, the prefix of the paths of its resources
+const componentPrefix = apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}"
+
+// apiEndpoints describes the API routes by their pattern, for the OpenAPI document
+var apiEndpoints = map[string]openapi.Endpoint{
+	"GET " + openAPIPath: {
+		OperationID: "getOpenAPI", Summary: "Get the OpenAPI document of the API", Tags: []string{"Meta"},
+		Raw: true, Public: true,
+	},
+	"POST " + apiPrefix + "/apply": {
+		OperationID: "applyResource", Summary: "Create or update OpenChoreo resources with server-side apply", Tags: []string{"Resources"},
+		Description: "The body is a single resource, a JSON array or List of resources, or a multi-document YAML bundle. " +
+			"Several resources are answered with an ApplyBatchResponse holding the result of each resource, " +
+			"with status 207 if some of them failed, or 409 if an atomic apply was aborted.",
+		Query: []openapi.Parameter{
+			openapi.EnumParam("dryRun", "Validate the resources and return the changes they would make without storing them", "true", "false"),
+			openapi.EnumParam("force", "Take the ownership of fields managed by other field managers instead of failing", "true", "false"),
+			openapi.EnumParam("atomic", "Apply none of the resources if one of them fails a dry run", "true", "false"),
+		},
+		Request: map[string]any{}, Response: ApplyResourceResponse{},
+	},
+	"DELETE " + apiPrefix + "/delete": {
+		OperationID: "deleteResource", Summary: "Delete an OpenChoreo resource", Tags: []string{"Resources"},
+		Request: map[string]any{}, Response: DeleteResourceResponse{},
+	},
+
+	"GET " + apiPrefix + "/orgs": {
+		OperationID: "listOrganizations", Summary: "List the organizations visible to the caller", Tags: []string{"Organizations"},
+		Query: listQuery, Response: models.OrganizationResponse{}, List: true,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}": {
+		OperationID: "getOrganization", Summary: "Get an organization", Tags: []string{"Organizations"},
+		Response: models.OrganizationResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/audit": {
+		OperationID: "listAuditRecords", Summary: "List the audit records of an organization", Tags: []string{"Audit"},
+		Query: []openapi.Parameter{
+			openapi.StringParam("since", "Only records at or after this RFC 3339 time"),
+			openapi.StringParam("until", "Only records before this RFC 3339 time"),
+			openapi.StringParam("actor", "Only records of this principal"),
+			openapi.StringParam("resourceKind", "Only records of resources of this kind"),
+			openapi.StringParam("resourceName", "Only records of resources with this name"),
+			openapi.IntegerParam("limit", "Maximum number of records"),
+		},
+		Response: audit.Record{}, List: true,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/dependencies": {
+		OperationID: "getOrganizationDependencies", Summary: "Get the dependency graph of the components of an organization", Tags: []string{"Dependencies"},
+		Query: dependencyQuery, Response: dependency.Graph{}, TextContent: dependencyTextContent,
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/dataplanes": {
+		OperationID: "listDataPlanes", Summary: "List the data planes of an organization", Tags: []string{"DataPlanes"},
+		Query: listQuery, Response: models.DataPlaneResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/dataplanes": {
+		OperationID: "createDataPlane", Summary: "Create a data plane", Tags: []string{"DataPlanes"},
+		Request: models.CreateDataPlaneRequest{}, Response: models.DataPlaneResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/dataplanes/{dpName}": {
+		OperationID: "getDataPlane", Summary: "Get a data plane", Tags: []string{"DataPlanes"},
+		Response: models.DataPlaneResponse{},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/environments": {
+		OperationID: "listEnvironments", Summary: "List the environments of an organization", Tags: []string{"Environments"},
+		Query: listQuery, Response: models.EnvironmentResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/environments": {
+		OperationID: "createEnvironment", Summary: "Create an environment", Tags: []string{"Environments"},
+		Request: models.CreateEnvironmentRequest{}, Response: models.EnvironmentResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/environments/{envName}": {
+		OperationID: "getEnvironment", Summary: "Get an environment", Tags: []string{"Environments"},
+		Response: models.EnvironmentResponse{},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/buildplanes": {
+		OperationID: "listBuildPlanes", Summary: "List the build planes of an organization", Tags: []string{"Builds"},
+		Query: listQuery, Response: models.BuildPlaneResponse{}, List: true,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/build-templates": {
+		OperationID: "listBuildTemplates", Summary: "List the build templates of an organization", Tags: []string{"Builds"},
+		Query: listQuery, Response: models.BuildTemplateResponse{}, List: true,
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/component-types": {
+		OperationID: "listComponentTypes", Summary: "List the component types of an organization", Tags: []string{"ComponentTypes"},
+		Query: listQuery, Response: models.ComponentTypeResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/component-types": {
+		OperationID: "createComponentType", Summary: "Create a component type", Tags: []string{"ComponentTypes"},
+		Request: models.ComponentTypeRequest{}, Response: models.ComponentTypeResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/component-types/{ctName}": {
+		OperationID: "getComponentType", Summary: "Get a component type with its spec", Tags: []string{"ComponentTypes"},
+		Response: models.ComponentTypeResponse{},
+	},
+	"PUT " + apiPrefix + "/orgs/{orgName}/component-types/{ctName}": {
+		OperationID: "updateComponentType", Summary: "Replace the spec of a component type", Tags: []string{"ComponentTypes"},
+		Description: "The update is rejected with 412 when If-Match or resourceVersion is not the current version.",
+		Request:     models.ComponentTypeRequest{}, Response: models.ComponentTypeResponse{},
+	},
+	"DELETE " + apiPrefix + "/orgs/{orgName}/component-types/{ctName}": {
+		OperationID: "deleteComponentType", Summary: "Delete a component type", Tags: []string{"ComponentTypes"},
+		Query: []openapi.Parameter{resourceVersionParam},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/component-types/{ctName}/schema": {
+		OperationID: "getComponentTypeSchema", Summary: "Get the JSON schema of the parameters of a component type", Tags: []string{"ComponentTypes"},
+		Response: extv1.JSONSchemaProps{},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/workflows": {
+		OperationID: "listWorkflows", Summary: "List the workflows of an organization", Tags: []string{"Workflows"},
+		Query: listQuery, Response: models.WorkflowResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/workflows": {
+		OperationID: "createWorkflow", Summary: "Create a workflow", Tags: []string{"Workflows"},
+		Request: models.WorkflowRequest{}, Response: models.WorkflowResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/workflows/{workflowName}": {
+		OperationID: "getWorkflow", Summary: "Get a workflow with its spec", Tags: []string{"Workflows"},
+		Response: models.WorkflowResponse{},
+	},
+	"PUT " + apiPrefix + "/orgs/{orgName}/workflows/{workflowName}": {
+		OperationID: "updateWorkflow", Summary: "Replace the spec of a workflow", Tags: []string{"Workflows"},
+		Description: "The update is rejected with 412 when If-Match or resourceVersion is not the current version.",
+		Request:     models.WorkflowRequest{}, Response: models.WorkflowResponse{},
+	},
+	"DELETE " + apiPrefix + "/orgs/{orgName}/workflows/{workflowName}": {
+		OperationID: "deleteWorkflow", Summary: "Delete a workflow", Tags: []string{"Workflows"},
+		Query: []openapi.Parameter{resourceVersionParam},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/workflows/{workflowName}/schema": {
+		OperationID: "getWorkflowSchema", Summary: "Get the JSON schema of the parameters of a workflow", Tags: []string{"Workflows"},
+		Response: extv1.JSONSchemaProps{},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/traits": {
+		OperationID: "listTraits", Summary: "List the traits of an organization", Tags: []string{"Traits"},
+		Query: listQuery, Response: models.TraitResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/traits": {
+		OperationID: "createTrait", Summary: "Create a trait", Tags: []string{"Traits"},
+		Request: models.TraitRequest{}, Response: models.TraitResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/traits/{traitName}": {
+		OperationID: "getTrait", Summary: "Get a trait with its spec", Tags: []string{"Traits"},
+		Response: models.TraitResponse{},
+	},
+	"PUT " + apiPrefix + "/orgs/{orgName}/traits/{traitName}": {
+		OperationID: "updateTrait", Summary: "Replace the spec of a trait", Tags: []string{"Traits"},
+		Description: "The update is rejected with 412 when If-Match or resourceVersion is not the current version.",
+		Request:     models.TraitRequest{}, Response: models.TraitResponse{},
+	},
+	"DELETE " + apiPrefix + "/orgs/{orgName}/traits/{traitName}": {
+		OperationID: "deleteTrait", Summary: "Delete a trait", Tags: []string{"Traits"},
+		Query: []openapi.Parameter{resourceVersionParam},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/traits/{traitName}/schema": {
+		OperationID: "getTraitSchema", Summary: "Get the JSON schema of the parameters of a trait", Tags: []string{"Traits"},
+		Response: extv1.JSONSchemaProps{},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/projects": {
+		OperationID: "listProjects", Summary: "List the projects of an organization", Tags: []string{"Projects"},
+		Query: listQuery, Response: models.ProjectResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/projects": {
+		OperationID: "createProject", Summary: "Create a project", Tags: []string{"Projects"},
+		Request: models.CreateProjectRequest{}, Response: models.ProjectResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}": {
+		OperationID: "getProject", Summary: "Get a project", Tags: []string{"Projects"},
+		Response: models.ProjectResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/deployment-pipeline": {
+		OperationID: "getProjectDeploymentPipeline", Summary: "Get the deployment pipeline of a project", Tags: []string{"Projects"},
+		Response: models.DeploymentPipelineResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/dependencies": {
+		OperationID: "getProjectDependencies", Summary: "Get the dependency graph of the components of a project", Tags: []string{"Dependencies"},
+		Query: dependencyQuery, Response: dependency.Graph{}, TextContent: dependencyTextContent,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/events": {
+		OperationID: "getProjectEvents", Summary: "Get or watch the status of the resources of a project", Tags: []string{"Events"},
+		Description: "With watch=true, the status changes are streamed as Server-Sent Events whose data is a ResourceEvent.",
+		Query:       eventsQuery, Response: models.ResourceEvent{}, List: true, TextContent: []string{"text/event-stream"},
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components": {
+		OperationID: "listComponents", Summary: "List the components of a project", Tags: []string{"Components"},
+		Query: listQuery, Response: models.ComponentResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components": {
+		OperationID: "createComponent", Summary: "Create a component", Tags: []string{"Components"},
+		Request: models.CreateComponentRequest{}, Response: models.ComponentResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}": {
+		OperationID: "getComponent", Summary: "Get a component", Tags: []string{"Components"},
+		Query:    []openapi.Parameter{openapi.StringParam("include", "Comma separated additional resources to include, such as type or workload")},
+		Response: models.ComponentResponse{},
+	},
+	"DELETE " + componentPrefix: {
+		OperationID: "deleteComponent", Summary: "Delete a component and undeploy it from all environments", Tags: []string{"Components"},
+		Query: []openapi.Parameter{resourceVersionParam,
+			openapi.StringParam("freezeOverride", "Justification of undeploying from frozen environments")},
+	},
+	"PUT " + componentPrefix + "/traits": {
+		OperationID: "updateComponentTraits", Summary: "Replace the traits of a component", Tags: []string{"Components"},
+		Description: "Every trait must exist in the organization. The update is rejected with 412 when If-Match or " +
+			"resourceVersion is not the current version.",
+		Request: models.ComponentTraitsRequest{}, Response: models.ComponentResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings": {
+		OperationID: "listComponentBindings", Summary: "List the bindings of a component", Tags: []string{"Components"},
+		Query:    []openapi.Parameter{openapi.StringParam("environment", "Only the binding of this environment, may be repeated")},
+		Response: models.BindingResponse{}, List: true,
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/release-trains/{trainName}/promote": {
+		OperationID: "promoteReleaseTrain", Summary: "Promote a release train to an environment", Tags: []string{"Deployments"},
+		Description: "Sets the target environment of the release train. While the environment is frozen, the promotion " +
+			"requires a freeze override, which is recorded on the environment once the components were promoted. " +
+			"The promotion is rejected with 412 when If-Match or resourceVersion is not the current version.",
+		Request: models.PromoteReleaseTrainRequest{}, Response: models.ReleaseTrainResponse{},
+	},
+	"GET " + componentPrefix + "/component-deployments": {
+		OperationID: "listComponentDeployments", Summary: "List the deployments of a component to environments", Tags: []string{"Deployments"},
+		Query: listQuery, Response: models.ComponentDeploymentResponse{}, List: true,
+	},
+	"GET " + componentPrefix + "/component-deployments/{environmentName}": {
+		OperationID: "getComponentDeployment", Summary: "Get the deployment of a component to an environment", Tags: []string{"Deployments"},
+		Response: models.ComponentDeploymentResponse{},
+	},
+	"PUT " + componentPrefix + "/component-deployments/{environmentName}": {
+		OperationID: "putComponentDeployment", Summary: "Deploy a component to an environment with environment overrides", Tags: []string{"Deployments"},
+		Description: "Creates the deployment (201) or replaces its overrides (200). The update is rejected with 412 when " +
+			"If-Match or resourceVersion is not the current version.",
+		Request: models.ComponentDeploymentRequest{}, Response: models.ComponentDeploymentResponse{},
+	},
+	"DELETE " + componentPrefix + "/component-deployments/{environmentName}": {
+		OperationID: "deleteComponentDeployment", Summary: "Undeploy a component from an environment", Tags: []string{"Deployments"},
+		Query: []openapi.Parameter{resourceVersionParam,
+			openapi.StringParam("freezeOverride", "Justification of the delete while the environment is frozen")},
+	},
+	"POST " + componentPrefix + "/component-deployments/{environmentName}/rollback": {
+		OperationID: "rollbackComponentDeployment", Summary: "Abort the rollout in progress of a component in an environment", Tags: []string{"Deployments"},
+		Description: "Aborts the progressive rollout in progress and restores the stable revision. A completed rollout is " +
+			"not rolled back: the request fails with 409 when no rollout is in progress.",
+		Response: models.ComponentDeploymentResponse{},
+	},
+	"GET " + componentPrefix + "/component-env-snapshots": {
+		OperationID: "listComponentEnvSnapshots", Summary: "List the environment snapshots of a component", Tags: []string{"Deployments"},
+		Query: listQuery, Response: models.ComponentEnvSnapshotResponse{}, List: true,
+	},
+	"GET " + componentPrefix + "/component-env-snapshots/{environmentName}": {
+		OperationID: "getComponentEnvSnapshot", Summary: "Get the snapshot of a component in an environment", Tags: []string{"Deployments"},
+		Response: models.ComponentEnvSnapshotResponse{},
+	},
+	"GET " + componentPrefix + "/releases": {
+		OperationID: "listReleases", Summary: "List the releases of a component", Tags: []string{"Deployments"},
+		Query: listQuery, Response: models.ReleaseResponse{}, List: true,
+	},
+	"GET " + componentPrefix + "/releases/{environmentName}": {
+		OperationID: "getRelease", Summary: "Get the release of a component in an environment", Tags: []string{"Deployments"},
+		Response: models.ReleaseResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/events": {
+		OperationID: "getComponentEvents", Summary: "Get or watch the status of the resources of a component", Tags: []string{"Events"},
+		Description: "With watch=true, the status changes are streamed as Server-Sent Events whose data is a ResourceEvent.",
+		Query:       eventsQuery, Response: models.ResourceEvent{}, List: true, TextContent: []string{"text/event-stream"},
+	},
+	"PATCH " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings/{bindingName}": {
+		OperationID: "updateComponentBinding", Summary: "Update the release state of a binding", Tags: []string{"Components"},
+		Request: models.UpdateBindingRequest{}, Response: models.BindingResponse{},
+	},
+	"POST " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/promote": {
+		OperationID: "promoteComponent", Summary: "Promote a component to the next environment", Tags: []string{"Components"},
+		Request: models.PromoteComponentRequest{}, Response: models.BindingResponse{}, List: true,
+	},
+
+	"POST " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds": {
+		OperationID: "triggerBuild", Summary: "Trigger a build of a component", Tags: []string{"Builds"},
+		Query:    []openapi.Parameter{openapi.StringParam("commit", "Commit to build")},
+		Response: models.BuildResponse{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds": {
+		OperationID: "listBuilds", Summary: "List the builds of a component", Tags: []string{"Builds"},
+		Query: listQuery, Response: models.BuildResponse{}, List: true,
+	},
+
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url": {
+		OperationID: "getComponentObserverURL", Summary: "Get the observer URL of a component in an environment", Tags: []string{"Observability"},
+		Response: models.ComponentObserverResponse{},
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url": {
+		OperationID: "getBuildObserverURL", Summary: "Get the observer URL of the builds of a component", Tags: []string{"Observability"},
+		Response: models.ComponentObserverResponse{},
+	},
+	"GET " + componentPrefix + "/environments/{environmentName}/logs": {
+		OperationID: "getComponentLogs", Summary: "Get or follow the logs of a component in an environment", Tags: []string{"Observability"},
+		Description: "With follow=true, the new entries are streamed as Server-Sent Events whose data is a LogEntry, " +
+			"in the order of their time. The end time, limit and sort order don't apply to followed logs.",
+		Query: componentLogQuery, Response: models.LogsResponse{}, TextContent: []string{"text/event-stream"},
+	},
+	"GET " + componentPrefix + "/environments/{environmentName}/traces": {
+		OperationID: "getComponentTraces", Summary: "Get the trace spans of a component in an environment", Tags: []string{"Observability"},
+		Query: traceQuery, Response: models.TracesResponse{},
+	},
+	"GET " + componentPrefix + "/builds/{buildName}/logs": {
+		OperationID: "getBuildLogs", Summary: "Get the logs of a build of a component", Tags: []string{"Observability"},
+		Query: logQuery[2:], Response: models.LogsResponse{},
+	},
+	"GET " + componentPrefix + "/releases/{environmentName}/health": {
+		OperationID: "getReleaseHealth", Summary: "Get the health of the release of a component in an environment", Tags: []string{"Observability"},
+		Response: models.ReleaseHealthResponse{},
+	},
+
+	"POST " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads": {
+		OperationID: "createWorkload", Summary: "Create or update the workload of a component", Tags: []string{"Workloads"},
+		Request: openchoreov1alpha1.WorkloadSpec{}, Response: openchoreov1alpha1.WorkloadSpec{}, Status: http.StatusCreated,
+	},
+	"GET " + apiPrefix + "/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads": {
+		OperationID: "getWorkloads", Summary: "Get the workload of a component", Tags: []string{"Workloads"},
+		Response: openchoreov1alpha1.WorkloadSpec{},
+	},
+}
+
+// sortedPatterns returns the patterns in a stable order, so that component names of clashing types are deterministic
+func sortedPatterns(patterns []string) []string {
+	sorted := slices.Clone(patterns)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/openapi"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// newTestRouter registers the routes of a handler without backing services
+func newTestRouter(t *testing.T) (*Handler, http.Handler, *bytes.Buffer) {
+	t.Helper()
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	h := New(&services.Services{}, nil, nil, nil, nil, nil, logger)
+	return h, h.Routes(), &logs
+}
+
+func TestOpenAPIDocumentDescribesAllRoutes(t *testing.T) {
+	_, routes, logs := newTestRouter(t)
+
+	rec := httptest.NewRecorder()
+	routes.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, openAPIPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s returned %d, logs:\n%s", openAPIPath, rec.Code, logs.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode the document: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("openapi = %q, want %q", doc.OpenAPI, openapi.Version)
+	}
+	if err := openapi.Validate(&doc); err != nil {
+		t.Errorf("served document is invalid: %v", err)
+	}
+
+	for pattern := range apiEndpoints {
+		method, path, _ := strings.Cut(pattern, " ")
+		if doc.Paths[path][strings.ToLower(method)] == nil {
+			t.Errorf("document has no operation for %s", pattern)
+		}
+	}
+}
+
+func TestOpenAPIDocumentRejectsUndescribedRoutes(t *testing.T) {
+	_, err := buildOpenAPIDocument([]string{"GET " + apiPrefix + "/orgs/{orgName}/undescribed"})
+	if err == nil || !strings.Contains(err.Error(), "undescribed") {
+		t.Errorf("buildOpenAPIDocument() error = %v, want an error about the undescribed route", err)
+	}
+
+	_, err = buildOpenAPIDocument([]string{"GET " + openAPIPath})
+	if err == nil || !strings.Contains(err.Error(), "has no route") {
+		t.Errorf("buildOpenAPIDocument() error = %v, want an error about endpoints without a route", err)
+	}
+}
+
+func TestOpenAPIDocumentPathParameters(t *testing.T) {
+	h, _, _ := newTestRouter(t)
+
+	var doc openapi.Document
+	if err := json.Unmarshal(h.openAPIDocument, &doc); err != nil {
+		t.Fatalf("failed to decode the document: %v", err)
+	}
+
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			declared := map[string]bool{}
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					declared[p.Name] = true
+				}
+			}
+			for _, segment := range strings.Split(path, "/") {
+				if name, ok := strings.CutPrefix(segment, "{"); ok {
+					name = strings.TrimSuffix(name, "}")
+					if !declared[name] {
+						t.Errorf("%s %s does not declare the path parameter %s", method, path, name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -14,14 +15,27 @@ import (
 func (h *Handler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	organizations, err := h.services.OrganizationService.ListOrganizations(ctx)
+	opts, err := parseListOptions(r)
 	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	// Only the organizations the principal is allowed to view are listed
+	page, err := h.services.OrganizationService.ListOrganizations(ctx, opts, func(orgName string) bool {
+		return h.auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}) == nil
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		h.logger.Error("Failed to list organizations", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list organizations", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, organizations, len(organizations), 1, len(organizations))
+	writeListPage(w, page, opts)
 }
 
 // GetOrganization handles GET /api/v1/orgs/{orgName}
@@ -0,0 +1,74 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// PromoteReleaseTrain promotes a release train to an environment, overriding an active freeze window of the
+// environment when the request has a freeze override
+func (h *Handler) PromoteReleaseTrain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("PromoteReleaseTrain handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	trainName := r.PathValue("trainName")
+	if orgName == "" || projectName == "" || trainName == "" {
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name and release train name are required", services.CodeInvalidInput)
+		return
+	}
+
+	var req models.PromoteReleaseTrainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	attributeFreezeOverride(r, req.FreezeOverride)
+	req.ResourceVersion = precondition
+
+	train, err := h.services.ReleaseTrainService.PromoteReleaseTrain(ctx, orgName, projectName, trainName, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReleaseTrainNotFound):
+			writeErrorResponse(w, http.StatusNotFound, "ReleaseTrain not found", services.CodeReleaseTrainNotFound)
+		case errors.Is(err, services.ErrEnvironmentNotFound):
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+		case writeResourceWriteError(w, err, precondition):
+		default:
+			logger.Error("Failed to promote ReleaseTrain", "error", err)
+			writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+			return
+		}
+		logger.Warn("ReleaseTrain promotion was rejected", "train", trainName, "environment", req.TargetEnvironment, "error", err)
+		return
+	}
+
+	logger.Info("ReleaseTrain promoted successfully", "org", orgName, "project", projectName, "train", trainName,
+		"environment", req.TargetEnvironment)
+	setETag(w, train.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, train)
+}
@@ -4,6 +4,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -25,21 +26,26 @@ func (h *Handler) ListTraits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseListOptions(r)
+	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
 	// Call service to list Traits
-	traits, err := h.services.TraitService.ListTraits(ctx, orgName)
+	page, err := h.services.TraitService.ListTraits(ctx, orgName, opts)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		logger.Error("Failed to list Traits", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	// Convert to slice of values for the list response
-	traitValues := make([]*models.TraitResponse, len(traits))
-	copy(traitValues, traits)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed Traits successfully", "org", orgName, "count", len(traits))
-	writeListResponse(w, traitValues, len(traits), 1, len(traits))
+	logger.Debug("Listed Traits successfully", "org", orgName, "count", len(page.Items))
+	writeListPage(w, page, opts)
 }
 
 func (h *Handler) GetTraitSchema(w http.ResponseWriter, r *http.Request) {
@@ -73,3 +79,189 @@ func (h *Handler) GetTraitSchema(w http.ResponseWriter, r *http.Request) {
 	logger.Debug("Retrieved Trait schema successfully", "org", orgName, "name", traitName)
 	writeSuccessResponse(w, http.StatusOK, schema)
 }
+
+func (h *Handler) GetTrait(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetTrait handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("traitName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Trait name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Trait name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to get Trait
+	resource, err := h.services.TraitService.GetTrait(ctx, orgName, name)
+	if err != nil {
+		if errors.Is(err, services.ErrTraitNotFound) {
+			logger.Warn("Trait not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Trait not found", services.CodeTraitNotFound)
+			return
+		}
+		logger.Error("Failed to get Trait", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) CreateTrait(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreateTrait handler called")
+
+	// Extract organization name from URL path
+	orgName := r.PathValue("orgName")
+	if orgName == "" {
+		logger.Warn("Organization name is required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.TraitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to create Trait
+	resource, err := h.services.TraitService.CreateTrait(ctx, orgName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrTraitAlreadyExists) {
+			logger.Warn("Trait already exists", "org", orgName, "name", req.Name)
+			writeErrorResponse(w, http.StatusConflict, "Trait already exists", services.CodeTraitExists)
+			return
+		}
+		if writeResourceWriteError(w, err, "") {
+			logger.Warn("Trait was rejected", "org", orgName, "name", req.Name, "error", err)
+			return
+		}
+		logger.Error("Failed to create Trait", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Trait created successfully", "org", orgName, "name", resource.Name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusCreated, resource)
+}
+
+func (h *Handler) UpdateTrait(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("UpdateTrait handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("traitName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Trait name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Trait name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.TraitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if req.Name == "" {
+		req.Name = name
+	}
+	if req.Name != name {
+		writeErrorResponse(w, http.StatusBadRequest, "The name of the request does not match the path", services.CodeInvalidInput)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	req.ResourceVersion = precondition
+
+	// Call service to update Trait
+	resource, err := h.services.TraitService.UpdateTrait(ctx, orgName, name, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrTraitNotFound) {
+			logger.Warn("Trait not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Trait not found", services.CodeTraitNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Trait update was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to update Trait", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Trait updated successfully", "org", orgName, "name", name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) DeleteTrait(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DeleteTrait handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("traitName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Trait name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Trait name are required", services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, r.URL.Query().Get("resourceVersion"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to delete Trait
+	if err := h.services.TraitService.DeleteTrait(ctx, orgName, name, precondition); err != nil {
+		if errors.Is(err, services.ErrTraitNotFound) {
+			logger.Warn("Trait not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Trait not found", services.CodeTraitNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Trait delete was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to delete Trait", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Trait deleted successfully", "org", orgName, "name", name)
+	writeSuccessResponse[any](w, http.StatusOK, nil)
+}
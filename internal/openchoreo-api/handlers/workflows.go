@@ -4,10 +4,12 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -23,15 +25,25 @@ func (h *Handler) ListWorkflows(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	wfs, err := h.services.WorkflowService.ListWorkflows(ctx, orgName)
+	opts, err := parseListOptions(r)
 	if err != nil {
+		writeListOptionsError(w, err)
+		return
+	}
+
+	page, err := h.services.WorkflowService.ListWorkflows(ctx, orgName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidListOptions) {
+			writeListOptionsError(w, err)
+			return
+		}
 		logger.Error("Failed to list Workflows", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	logger.Debug("Listed Workflows successfully", "org", orgName, "count", len(wfs))
-	writeListResponse(w, wfs, len(wfs), 1, len(wfs))
+	logger.Debug("Listed Workflows successfully", "org", orgName, "count", len(page.Items))
+	writeListPage(w, page, opts)
 }
 
 func (h *Handler) GetWorkflowSchema(w http.ResponseWriter, r *http.Request) {
@@ -62,3 +74,189 @@ func (h *Handler) GetWorkflowSchema(w http.ResponseWriter, r *http.Request) {
 	logger.Debug("Retrieved Workflow schema successfully", "org", orgName, "name", workflowName)
 	writeSuccessResponse(w, http.StatusOK, schema)
 }
+
+func (h *Handler) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetWorkflow handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("workflowName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Workflow name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Workflow name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to get Workflow
+	resource, err := h.services.WorkflowService.GetWorkflow(ctx, orgName, name)
+	if err != nil {
+		if errors.Is(err, services.ErrWorkflowNotFound) {
+			logger.Warn("Workflow not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Workflow not found", services.CodeWorkflowNotFound)
+			return
+		}
+		logger.Error("Failed to get Workflow", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreateWorkflow handler called")
+
+	// Extract organization name from URL path
+	orgName := r.PathValue("orgName")
+	if orgName == "" {
+		logger.Warn("Organization name is required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.WorkflowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to create Workflow
+	resource, err := h.services.WorkflowService.CreateWorkflow(ctx, orgName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrWorkflowAlreadyExists) {
+			logger.Warn("Workflow already exists", "org", orgName, "name", req.Name)
+			writeErrorResponse(w, http.StatusConflict, "Workflow already exists", services.CodeWorkflowExists)
+			return
+		}
+		if writeResourceWriteError(w, err, "") {
+			logger.Warn("Workflow was rejected", "org", orgName, "name", req.Name, "error", err)
+			return
+		}
+		logger.Error("Failed to create Workflow", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Workflow created successfully", "org", orgName, "name", resource.Name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusCreated, resource)
+}
+
+func (h *Handler) UpdateWorkflow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("UpdateWorkflow handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("workflowName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Workflow name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Workflow name are required", services.CodeInvalidInput)
+		return
+	}
+
+	// Parse request body
+	var req models.WorkflowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if req.Name == "" {
+		req.Name = name
+	}
+	if req.Name != name {
+		writeErrorResponse(w, http.StatusBadRequest, "The name of the request does not match the path", services.CodeInvalidInput)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, req.ResourceVersion)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	req.ResourceVersion = precondition
+
+	// Call service to update Workflow
+	resource, err := h.services.WorkflowService.UpdateWorkflow(ctx, orgName, name, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrWorkflowNotFound) {
+			logger.Warn("Workflow not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Workflow not found", services.CodeWorkflowNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Workflow update was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to update Workflow", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Workflow updated successfully", "org", orgName, "name", name)
+	setETag(w, resource.ResourceVersion)
+	writeSuccessResponse(w, http.StatusOK, resource)
+}
+
+func (h *Handler) DeleteWorkflow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DeleteWorkflow handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	name := r.PathValue("workflowName")
+	if orgName == "" || name == "" {
+		logger.Warn("Organization name and Workflow name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name and Workflow name are required", services.CodeInvalidInput)
+		return
+	}
+	precondition, err := resourceVersionPrecondition(r, r.URL.Query().Get("resourceVersion"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	// Call service to delete Workflow
+	if err := h.services.WorkflowService.DeleteWorkflow(ctx, orgName, name, precondition); err != nil {
+		if errors.Is(err, services.ErrWorkflowNotFound) {
+			logger.Warn("Workflow not found", "org", orgName, "name", name)
+			writeErrorResponse(w, http.StatusNotFound, "Workflow not found", services.CodeWorkflowNotFound)
+			return
+		}
+		if writeResourceWriteError(w, err, precondition) {
+			logger.Warn("Workflow delete was rejected", "org", orgName, "name", name, "error", err)
+			return
+		}
+		logger.Error("Failed to delete Workflow", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Info("Workflow deleted successfully", "org", orgName, "name", name)
+	writeSuccessResponse[any](w, http.StatusOK, nil)
+}
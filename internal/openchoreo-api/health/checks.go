@@ -0,0 +1,138 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+// KubernetesAPI checks that the Kubernetes API server is reachable
+func KubernetesAPI(dc discovery.DiscoveryInterface) Check {
+	return Check{
+		Name:     "kubernetes-api",
+		Critical: true,
+		Func: func(ctx context.Context) error {
+			// The readiness endpoint of the API server reports whether it can serve requests itself
+			if err := dc.RESTClient().Get().AbsPath("/readyz").Do(ctx).Error(); err != nil {
+				return fmt.Errorf("kubernetes API server is not ready: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// CRDs checks that the custom resource definitions of all OpenChoreo kinds of the scheme are installed
+func CRDs(dc discovery.DiscoveryInterface, scheme *runtime.Scheme) Check {
+	gv := openchoreov1alpha1.GroupVersion
+	kinds := openChoreoKinds(scheme)
+
+	return Check{
+		Name:     "crds",
+		Critical: true,
+		Func: func(ctx context.Context) error {
+			resources, err := dc.ServerResourcesForGroupVersion(gv.String())
+			if err != nil {
+				return fmt.Errorf("failed to discover the resources of %s: %w", gv, err)
+			}
+			served := make(map[string]bool, len(resources.APIResources))
+			for _, r := range resources.APIResources {
+				served[r.Kind] = true
+			}
+			var missing []string
+			for _, kind := range kinds {
+				if !served[kind] {
+					missing = append(missing, kind)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("custom resource definitions of %s are not installed: %s", gv, strings.Join(missing, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// openChoreoKinds returns the sorted OpenChoreo resource kinds of the scheme. The kinds without a list, such as the
+// WatchEvent and options types registered in every group version, are not resources.
+func openChoreoKinds(scheme *runtime.Scheme) []string {
+	known := scheme.KnownTypes(openchoreov1alpha1.GroupVersion)
+	var kinds []string
+	for kind := range known {
+		if _, ok := known[kind+"List"]; ok {
+			kinds = append(kinds, kind)
+		}
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// DataPlanes checks that the Kubernetes clusters of all data planes are reachable.
+// It is not critical, since the API serves the requests that don't involve an unreachable data plane.
+func DataPlanes(c client.Client, clientMgr *kubernetesClient.KubeMultiClientManager) Check {
+	return Check{
+		Name: "dataplanes",
+		Func: func(ctx context.Context) error {
+			var list openchoreov1alpha1.DataPlaneList
+			if err := c.List(ctx, &list); err != nil {
+				return fmt.Errorf("failed to list data planes: %w", err)
+			}
+			var errs []error
+			for _, dp := range list.Items {
+				if err := clusterReachable(ctx, clientMgr, dp.Namespace, dp.Name, dp.Spec.KubernetesCluster); err != nil {
+					errs = append(errs, fmt.Errorf("data plane %s/%s: %w", dp.Namespace, dp.Name, err))
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
+
+// BuildPlanes checks that the Kubernetes clusters of all build planes are reachable.
+// It is not critical, since only builds need a build plane.
+func BuildPlanes(c client.Client, clientMgr *kubernetesClient.KubeMultiClientManager) Check {
+	return Check{
+		Name: "buildplanes",
+		Func: func(ctx context.Context) error {
+			var list openchoreov1alpha1.BuildPlaneList
+			if err := c.List(ctx, &list); err != nil {
+				return fmt.Errorf("failed to list build planes: %w", err)
+			}
+			var errs []error
+			for _, bp := range list.Items {
+				if err := clusterReachable(ctx, clientMgr, bp.Namespace, bp.Name, bp.Spec.KubernetesCluster); err != nil {
+					errs = append(errs, fmt.Errorf("build plane %s/%s: %w", bp.Namespace, bp.Name, err))
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
+
+// clusterReachable returns nil if the API server of a plane's cluster answers a request.
+// Authorization failures are answers too, as the check is about connectivity.
+func clusterReachable(ctx context.Context, clientMgr *kubernetesClient.KubeMultiClientManager, orgName, name string,
+	cluster openchoreov1alpha1.KubernetesClusterSpec) error {
+	cl, err := kubernetesClient.GetK8sClient(clientMgr, orgName, name, cluster)
+	if err != nil {
+		return err
+	}
+	err = cl.List(ctx, &corev1.NamespaceList{}, client.Limit(1))
+	if err != nil && !apierrors.IsForbidden(err) && !apierrors.IsUnauthorized(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// TestChartCRDsCoverScheme checks that the charts installing the API server ship the CRD of every kind
+// required by the crds check, as the startup probe fails until they are all served
+func TestChartCRDsCoverScheme(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build the scheme: %v", err)
+	}
+	kinds := openChoreoKinds(scheme)
+	if len(kinds) == 0 {
+		t.Fatal("no OpenChoreo kinds in the scheme")
+	}
+
+	for _, chart := range []string{"openchoreo", "openchoreo-control-plane"} {
+		t.Run(chart, func(t *testing.T) {
+			dir := filepath.Join("..", "..", "..", "install", "helm", chart, "crds")
+			files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+			if err != nil || len(files) == 0 {
+				t.Fatalf("no CRDs found in %s: %v", dir, err)
+			}
+			shipped := make(map[string]bool)
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatalf("failed to read %s: %v", file, err)
+				}
+				var crd apiextensionsv1.CustomResourceDefinition
+				if err := yaml.Unmarshal(data, &crd); err != nil {
+					t.Fatalf("failed to parse %s: %v", file, err)
+				}
+				if crd.Spec.Group != openchoreov1alpha1.GroupVersion.Group {
+					continue
+				}
+				for _, version := range crd.Spec.Versions {
+					if version.Name == openchoreov1alpha1.GroupVersion.Version && version.Served {
+						shipped[crd.Spec.Names.Kind] = true
+					}
+				}
+			}
+			for _, kind := range kinds {
+				if !shipped[kind] {
+					t.Errorf("chart %s has no served CRD for %s", chart, kind)
+				}
+			}
+		})
+	}
+}
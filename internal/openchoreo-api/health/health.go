@@ -0,0 +1,163 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Probe is a kind of health probe of the server
+type Probe string
+
+const (
+	// ProbeLiveness reports whether the server process is able to serve requests at all.
+	// It runs no checks, since a failing dependency doesn't get fixed by restarting the server.
+	ProbeLiveness Probe = "liveness"
+	// ProbeReadiness reports whether the server can serve requests now, which is when all critical checks pass
+	ProbeReadiness Probe = "readiness"
+	// ProbeStartup reports whether the server has started, which is once the readiness checks passed for the first time
+	ProbeStartup Probe = "startup"
+)
+
+// Status is the outcome of a probe or check
+type Status string
+
+const (
+	StatusOK     Status = "ok"
+	StatusFailed Status = "failed"
+)
+
+// Check is a check of a dependency of the server
+type Check struct {
+	// Name identifies the check in reports
+	Name string
+	// Critical checks fail the readiness probe when they fail. The failures of the other checks are only reported.
+	Critical bool
+	// Func returns an error if the dependency is not usable
+	Func func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of a check
+type CheckResult struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	Critical   bool   `json:"critical"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// Report is the outcome of a probe with the results of its checks
+type Report struct {
+	Probe     Probe         `json:"probe"`
+	Status    Status        `json:"status"`
+	CheckedAt time.Time     `json:"checkedAt"`
+	Checks    []CheckResult `json:"checks,omitempty"`
+}
+
+// OK reports whether the probe passed
+func (r *Report) OK() bool {
+	return r.Status == StatusOK
+}
+
+// Registry holds the checks of the server and runs them for the probes. The checks run concurrently,
+// each within the timeout, and their results are reused for maxAge, so that frequent probes don't
+// load the dependencies.
+type Registry struct {
+	timeout time.Duration
+	maxAge  time.Duration
+
+	mu     sync.Mutex
+	checks []Check
+	last   *Report
+
+	started atomic.Bool
+	now     func() time.Time
+}
+
+// NewRegistry creates a registry without checks
+func NewRegistry(timeout, maxAge time.Duration) *Registry {
+	return &Registry{timeout: timeout, maxAge: maxAge, now: time.Now}
+}
+
+// Register adds a check run by the readiness and startup probes
+func (r *Registry) Register(check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, check)
+	r.last = nil
+}
+
+// Run runs the checks of a probe and returns its report.
+// A nil registry has no checks, so that all its probes pass.
+func (r *Registry) Run(ctx context.Context, probe Probe) *Report {
+	if r == nil || probe == ProbeLiveness {
+		return &Report{Probe: probe, Status: StatusOK, CheckedAt: time.Now()}
+	}
+	if probe == ProbeStartup && r.started.Load() {
+		return &Report{Probe: probe, Status: StatusOK, CheckedAt: r.now()}
+	}
+
+	report := r.readiness(ctx)
+	if report.OK() {
+		r.started.Store(true)
+	}
+	result := *report
+	result.Probe = probe
+	return &result
+}
+
+// readiness returns the outcome of the checks, reusing the last one if it is recent enough
+func (r *Registry) readiness(ctx context.Context) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if r.last != nil && now.Sub(r.last.CheckedAt) < r.maxAge {
+		return r.last
+	}
+
+	results := make([]CheckResult, len(r.checks))
+	var wg sync.WaitGroup
+	for i, check := range r.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	status := StatusOK
+	for _, result := range results {
+		if result.Critical && result.Status != StatusOK {
+			status = StatusFailed
+		}
+	}
+	r.last = &Report{Probe: ProbeReadiness, Status: status, CheckedAt: now, Checks: results}
+	return r.last
+}
+
+// run runs a check within the timeout. The check is not canceled with the probe request,
+// since its result is reused by the other probes.
+func (r *Registry) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Func(ctx)
+	result := CheckResult{
+		Name:       check.Name,
+		Status:     StatusOK,
+		Critical:   check.Critical,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeCheck is a check returning err and counting its runs
+type fakeCheck struct {
+	err  error
+	runs int
+}
+
+func (f *fakeCheck) check(name string, critical bool) Check {
+	return Check{Name: name, Critical: critical, Func: func(context.Context) error {
+		f.runs++
+		return f.err
+	}}
+}
+
+func newTestRegistry() (*Registry, *time.Time) {
+	r := NewRegistry(time.Second, time.Second)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	return r, &now
+}
+
+func TestRunFailsReadinessOnCriticalChecks(t *testing.T) {
+	r, now := newTestRegistry()
+	api := &fakeCheck{err: errors.New("connection refused")}
+	planes := &fakeCheck{err: errors.New("timeout")}
+	r.Register(api.check("kubernetes-api", true))
+	r.Register(planes.check("dataplanes", false))
+	ctx := context.Background()
+
+	report := r.Run(ctx, ProbeReadiness)
+	if report.OK() || len(report.Checks) != 2 || report.Checks[0].Error != "connection refused" {
+		t.Fatalf("readiness report = %+v, want failed with the errors of both checks", report)
+	}
+	if r.Run(ctx, ProbeStartup).OK() {
+		t.Error("startup passed before the server was ready")
+	}
+	if !r.Run(ctx, ProbeLiveness).OK() {
+		t.Error("liveness failed on a failing dependency")
+	}
+
+	// Non-critical failures are reported without failing readiness
+	api.err = nil
+	*now = now.Add(time.Second)
+	report = r.Run(ctx, ProbeReadiness)
+	if !report.OK() || report.Checks[1].Status != StatusFailed {
+		t.Errorf("readiness report = %+v, want ok with the dataplanes check failed", report)
+	}
+}
+
+func TestRunReusesRecentResults(t *testing.T) {
+	r, now := newTestRegistry()
+	api := &fakeCheck{}
+	r.Register(api.check("kubernetes-api", true))
+	ctx := context.Background()
+
+	r.Run(ctx, ProbeReadiness)
+	r.Run(ctx, ProbeStartup)
+	if api.runs != 1 {
+		t.Errorf("check ran %d times within its max age, want 1", api.runs)
+	}
+	*now = now.Add(time.Second)
+	r.Run(ctx, ProbeReadiness)
+	if api.runs != 2 {
+		t.Errorf("check ran %d times after its max age, want 2", api.runs)
+	}
+}
+
+func TestStartupPassesOnceReady(t *testing.T) {
+	r, now := newTestRegistry()
+	api := &fakeCheck{}
+	r.Register(api.check("kubernetes-api", true))
+	ctx := context.Background()
+
+	if !r.Run(ctx, ProbeStartup).OK() {
+		t.Fatal("startup failed while the checks pass")
+	}
+	api.err = errors.New("connection refused")
+	*now = now.Add(time.Second)
+	if r.Run(ctx, ProbeReadiness).OK() {
+		t.Error("readiness passed on a failing critical check")
+	}
+	if !r.Run(ctx, ProbeStartup).OK() {
+		t.Error("startup failed after the server started")
+	}
+}
+
+func TestNilRegistryPasses(t *testing.T) {
+	var r *Registry
+	for _, probe := range []Probe{ProbeLiveness, ProbeReadiness, ProbeStartup} {
+		if report := r.Run(context.Background(), probe); !report.OK() || report.Probe != probe {
+			t.Errorf("%s report of a nil registry = %+v, want ok", probe, report)
+		}
+	}
+}
@@ -5,13 +5,20 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) ListBuildPlanes(ctx context.Context, orgName string) (string, error) {
-	buildplanes, err := h.Services.BuildPlaneService.ListBuildPlanes(ctx, orgName)
+func (h *MCPHandler) ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildPlaneResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.BuildPlaneService.ListBuildPlanes(ctx, orgName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(buildplanes)
+	return page, nil
 }
@@ -5,31 +5,50 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) ListBuildTemplates(ctx context.Context, orgName string) (string, error) {
-	templates, err := h.Services.BuildService.ListBuildTemplates(ctx, orgName)
+func (h *MCPHandler) ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildTemplateResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.BuildService.ListBuildTemplates(ctx, orgName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(templates)
+	return page, nil
 }
 
-func (h *MCPHandler) TriggerBuild(ctx context.Context, orgName, projectName, componentName, commit string) (string, error) {
+func (h *MCPHandler) TriggerBuild(ctx context.Context, orgName, projectName, componentName, commit string) (_ *models.BuildResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionBuild, audit.Resource{Kind: "Component", Name: componentName}, scope, map[string]string{"commit": commit})(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+
 	build, err := h.Services.BuildService.TriggerBuild(ctx, orgName, projectName, componentName, commit)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(build)
+	return build, nil
 }
 
-func (h *MCPHandler) ListBuilds(ctx context.Context, orgName, projectName, componentName string) (string, error) {
-	builds, err := h.Services.BuildService.ListBuilds(ctx, orgName, projectName, componentName)
+func (h *MCPHandler) ListBuilds(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListPage[models.BuildResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.BuildService.ListBuilds(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(builds)
+	return page, nil
 }
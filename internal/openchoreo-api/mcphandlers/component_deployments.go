@@ -0,0 +1,153 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (h *MCPHandler) ListComponentDeployments(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentDeploymentResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ComponentDeploymentService.ListComponentDeployments(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetComponentDeployment(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ComponentDeploymentResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	cd, err := h.Services.ComponentDeploymentService.GetComponentDeployment(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return cd, nil
+}
+
+func (h *MCPHandler) PutComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment string, req *models.ComponentDeploymentRequest,
+) (_ *models.ComponentDeploymentResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	resource := audit.Resource{Kind: "ComponentDeployment", Name: componentName + "-" + environment}
+	defer h.audited(ctx, audit.ActionUpdate, resource, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+	attributeFreezeOverride(ctx, req.FreezeOverride)
+
+	cd, _, err := h.Services.ComponentDeploymentService.PutComponentDeployment(ctx, orgName, projectName, componentName, environment, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cd, nil
+}
+
+func (h *MCPHandler) DeleteComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string, override *models.FreezeOverride,
+) (err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	resource := audit.Resource{Kind: "ComponentDeployment", Name: componentName + "-" + environment}
+	defer h.audited(ctx, audit.ActionDelete, resource, scope, override)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return err
+	}
+	attributeFreezeOverride(ctx, override)
+
+	return h.Services.ComponentDeploymentService.DeleteComponentDeployment(ctx, orgName, projectName, componentName, environment,
+		resourceVersion, override)
+}
+
+func (h *MCPHandler) ListComponentEnvSnapshots(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentEnvSnapshotResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ComponentDeploymentService.ListComponentEnvSnapshots(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetComponentEnvSnapshot(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ComponentEnvSnapshotResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := h.Services.ComponentDeploymentService.GetComponentEnvSnapshot(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (h *MCPHandler) ListReleases(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListPage[*models.ReleaseResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ComponentDeploymentService.ListReleases(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetRelease(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ReleaseResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	release, err := h.Services.ComponentDeploymentService.GetRelease(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (h *MCPHandler) RollbackComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string,
+) (_ *models.ComponentDeploymentResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	resource := audit.Resource{Kind: "ComponentDeployment", Name: componentName + "-" + environment}
+	defer h.audited(ctx, audit.ActionRollback, resource, scope, resourceVersion)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+
+	cd, err := h.Services.ComponentDeploymentService.RollbackComponentDeployment(ctx, orgName, projectName, componentName,
+		environment, resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return cd, nil
+}
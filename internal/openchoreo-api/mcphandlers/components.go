@@ -6,77 +6,188 @@ package mcphandlers
 import (
 	"context"
 
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
-func (h *MCPHandler) CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (string, error) {
+func (h *MCPHandler) CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (_ *models.ComponentResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "Component", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+
 	component, err := h.Services.ComponentService.CreateComponent(ctx, orgName, projectName, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(component)
+	return component, nil
 }
 
-func (h *MCPHandler) ListComponents(ctx context.Context, orgName, projectName string) (string, error) {
-	components, err := h.Services.ComponentService.ListComponents(ctx, orgName, projectName)
+func (h *MCPHandler) ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ComponentService.ListComponents(ctx, orgName, projectName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(components)
+	return page, nil
 }
 
-func (h *MCPHandler) GetComponent(ctx context.Context, orgName, projectName, componentName string, additionalResources []string) (string, error) {
+func (h *MCPHandler) GetComponent(ctx context.Context, orgName, projectName, componentName string, additionalResources []string) (*models.ComponentResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	component, err := h.Services.ComponentService.GetComponent(ctx, orgName, projectName, componentName, additionalResources)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(component)
+	return component, nil
 }
 
-func (h *MCPHandler) GetComponentBinding(ctx context.Context, orgName, projectName, componentName, environment string) (string, error) {
+func (h *MCPHandler) GetComponentBinding(ctx context.Context, orgName, projectName, componentName, environment string) (*models.BindingResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	binding, err := h.Services.ComponentService.GetComponentBinding(ctx, orgName, projectName, componentName, environment)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(binding)
+	return binding, nil
 }
 
-func (h *MCPHandler) UpdateComponentBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.UpdateBindingRequest) (string, error) {
+func (h *MCPHandler) UpdateComponentBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.UpdateBindingRequest) (_ *models.BindingResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionUpdate, audit.Resource{Kind: "ComponentBinding", Name: bindingName}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+	attributeFreezeOverride(ctx, req.FreezeOverride)
+
 	binding, err := h.Services.ComponentService.UpdateComponentBinding(ctx, orgName, projectName, componentName, bindingName, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(binding)
+	return binding, nil
 }
 
-func (h *MCPHandler) GetComponentObserverURL(ctx context.Context, orgName, projectName, componentName, environmentName string) (string, error) {
+func (h *MCPHandler) GetComponentObserverURL(ctx context.Context, orgName, projectName, componentName, environmentName string) (*models.ComponentObserverResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	observerURL, err := h.Services.ComponentService.GetComponentObserverURL(ctx, orgName, projectName, componentName, environmentName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(observerURL)
+	return observerURL, nil
 }
 
-func (h *MCPHandler) GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (string, error) {
+func (h *MCPHandler) GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (*models.ComponentObserverResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	observerURL, err := h.Services.ComponentService.GetBuildObserverURL(ctx, orgName, projectName, componentName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(observerURL)
+	return observerURL, nil
 }
 
-func (h *MCPHandler) GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (string, error) {
+func (h *MCPHandler) GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (*openchoreov1alpha1.WorkloadSpec, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	workloads, err := h.Services.ComponentService.GetComponentWorkloads(ctx, orgName, projectName, componentName)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return workloads, nil
+}
+
+func (h *MCPHandler) UpdateComponentTraits(
+	ctx context.Context, orgName, projectName, componentName string, req *models.ComponentTraitsRequest,
+) (_ *models.ComponentResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionUpdate, audit.Resource{Kind: "Component", Name: componentName}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	component, err := h.Services.ComponentService.UpdateComponentTraits(ctx, orgName, projectName, componentName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+func (h *MCPHandler) DeleteComponent(
+	ctx context.Context, orgName, projectName, componentName, resourceVersion string, override *models.FreezeOverride,
+) (err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionDelete, audit.Resource{Kind: "Component", Name: componentName}, scope, override)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return err
+	}
+	if override != nil {
+		if err := override.Validate(); err != nil {
+			return invalidInput(err)
+		}
+	}
+	attributeFreezeOverride(ctx, override)
+
+	return h.Services.ComponentService.DeleteComponent(ctx, orgName, projectName, componentName, resourceVersion, override)
+}
+
+func (h *MCPHandler) PromoteComponent(
+	ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
+) (_ []*models.BindingResponse, err error) {
+	scope := auth.Scope{Org: orgName, Project: projectName}
+	defer h.audited(ctx, audit.ActionPromote, audit.Resource{Kind: "Component", Name: componentName}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionEdit, scope); err != nil {
+		return nil, err
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+	attributeFreezeOverride(ctx, req.FreezeOverride)
+
+	bindings, err := h.Services.ComponentService.PromoteComponent(ctx, &services.PromoteComponentPayload{
+		PromoteComponentRequest: *req,
+		ComponentName:           componentName,
+		ProjectName:             projectName,
+		OrgName:                 orgName,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return marshalResponse(workloads)
+	return bindings, nil
 }
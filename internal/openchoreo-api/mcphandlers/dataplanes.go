@@ -6,32 +6,49 @@ package mcphandlers
 import (
 	"context"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) ListDataPlanes(ctx context.Context, orgName string) (string, error) {
-	dataplanes, err := h.Services.DataPlaneService.ListDataPlanes(ctx, orgName)
+func (h *MCPHandler) ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.DataPlaneResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.DataPlaneService.ListDataPlanes(ctx, orgName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(dataplanes)
+	return page, nil
 }
 
-func (h *MCPHandler) GetDataPlane(ctx context.Context, orgName, dpName string) (string, error) {
+func (h *MCPHandler) GetDataPlane(ctx context.Context, orgName, dpName string) (*models.DataPlaneResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
 	dataplane, err := h.Services.DataPlaneService.GetDataPlane(ctx, orgName, dpName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(dataplane)
+	return dataplane, nil
 }
 
-func (h *MCPHandler) CreateDataPlane(ctx context.Context, orgName string, req *models.CreateDataPlaneRequest) (string, error) {
+func (h *MCPHandler) CreateDataPlane(ctx context.Context, orgName string, req *models.CreateDataPlaneRequest) (_ *models.DataPlaneResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "DataPlane", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+
 	dataplane, err := h.Services.DataPlaneService.CreateDataPlane(ctx, orgName, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(dataplane)
+	return dataplane, nil
 }
@@ -5,13 +5,20 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) GetProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (string, error) {
+func (h *MCPHandler) GetProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (*models.DeploymentPipelineResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	pipeline, err := h.Services.DeploymentPipelineService.GetProjectDeploymentPipeline(ctx, orgName, projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(pipeline)
+	return pipeline, nil
 }
@@ -6,32 +6,49 @@ package mcphandlers
 import (
 	"context"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) ListEnvironments(ctx context.Context, orgName string) (string, error) {
-	environments, err := h.Services.EnvironmentService.ListEnvironments(ctx, orgName)
+func (h *MCPHandler) ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.EnvironmentResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.EnvironmentService.ListEnvironments(ctx, orgName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(environments)
+	return page, nil
 }
 
-func (h *MCPHandler) GetEnvironment(ctx context.Context, orgName, envName string) (string, error) {
+func (h *MCPHandler) GetEnvironment(ctx context.Context, orgName, envName string) (*models.EnvironmentResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
 	environment, err := h.Services.EnvironmentService.GetEnvironment(ctx, orgName, envName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(environment)
+	return environment, nil
 }
 
-func (h *MCPHandler) CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (string, error) {
+func (h *MCPHandler) CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (_ *models.EnvironmentResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "Environment", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+
 	environment, err := h.Services.EnvironmentService.CreateEnvironment(ctx, orgName, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(environment)
+	return environment, nil
 }
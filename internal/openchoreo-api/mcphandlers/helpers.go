@@ -4,21 +4,66 @@
 package mcphandlers
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 type MCPHandler struct {
 	Services *services.Services
+	// Auth authorizes the tool calls of the authenticated principal. All calls are allowed if nil.
+	Auth *auth.Auth
+	// Auditor records the mutating tool calls. Tool calls are not audited if nil.
+	Auditor *audit.Auditor
 }
 
-// marshalResponse is a helper function that marshals any data into a JSON string.
-// It returns the JSON string representation of the data or an error if marshaling fails.
-func marshalResponse(data any) (string, error) {
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return "", err
+// audited starts the audit of a mutating tool call. The returned function records the call
+// once it completed with the error pointed to by errp, and is meant to be deferred.
+func (h *MCPHandler) audited(ctx context.Context, action audit.Action, resource audit.Resource, scope auth.Scope, payload any) func(errp *error) {
+	start := time.Now()
+	return func(errp *error) {
+		record := &audit.Record{
+			Source:      audit.SourceMCP,
+			Action:      action,
+			Resource:    resource,
+			Org:         scope.Org,
+			Project:     scope.Project,
+			PayloadHash: audit.HashValue(payload),
+			Outcome:     audit.OutcomeOf(*errp),
+			LatencyMs:   time.Since(start).Milliseconds(),
+		}
+		if *errp != nil {
+			record.Error = (*errp).Error()
+		}
+		h.Auditor.Record(ctx, record)
+	}
+}
+
+// invalidInput marks the validation errors of tool arguments as invalid input
+func invalidInput(err error) error {
+	return fmt.Errorf("%w: %w", services.ErrInvalidInput, err)
+}
+
+// ErrorCode returns the error code of the errors returned to tool calls
+func (h *MCPHandler) ErrorCode(err error) string {
+	if errors.Is(err, auth.ErrForbidden) {
+		return services.CodeForbidden
+	}
+	return services.ErrorCode(err)
+}
+
+// attributeFreezeOverride sets the authenticated caller of the tool call as the principal of a freeze override
+func attributeFreezeOverride(ctx context.Context, override *models.FreezeOverride) {
+	if override == nil {
+		return
+	}
+	if principal := auth.GetPrincipal(ctx); principal != nil {
+		override.Principal = principal.Name
 	}
-	return string(jsonData), nil
 }
@@ -0,0 +1,78 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (h *MCPHandler) GetComponentLogs(
+	ctx context.Context, orgName, projectName, componentName, environment string, query *models.LogQuery,
+) (*models.LogsResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	logs, err := h.Services.ObservabilityService.GetComponentLogs(ctx, orgName, projectName, componentName, environment, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (h *MCPHandler) GetBuildLogs(
+	ctx context.Context, orgName, projectName, componentName, buildName string, query *models.LogQuery,
+) (*models.LogsResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	logs, err := h.Services.ObservabilityService.GetBuildLogs(ctx, orgName, projectName, componentName, buildName, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (h *MCPHandler) GetComponentTraces(
+	ctx context.Context, orgName, projectName, componentName, environment string, query *models.TraceQuery,
+) (*models.TracesResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	traces, err := h.Services.ObservabilityService.GetComponentTraces(ctx, orgName, projectName, componentName, environment, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return traces, nil
+}
+
+func (h *MCPHandler) GetReleaseHealth(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ReleaseHealthResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
+	health, err := h.Services.ObservabilityService.GetReleaseHealth(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
@@ -5,28 +5,26 @@ package mcphandlers
 
 import (
 	"context"
-)
 
-func (h *MCPHandler) GetOrganization(ctx context.Context, name string) (string, error) {
-	if name == "" {
-		return h.listOrganizations(ctx)
-	} else {
-		return h.getOrganizationByName(ctx, name)
-	}
-}
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
 
-func (h *MCPHandler) listOrganizations(ctx context.Context) (string, error) {
-	res, err := h.Services.OrganizationService.ListOrganizations(ctx)
+func (h *MCPHandler) ListOrganizations(ctx context.Context) ([]*models.OrganizationResponse, error) {
+	// Only the organizations the principal is allowed to view are listed
+	page, err := h.Services.OrganizationService.ListOrganizations(ctx, nil, func(orgName string) bool {
+		return h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}) == nil
+	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return marshalResponse(res)
+	return page.Items, nil
 }
 
-func (h *MCPHandler) getOrganizationByName(ctx context.Context, name string) (string, error) {
-	res, err := h.Services.OrganizationService.GetOrganization(ctx, name)
-	if err != nil {
-		return "", err
+func (h *MCPHandler) GetOrganization(ctx context.Context, name string) (*models.OrganizationResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: name}); err != nil {
+		return nil, err
 	}
-	return marshalResponse(res)
+
+	return h.Services.OrganizationService.GetOrganization(ctx, name)
 }
@@ -0,0 +1,274 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (h *MCPHandler) ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentTypeResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ComponentTypeService.ListComponentTypes(ctx, orgName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetComponentType(ctx context.Context, orgName, name string) (*models.ComponentTypeResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	resource, err := h.Services.ComponentTypeService.GetComponentType(ctx, orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) CreateComponentType(ctx context.Context, orgName string, req *models.ComponentTypeRequest) (_ *models.ComponentTypeResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "ComponentType", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.ComponentTypeService.CreateComponentType(ctx, orgName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) UpdateComponentType(ctx context.Context, orgName, name string, req *models.ComponentTypeRequest) (_ *models.ComponentTypeResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionUpdate, audit.Resource{Kind: "ComponentType", Name: name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Name = name
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.ComponentTypeService.UpdateComponentType(ctx, orgName, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) DeleteComponentType(ctx context.Context, orgName, name, resourceVersion string) (err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionDelete, audit.Resource{Kind: "ComponentType", Name: name}, scope, resourceVersion)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return err
+	}
+
+	return h.Services.ComponentTypeService.DeleteComponentType(ctx, orgName, name, resourceVersion)
+}
+
+func (h *MCPHandler) ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.TraitResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.TraitService.ListTraits(ctx, orgName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetTrait(ctx context.Context, orgName, name string) (*models.TraitResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	resource, err := h.Services.TraitService.GetTrait(ctx, orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) CreateTrait(ctx context.Context, orgName string, req *models.TraitRequest) (_ *models.TraitResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "Trait", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.TraitService.CreateTrait(ctx, orgName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) UpdateTrait(ctx context.Context, orgName, name string, req *models.TraitRequest) (_ *models.TraitResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionUpdate, audit.Resource{Kind: "Trait", Name: name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Name = name
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.TraitService.UpdateTrait(ctx, orgName, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) DeleteTrait(ctx context.Context, orgName, name, resourceVersion string) (err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionDelete, audit.Resource{Kind: "Trait", Name: name}, scope, resourceVersion)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return err
+	}
+
+	return h.Services.TraitService.DeleteTrait(ctx, orgName, name, resourceVersion)
+}
+
+func (h *MCPHandler) ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.WorkflowResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.WorkflowService.ListWorkflows(ctx, orgName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (h *MCPHandler) GetWorkflow(ctx context.Context, orgName, name string) (*models.WorkflowResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	resource, err := h.Services.WorkflowService.GetWorkflow(ctx, orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) CreateWorkflow(ctx context.Context, orgName string, req *models.WorkflowRequest) (_ *models.WorkflowResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "Workflow", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.WorkflowService.CreateWorkflow(ctx, orgName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) UpdateWorkflow(ctx context.Context, orgName, name string, req *models.WorkflowRequest) (_ *models.WorkflowResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionUpdate, audit.Resource{Kind: "Workflow", Name: name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+	req.Name = name
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(err)
+	}
+
+	resource, err := h.Services.WorkflowService.UpdateWorkflow(ctx, orgName, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+func (h *MCPHandler) DeleteWorkflow(ctx context.Context, orgName, name, resourceVersion string) (err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionDelete, audit.Resource{Kind: "Workflow", Name: name}, scope, resourceVersion)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return err
+	}
+
+	return h.Services.WorkflowService.DeleteWorkflow(ctx, orgName, name, resourceVersion)
+}
+
+func (h *MCPHandler) GetComponentTypeSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	schema, err := h.Services.ComponentTypeService.GetComponentTypeSchema(ctx, orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func (h *MCPHandler) GetTraitSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	schema, err := h.Services.TraitService.GetTraitSchema(ctx, orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
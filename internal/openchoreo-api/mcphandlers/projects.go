@@ -6,32 +6,49 @@ package mcphandlers
 import (
 	"context"
 
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
-func (h *MCPHandler) ListProjects(ctx context.Context, orgName string) (string, error) {
-	projects, err := h.Services.ProjectService.ListProjects(ctx, orgName)
+func (h *MCPHandler) ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ProjectResponse], error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName}); err != nil {
+		return nil, err
+	}
+
+	page, err := h.Services.ProjectService.ListProjects(ctx, orgName, opts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(projects)
+	return page, nil
 }
 
-func (h *MCPHandler) GetProject(ctx context.Context, orgName, projectName string) (string, error) {
+func (h *MCPHandler) GetProject(ctx context.Context, orgName, projectName string) (*models.ProjectResponse, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: orgName, Project: projectName}); err != nil {
+		return nil, err
+	}
+
 	project, err := h.Services.ProjectService.GetProject(ctx, orgName, projectName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(project)
+	return project, nil
 }
 
-func (h *MCPHandler) CreateProject(ctx context.Context, orgName string, req *models.CreateProjectRequest) (string, error) {
+func (h *MCPHandler) CreateProject(ctx context.Context, orgName string, req *models.CreateProjectRequest) (_ *models.ProjectResponse, err error) {
+	scope := auth.Scope{Org: orgName}
+	defer h.audited(ctx, audit.ActionCreate, audit.Resource{Kind: "Project", Name: req.Name}, scope, req)(&err)
+
+	if err := h.Auth.Authorize(ctx, auth.ActionAdmin, scope); err != nil {
+		return nil, err
+	}
+
 	project, err := h.Services.ProjectService.CreateProject(ctx, orgName, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(project)
+	return project, nil
 }
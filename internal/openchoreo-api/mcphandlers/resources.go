@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/pkg/mcp"
+)
+
+// WatchResource watches the status changes of a resource a client subscribed to. A component changes
+// with its builds, releases and deployments, while releases and deployments change on their own.
+func (h *MCPHandler) WatchResource(ctx context.Context, ref mcp.ResourceRef) (<-chan struct{}, error) {
+	if err := h.Auth.Authorize(ctx, auth.ActionView, auth.Scope{Org: ref.OrgName, Project: ref.ProjectName}); err != nil {
+		return nil, err
+	}
+
+	scope := services.EventScope{OrgName: ref.OrgName, ProjectName: ref.ProjectName, ComponentName: ref.ComponentName}
+	switch ref.Kind {
+	case mcp.ResourceKindComponent:
+	case mcp.ResourceKindComponentDeployment:
+		scope.Kinds = []string{models.EventKindComponentDeployment}
+	case mcp.ResourceKindRelease, mcp.ResourceKindReleaseHealth:
+		scope.Kinds = []string{models.EventKindRelease}
+	default:
+		return nil, fmt.Errorf("resources of kind %s can't be watched", ref.Kind)
+	}
+
+	// The watch starts by reporting the current status, which the subscriber already read
+	current, err := h.Services.EventService.ListEvents(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]string, len(current))
+	for _, event := range current {
+		seen[event.Kind+"/"+event.Name] = event.ResourceVersion
+	}
+
+	events, err := h.Services.EventService.WatchEvents(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changes are coalesced while the subscribers are being notified of a previous one
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		for event := range events {
+			if ref.Environment != "" && event.Environment != ref.Environment {
+				continue
+			}
+			key := event.Kind + "/" + event.Name
+			if version, ok := seen[key]; ok && version == event.ResourceVersion {
+				continue
+			}
+			seen[key] = event.ResourceVersion
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return changes, nil
+}
@@ -5,16 +5,24 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 // ExplainSchema explains the schema of a Kubernetes resource kind.
 // It accepts a kind (e.g., "Component") and an optional path (e.g., "spec" or "spec.build")
 // to drill down into nested fields.
-func (h *MCPHandler) ExplainSchema(ctx context.Context, kind, path string) (string, error) {
+func (h *MCPHandler) ExplainSchema(ctx context.Context, kind, path string) (*models.SchemaExplanation, error) {
 	explanation, err := h.Services.SchemaService.ExplainSchema(ctx, kind, path)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return marshalResponse(explanation)
+	return explanation, nil
+}
+
+// JSONSchema returns the JSON schema of a field of a resource kind. It is used to describe the
+// structured arguments of the write tools.
+func (h *MCPHandler) JSONSchema(ctx context.Context, kind, path string) (map[string]any, error) {
+	return h.Services.SchemaService.JSONSchema(ctx, kind, path)
 }
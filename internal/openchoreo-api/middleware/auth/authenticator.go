@@ -0,0 +1,56 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNoCredentials is returned when the request carries no bearer token
+	ErrNoCredentials = errors.New("no bearer token provided")
+	// ErrUnknownToken is returned by an authenticator for tokens it can't verify,
+	// so that the next authenticator is tried
+	ErrUnknownToken = errors.New("unknown token")
+	// ErrInvalidToken is returned for tokens that failed verification
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Authenticator authenticates the bearer token of a request
+type Authenticator interface {
+	// AuthenticateToken returns the principal the token was issued to.
+	// It returns ErrUnknownToken if the token is not one the authenticator can verify.
+	AuthenticateToken(ctx context.Context, token string) (*Principal, error)
+}
+
+// Authenticators tries each authenticator in order until one of them knows the token
+type Authenticators []Authenticator
+
+// AuthenticateToken implements Authenticator.
+func (a Authenticators) AuthenticateToken(ctx context.Context, token string) (*Principal, error) {
+	for _, authenticator := range a {
+		principal, err := authenticator.AuthenticateToken(ctx, token)
+		if errors.Is(err, ErrUnknownToken) {
+			continue
+		}
+		return principal, err
+	}
+	return nil, ErrInvalidToken
+}
+
+// bearerToken extracts the bearer token from the Authorization header of the request
+func bearerToken(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", ErrNoCredentials
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", ErrNoCredentials
+	}
+	return strings.TrimSpace(token), nil
+}
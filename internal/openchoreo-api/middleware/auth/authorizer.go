@@ -0,0 +1,186 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ErrForbidden is returned when the principal is not allowed to perform an action
+var ErrForbidden = errors.New("forbidden")
+
+// Role is a set of actions granted to the subjects of a role binding
+type Role string
+
+const (
+	// RoleOrgAdmin can perform all actions in the organization
+	RoleOrgAdmin Role = "org-admin"
+	// RoleProjectDeveloper can view the organization and view and edit the resources of its projects
+	RoleProjectDeveloper Role = "project-developer"
+	// RoleViewer can view the organization and the resources of its projects
+	RoleViewer Role = "viewer"
+)
+
+// Action is an action performed on the resources of a scope
+type Action string
+
+const (
+	// ActionView reads resources
+	ActionView Action = "view"
+	// ActionEdit creates, updates, builds, deploys and promotes the resources of a project
+	ActionEdit Action = "edit"
+	// ActionAdmin manages the organization level resources, such as projects, environments and data planes
+	ActionAdmin Action = "admin"
+)
+
+// Wildcard matches all organizations or projects in a role binding
+const Wildcard = "*"
+
+// Scope is the organization and optionally the project an action is performed in.
+// An empty organization refers to cluster level resources, which only wildcard bindings apply to.
+type Scope struct {
+	Org     string
+	Project string
+}
+
+func (s Scope) String() string {
+	switch {
+	case s.Org == "":
+		return "cluster"
+	case s.Project == "":
+		return "organization " + s.Org
+	default:
+		return fmt.Sprintf("project %s/%s", s.Org, s.Project)
+	}
+}
+
+// SubjectKind is the kind of a role binding subject
+type SubjectKind string
+
+const (
+	SubjectKindUser  SubjectKind = "User"
+	SubjectKindGroup SubjectKind = "Group"
+)
+
+// Subject is a user or group a role is bound to
+type Subject struct {
+	Kind SubjectKind `json:"kind"`
+	Name string      `json:"name"`
+}
+
+// RoleBinding grants a role to subjects in an organization, or in a project of it
+type RoleBinding struct {
+	Role Role `json:"role"`
+	// Org is the organization the role is granted in, or "*" for all organizations
+	Org string `json:"org"`
+	// Project restricts the role to a project of the organization, or "*" or empty for all projects.
+	// Only project-developer and viewer roles can be restricted to a project.
+	Project  string    `json:"project,omitempty"`
+	Subjects []Subject `json:"subjects"`
+}
+
+// AuthorizationConfig is the content of the authorization config file
+type AuthorizationConfig struct {
+	RoleBindings []RoleBinding `json:"roleBindings"`
+}
+
+// Authorizer checks the actions of principals against the role bindings
+type Authorizer struct {
+	bindings []RoleBinding
+}
+
+// NewAuthorizer creates an authorizer for the role bindings.
+func NewAuthorizer(bindings []RoleBinding) (*Authorizer, error) {
+	for i, b := range bindings {
+		switch b.Role {
+		case RoleOrgAdmin:
+			if b.Project != "" && b.Project != Wildcard {
+				return nil, fmt.Errorf("role binding %d: %s can't be restricted to a project", i, b.Role)
+			}
+		case RoleProjectDeveloper, RoleViewer:
+		default:
+			return nil, fmt.Errorf("role binding %d: unknown role %q", i, b.Role)
+		}
+		if b.Org == "" {
+			return nil, fmt.Errorf("role binding %d: org is required", i)
+		}
+		for _, s := range b.Subjects {
+			if (s.Kind != SubjectKindUser && s.Kind != SubjectKindGroup) || s.Name == "" {
+				return nil, fmt.Errorf("role binding %d: subjects must be a User or Group with a name", i)
+			}
+		}
+	}
+	return &Authorizer{bindings: bindings}, nil
+}
+
+// LoadAuthorizer creates an authorizer for the role bindings of the config file.
+func LoadAuthorizer(path string) (*Authorizer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization config: %w", err)
+	}
+	var config AuthorizationConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization config: %w", err)
+	}
+	return NewAuthorizer(config.RoleBindings)
+}
+
+// Authorize returns nil if a role bound to the principal allows the action in the scope, or ErrForbidden otherwise.
+func (a *Authorizer) Authorize(principal *Principal, action Action, scope Scope) error {
+	if principal != nil {
+		for _, b := range a.bindings {
+			if b.boundTo(principal) && b.allows(action, scope) {
+				return nil
+			}
+		}
+	}
+	name := "anonymous"
+	if principal != nil {
+		name = principal.Name
+	}
+	return fmt.Errorf("%w: %s is not allowed to %s in %s", ErrForbidden, name, action, scope)
+}
+
+func (b RoleBinding) boundTo(principal *Principal) bool {
+	for _, s := range b.Subjects {
+		switch s.Kind {
+		case SubjectKindUser:
+			if s.Name == principal.Name {
+				return true
+			}
+		case SubjectKindGroup:
+			if slices.Contains(principal.Groups, s.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b RoleBinding) allows(action Action, scope Scope) bool {
+	if b.Org != Wildcard && b.Org != scope.Org {
+		return false
+	}
+	// Organization and cluster level resources are visible to every role of the organization
+	if scope.Project == "" {
+		return action == ActionView || b.Role == RoleOrgAdmin
+	}
+	if b.Project != "" && b.Project != Wildcard && b.Project != scope.Project {
+		return false
+	}
+	switch b.Role {
+	case RoleOrgAdmin:
+		return true
+	case RoleProjectDeveloper:
+		return action == ActionView || action == ActionEdit
+	default:
+		return action == ActionView
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRoleBindingAllows(t *testing.T) {
+	t.Parallel()
+
+	org := Scope{Org: "acme"}
+	project := Scope{Org: "acme", Project: "shop"}
+	otherProject := Scope{Org: "acme", Project: "billing"}
+	otherOrg := Scope{Org: "globex", Project: "shop"}
+	cluster := Scope{}
+
+	type check struct {
+		action Action
+		scope  Scope
+		want   bool
+	}
+	tests := []struct {
+		name    string
+		binding RoleBinding
+		checks  []check
+	}{
+		{
+			name:    "org-admin of an organization",
+			binding: RoleBinding{Role: RoleOrgAdmin, Org: "acme"},
+			checks: []check{
+				{ActionAdmin, org, true},
+				{ActionEdit, project, true},
+				{ActionAdmin, otherProject, true},
+				{ActionView, otherOrg, false},
+				{ActionView, cluster, false},
+			},
+		},
+		{
+			name:    "org-admin of all organizations",
+			binding: RoleBinding{Role: RoleOrgAdmin, Org: Wildcard},
+			checks: []check{
+				{ActionAdmin, otherOrg, true},
+				{ActionAdmin, cluster, true},
+			},
+		},
+		{
+			name:    "project-developer of a project",
+			binding: RoleBinding{Role: RoleProjectDeveloper, Org: "acme", Project: "shop"},
+			checks: []check{
+				{ActionView, org, true},
+				{ActionAdmin, org, false},
+				{ActionEdit, org, false},
+				{ActionEdit, project, true},
+				{ActionAdmin, project, false},
+				{ActionView, otherProject, false},
+				{ActionView, otherOrg, false},
+			},
+		},
+		{
+			name:    "project-developer of all projects",
+			binding: RoleBinding{Role: RoleProjectDeveloper, Org: "acme", Project: Wildcard},
+			checks: []check{
+				{ActionEdit, project, true},
+				{ActionEdit, otherProject, true},
+				{ActionEdit, otherOrg, false},
+			},
+		},
+		{
+			name:    "viewer of an organization",
+			binding: RoleBinding{Role: RoleViewer, Org: "acme"},
+			checks: []check{
+				{ActionView, org, true},
+				{ActionView, project, true},
+				{ActionEdit, project, false},
+				{ActionAdmin, org, false},
+				{ActionView, otherOrg, false},
+			},
+		},
+		{
+			name:    "viewer of all organizations",
+			binding: RoleBinding{Role: RoleViewer, Org: Wildcard},
+			checks: []check{
+				{ActionView, otherOrg, true},
+				{ActionView, cluster, true},
+				{ActionEdit, otherOrg, false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for _, c := range tt.checks {
+				if got := tt.binding.allows(c.action, c.scope); got != c.want {
+					t.Errorf("allows(%s, %s) = %v, want %v", c.action, c.scope, got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	t.Parallel()
+
+	authorizer, err := NewAuthorizer([]RoleBinding{
+		{Role: RoleProjectDeveloper, Org: "acme", Project: "shop", Subjects: []Subject{{Kind: SubjectKindGroup, Name: "developers"}}},
+		{Role: RoleOrgAdmin, Org: "acme", Subjects: []Subject{{Kind: SubjectKindUser, Name: "admin"}}},
+	})
+	if err != nil {
+		t.Fatalf("NewAuthorizer() = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		action    Action
+		scope     Scope
+		wantErr   bool
+	}{
+		{name: "bound by group", principal: &Principal{Name: "alice", Groups: []string{"developers"}}, action: ActionEdit, scope: Scope{Org: "acme", Project: "shop"}},
+		{name: "bound by user", principal: &Principal{Name: "admin"}, action: ActionAdmin, scope: Scope{Org: "acme"}},
+		{name: "not allowed by the role", principal: &Principal{Name: "alice", Groups: []string{"developers"}}, action: ActionAdmin, scope: Scope{Org: "acme"}, wantErr: true},
+		{name: "not bound", principal: &Principal{Name: "bob"}, action: ActionView, scope: Scope{Org: "acme"}, wantErr: true},
+		{name: "anonymous", action: ActionView, scope: Scope{Org: "acme"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := authorizer.Authorize(tt.principal, tt.action, tt.scope)
+			if tt.wantErr != errors.Is(err, ErrForbidden) {
+				t.Errorf("Authorize() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAuthorizerValidatesBindings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		binding RoleBinding
+	}{
+		{name: "unknown role", binding: RoleBinding{Role: "owner", Org: "acme"}},
+		{name: "org-admin of a project", binding: RoleBinding{Role: RoleOrgAdmin, Org: "acme", Project: "shop"}},
+		{name: "no organization", binding: RoleBinding{Role: RoleViewer}},
+		{name: "unnamed subject", binding: RoleBinding{Role: RoleViewer, Org: "acme", Subjects: []Subject{{Kind: SubjectKindUser}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := NewAuthorizer([]RoleBinding{tt.binding}); err == nil {
+				t.Errorf("NewAuthorizer(%+v) = nil, want an error", tt.binding)
+			}
+		})
+	}
+}
+
+func TestNewFromConfigFailsClosed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "tokens.csv")
+	if err := os.WriteFile(tokenFile, []byte("secret,ci,1,\"builders\"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write the token file: %v", err)
+	}
+	authorizationFile := filepath.Join(dir, "authorization.yaml")
+	bindings := "roleBindings:\n- role: viewer\n  org: acme\n  subjects:\n  - kind: Group\n    name: builders\n"
+	if err := os.WriteFile(authorizationFile, []byte(bindings), 0o600); err != nil {
+		t.Fatalf("failed to write the authorization config: %v", err)
+	}
+
+	if _, err := NewFromConfig(Config{TokenFile: tokenFile}); err == nil {
+		t.Error("NewFromConfig() without role bindings = nil, want an error")
+	}
+	if _, err := NewFromConfig(Config{AuthorizationConfigFile: authorizationFile}); err == nil {
+		t.Error("NewFromConfig() with role bindings but no authenticator = nil, want an error")
+	}
+
+	disabled, err := NewFromConfig(Config{})
+	if err != nil || disabled.Enabled() {
+		t.Errorf("NewFromConfig() of an empty config = %v, %v, want authentication disabled", disabled, err)
+	}
+
+	a, err := NewFromConfig(Config{TokenFile: tokenFile, AuthorizationConfigFile: authorizationFile})
+	if err != nil {
+		t.Fatalf("NewFromConfig() = %v", err)
+	}
+	ctx := WithPrincipal(context.Background(), &Principal{Name: "ci", Groups: []string{"builders"}})
+	if err := a.Authorize(ctx, ActionView, Scope{Org: "acme"}); err != nil {
+		t.Errorf("Authorize() of a bound action = %v", err)
+	}
+	if err := a.Authorize(ctx, ActionEdit, Scope{Org: "acme", Project: "shop"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() of an unbound action = %v, want ErrForbidden", err)
+	}
+
+	// An Auth without an authorizer denies every action of authenticated principals
+	if err := New(Authenticators{}, nil).Authorize(ctx, ActionView, Scope{Org: "acme"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() without an authorizer = %v, want ErrForbidden", err)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// clockSkew is the leeway allowed when validating the expiry and not before times of tokens
+const clockSkew = time.Minute
+
+// OIDCConfig configures the validation of OIDC/JWT bearer tokens
+type OIDCConfig struct {
+	// IssuerURL is the expected issuer of the tokens
+	IssuerURL string
+	// Audience is the expected audience of the tokens. The audience is not checked if empty.
+	Audience string
+	// JWKSURL is the URL of the JSON Web Key Set of the issuer.
+	// If empty, it is discovered from the OpenID configuration of the issuer.
+	JWKSURL string
+	// UsernameClaim is the claim used as the user name, "sub" by default
+	UsernameClaim string
+	// GroupsClaim is the claim holding the groups of the user, "groups" by default
+	GroupsClaim string
+}
+
+// JWTAuthenticator authenticates JWT bearer tokens signed by an OIDC issuer
+type JWTAuthenticator struct {
+	config OIDCConfig
+	keys   *keySet
+	now    func() time.Time
+}
+
+// NewJWTAuthenticator creates an authenticator validating tokens of the issuer.
+// The signing keys of the issuer are fetched when the first token is validated.
+func NewJWTAuthenticator(config OIDCConfig) (*JWTAuthenticator, error) {
+	if config.IssuerURL == "" {
+		return nil, errors.New("OIDC issuer URL is required")
+	}
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "sub"
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+	return &JWTAuthenticator{
+		config: config,
+		keys:   newKeySet(config.IssuerURL, config.JWKSURL),
+		now:    time.Now,
+	}, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// AuthenticateToken implements Authenticator.
+func (a *JWTAuthenticator) AuthenticateToken(ctx context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrUnknownToken
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrUnknownToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+
+	keys, err := a.keys.get(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, key := range keys {
+		if err := verifySignature(header.Alg, key, signed, signature); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("%w: signature verification failed", ErrInvalidToken)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+	if err := a.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	name, _ := claims[a.config.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%w: claim %q is missing", ErrInvalidToken, a.config.UsernameClaim)
+	}
+	return &Principal{Name: name, Groups: stringsClaim(claims[a.config.GroupsClaim])}, nil
+}
+
+func (a *JWTAuthenticator) validateClaims(claims map[string]any) error {
+	if iss, _ := claims["iss"].(string); iss != a.config.IssuerURL {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if a.config.Audience != "" {
+		found := false
+		for _, aud := range stringsClaim(claims["aud"]) {
+			if aud == a.config.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("token was not issued for this audience")
+		}
+	}
+
+	now := a.now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("token has no expiry")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token is not valid yet")
+	}
+	return nil
+}
+
+// verifySignature verifies the signature of a JWT. Only asymmetric algorithms are accepted.
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	if len(alg) != len("RS256") {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"):
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		return rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+	case strings.HasPrefix(alg, "PS"):
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		return rsa.VerifyPSS(pub, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case strings.HasPrefix(alg, "ES"):
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("malformed signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// stringsClaim returns the values of a claim that is either a string or a list of strings
+func stringsClaim(v any) []string {
+	switch claim := v.(type) {
+	case string:
+		return []string{claim}
+	case []any:
+		values := make([]string, 0, len(claim))
+		for _, item := range claim {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
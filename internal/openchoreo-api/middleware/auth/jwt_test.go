@@ -0,0 +1,214 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+// testIssuerServer serves the JWKS of the signing keys and counts how often it was fetched
+type testIssuerServer struct {
+	*httptest.Server
+	keys    map[string]*rsa.PrivateKey
+	fetches atomic.Int32
+}
+
+func newTestIssuerServer(t *testing.T, keys map[string]*rsa.PrivateKey) *testIssuerServer {
+	t.Helper()
+	s := &testIssuerServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+		jwks := struct {
+			Keys []jsonWebKey `json:"keys"`
+		}{}
+		for kid, key := range s.keys {
+			jwks.Keys = append(jwks.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate a key: %v", err)
+	}
+	return key
+}
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode a token segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// signRS256 returns a token of the claims signed with the key
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign the token: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims(now time.Time) map[string]any {
+	return map[string]any{
+		"iss":    testIssuer,
+		"aud":    []string{"openchoreo"},
+		"sub":    "alice",
+		"groups": []string{"developers"},
+		"exp":    now.Add(time.Hour).Unix(),
+		"nbf":    now.Add(-time.Minute).Unix(),
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key := newTestKey(t)
+	server := newTestIssuerServer(t, map[string]*rsa.PrivateKey{"key-1": key})
+	authenticator, err := NewJWTAuthenticator(OIDCConfig{IssuerURL: testIssuer, Audience: "openchoreo", JWKSURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() = %v", err)
+	}
+	now := time.Now()
+
+	withClaim := func(name string, value any) map[string]any {
+		claims := validClaims(now)
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal the public key: %v", err)
+	}
+	hs256 := func(claims map[string]any) string {
+		signed := encodeSegment(t, map[string]string{"alg": "HS256", "kid": "key-1"}) + "." + encodeSegment(t, claims)
+		mac := hmac.New(sha256.New, publicKey)
+		mac.Write([]byte(signed))
+		return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: signRS256(t, key, "key-1", validClaims(now))},
+		{
+			name:    "alg none",
+			token:   encodeSegment(t, map[string]string{"alg": "none", "kid": "key-1"}) + "." + encodeSegment(t, validClaims(now)) + ".",
+			wantErr: ErrInvalidToken,
+		},
+		{name: "HS256 signed with the public key", token: hs256(validClaims(now)), wantErr: ErrInvalidToken},
+		{name: "signed with another key", token: signRS256(t, newTestKey(t), "key-1", validClaims(now)), wantErr: ErrInvalidToken},
+		{name: "expired", token: signRS256(t, key, "key-1", withClaim("exp", now.Add(-time.Hour).Unix())), wantErr: ErrInvalidToken},
+		{name: "expired within the clock skew", token: signRS256(t, key, "key-1", withClaim("exp", now.Add(-clockSkew/2).Unix()))},
+		{name: "no expiry", token: signRS256(t, key, "key-1", withClaim("exp", nil)), wantErr: ErrInvalidToken},
+		{name: "not valid yet", token: signRS256(t, key, "key-1", withClaim("nbf", now.Add(time.Hour).Unix())), wantErr: ErrInvalidToken},
+		{name: "wrong issuer", token: signRS256(t, key, "key-1", withClaim("iss", "https://evil.example.com")), wantErr: ErrInvalidToken},
+		{name: "wrong audience", token: signRS256(t, key, "key-1", withClaim("aud", "other")), wantErr: ErrInvalidToken},
+		{name: "no user name", token: signRS256(t, key, "key-1", withClaim("sub", nil)), wantErr: ErrInvalidToken},
+		{name: "not a JWT", token: "static-token", wantErr: ErrUnknownToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticator.AuthenticateToken(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AuthenticateToken() = %v, %v, want %v", principal, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuthenticateToken() = %v", err)
+			}
+			if principal.Name != "alice" || len(principal.Groups) != 1 || principal.Groups[0] != "developers" {
+				t.Errorf("AuthenticateToken() = %+v, want alice in developers", principal)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticatorRefreshesKeysForUnknownKid(t *testing.T) {
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+	server := newTestIssuerServer(t, map[string]*rsa.PrivateKey{"old": oldKey})
+	authenticator, err := NewJWTAuthenticator(OIDCConfig{IssuerURL: testIssuer, JWKSURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() = %v", err)
+	}
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := authenticator.AuthenticateToken(ctx, signRS256(t, oldKey, "old", validClaims(now))); err != nil {
+		t.Fatalf("AuthenticateToken() with the old key = %v", err)
+	}
+	if got := server.fetches.Load(); got != 1 {
+		t.Fatalf("JWKS fetches = %d, want 1", got)
+	}
+
+	// The issuer rotates its keys
+	server.keys = map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey}
+	newToken := signRS256(t, newKey, "new", validClaims(now))
+
+	// Unknown keys don't refetch the key set more often than the refresh interval
+	if _, err := authenticator.AuthenticateToken(ctx, newToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("AuthenticateToken() within the refresh interval = %v, want ErrInvalidToken", err)
+	}
+	if got := server.fetches.Load(); got != 1 {
+		t.Errorf("JWKS fetches within the refresh interval = %d, want 1", got)
+	}
+
+	authenticator.keys.fetchedAt = time.Now().Add(-minKeyRefreshInterval)
+	if _, err := authenticator.AuthenticateToken(ctx, newToken); err != nil {
+		t.Errorf("AuthenticateToken() with the rotated key = %v", err)
+	}
+	if got := server.fetches.Load(); got != 2 {
+		t.Errorf("JWKS fetches after the refresh interval = %d, want 2", got)
+	}
+
+	// Known keys don't refetch the key set
+	if _, err := authenticator.AuthenticateToken(ctx, signRS256(t, oldKey, "old", validClaims(now))); err != nil {
+		t.Errorf("AuthenticateToken() with the old key = %v", err)
+	}
+	if got := server.fetches.Load(); got != 2 {
+		t.Errorf("JWKS fetches for a known key = %d, want 2", got)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	mcpauth "github.com/modelcontextprotocol/go-sdk/auth"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// Config configures authentication and authorization of the API server
+type Config struct {
+	// TokenFile is the path of the static token file. Static tokens are disabled if empty.
+	TokenFile string
+	// OIDC configures the validation of JWT bearer tokens. JWT validation is disabled if the issuer is empty.
+	OIDC OIDCConfig
+	// AuthorizationConfigFile is the path of the role bindings file. It is required when authentication is enabled,
+	// so that authenticated principals are only allowed the actions of their roles.
+	AuthorizationConfigFile string
+}
+
+// Auth authenticates the requests to the API server and authorizes their actions.
+// Authentication is disabled when no authenticator is configured.
+type Auth struct {
+	authenticator Authenticator
+	authorizer    *Authorizer
+}
+
+// New creates an Auth from the authenticator and the authorizer, either of which can be nil.
+func New(authenticator Authenticator, authorizer *Authorizer) *Auth {
+	return &Auth{authenticator: authenticator, authorizer: authorizer}
+}
+
+// NewFromConfig creates the authenticators and the authorizer of the config.
+func NewFromConfig(config Config) (*Auth, error) {
+	var authenticators Authenticators
+	if config.TokenFile != "" {
+		tokenFile, err := NewTokenFileAuthenticator(config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, tokenFile)
+	}
+	if config.OIDC.IssuerURL != "" {
+		jwt, err := NewJWTAuthenticator(config.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, jwt)
+	}
+
+	if len(authenticators) == 0 {
+		if config.AuthorizationConfigFile != "" {
+			return nil, errors.New("authorization requires a token file or an OIDC issuer to be configured")
+		}
+		return New(nil, nil), nil
+	}
+
+	// Authenticated principals must not be allowed everything by default
+	if config.AuthorizationConfigFile == "" {
+		return nil, errors.New("authentication requires an authorization config of role bindings to be configured")
+	}
+	authorizer, err := LoadAuthorizer(config.AuthorizationConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return New(authenticators, authorizer), nil
+}
+
+// Enabled reports whether requests are authenticated
+func (a *Auth) Enabled() bool {
+	return a != nil && a.authenticator != nil
+}
+
+// Middleware authenticates the bearer token of requests, except for the given public paths,
+// and adds the principal to the request context.
+func (a *Auth) Middleware(publicPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !a.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(publicPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := a.authenticate(r)
+			if err != nil {
+				logger.GetLogger(r.Context()).Warn("Authentication failed", "error", err)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="openchoreo"`)
+				writeError(w, http.StatusUnauthorized, "Authentication required", services.CodeUnauthorized)
+				return
+			}
+
+			ctx := WithPrincipal(r.Context(), principal)
+			ctx = logger.WithLogger(ctx, logger.GetLogger(ctx).With("user", principal.Name))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// MCPMiddleware authenticates the bearer token of MCP requests. The principal is passed to
+// the MCP tool handlers in the token info of the request, where GetPrincipal finds it.
+func (a *Auth) MCPMiddleware() func(http.Handler) http.Handler {
+	if !a.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	return mcpauth.RequireBearerToken(func(ctx context.Context, token string, _ *http.Request) (*mcpauth.TokenInfo, error) {
+		principal, err := a.authenticator.AuthenticateToken(ctx, token)
+		if err != nil {
+			logger.GetLogger(ctx).Warn("MCP authentication failed", "error", err)
+			return nil, fmt.Errorf("%w: %w", mcpauth.ErrInvalidToken, err)
+		}
+		return &mcpauth.TokenInfo{Extra: map[string]any{tokenInfoPrincipalKey: principal}}, nil
+	}, nil)
+}
+
+// Authorize returns nil if the principal of the context is allowed to perform the action in the scope.
+// All actions are allowed when authentication is disabled, and denied when it is enabled without an authorizer.
+func (a *Auth) Authorize(ctx context.Context, action Action, scope Scope) error {
+	if !a.Enabled() {
+		return nil
+	}
+	principal := GetPrincipal(ctx)
+	if principal == nil {
+		return fmt.Errorf("%w: the request is not authenticated", ErrForbidden)
+	}
+	if a.authorizer == nil {
+		logger.GetLogger(ctx).Error("Denying the request because no authorizer is configured", "user", principal.Name)
+		return fmt.Errorf("%w: no role bindings are configured", ErrForbidden)
+	}
+	return a.authorizer.Authorize(principal, action, scope)
+}
+
+func (a *Auth) authenticate(r *http.Request) (*Principal, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	return a.authenticator.AuthenticateToken(r.Context(), token)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(models.ErrorResponse(message, code)) // Ignore encoding errors for response
+}
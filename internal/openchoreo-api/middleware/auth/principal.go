@@ -0,0 +1,46 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/pkg/mcp"
+)
+
+// Principal is the authenticated caller of the API
+type Principal struct {
+	// Name is the user name of the caller
+	Name string `json:"name"`
+	// Groups are the groups the caller belongs to
+	Groups []string `json:"groups,omitempty"`
+}
+
+type contextKey string
+
+const (
+	principalKey contextKey = "principal"
+
+	// tokenInfoPrincipalKey is the key of the principal in the token info of MCP requests
+	tokenInfoPrincipalKey = "principal"
+)
+
+// WithPrincipal returns a copy of the context carrying the principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+// GetPrincipal returns the principal of the request, or nil if the request is not authenticated.
+// MCP tool calls carry the principal in the bearer token info of the MCP request.
+func GetPrincipal(ctx context.Context) *Principal {
+	if principal, ok := ctx.Value(principalKey).(*Principal); ok {
+		return principal
+	}
+	if tokenInfo := mcp.TokenInfoFromContext(ctx); tokenInfo != nil {
+		if principal, ok := tokenInfo.Extra[tokenInfoPrincipalKey].(*Principal); ok {
+			return principal
+		}
+	}
+	return nil
+}
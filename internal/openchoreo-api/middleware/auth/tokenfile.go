@@ -0,0 +1,75 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// TokenFileAuthenticator authenticates static tokens, e.g. the tokens of CI pipelines.
+// The token file uses the format of the Kubernetes static token file, one token per line:
+//
+//	token,user,uid,"group1,group2"
+//
+// The uid and groups columns are optional and lines starting with # are ignored.
+type TokenFileAuthenticator struct {
+	// tokens are keyed by the hash of the token so that lookups don't leak the tokens through timing
+	tokens map[[sha256.Size]byte]*Principal
+}
+
+// NewTokenFileAuthenticator loads the static tokens from the file.
+func NewTokenFileAuthenticator(path string) (*TokenFileAuthenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	a := &TokenFileAuthenticator{tokens: make(map[[sha256.Size]byte]*Principal)}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token file: %w", err)
+		}
+		if len(record) < 2 || record[0] == "" || record[1] == "" {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("token file line %d: token and user are required", line)
+		}
+
+		principal := &Principal{Name: record[1]}
+		if len(record) > 3 && record[3] != "" {
+			for _, group := range strings.Split(record[3], ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					principal.Groups = append(principal.Groups, group)
+				}
+			}
+		}
+		a.tokens[sha256.Sum256([]byte(record[0]))] = principal
+	}
+	return a, nil
+}
+
+// AuthenticateToken implements Authenticator.
+func (a *TokenFileAuthenticator) AuthenticateToken(_ context.Context, token string) (*Principal, error) {
+	principal, ok := a.tokens[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, ErrUnknownToken
+	}
+	return principal, nil
+}
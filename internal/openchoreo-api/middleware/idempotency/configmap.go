@@ -0,0 +1,253 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/slog"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// configMapLabel marks the ConfigMaps holding idempotency records
+	configMapLabel = "openchoreo.dev/idempotency-record"
+	// configMapRecordKey is the data key of the record in its ConfigMap
+	configMapRecordKey = "record"
+	// cleanupLeaseName is the name of the lease held by the replica removing expired records
+	cleanupLeaseName = "openchoreo-api-idempotency-cleanup"
+)
+
+// ConfigMapStore keeps the records of idempotency keys in ConfigMaps of a namespace, one per key,
+// so that they survive restarts and are shared by all replicas of the API server.
+// A key is reserved by creating its ConfigMap, which only one replica can succeed in.
+type ConfigMapStore struct {
+	client    client.Client
+	namespace string
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewConfigMapStore creates a store keeping its records in the namespace.
+// The client must use the server's own credentials rather than impersonate the caller.
+func NewConfigMapStore(c client.Client, namespace string, logger *slog.Logger) *ConfigMapStore {
+	return &ConfigMapStore{client: c, namespace: namespace, logger: logger, now: time.Now}
+}
+
+// Reserve implements Store.
+func (s *ConfigMapStore) Reserve(ctx context.Context, key string, record *Record) (*Record, error) {
+	cm, err := s.configMap(key, record)
+	if err != nil {
+		return nil, err
+	}
+	// A second attempt is made when the existing record has expired and is removed
+	for attempt := 0; attempt < 2; attempt++ {
+		err := s.client.Create(ctx, cm.DeepCopy())
+		if err == nil {
+			return nil, nil
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+
+		existing := &corev1.ConfigMap{}
+		if err := s.client.Get(ctx, client.ObjectKeyFromObject(cm), existing); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get idempotency record: %w", err)
+		}
+		stored, err := decodeRecord(existing)
+		if err != nil {
+			return nil, err
+		}
+		if !stored.Expired(s.now()) {
+			return stored, nil
+		}
+		if err := s.deleteConfigMap(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("failed to reserve idempotency key: the key is being reused concurrently")
+}
+
+// Complete implements Store.
+func (s *ConfigMapStore) Complete(ctx context.Context, key string, record *Record) error {
+	cm, err := s.configMap(key, record)
+	if err != nil {
+		return err
+	}
+	existing := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, client.ObjectKeyFromObject(cm), existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return s.client.Create(ctx, cm)
+		}
+		return fmt.Errorf("failed to get idempotency record: %w", err)
+	}
+	existing.Data = cm.Data
+	if err := s.client.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to store idempotency record: %w", err)
+	}
+	return nil
+}
+
+// Release implements Store.
+func (s *ConfigMapStore) Release(ctx context.Context, key string) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: configMapName(key), Namespace: s.namespace}}
+	if err := s.client.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// RunCleanup removes the expired records at every interval until the context is done.
+// Only the replica holding the cleanup lease removes records, the others stand by
+// and take over the lease when it is not renewed.
+func (s *ConfigMapStore) RunCleanup(ctx context.Context, interval time.Duration, identity string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		leader, err := s.acquireLease(ctx, identity, 2*interval)
+		if err != nil {
+			s.logger.Warn("Failed to acquire the idempotency cleanup lease", "error", err)
+			continue
+		}
+		if !leader {
+			continue
+		}
+		if err := s.cleanup(ctx); err != nil {
+			s.logger.Warn("Failed to remove expired idempotency records", "error", err)
+		}
+	}
+}
+
+// cleanup removes the expired records
+func (s *ConfigMapStore) cleanup(ctx context.Context) error {
+	var list corev1.ConfigMapList
+	if err := s.client.List(ctx, &list, client.InNamespace(s.namespace), client.HasLabels{configMapLabel}); err != nil {
+		return fmt.Errorf("failed to list idempotency records: %w", err)
+	}
+	now := s.now()
+	removed := 0
+	for i := range list.Items {
+		record, err := decodeRecord(&list.Items[i])
+		if err == nil && !record.Expired(now) {
+			continue
+		}
+		if err := s.deleteConfigMap(ctx, &list.Items[i]); err != nil {
+			return err
+		}
+		removed++
+	}
+	if removed > 0 {
+		s.logger.Debug("Removed expired idempotency records", "count", removed)
+	}
+	return nil
+}
+
+// acquireLease acquires or renews the cleanup lease for the identity, and reports whether it holds the lease
+func (s *ConfigMapStore) acquireLease(ctx context.Context, identity string, duration time.Duration) (bool, error) {
+	now := metav1.NewMicroTime(s.now())
+	lease := &coordinationv1.Lease{}
+	err := s.client.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: cleanupLeaseName}, lease)
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: cleanupLeaseName, Namespace: s.namespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(identity),
+				LeaseDurationSeconds: ptr.To(int32(duration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err := s.client.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	held := lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity == identity
+	if !held && lease.Spec.RenewTime != nil && lease.Spec.LeaseDurationSeconds != nil {
+		expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if now.Time.Before(expiry) {
+			return false, nil
+		}
+	}
+	if !held {
+		lease.Spec.HolderIdentity = ptr.To(identity)
+		lease.Spec.AcquireTime = &now
+	}
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(duration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if err := s.client.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteConfigMap deletes the ConfigMap of a record if it has not changed since it was read
+func (s *ConfigMapStore) deleteConfigMap(ctx context.Context, cm *corev1.ConfigMap) error {
+	err := s.client.Delete(ctx, cm, client.Preconditions{UID: &cm.UID, ResourceVersion: &cm.ResourceVersion})
+	if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return fmt.Errorf("failed to remove idempotency record: %w", err)
+	}
+	return nil
+}
+
+// configMap returns the ConfigMap holding the record of the key
+func (s *ConfigMapStore) configMap(key string, record *Record) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName(key),
+			Namespace: s.namespace,
+			Labels:    map[string]string{configMapLabel: "true"},
+		},
+		Data: map[string]string{configMapRecordKey: string(data)},
+	}, nil
+}
+
+// configMapName returns the name of the ConfigMap of a key. Keys are hashed since
+// they are chosen by clients and may not be valid resource names.
+func configMapName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "idempotency-" + hex.EncodeToString(sum[:20])
+}
+
+// decodeRecord returns the record held by a ConfigMap
+func decodeRecord(cm *corev1.ConfigMap) (*Record, error) {
+	var record Record
+	if err := json.Unmarshal([]byte(cm.Data[configMapRecordKey]), &record); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency record %s: %w", cm.Name, err)
+	}
+	return &record, nil
+}
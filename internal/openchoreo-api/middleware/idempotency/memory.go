@@ -0,0 +1,145 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrStoreFull is returned when a record doesn't fit in the store, because the records of the requests
+// in progress take all of its space
+var ErrStoreFull = errors.New("idempotency store is full")
+
+// MemoryStore keeps the records of idempotency keys in memory. The records are lost when
+// the server restarts and are not shared between replicas, so it only suits a single replica.
+// The records take at most the maximum size; when the store is full, the completed records
+// stored the longest ago are evicted before their TTL.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*list.Element
+	// order holds the entries of the records by the time they were last stored, oldest first
+	order   *list.List
+	size    int64
+	maxSize int64
+	now     func() time.Time
+}
+
+// memoryEntry is the record of a key in the memory store
+type memoryEntry struct {
+	key    string
+	record Record
+}
+
+// size returns the number of bytes the entry is accounted for
+func (e *memoryEntry) size() int64 {
+	return int64(len(e.key) + len(e.record.Fingerprint) + len(e.record.ContentType) + len(e.record.Body))
+}
+
+// NewMemoryStore creates an empty store keeping records of at most maxSize bytes in total
+func NewMemoryStore(maxSize int64) *MemoryStore {
+	return &MemoryStore{records: make(map[string]*list.Element), order: list.New(), maxSize: maxSize, now: time.Now}
+}
+
+// Reserve implements Store. It returns ErrStoreFull if no completed record can be evicted to make room.
+func (s *MemoryStore) Reserve(_ context.Context, key string, record *Record) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.records[key]; ok {
+		entry := e.Value.(*memoryEntry)
+		if !entry.record.Expired(s.now()) {
+			r := entry.record
+			return &r, nil
+		}
+		s.remove(e)
+	}
+	entry := &memoryEntry{key: key, record: *record}
+	if !s.makeRoom(entry.size()) {
+		return nil, ErrStoreFull
+	}
+	s.records[key] = s.order.PushBack(entry)
+	s.size += entry.size()
+	return nil, nil
+}
+
+// Complete implements Store. If the record doesn't fit in the store, the key is released and ErrStoreFull is returned.
+func (s *MemoryStore) Complete(_ context.Context, key string, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.records[key]; ok {
+		s.remove(e)
+	}
+	entry := &memoryEntry{key: key, record: *record}
+	if !s.makeRoom(entry.size()) {
+		return ErrStoreFull
+	}
+	s.records[key] = s.order.PushBack(entry)
+	s.size += entry.size()
+	return nil
+}
+
+// Release implements Store.
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.records[key]; ok {
+		s.remove(e)
+	}
+	return nil
+}
+
+// RunCleanup removes the expired records at every interval until the context is done
+func (s *MemoryStore) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cleanup()
+		}
+	}
+}
+
+// cleanup removes the expired records
+func (s *MemoryStore) cleanup() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for e := s.order.Front(); e != nil; {
+		next := e.Next()
+		if e.Value.(*memoryEntry).record.Expired(now) {
+			s.remove(e)
+		}
+		e = next
+	}
+}
+
+// makeRoom evicts expired and completed records, oldest first, until a record of the given size fits.
+// Records of requests in progress are kept. It reports whether the record fits. It must be called with
+// the lock held.
+func (s *MemoryStore) makeRoom(size int64) bool {
+	now := s.now()
+	for e := s.order.Front(); e != nil && s.size+size > s.maxSize; {
+		next := e.Next()
+		if record := &e.Value.(*memoryEntry).record; record.Completed || record.Expired(now) {
+			s.remove(e)
+		}
+		e = next
+	}
+	return s.size+size <= s.maxSize
+}
+
+// remove removes the record of an entry. It must be called with the lock held.
+func (s *MemoryStore) remove(e *list.Element) {
+	entry := s.order.Remove(e).(*memoryEntry)
+	delete(s.records, entry.key)
+	s.size -= entry.size()
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	// HeaderKey is the request header carrying the idempotency key
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses replayed from the outcome of an earlier request with the same key
+	HeaderReplayed = "Idempotent-Replayed"
+
+	// maxKeyLength is the maximum length of an idempotency key
+	maxKeyLength = 255
+	// maxStoredBodySize is the size of the largest response body that is stored for replay.
+	// Requests with larger responses are processed again when retried.
+	maxStoredBodySize = 256 * 1024
+)
+
+// Idempotency makes the mutating requests that carry an idempotency key safe to retry. The outcome of
+// the first request with a key is stored for the TTL and returned for the later requests with the key,
+// which are not processed again. Keys are scoped to the caller, and reusing a key for a different
+// request is rejected. While a request is processed its key is reserved only until the reservation
+// timeout, so that the key of a request that never completed can be retried soon.
+type Idempotency struct {
+	store              Store
+	ttl                time.Duration
+	reservationTimeout time.Duration
+}
+
+// New creates an Idempotency keeping the outcomes of requests in the store for the TTL and reserving
+// the keys of requests in progress for the reservation timeout
+func New(store Store, ttl, reservationTimeout time.Duration) *Idempotency {
+	return &Idempotency{store: store, ttl: ttl, reservationTimeout: reservationTimeout}
+}
+
+// Enabled reports whether idempotency keys are honored
+func (i *Idempotency) Enabled() bool {
+	return i != nil && i.store != nil
+}
+
+// Middleware handles the idempotency keys of mutating requests. It must run after authentication,
+// since keys are scoped to the principal of the request.
+func (i *Idempotency) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !i.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderKey)
+			if key == "" || !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			i.serve(w, r, next, key)
+		})
+	}
+}
+
+func (i *Idempotency) serve(w http.ResponseWriter, r *http.Request, next http.Handler, key string) {
+	log := logger.GetLogger(r.Context()).With("idempotency_key", key)
+	if len(key) > maxKeyLength {
+		writeError(w, http.StatusBadRequest, "Idempotency key must be at most "+strconv.Itoa(maxKeyLength)+" characters",
+			services.CodeIdempotencyKeyInvalid)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", services.CodeInvalidInput)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// The store is used past the end of the request, so that an interrupted request still releases its key
+	ctx := context.WithoutCancel(r.Context())
+	scopedKey := scopeKey(auth.GetPrincipal(ctx), key)
+	record := &Record{Fingerprint: fingerprint(r, body), ExpiresAt: time.Now().Add(i.reservationTimeout)}
+
+	existing, err := i.store.Reserve(ctx, scopedKey, record)
+	if errors.Is(err, ErrStoreFull) {
+		log.Warn("Idempotency store is full")
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, "Too many requests with an idempotency key are being processed",
+			services.CodeIdempotencyStoreFull)
+		return
+	}
+	if err != nil {
+		log.Error("Failed to reserve idempotency key", "error", err)
+		writeError(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+	if existing != nil {
+		switch {
+		case existing.Fingerprint != record.Fingerprint:
+			log.Warn("Idempotency key reused for a different request")
+			writeError(w, http.StatusUnprocessableEntity, "Idempotency key was already used for a different request",
+				services.CodeIdempotencyKeyMismatch)
+		case !existing.Completed:
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusConflict, "A request with this idempotency key is still being processed",
+				services.CodeIdempotencyKeyInUse)
+		default:
+			log.Info("Replaying the response of an earlier request with the idempotency key")
+			replay(w, existing)
+		}
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+	handled := false
+	defer func() {
+		// Server errors and panics are not stored, so that the request can be retried with the same key
+		if !handled || recorder.statusCode >= http.StatusInternalServerError || recorder.truncated {
+			if err := i.store.Release(ctx, scopedKey); err != nil {
+				log.Error("Failed to release idempotency key", "error", err)
+			}
+			return
+		}
+		record.Completed = true
+		record.StatusCode = recorder.statusCode
+		record.ContentType = recorder.Header().Get("Content-Type")
+		record.Body = recorder.body.Bytes()
+		record.ExpiresAt = time.Now().Add(i.ttl)
+		if err := i.store.Complete(ctx, scopedKey, record); err != nil {
+			log.Error("Failed to store the outcome of the idempotent request", "error", err)
+			// The reservation is released so that a retry is processed rather than rejected until it expires
+			if err := i.store.Release(ctx, scopedKey); err != nil {
+				log.Error("Failed to release idempotency key", "error", err)
+			}
+		}
+	}()
+	next.ServeHTTP(recorder, r)
+	handled = true
+}
+
+// replay writes the stored response of a record
+func replay(w http.ResponseWriter, record *Record) {
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+// isMutating reports whether requests of the method change resources
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// scopeKey returns the key scoped to the principal, so that callers can't see each other's outcomes
+func scopeKey(principal *auth.Principal, key string) string {
+	name := ""
+	if principal != nil {
+		name = principal.Name
+	}
+	return name + "\x00" + key
+}
+
+// fingerprint returns the hash identifying the request by its method, path, query and payload
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder records the status code and the body written by a handler while writing them through
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+	// truncated is set when the body is too large to be stored
+	truncated bool
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.truncated {
+		if r.body.Len()+len(b) > maxStoredBodySize {
+			r.truncated = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(models.ErrorResponse(message, code)) // Ignore encoding errors for response
+}
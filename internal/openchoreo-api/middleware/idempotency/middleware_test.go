@@ -0,0 +1,251 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+)
+
+// testStoreSize is the size of the memory stores of the tests
+const testStoreSize = 1024 * 1024
+
+// countingHandler answers requests with the number of requests it processed and the given status
+type countingHandler struct {
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	fmt.Fprintf(w, `{"call":%d,"body":%q}`, h.calls, body)
+}
+
+func request(method, path, body, key string, principal *auth.Principal) *http.Request {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		r.Header.Set(HeaderKey, key)
+	}
+	if principal != nil {
+		r = r.WithContext(auth.WithPrincipal(r.Context(), principal))
+	}
+	return r
+}
+
+func serve(handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestMiddlewareReplaysCompletedRequests(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := New(NewMemoryStore(testStoreSize), time.Hour, time.Minute).Middleware()(next)
+	alice := &auth.Principal{Name: "alice"}
+
+	first := serve(handler, request(http.MethodPost, "/api/v1/builds", `{"a":1}`, "k1", alice))
+	retry := serve(handler, request(http.MethodPost, "/api/v1/builds", `{"a":1}`, "k1", alice))
+
+	if next.calls != 1 {
+		t.Fatalf("handler called %d times, want 1", next.calls)
+	}
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Errorf("retry = %d %s, want %d %s", retry.Code, retry.Body, first.Code, first.Body)
+	}
+	if retry.Header().Get(HeaderReplayed) != "true" || first.Header().Get(HeaderReplayed) != "" {
+		t.Errorf("%s header = %q on the retry and %q on the first request, want true and empty",
+			HeaderReplayed, retry.Header().Get(HeaderReplayed), first.Header().Get(HeaderReplayed))
+	}
+	if ct := retry.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type of the retry = %q, want application/json", ct)
+	}
+
+	// The same key of another principal is another key
+	serve(handler, request(http.MethodPost, "/api/v1/builds", `{"a":1}`, "k1", &auth.Principal{Name: "bob"}))
+	if next.calls != 2 {
+		t.Errorf("handler called %d times, want 2 after a request of another principal", next.calls)
+	}
+}
+
+func TestMiddlewareRejectsKeyReuseForAnotherRequest(t *testing.T) {
+	next := &countingHandler{status: http.StatusOK}
+	handler := New(NewMemoryStore(testStoreSize), time.Hour, time.Minute).Middleware()(next)
+
+	serve(handler, request(http.MethodPost, "/api/v1/promote", `{"env":"dev"}`, "k1", nil))
+	for _, r := range []*http.Request{
+		request(http.MethodPost, "/api/v1/promote", `{"env":"prod"}`, "k1", nil),
+		request(http.MethodPost, "/api/v1/builds", `{"env":"dev"}`, "k1", nil),
+		request(http.MethodPost, "/api/v1/promote?force=true", `{"env":"dev"}`, "k1", nil),
+	} {
+		rec := serve(handler, r)
+		if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_MISMATCH") {
+			t.Errorf("%s %s = %d %s, want 422 IDEMPOTENCY_KEY_MISMATCH", r.Method, r.URL, rec.Code, rec.Body)
+		}
+	}
+	if next.calls != 1 {
+		t.Errorf("handler called %d times, want 1", next.calls)
+	}
+}
+
+func TestMiddlewareRejectsConcurrentRequests(t *testing.T) {
+	store := NewMemoryStore(testStoreSize)
+	handler := New(store, time.Hour, time.Minute).Middleware()(&countingHandler{status: http.StatusOK})
+	r := request(http.MethodPost, "/api/v1/builds", "", "k1", nil)
+
+	// A reservation without outcome is a request still being processed
+	if _, err := store.Reserve(context.Background(), scopeKey(nil, "k1"), &Record{
+		Fingerprint: fingerprint(r, nil),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := serve(handler, r)
+	if rec.Code != http.StatusConflict || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("response = %d with Retry-After %q, want 409 with Retry-After", rec.Code, rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestMiddlewareDoesNotStoreServerErrors(t *testing.T) {
+	next := &countingHandler{status: http.StatusInternalServerError}
+	handler := New(NewMemoryStore(testStoreSize), time.Hour, time.Minute).Middleware()(next)
+
+	serve(handler, request(http.MethodPost, "/api/v1/builds", "", "k1", nil))
+	next.status = http.StatusCreated
+	rec := serve(handler, request(http.MethodPost, "/api/v1/builds", "", "k1", nil))
+
+	if next.calls != 2 || rec.Code != http.StatusCreated {
+		t.Errorf("handler called %d times with last response %d, want 2 and 201", next.calls, rec.Code)
+	}
+}
+
+func TestMiddlewareIgnoresRequestsWithoutKey(t *testing.T) {
+	next := &countingHandler{status: http.StatusOK}
+	handler := New(NewMemoryStore(testStoreSize), time.Hour, time.Minute).Middleware()(next)
+
+	serve(handler, request(http.MethodPost, "/api/v1/builds", "", "", nil))
+	serve(handler, request(http.MethodPost, "/api/v1/builds", "", "", nil))
+	serve(handler, request(http.MethodGet, "/api/v1/builds", "", "k1", nil))
+	serve(handler, request(http.MethodGet, "/api/v1/builds", "", "k1", nil))
+	if next.calls != 4 {
+		t.Errorf("handler called %d times, want 4", next.calls)
+	}
+
+	rec := serve(handler, request(http.MethodPost, "/api/v1/builds", "", strings.Repeat("k", maxKeyLength+1), nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("response to a too long key = %d, want 400", rec.Code)
+	}
+}
+
+func TestMemoryStoreExpiresRecords(t *testing.T) {
+	store := NewMemoryStore(testStoreSize)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if existing, _ := store.Reserve(ctx, "k", &Record{Fingerprint: "a", ExpiresAt: now.Add(time.Minute)}); existing != nil {
+		t.Fatalf("first reservation returned %+v, want nil", existing)
+	}
+	if existing, _ := store.Reserve(ctx, "k", &Record{Fingerprint: "b", ExpiresAt: now.Add(time.Minute)}); existing == nil || existing.Fingerprint != "a" {
+		t.Fatalf("second reservation returned %+v, want the first record", existing)
+	}
+
+	now = now.Add(time.Minute)
+	if existing, _ := store.Reserve(ctx, "k", &Record{Fingerprint: "b", ExpiresAt: now.Add(time.Minute)}); existing != nil {
+		t.Errorf("reservation after expiry returned %+v, want nil", existing)
+	}
+}
+
+func TestMiddlewareReservesKeysUntilTheReservationTimeout(t *testing.T) {
+	store := NewMemoryStore(testStoreSize)
+	key := scopeKey(nil, "k1")
+	var reservedUntil time.Time
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reservedUntil = store.records[key].Value.(*memoryEntry).record.ExpiresAt
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := New(store, time.Hour, time.Minute).Middleware()(next)
+
+	start := time.Now()
+	serve(handler, request(http.MethodPost, "/api/v1/builds", "", "k1", nil))
+
+	if reservedUntil.Before(start.Add(time.Minute)) || reservedUntil.After(time.Now().Add(time.Minute)) {
+		t.Errorf("key of the request in progress reserved until %v, want a minute after the request", reservedUntil)
+	}
+	completed := store.records[key].Value.(*memoryEntry).record
+	if !completed.Completed || completed.ExpiresAt.Before(start.Add(time.Hour)) {
+		t.Errorf("completed record = %+v, want it kept for an hour after the request", completed)
+	}
+}
+
+func TestMemoryStoreEvictsTheOldestCompletedRecords(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+	record := func(fingerprint string, completed bool) *Record {
+		return &Record{Fingerprint: fingerprint, Completed: completed, Body: make([]byte, 94), ExpiresAt: expiresAt}
+	}
+	// Each record takes 100 bytes with its key and fingerprint
+	store := NewMemoryStore(300)
+
+	for _, key := range []string{"k1", "k2", "k3"} {
+		if _, err := store.Reserve(ctx, key, record("f-"+key, false)); err != nil {
+			t.Fatalf("Reserve(%s) = %v", key, err)
+		}
+	}
+	if _, err := store.Reserve(ctx, "k4", record("f-k4", false)); !errors.Is(err, ErrStoreFull) {
+		t.Fatalf("Reserve() into a store full of requests in progress = %v, want ErrStoreFull", err)
+	}
+
+	for _, key := range []string{"k2", "k1"} {
+		if err := store.Complete(ctx, key, record("f-"+key, true)); err != nil {
+			t.Fatalf("Complete(%s) = %v", key, err)
+		}
+	}
+	if _, err := store.Reserve(ctx, "k4", record("f-k4", false)); err != nil {
+		t.Fatalf("Reserve() into a full store with completed records = %v", err)
+	}
+	if _, ok := store.records["k2"]; ok {
+		t.Errorf("the record completed first was kept, want it evicted")
+	}
+	for _, key := range []string{"k1", "k3", "k4"} {
+		if _, ok := store.records[key]; !ok {
+			t.Errorf("record %s was evicted, want it kept", key)
+		}
+	}
+	if store.size != 300 {
+		t.Errorf("store size = %d, want 300", store.size)
+	}
+}
+
+func TestMemoryStoreCleanupRemovesExpiredRecords(t *testing.T) {
+	store := NewMemoryStore(testStoreSize)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = store.Reserve(ctx, "expiring", &Record{Fingerprint: "a", ExpiresAt: now.Add(time.Minute)})
+	_, _ = store.Reserve(ctx, "kept", &Record{Fingerprint: "b", ExpiresAt: now.Add(time.Hour)})
+
+	now = now.Add(time.Minute)
+	store.cleanup()
+
+	if _, ok := store.records["expiring"]; ok {
+		t.Errorf("expired record was kept by the cleanup")
+	}
+	if _, ok := store.records["kept"]; !ok || store.order.Len() != 1 || store.size != int64(len("kept")+len("b")) {
+		t.Errorf("cleanup left %d records of %d bytes, want only the unexpired record", store.order.Len(), store.size)
+	}
+}
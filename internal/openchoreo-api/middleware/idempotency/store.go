@@ -0,0 +1,39 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Record is the stored outcome of the request first made with an idempotency key
+type Record struct {
+	// Fingerprint identifies the request by its method, path, query and payload,
+	// so that a key reused for a different request is detected
+	Fingerprint string `json:"fingerprint"`
+	// Completed is false while the request is being processed
+	Completed   bool      `json:"completed"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	ContentType string    `json:"contentType,omitempty"`
+	Body        []byte    `json:"body,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// Expired reports whether the record has outlived its TTL at the given time
+func (r *Record) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Store keeps the records of idempotency keys until they expire.
+// Keys are opaque strings already scoped to the caller.
+type Store interface {
+	// Reserve stores the in-progress record of a key that is not in use, and returns nil.
+	// If the key is in use, it returns its record and stores nothing.
+	Reserve(ctx context.Context, key string, record *Record) (*Record, error)
+	// Complete stores the outcome of the request of a reserved key.
+	Complete(ctx context.Context, key string, record *Record) error
+	// Release removes the record of a key, so that the request can be retried.
+	Release(ctx context.Context, key string) error
+}
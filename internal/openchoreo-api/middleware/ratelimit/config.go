@@ -0,0 +1,129 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Class is the class of endpoints a request is limited as
+type Class string
+
+const (
+	// ClassRead covers the requests that only read resources
+	ClassRead Class = "read"
+	// ClassWrite covers the requests that create, update or delete resources
+	ClassWrite Class = "write"
+	// ClassBuild covers the requests that trigger builds
+	ClassBuild Class = "build"
+)
+
+// Limit is a token bucket refilled at RequestsPerSecond that holds at most Burst requests
+type Limit struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	Burst             int     `json:"burst"`
+}
+
+// ClassLimits are the limits of each class of endpoints. Requests of a class without a limit are not limited.
+type ClassLimits struct {
+	Read  *Limit `json:"read,omitempty"`
+	Write *Limit `json:"write,omitempty"`
+	Build *Limit `json:"build,omitempty"`
+}
+
+// Limits are the limits of the requests made in an organization
+type Limits struct {
+	// PerPrincipal limits the requests of each principal in the organization. The requests a principal
+	// makes in all organizations without their own per principal limit share a single bucket.
+	PerPrincipal ClassLimits `json:"perPrincipal"`
+	// PerOrganization limits the requests of all principals in the organization together
+	PerOrganization ClassLimits `json:"perOrganization"`
+}
+
+// Config is the rate limit configuration of the server. The limits apply to all organizations,
+// except for the classes an organization of Organizations has its own limits for.
+// Requests that are not made in an organization, such as applying resources, are only limited per principal,
+// sharing the bucket of the organizations without their own per principal limit.
+type Config struct {
+	Limits        `json:",inline"`
+	Organizations map[string]Limits `json:"organizations,omitempty"`
+}
+
+// LoadConfig reads the rate limit configuration of a YAML file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limit config: %w", err)
+	}
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse rate limit config: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) validate() error {
+	if err := c.Limits.validate(); err != nil {
+		return err
+	}
+	for org, limits := range c.Organizations {
+		if err := limits.validate(); err != nil {
+			return fmt.Errorf("organization %s: %w", org, err)
+		}
+	}
+	return nil
+}
+
+func (l *Limits) validate() error {
+	for scope, limits := range map[string]ClassLimits{"perPrincipal": l.PerPrincipal, "perOrganization": l.PerOrganization} {
+		for class, limit := range map[Class]*Limit{ClassRead: limits.Read, ClassWrite: limits.Write, ClassBuild: limits.Build} {
+			if limit != nil && (limit.RequestsPerSecond <= 0 || limit.Burst < 1) {
+				return fmt.Errorf("%s.%s: requestsPerSecond must be positive and burst at least 1", scope, class)
+			}
+		}
+	}
+	return nil
+}
+
+// limit returns the limit of a class of requests in the organization for a scope, or nil if they are not limited
+func (c *Config) limit(org string, scope Scope, class Class) *Limit {
+	if limits, ok := c.Organizations[org]; ok {
+		if limit := limits.classLimits(scope).limit(class); limit != nil {
+			return limit
+		}
+	}
+	return c.Limits.classLimits(scope).limit(class)
+}
+
+// hasOwnLimit reports whether the organization has its own limit of a class of requests for a scope
+func (c *Config) hasOwnLimit(org string, scope Scope, class Class) bool {
+	limits, ok := c.Organizations[org]
+	return ok && limits.classLimits(scope).limit(class) != nil
+}
+
+func (l *Limits) classLimits(scope Scope) *ClassLimits {
+	if scope == ScopeOrganization {
+		return &l.PerOrganization
+	}
+	return &l.PerPrincipal
+}
+
+func (l *ClassLimits) limit(class Class) *Limit {
+	switch class {
+	case ClassRead:
+		return l.Read
+	case ClassWrite:
+		return l.Write
+	case ClassBuild:
+		return l.Build
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Scope is the set of requests sharing a token bucket
+type Scope string
+
+const (
+	// ScopePrincipal buckets are shared by the requests of a principal in an organization with its own
+	// per principal limit, or else in all organizations without one
+	ScopePrincipal Scope = "principal"
+	// ScopeOrganization buckets are shared by the requests of all principals in an organization
+	ScopeOrganization Scope = "organization"
+)
+
+// sweepInterval is the interval at which the full buckets, which limit nothing, are removed
+const sweepInterval = time.Minute
+
+// bucketKey identifies a token bucket. Principal is empty for organization buckets, and org is empty for the
+// principal buckets of the organizations without their own per principal limit.
+type bucketKey struct {
+	scope     Scope
+	principal string
+	org       string
+	class     Class
+}
+
+// Limiter limits the rate of requests with token buckets per principal and organization,
+// and per organization, for each class of endpoints. Buckets are created on first use
+// and removed once they are full again. The organization of a request is taken from the request
+// before it is validated, so a principal only has a bucket of its own in the organizations
+// configured with a per principal limit; otherwise naming another organization would yield a
+// fresh bucket.
+type Limiter struct {
+	config *Config
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*rate.Limiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// New creates a Limiter enforcing the limits of the config
+func New(config *Config) *Limiter {
+	return &Limiter{config: config, buckets: make(map[bucketKey]*rate.Limiter), now: time.Now}
+}
+
+// Enabled reports whether requests are limited
+func (l *Limiter) Enabled() bool {
+	return l != nil && l.config != nil
+}
+
+// Allow takes a token for a request of the principal in the organization from each bucket limiting it.
+// If a bucket is empty, no token is taken and Allow returns false with the scope of the empty bucket
+// and the time after which the request would be allowed.
+func (l *Limiter) Allow(principal, org string, class Class) (bool, Scope, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	principalOrg := ""
+	if l.config.hasOwnLimit(org, ScopePrincipal, class) {
+		principalOrg = org
+	}
+	keys := []bucketKey{{scope: ScopePrincipal, principal: principal, org: principalOrg, class: class}}
+	if org != "" {
+		keys = append(keys, bucketKey{scope: ScopeOrganization, org: org, class: class})
+	}
+
+	var reservations []*rate.Reservation
+	for _, key := range keys {
+		bucket := l.bucket(key)
+		if bucket == nil {
+			continue
+		}
+		r := bucket.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			// The tokens taken from the other buckets are returned, as the request is not made
+			r.CancelAt(now)
+			for _, taken := range reservations {
+				taken.CancelAt(now)
+			}
+			return false, key.scope, delay
+		}
+		reservations = append(reservations, r)
+	}
+	return true, "", 0
+}
+
+// bucket returns the bucket of the key, creating it if needed, or nil if the requests of the key are not limited.
+// It must be called with the lock held.
+func (l *Limiter) bucket(key bucketKey) *rate.Limiter {
+	if bucket, ok := l.buckets[key]; ok {
+		return bucket
+	}
+	limit := l.config.limit(key.org, key.scope, key.class)
+	if limit == nil {
+		return nil
+	}
+	bucket := rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)
+	l.buckets[key] = bucket
+	return bucket
+}
+
+// sweep removes the full buckets, which are the same as new ones, so that the buckets of
+// principals and organizations no longer making requests don't accumulate.
+// It must be called with the lock held.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// retryAfterSeconds returns the value of the Retry-After header for a delay, rounded up to whole seconds
+func retryAfterSeconds(delay time.Duration) int {
+	return int(math.Max(1, math.Ceil(delay.Seconds())))
+}
@@ -0,0 +1,16 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// throttledRequests counts the requests rejected by the rate limits, by class of endpoints and scope of the exceeded limit
+var throttledRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "openchoreo_api",
+	Name:      "throttled_requests_total",
+	Help:      "Number of requests rejected by the rate limits, by class of endpoints and scope of the exceeded limit.",
+}, []string{"class", "scope"})
@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// orgsPathPrefix is the path prefix of the endpoints of an organization
+const orgsPathPrefix = "/api/v1/orgs/"
+
+// Middleware limits the rate of requests, answering the throttled ones with 429 Too Many Requests
+// and a Retry-After header. Requests to the exempt paths are not limited. It must run after
+// authentication, since requests are limited per principal; unauthenticated requests share a bucket.
+func (l *Limiter) Middleware(exemptPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !l.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(exemptPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ok, retryAfter := l.allow(r.Context(), requestOrg(r.URL.Path), requestClass(r))
+			if !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				writeError(w, http.StatusTooManyRequests, "Rate limit exceeded, retry after "+strconv.Itoa(retryAfter)+" seconds",
+					services.CodeRateLimited)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// MCPMiddleware limits the rate of MCP requests. Tool calls are limited in the organization of their
+// org_name argument, as reads, writes or builds depending on the tool; other MCP requests are limited as reads.
+func (l *Limiter) MCPMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		if !l.Enabled() {
+			return next
+		}
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			org, class := "", ClassRead
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok && method == "tools/call" {
+				org, class = toolOrg(params.Arguments), toolClass(params.Name)
+			}
+			if ok, retryAfter := l.allow(ctx, org, class); !ok {
+				return nil, fmt.Errorf("rate limit exceeded, retry after %d seconds", retryAfter)
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// allow takes a token for a request of the principal of the context, and returns whether it is allowed
+// and otherwise the number of seconds after which it can be retried
+func (l *Limiter) allow(ctx context.Context, org string, class Class) (bool, int) {
+	principal := ""
+	if p := auth.GetPrincipal(ctx); p != nil {
+		principal = p.Name
+	}
+	ok, scope, delay := l.Allow(principal, org, class)
+	if ok {
+		return true, 0
+	}
+	throttledRequests.WithLabelValues(string(class), string(scope)).Inc()
+	logger.GetLogger(ctx).Warn("Request throttled", "org", org, "class", class, "scope", scope, "retry_after", delay)
+	return false, retryAfterSeconds(delay)
+}
+
+// requestClass returns the class of endpoints of an HTTP request
+func requestClass(r *http.Request) Class {
+	switch {
+	case r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions:
+		return ClassRead
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/builds"):
+		return ClassBuild
+	default:
+		return ClassWrite
+	}
+}
+
+// requestOrg returns the organization of the endpoint of a path, or an empty string if it is not in an organization
+func requestOrg(path string) string {
+	rest, ok := strings.CutPrefix(path, orgsPathPrefix)
+	if !ok {
+		return ""
+	}
+	org, _, _ := strings.Cut(rest, "/")
+	return org
+}
+
+// toolClass returns the class of endpoints of an MCP tool
+func toolClass(name string) Class {
+	switch {
+	case strings.HasPrefix(name, "get_"), strings.HasPrefix(name, "list_"), strings.HasPrefix(name, "explain_"):
+		return ClassRead
+	case name == "trigger_build":
+		return ClassBuild
+	default:
+		return ClassWrite
+	}
+}
+
+// toolOrg returns the organization of the org_name argument of a tool call, if any
+func toolOrg(arguments json.RawMessage) string {
+	var args struct {
+		OrgName string `json:"org_name"`
+	}
+	_ = json.Unmarshal(arguments, &args) // Calls with malformed arguments are limited outside organizations
+	return args.OrgName
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(models.ErrorResponse(message, code)) // Ignore encoding errors for response
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/auth"
+)
+
+const testConfig = `
+perPrincipal:
+  read:
+    requestsPerSecond: 1
+    burst: 2
+  build:
+    requestsPerSecond: 0.1
+    burst: 1
+perOrganization:
+  read:
+    requestsPerSecond: 1
+    burst: 3
+organizations:
+  acme:
+    perPrincipal:
+      read:
+        requestsPerSecond: 1
+        burst: 5
+`
+
+func loadTestConfig(t *testing.T, content string) (*Config, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rate-limit.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return LoadConfig(path)
+}
+
+func newTestLimiter(t *testing.T) (*Limiter, *time.Time) {
+	t.Helper()
+	config, err := loadTestConfig(t, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	limiter := New(config)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func request(method, path, principal string) *http.Request {
+	r := httptest.NewRequest(method, path, nil)
+	if principal != "" {
+		r = r.WithContext(auth.WithPrincipal(r.Context(), &auth.Principal{Name: principal}))
+	}
+	return r
+}
+
+func serve(handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+	return rec
+}
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+func TestMiddlewareThrottlesPrincipals(t *testing.T) {
+	limiter, now := newTestLimiter(t)
+	handler := limiter.Middleware("/health")(okHandler)
+	before := testutil.ToFloat64(throttledRequests.WithLabelValues("read", "principal"))
+
+	for i := 0; i < 2; i++ {
+		if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/default/projects", "alice")); rec.Code != http.StatusOK {
+			t.Fatalf("request %d = %d, want 200", i, rec.Code)
+		}
+	}
+	rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/default/projects", "alice"))
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "1" {
+		t.Errorf("request over the burst = %d with Retry-After %q, want 429 with Retry-After 1", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if got := testutil.ToFloat64(throttledRequests.WithLabelValues("read", "principal")) - before; got != 1 {
+		t.Errorf("throttled requests counter increased by %v, want 1", got)
+	}
+
+	// Other principals, classes and exempt paths have their own limits
+	for _, r := range []*http.Request{
+		request(http.MethodGet, "/api/v1/orgs/default/projects", "bob"),
+		request(http.MethodPost, "/api/v1/orgs/default/projects", "alice"),
+		request(http.MethodGet, "/health", "alice"),
+	} {
+		if rec := serve(handler, r); rec.Code != http.StatusOK {
+			t.Errorf("%s %s = %d, want 200", r.Method, r.URL, rec.Code)
+		}
+	}
+
+	*now = now.Add(time.Second)
+	if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/default/projects", "alice")); rec.Code != http.StatusOK {
+		t.Errorf("request after the refill = %d, want 200", rec.Code)
+	}
+}
+
+func TestMiddlewareThrottlesOrganizations(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+	handler := limiter.Middleware()(okHandler)
+
+	// Three principals share the burst of 3 reads of the organization
+	for _, principal := range []string{"alice", "bob", "carol"} {
+		serve(handler, request(http.MethodGet, "/api/v1/orgs/default/projects", principal))
+	}
+	rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/default/projects", "dave"))
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("request over the organization burst = %d, want 429", rec.Code)
+	}
+
+	// The principal limit of acme is raised, but the organization limit still applies
+	for i := 0; i < 3; i++ {
+		if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/acme/projects", "alice")); rec.Code != http.StatusOK {
+			t.Fatalf("request %d in acme = %d, want 200", i, rec.Code)
+		}
+	}
+	if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/acme/projects", "alice")); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("request over the acme organization burst = %d, want 429", rec.Code)
+	}
+}
+
+func TestMiddlewareSharesPrincipalBucketsAcrossOrganizations(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+	handler := limiter.Middleware()(okHandler)
+
+	// Naming other organizations doesn't give alice fresh tokens
+	for _, org := range []string{"default", "other"} {
+		if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/"+org+"/projects", "alice")); rec.Code != http.StatusOK {
+			t.Fatalf("request in %s = %d, want 200", org, rec.Code)
+		}
+	}
+	for _, path := range []string{"/api/v1/orgs/unknown/projects", "/api/v1/apply"} {
+		if rec := serve(handler, request(http.MethodGet, path, "alice")); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("request to %s over the principal burst = %d, want 429", path, rec.Code)
+		}
+	}
+
+	// acme has its own per principal limit, and so its own bucket
+	if rec := serve(handler, request(http.MethodGet, "/api/v1/orgs/acme/projects", "alice")); rec.Code != http.StatusOK {
+		t.Errorf("request in acme = %d, want 200", rec.Code)
+	}
+}
+
+func TestMiddlewareThrottlesBuilds(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+	handler := limiter.Middleware()(okHandler)
+	path := "/api/v1/orgs/default/projects/p/components/c/builds"
+
+	serve(handler, request(http.MethodPost, path, "alice"))
+	rec := serve(handler, request(http.MethodPost, path, "alice"))
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "10" {
+		t.Errorf("second build = %d with Retry-After %q, want 429 with Retry-After 10", rec.Code, rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestAllowReturnsTokensOfThrottledRequests(t *testing.T) {
+	limiter, now := newTestLimiter(t)
+
+	// The organization bucket is emptied by others; the throttled requests of alice don't use her tokens
+	limiter.Allow("bob", "default", ClassRead)
+	limiter.Allow("bob", "default", ClassRead)
+	limiter.Allow("carol", "default", ClassRead)
+	for i := 0; i < 3; i++ {
+		if ok, scope, _ := limiter.Allow("alice", "default", ClassRead); ok || scope != ScopeOrganization {
+			t.Fatalf("Allow = %v with scope %q, want false with scope organization", ok, scope)
+		}
+	}
+	bucket := limiter.buckets[bucketKey{scope: ScopePrincipal, principal: "alice", class: ClassRead}]
+	if tokens := bucket.TokensAt(*now); tokens != 2 {
+		t.Errorf("bucket of alice holds %v tokens, want 2", tokens)
+	}
+}
+
+func TestLoadConfigRejectsInvalidLimits(t *testing.T) {
+	for _, content := range []string{
+		"perPrincipal:\n  read:\n    requestsPerSecond: 0\n    burst: 1\n",
+		"organizations:\n  acme:\n    perOrganization:\n      write:\n        requestsPerSecond: 1\n        burst: 0\n",
+		"perPrincipal:\n  reads: {}\n",
+	} {
+		if _, err := loadTestConfig(t, content); err == nil {
+			t.Errorf("LoadConfig(%q) succeeded, want an error", content)
+		}
+	}
+}
+
+func TestToolClass(t *testing.T) {
+	for tool, want := range map[string]Class{
+		"list_projects":            ClassRead,
+		"get_component":            ClassRead,
+		"explain_schema":           ClassRead,
+		"trigger_build":            ClassBuild,
+		"create_project":           ClassWrite,
+		"delete_trait":             ClassWrite,
+		"put_component_deployment": ClassWrite,
+	} {
+		if got := toolClass(tool); got != want {
+			t.Errorf("toolClass(%q) = %q, want %q", tool, got, want)
+		}
+	}
+	if got := toolOrg([]byte(`{"org_name":"acme","project_name":"p"}`)); got != "acme" {
+		t.Errorf("toolOrg = %q, want acme", got)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import "time"
+
+// ResourceEventType is the type of change of a watched resource
+type ResourceEventType string
+
+const (
+	ResourceEventAdded    ResourceEventType = "ADDED"
+	ResourceEventModified ResourceEventType = "MODIFIED"
+	ResourceEventDeleted  ResourceEventType = "DELETED"
+)
+
+// Kinds of the resources whose status changes are reported as events
+const (
+	EventKindComponent           = "Component"
+	EventKindWorkflowRun         = "WorkflowRun"
+	EventKindRelease             = "Release"
+	EventKindComponentDeployment = "ComponentDeployment"
+)
+
+// EventKinds lists the kinds of resources that can be watched
+var EventKinds = []string{EventKindComponent, EventKindWorkflowRun, EventKindRelease, EventKindComponentDeployment}
+
+// ResourceEvent is a status change of a resource that belongs to a component
+type ResourceEvent struct {
+	Type            ResourceEventType   `json:"type"`
+	Kind            string              `json:"kind"`
+	Name            string              `json:"name"`
+	OrgName         string              `json:"orgName"`
+	ProjectName     string              `json:"projectName"`
+	ComponentName   string              `json:"componentName"`
+	Environment     string              `json:"environment,omitempty"`
+	Status          string              `json:"status,omitempty"`
+	Reason          string              `json:"reason,omitempty"`
+	Message         string              `json:"message,omitempty"`
+	Conditions      []ConditionResponse `json:"conditions,omitempty"`
+	Rollout         *RolloutEventStatus `json:"rollout,omitempty"`
+	ResourceVersion string              `json:"resourceVersion"`
+	Timestamp       time.Time           `json:"timestamp"`
+}
+
+// ConditionResponse represents a status condition of a resource
+type ConditionResponse struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// RolloutEventStatus represents the progress of the rollout of a component deployment
+type RolloutEventStatus struct {
+	Phase           string `json:"phase,omitempty"`
+	StableRevision  string `json:"stableRevision,omitempty"`
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	CurrentStep     int32  `json:"currentStep"`
+	CurrentWeight   int32  `json:"currentWeight"`
+}
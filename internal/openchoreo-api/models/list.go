@@ -0,0 +1,138 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MaxListLimit is the largest page size of list requests
+const MaxListLimit = 500
+
+// ListOptions selects, orders and pages the items of list requests
+type ListOptions struct {
+	// Limit is the maximum number of items returned, at most MaxListLimit. All items are returned if zero.
+	Limit int `json:"limit,omitempty"`
+	// Continue is the token of the next page returned by the previous list request
+	Continue string `json:"continue,omitempty"`
+	// LabelSelector selects the items by the labels of their resources, in Kubernetes label selector syntax
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// FieldSelector selects the items by their fields, e.g. "status=Ready,type!=Service".
+	// Nested fields are separated by dots.
+	FieldSelector string `json:"fieldSelector,omitempty"`
+	// Sort is the field the items are sorted by, prefixed with "-" for descending order.
+	// Items are sorted by name if empty.
+	Sort string `json:"sort,omitempty"`
+	// Fields restricts the returned items to the given fields
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Validate checks the bounds of the options
+func (o *ListOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Limit < 0 || o.Limit > MaxListLimit {
+		return fmt.Errorf("limit must be between 0 and %d, 0 returning all items", MaxListLimit)
+	}
+	if strings.TrimPrefix(o.Sort, "-") == "" && o.Sort != "" {
+		return fmt.Errorf("invalid sort field %q", o.Sort)
+	}
+	return nil
+}
+
+// ListPage is a page of the items of a list request
+type ListPage[T any] struct {
+	Items []T
+	// Continue is the token of the next page, empty on the last page
+	Continue string
+	// Total is the number of items of all pages, or -1 if unknown
+	Total int
+	// Page is the number of the page, starting at 1
+	Page int
+}
+
+// NewListPageResponse returns the list response of a page, with its items restricted to the selected fields of the options
+func NewListPageResponse[T any](page *ListPage[T], opts *ListOptions) (any, error) {
+	var total *int
+	if page.Total >= 0 {
+		total = &page.Total
+	}
+	pageSize := len(page.Items)
+	if opts != nil && opts.Limit > 0 {
+		pageSize = opts.Limit
+	}
+
+	if opts == nil || len(opts.Fields) == 0 {
+		return ListResponse[T]{Items: page.Items, TotalCount: total, Page: max(page.Page, 1), PageSize: pageSize, Continue: page.Continue}, nil
+	}
+	items, err := SelectFields(page.Items, opts.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return ListResponse[map[string]any]{Items: items, TotalCount: total, Page: max(page.Page, 1), PageSize: pageSize, Continue: page.Continue}, nil
+}
+
+// SelectFields returns the items restricted to the given fields, which may be nested fields separated by dots.
+// The items are returned as JSON objects.
+func SelectFields[T any](items []T, fields []string) ([]map[string]any, error) {
+	selected := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		object, err := ToJSONObject(item)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]any, len(fields))
+		for _, field := range fields {
+			if value, ok := LookupField(object, field); ok {
+				setField(result, field, value)
+			}
+		}
+		selected = append(selected, result)
+	}
+	return selected, nil
+}
+
+// ToJSONObject returns the JSON object representation of the value
+func ToJSONObject(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// LookupField returns the value of a field of a JSON object, where nested fields are separated by dots
+func LookupField(object map[string]any, field string) (any, bool) {
+	var value any = object
+	for _, key := range strings.Split(field, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func setField(object map[string]any, field string, value any) {
+	keys := strings.Split(field, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := object[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			object[key] = next
+		}
+		object = next
+	}
+	object[keys[len(keys)-1]] = value
+}
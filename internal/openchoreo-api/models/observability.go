@@ -0,0 +1,173 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultObservabilityLimit is the number of log entries or spans returned when a query sets no limit
+	DefaultObservabilityLimit = 100
+	// MaxObservabilityLimit is the largest number of log entries or spans a query returns
+	MaxObservabilityLimit = 1000
+	// DefaultObservabilityWindow is the time range queried when a query sets no start time
+	DefaultObservabilityWindow = time.Hour
+)
+
+// LogLevels are the log levels log entries can be filtered by
+var LogLevels = []string{"ERROR", "WARN", "INFO", "DEBUG"}
+
+// SortOrders are the orders of the log entries and spans of a query, newest first by default
+var SortOrders = []string{"desc", "asc"}
+
+// TimeRange bounds the time of the log entries or spans of a query. A zero end time is the current time,
+// and a zero start time is DefaultObservabilityWindow before the end time.
+type TimeRange struct {
+	StartTime time.Time `json:"startTime,omitempty"`
+	EndTime   time.Time `json:"endTime,omitempty"`
+}
+
+// Resolve returns the bounds of the range, filling in the defaults relative to now
+func (r TimeRange) Resolve(now time.Time) (time.Time, time.Time) {
+	end := r.EndTime
+	if end.IsZero() {
+		end = now
+	}
+	start := r.StartTime
+	if start.IsZero() {
+		start = end.Add(-DefaultObservabilityWindow)
+	}
+	return start, end
+}
+
+// Validate validates the TimeRange
+func (r TimeRange) Validate() error {
+	if !r.StartTime.IsZero() && !r.EndTime.IsZero() && !r.StartTime.Before(r.EndTime) {
+		return errors.New("startTime must be before endTime")
+	}
+	return nil
+}
+
+// LogQuery selects the log entries of a component or build
+type LogQuery struct {
+	// TimeRange bounds the entries of component logs. Build logs are not bounded by time.
+	TimeRange
+	// Levels restricts the entries to these log levels
+	Levels []string `json:"levels,omitempty"`
+	// Search restricts the entries to those containing the phrase
+	Search string `json:"search,omitempty"`
+	// Limit is the maximum number of entries returned, DefaultObservabilityLimit if zero
+	Limit int `json:"limit,omitempty"`
+	// SortOrder is desc for the newest entries first, or asc for the oldest first
+	SortOrder string `json:"sortOrder,omitempty"`
+}
+
+// Validate validates the LogQuery, normalizing the case of its levels
+func (q *LogQuery) Validate() error {
+	if err := q.TimeRange.Validate(); err != nil {
+		return err
+	}
+	for i, level := range q.Levels {
+		q.Levels[i] = strings.ToUpper(level)
+		if !slices.Contains(LogLevels, q.Levels[i]) {
+			return fmt.Errorf("level must be one of: %s", strings.Join(LogLevels, ", "))
+		}
+	}
+	return validateLimitAndOrder(q.Limit, q.SortOrder)
+}
+
+// TraceQuery selects the spans of a component
+type TraceQuery struct {
+	TimeRange
+	// Limit is the maximum number of spans returned, DefaultObservabilityLimit if zero
+	Limit int `json:"limit,omitempty"`
+	// SortOrder is desc for the newest spans first, or asc for the oldest first
+	SortOrder string `json:"sortOrder,omitempty"`
+}
+
+// Validate validates the TraceQuery
+func (q *TraceQuery) Validate() error {
+	if err := q.TimeRange.Validate(); err != nil {
+		return err
+	}
+	return validateLimitAndOrder(q.Limit, q.SortOrder)
+}
+
+func validateLimitAndOrder(limit int, sortOrder string) error {
+	if limit < 0 || limit > MaxObservabilityLimit {
+		return fmt.Errorf("limit must be between 0 and %d, 0 returning %d", MaxObservabilityLimit, DefaultObservabilityLimit)
+	}
+	if sortOrder != "" && !slices.Contains(SortOrders, sortOrder) {
+		return fmt.Errorf("sortOrder must be one of: %s", strings.Join(SortOrders, ", "))
+	}
+	return nil
+}
+
+// LogEntry represents a log line of a container in API responses
+type LogEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Level     string    `json:"level,omitempty"`
+	Log       string    `json:"log"`
+	Pod       string    `json:"pod,omitempty"`
+	Container string    `json:"container,omitempty"`
+	Version   string    `json:"version,omitempty"`
+}
+
+// LogGap reports log entries skipped by a followed log stream
+type LogGap struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// LogsResponse represents the log entries of a component or build in API responses
+type LogsResponse struct {
+	Entries []LogEntry `json:"entries"`
+	// TotalCount is the number of matching entries, which exceeds the number of returned entries when limited
+	TotalCount int `json:"totalCount"`
+	// StartTime and EndTime are the queried time range, unset for build logs
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+}
+
+// TraceSpan represents a span of a distributed trace in API responses
+type TraceSpan struct {
+	TraceID    string    `json:"traceId"`
+	SpanID     string    `json:"spanId"`
+	Name       string    `json:"name"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+	DurationMs float64   `json:"durationMs"`
+}
+
+// TracesResponse represents the spans of a component in API responses
+type TracesResponse struct {
+	Spans []TraceSpan `json:"spans"`
+	// TotalCount is the number of matching spans, which exceeds the number of returned spans when limited
+	TotalCount int       `json:"totalCount"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+}
+
+// ReleaseHealthResponse summarizes the health of the resources a release applied to the data plane
+type ReleaseHealthResponse struct {
+	Name          string `json:"name"`
+	OrgName       string `json:"orgName"`
+	ProjectName   string `json:"projectName"`
+	ComponentName string `json:"componentName"`
+	Environment   string `json:"environment"`
+	// Health is the worst health of the resources: Degraded, Progressing, Unknown, Suspended or Healthy
+	Health string `json:"health"`
+	// Status is the status of the Ready condition of the release
+	Status string `json:"status,omitempty"`
+	// ResourceCounts is the number of resources of each health
+	ResourceCounts map[string]int `json:"resourceCounts"`
+	// UnhealthyResources are the resources that are not healthy
+	UnhealthyResources []ReleaseResourceStatus `json:"unhealthyResources,omitempty"`
+	Conditions         []ConditionResponse     `json:"conditions,omitempty"`
+}
@@ -5,7 +5,13 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 // CreateProjectRequest represents the request to create a new project
@@ -45,6 +51,16 @@ type PromoteComponentRequest struct {
 	SourceEnvironment string `json:"sourceEnv"`
 	TargetEnvironment string `json:"targetEnv"`
 	// TODO Support overrides for the target environment
+
+	// FreezeOverride allows the promotion to proceed while the target environment is frozen
+	FreezeOverride *FreezeOverride `json:"freezeOverride,omitempty"`
+}
+
+// FreezeOverride requests that an active environment freeze window be bypassed
+type FreezeOverride struct {
+	Justification string `json:"justification"`
+	// Principal is the caller overriding the freeze, set from the authenticated principal of the request
+	Principal string `json:"-"`
 }
 
 // CreateEnvironmentRequest represents the request to create a new environment
@@ -101,6 +117,9 @@ func (req *CreateDataPlaneRequest) Validate() error {
 // Validate validates the PromoteComponentRequest
 func (req *PromoteComponentRequest) Validate() error {
 	// TODO: Implement custom validation using Go stdlib
+	if req.FreezeOverride != nil {
+		return req.FreezeOverride.Validate()
+	}
 	return nil
 }
 
@@ -151,6 +170,9 @@ func (req *CreateDataPlaneRequest) Sanitize() {
 func (req *PromoteComponentRequest) Sanitize() {
 	req.SourceEnvironment = strings.TrimSpace(req.SourceEnvironment)
 	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+	if req.FreezeOverride != nil {
+		req.FreezeOverride.Justification = strings.TrimSpace(req.FreezeOverride.Justification)
+	}
 }
 
 type BindingReleaseState string
@@ -167,6 +189,9 @@ type UpdateBindingRequest struct {
 	// ReleaseState controls the state of the Release created by this binding.
 	// Valid values: Active, Suspend, Undeploy
 	ReleaseState BindingReleaseState `json:"releaseState"`
+
+	// FreezeOverride allows the update to proceed while the binding's environment is frozen
+	FreezeOverride *FreezeOverride `json:"freezeOverride,omitempty"`
 }
 
 // Validate validates the UpdateBindingRequest
@@ -181,5 +206,192 @@ func (req *UpdateBindingRequest) Validate() error {
 	default:
 		return errors.New("releaseState must be one of: Active, Suspend, Undeploy")
 	}
+	if req.FreezeOverride != nil {
+		return req.FreezeOverride.Validate()
+	}
+	return nil
+}
+
+// Validate validates the FreezeOverride
+func (req *FreezeOverride) Validate() error {
+	if strings.TrimSpace(req.Justification) == "" {
+		return errors.New("freezeOverride.justification is required")
+	}
+	return nil
+}
+
+// ComponentTypeRequest represents the request to create or update a ComponentType
+type ComponentTypeRequest struct {
+	Name        string                               `json:"name"`
+	DisplayName string                               `json:"displayName,omitempty"`
+	Description string                               `json:"description,omitempty"`
+	Spec        openchoreov1alpha1.ComponentTypeSpec `json:"spec"`
+	// ResourceVersion is the version of the ComponentType an update is based on.
+	// The update is rejected if the ComponentType has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// TraitRequest represents the request to create or update a Trait
+type TraitRequest struct {
+	Name        string                       `json:"name"`
+	DisplayName string                       `json:"displayName,omitempty"`
+	Description string                       `json:"description,omitempty"`
+	Spec        openchoreov1alpha1.TraitSpec `json:"spec"`
+	// ResourceVersion is the version of the Trait an update is based on.
+	// The update is rejected if the Trait has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// WorkflowRequest represents the request to create or update a Workflow
+type WorkflowRequest struct {
+	Name        string                          `json:"name"`
+	DisplayName string                          `json:"displayName,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Spec        openchoreov1alpha1.WorkflowSpec `json:"spec"`
+	// ResourceVersion is the version of the Workflow an update is based on.
+	// The update is rejected if the Workflow has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// ComponentDeploymentRequest represents the request to create or update the ComponentDeployment
+// of a component in an environment
+type ComponentDeploymentRequest struct {
+	// Overrides for the envOverrides parameters of the ComponentType
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+	// TraitOverrides for the envOverrides parameters of the traits, by trait instance name
+	TraitOverrides         map[string]runtime.RawExtension               `json:"traitOverrides,omitempty"`
+	ConfigurationOverrides *openchoreov1alpha1.EnvConfigurationOverrides `json:"configurationOverrides,omitempty"`
+	Rollout                *openchoreov1alpha1.RolloutStrategy           `json:"rollout,omitempty"`
+	// ResourceVersion is the version of the ComponentDeployment an update is based on.
+	// The update is rejected if the ComponentDeployment has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// FreezeOverride allows the change to proceed while the environment is frozen
+	FreezeOverride *FreezeOverride `json:"freezeOverride,omitempty"`
+}
+
+// PromoteReleaseTrainRequest represents the request to promote a release train to an environment
+type PromoteReleaseTrainRequest struct {
+	TargetEnvironment string `json:"targetEnv"`
+	// ResourceVersion is the version of the ReleaseTrain the promotion is based on.
+	// The promotion is rejected if the ReleaseTrain has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// FreezeOverride allows the promotion to proceed while the target environment is frozen
+	FreezeOverride *FreezeOverride `json:"freezeOverride,omitempty"`
+}
+
+// Sanitize sanitizes the PromoteReleaseTrainRequest by trimming whitespace
+func (req *PromoteReleaseTrainRequest) Sanitize() {
+	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+	if req.FreezeOverride != nil {
+		req.FreezeOverride.Justification = strings.TrimSpace(req.FreezeOverride.Justification)
+	}
+}
+
+// Validate validates the PromoteReleaseTrainRequest
+func (req *PromoteReleaseTrainRequest) Validate() error {
+	if req.TargetEnvironment == "" {
+		return errors.New("targetEnv is required")
+	}
+	if req.FreezeOverride != nil {
+		return req.FreezeOverride.Validate()
+	}
+	return nil
+}
+
+// ComponentTraitsRequest represents the request to replace the traits of a component
+type ComponentTraitsRequest struct {
+	Traits []openchoreov1alpha1.ComponentTrait `json:"traits"`
+	// ResourceVersion is the version of the component the update is based on.
+	// The update is rejected if the component has changed since.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// Validate validates the ComponentTypeRequest
+func (req *ComponentTypeRequest) Validate() error {
+	if err := validateResourceName(req.Name); err != nil {
+		return err
+	}
+	if req.Spec.WorkloadType == "" {
+		return errors.New("spec.workloadType is required")
+	}
+	if len(req.Spec.Resources) == 0 {
+		return errors.New("spec.resources must contain at least one resource")
+	}
+	return nil
+}
+
+// Validate validates the TraitRequest
+func (req *TraitRequest) Validate() error {
+	return validateResourceName(req.Name)
+}
+
+// Validate validates the WorkflowRequest
+func (req *WorkflowRequest) Validate() error {
+	if err := validateResourceName(req.Name); err != nil {
+		return err
+	}
+	if req.Spec.Resource == nil || len(req.Spec.Resource.Raw) == 0 {
+		return errors.New("spec.resource is required")
+	}
+	return nil
+}
+
+// Validate validates the ComponentDeploymentRequest
+func (req *ComponentDeploymentRequest) Validate() error {
+	if req.Rollout != nil && req.Rollout.Type == "" {
+		return errors.New("rollout.type is required")
+	}
+	if req.FreezeOverride != nil {
+		return req.FreezeOverride.Validate()
+	}
+	return nil
+}
+
+// Validate validates the ComponentTraitsRequest. Instance names must be unique across the traits of a component.
+func (req *ComponentTraitsRequest) Validate() error {
+	instances := make(map[string]bool, len(req.Traits))
+	for i, trait := range req.Traits {
+		if trait.Name == "" || trait.InstanceName == "" {
+			return fmt.Errorf("traits[%d]: name and instanceName are required", i)
+		}
+		if instances[trait.InstanceName] {
+			return fmt.Errorf("traits[%d]: instanceName %q is not unique", i, trait.InstanceName)
+		}
+		instances[trait.InstanceName] = true
+	}
+	return nil
+}
+
+// Sanitize sanitizes the ComponentTypeRequest by trimming whitespace
+func (req *ComponentTypeRequest) Sanitize() {
+	req.Name = strings.TrimSpace(req.Name)
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
+	req.Description = strings.TrimSpace(req.Description)
+}
+
+// Sanitize sanitizes the TraitRequest by trimming whitespace
+func (req *TraitRequest) Sanitize() {
+	req.Name = strings.TrimSpace(req.Name)
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
+	req.Description = strings.TrimSpace(req.Description)
+}
+
+// Sanitize sanitizes the WorkflowRequest by trimming whitespace
+func (req *WorkflowRequest) Sanitize() {
+	req.Name = strings.TrimSpace(req.Name)
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
+	req.Description = strings.TrimSpace(req.Description)
+}
+
+// validateResourceName checks that the name is a valid Kubernetes resource name
+func validateResourceName(name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %s", name, strings.Join(errs, ", "))
+	}
 	return nil
 }
@@ -6,23 +6,29 @@ package models
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
+
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 // APIResponse represents a standard API response wrapper
 type APIResponse[T any] struct {
-	Success bool   `json:"success"`
-	Data    T      `json:"data,omitempty"`
-	Error   string `json:"error,omitempty"`
-	Code    string `json:"code,omitempty"`
+	Success  bool     `json:"success"`
+	Data     T        `json:"data,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Code     string   `json:"code,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // ListResponse represents a paginated list response
 type ListResponse[T any] struct {
-	Items      []T `json:"items"`
-	TotalCount int `json:"totalCount"`
-	Page       int `json:"page"`
-	PageSize   int `json:"pageSize"`
+	Items []T `json:"items"`
+	// TotalCount is the number of items of all pages, omitted if unknown
+	TotalCount *int `json:"totalCount,omitempty"`
+	Page       int  `json:"page"`
+	PageSize   int  `json:"pageSize"`
+	// Continue is the token of the next page, empty on the last page
+	Continue string `json:"continue,omitempty"`
 }
 
 // ProjectResponse represents a project in API responses
@@ -42,6 +48,7 @@ type ComponentResponse struct {
 	DisplayName    string                                 `json:"displayName,omitempty"`
 	Description    string                                 `json:"description,omitempty"`
 	Type           string                                 `json:"type"`
+	ComponentType  string                                 `json:"componentType,omitempty"`
 	ProjectName    string                                 `json:"projectName"`
 	OrgName        string                                 `json:"orgName"`
 	CreatedAt      time.Time                              `json:"createdAt"`
@@ -52,6 +59,9 @@ type ComponentResponse struct {
 	API            *openchoreov1alpha1.APISpec            `json:"api,omitempty"`
 	Workload       *openchoreov1alpha1.WorkloadSpec       `json:"workload,omitempty"`
 	BuildConfig    *BuildConfig                           `json:"buildConfig,omitempty"`
+	// Traits are the trait instances composed into a ComponentType based component
+	Traits          []openchoreov1alpha1.ComponentTrait `json:"traits,omitempty"`
+	ResourceVersion string                              `json:"resourceVersion,omitempty"`
 }
 
 type BindingResponse struct {
@@ -66,6 +76,8 @@ type BindingResponse struct {
 	ServiceBinding        *ServiceBinding        `json:"serviceBinding,omitempty"`
 	WebApplicationBinding *WebApplicationBinding `json:"webApplicationBinding,omitempty"`
 	ScheduledTaskBinding  *ScheduledTaskBinding  `json:"scheduledTaskBinding,omitempty"`
+	// ComponentDeployment is set for components defined with a ComponentType
+	ComponentDeployment *ComponentDeploymentBinding `json:"componentDeployment,omitempty"`
 }
 
 type BindingStatusType string
@@ -102,6 +114,12 @@ type ScheduledTaskBinding struct {
 	ReleaseState string `json:"releaseState,omitempty"`
 }
 
+// ComponentDeploymentBinding represents the deployment of a ComponentType based component to an environment
+type ComponentDeploymentBinding struct {
+	Image        string `json:"image,omitempty"`
+	SnapshotName string `json:"snapshotName,omitempty"`
+}
+
 type EndpointStatus struct {
 	Name         string           `json:"name"`
 	Type         string           `json:"type"`
@@ -140,6 +158,14 @@ type TargetEnvironmentRef struct {
 	Name                     string `json:"name"`
 	RequiresApproval         bool   `json:"requiresApproval,omitempty"`
 	IsManualApprovalRequired bool   `json:"isManualApprovalRequired,omitempty"`
+	// AutoPromote is set when promotion to this environment happens automatically
+	AutoPromote *AutoPromotePolicy `json:"autoPromote,omitempty"`
+}
+
+// AutoPromotePolicy represents the automatic promotion settings of a target environment
+type AutoPromotePolicy struct {
+	// HealthyFor is how long the workload must be healthy in the source environment, e.g. "30m0s"
+	HealthyFor string `json:"healthyFor,omitempty"`
 }
 
 // OrganizationResponse represents an organization in API responses
@@ -163,6 +189,16 @@ type EnvironmentResponse struct {
 	DNSPrefix    string    `json:"dnsPrefix,omitempty"`
 	CreatedAt    time.Time `json:"createdAt"`
 	Status       string    `json:"status,omitempty"`
+
+	// ActiveFreeze is set when the environment is in a freeze window
+	ActiveFreeze *ActiveFreezeResponse `json:"activeFreeze,omitempty"`
+}
+
+// ActiveFreezeResponse describes the freeze window currently blocking deployments to an environment
+type ActiveFreezeResponse struct {
+	Window string    `json:"window"`
+	Reason string    `json:"reason"`
+	Until  time.Time `json:"until"`
 }
 
 // DataPlaneResponse represents a dataplane in API responses
@@ -235,7 +271,7 @@ func ListSuccessResponse[T any](items []T, total, page, pageSize int) APIRespons
 		Success: true,
 		Data: ListResponse[T]{
 			Items:      items,
-			TotalCount: total,
+			TotalCount: &total,
 			Page:       page,
 			PageSize:   pageSize,
 		},
@@ -258,6 +294,9 @@ type ComponentTypeResponse struct {
 	WorkloadType     string    `json:"workloadType"`
 	AllowedWorkflows []string  `json:"allowedWorkflows,omitempty"`
 	CreatedAt        time.Time `json:"createdAt"`
+	// Spec is only set when a single ComponentType is returned
+	Spec            *openchoreov1alpha1.ComponentTypeSpec `json:"spec,omitempty"`
+	ResourceVersion string                                `json:"resourceVersion,omitempty"`
 }
 
 // TraitResponse represents an Trait in API responses
@@ -266,6 +305,9 @@ type TraitResponse struct {
 	DisplayName string    `json:"displayName,omitempty"`
 	Description string    `json:"description,omitempty"`
 	CreatedAt   time.Time `json:"createdAt"`
+	// Spec is only set when a single Trait is returned
+	Spec            *openchoreov1alpha1.TraitSpec `json:"spec,omitempty"`
+	ResourceVersion string                        `json:"resourceVersion,omitempty"`
 }
 
 // WorkflowResponse represents a Workflow in API responses
@@ -274,4 +316,117 @@ type WorkflowResponse struct {
 	DisplayName string    `json:"displayName,omitempty"`
 	Description string    `json:"description,omitempty"`
 	CreatedAt   time.Time `json:"createdAt"`
+	// Spec is only set when a single Workflow is returned
+	Spec            *openchoreov1alpha1.WorkflowSpec `json:"spec,omitempty"`
+	ResourceVersion string                           `json:"resourceVersion,omitempty"`
+}
+
+// ComponentDeploymentResponse represents the deployment of a component to an environment in API responses
+type ComponentDeploymentResponse struct {
+	Name                   string                                        `json:"name"`
+	OrgName                string                                        `json:"orgName"`
+	ProjectName            string                                        `json:"projectName"`
+	ComponentName          string                                        `json:"componentName"`
+	Environment            string                                        `json:"environment"`
+	Overrides              *runtime.RawExtension                         `json:"overrides,omitempty"`
+	TraitOverrides         map[string]runtime.RawExtension               `json:"traitOverrides,omitempty"`
+	ConfigurationOverrides *openchoreov1alpha1.EnvConfigurationOverrides `json:"configurationOverrides,omitempty"`
+	Rollout                *openchoreov1alpha1.RolloutStrategy           `json:"rollout,omitempty"`
+	Status                 string                                        `json:"status,omitempty"`
+	Conditions             []ConditionResponse                           `json:"conditions,omitempty"`
+	RolloutStatus          *RolloutEventStatus                           `json:"rolloutStatus,omitempty"`
+	ResourceVersion        string                                        `json:"resourceVersion"`
+	CreatedAt              time.Time                                     `json:"createdAt"`
+}
+
+// ReleaseTrainResponse represents a release train in API responses
+type ReleaseTrainResponse struct {
+	Name               string              `json:"name"`
+	OrgName            string              `json:"orgName"`
+	ProjectName        string              `json:"projectName"`
+	Components         []string            `json:"components"`
+	SourceEnvironment  string              `json:"sourceEnvironment"`
+	TargetEnvironment  string              `json:"targetEnvironment"`
+	CurrentEnvironment string              `json:"currentEnvironment,omitempty"`
+	Phase              string              `json:"phase,omitempty"`
+	Conditions         []ConditionResponse `json:"conditions,omitempty"`
+	ResourceVersion    string              `json:"resourceVersion"`
+	CreatedAt          time.Time           `json:"createdAt"`
+}
+
+// ComponentEnvSnapshotResponse represents the snapshot of a component deployed to an environment in API responses
+type ComponentEnvSnapshotResponse struct {
+	Name                  string              `json:"name"`
+	OrgName               string              `json:"orgName"`
+	ProjectName           string              `json:"projectName"`
+	ComponentName         string              `json:"componentName"`
+	Environment           string              `json:"environment"`
+	ComponentType         string              `json:"componentType"`
+	Traits                []string            `json:"traits,omitempty"`
+	Workload              string              `json:"workload"`
+	ReleaseRef            string              `json:"releaseRef,omitempty"`
+	RenderedResourceCount int                 `json:"renderedResourceCount"`
+	Conditions            []ConditionResponse `json:"conditions,omitempty"`
+	ResourceVersion       string              `json:"resourceVersion"`
+	CreatedAt             time.Time           `json:"createdAt"`
+}
+
+// ReleaseResponse represents the resources released to the data plane of an environment in API responses
+type ReleaseResponse struct {
+	Name            string                  `json:"name"`
+	OrgName         string                  `json:"orgName"`
+	ProjectName     string                  `json:"projectName"`
+	ComponentName   string                  `json:"componentName"`
+	Environment     string                  `json:"environment"`
+	Status          string                  `json:"status,omitempty"`
+	Resources       []ReleaseResourceStatus `json:"resources,omitempty"`
+	Conditions      []ConditionResponse     `json:"conditions,omitempty"`
+	ResourceVersion string                  `json:"resourceVersion"`
+	CreatedAt       time.Time               `json:"createdAt"`
+}
+
+// ReleaseResourceStatus represents a resource of a release applied to the data plane
+type ReleaseResourceStatus struct {
+	ID           string `json:"id"`
+	Group        string `json:"group,omitempty"`
+	Version      string `json:"version"`
+	Kind         string `json:"kind"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace,omitempty"`
+	HealthStatus string `json:"healthStatus,omitempty"`
+}
+
+// ComponentObserverResponse represents the response for observer URL requests
+type ComponentObserverResponse struct {
+	ObserverURL      string                    `json:"observerUrl,omitempty"`
+	ConnectionMethod *ObserverConnectionMethod `json:"connectionMethod,omitempty"`
+	Message          string                    `json:"message,omitempty"`
+}
+
+// ObserverConnectionMethod contains the access method for the observer
+type ObserverConnectionMethod struct {
+	Type        string `json:"type,omitempty"`
+	Username    string `json:"username,omitempty"`
+	Password    string `json:"password,omitempty"`
+	BearerToken string `json:"bearerToken,omitempty"`
+}
+
+// SchemaExplanation represents the structured schema information
+type SchemaExplanation struct {
+	Group       string                `json:"group"`
+	Kind        string                `json:"kind"`
+	Version     string                `json:"version"`
+	Field       string                `json:"field,omitempty"`
+	Type        string                `json:"type"`
+	Description string                `json:"description,omitempty"`
+	Properties  []PropertyDescription `json:"properties,omitempty"`
+	Required    []string              `json:"required,omitempty"`
+}
+
+// PropertyDescription represents a single field/property in the schema
+type PropertyDescription struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
 }
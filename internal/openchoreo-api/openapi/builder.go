@@ -0,0 +1,296 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	contentTypeJSON = "application/json"
+
+	// Names of the component schemas of the response envelopes
+	apiResponseSchema  = "APIResponse"
+	listResponseSchema = "ListResponse"
+
+	bearerAuthScheme = "bearerAuth"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{([^}.$]+)(?:\.\.\.)?\}`)
+
+// Endpoint describes the API operation of a route
+type Endpoint struct {
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	// Query lists the query parameters, the path parameters are taken from the route
+	Query []Parameter
+	// Request is a value of the type of the request body, nil when the operation has no body
+	Request any
+	// Response is a value of the type of the data of the response envelope, nil when there is no data
+	Response any
+	// List is set when the data of the response is a list of Response items
+	List bool
+	// Raw is set when the response is the Response value itself rather than the response envelope
+	Raw bool
+	// Status is the status code of the successful response, 200 when not set
+	Status int
+	// TextContent lists the alternative text content types of the successful response
+	TextContent []string
+	// Public is set when the operation does not require authentication
+	Public bool
+}
+
+// Builder builds an OpenAPI document from the endpoints of the routes of the API
+type Builder struct {
+	doc       *Document
+	reflector *Reflector
+}
+
+// NewBuilder creates a builder of a document with the given info. The reflector is used to derive the
+// schemas of the request and response types, and may define the schemas of types that can't be reflected.
+func NewBuilder(info Info, reflector *Reflector) *Builder {
+	reflector.Define(reflect.TypeFor[apiResponseEnvelope](), apiResponseSchema, &Schema{
+		Type:        "object",
+		Description: "Envelope of all responses. Data is set on success, error and code on failure.",
+		Properties: map[string]*Schema{
+			"success":  {Type: "boolean"},
+			"data":     {},
+			"error":    {Type: "string"},
+			"code":     {Type: "string"},
+			"warnings": {Type: "array", Items: &Schema{Type: "string"}},
+		},
+		Required: []string{"success"},
+	})
+	reflector.Define(reflect.TypeFor[listResponseEnvelope](), listResponseSchema, &Schema{
+		Type:        "object",
+		Description: "A page of a list. Continue is the token of the next page, empty on the last page.",
+		Properties: map[string]*Schema{
+			"items":      {Type: "array"},
+			"totalCount": {Type: "integer", Description: "Total number of items, omitted when unknown"},
+			"page":       {Type: "integer"},
+			"pageSize":   {Type: "integer"},
+			"continue":   {Type: "string"},
+		},
+		Required: []string{"items", "page", "pageSize"},
+	})
+
+	return &Builder{
+		doc: &Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   make(map[string]PathItem),
+			Components: Components{
+				Schemas: reflector.Components(),
+				SecuritySchemes: map[string]*SecurityScheme{
+					bearerAuthScheme: {
+						Type:        "http",
+						Scheme:      "bearer",
+						Description: "A static token or an OIDC JWT",
+					},
+				},
+			},
+			Security: []SecurityRequirement{{bearerAuthScheme: {}}},
+		},
+		reflector: reflector,
+	}
+}
+
+// apiResponseEnvelope and listResponseEnvelope identify the envelope schemas, which are defined explicitly
+// since the generic models they describe can't be reflected without their type arguments
+type apiResponseEnvelope struct{}
+type listResponseEnvelope struct{}
+
+// Add adds the operation of a route, given by its method and path pattern
+func (b *Builder) Add(method, pattern string, e Endpoint) error {
+	if e.OperationID == "" {
+		return fmt.Errorf("operation %s %s has no operation id", method, pattern)
+	}
+	item, ok := b.doc.Paths[pattern]
+	if !ok {
+		item = make(PathItem)
+		b.doc.Paths[pattern] = item
+	}
+	m := strings.ToLower(method)
+	if _, exists := item[m]; exists {
+		return fmt.Errorf("operation %s %s is added twice", method, pattern)
+	}
+
+	op := &Operation{
+		OperationID: e.OperationID,
+		Summary:     e.Summary,
+		Description: e.Description,
+		Tags:        e.Tags,
+		Responses:   make(map[string]*Response),
+	}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(pattern, -1) {
+		op.Parameters = append(op.Parameters, Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	op.Parameters = append(op.Parameters, e.Query...)
+
+	if e.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{contentTypeJSON: {Schema: b.reflector.Schema(reflect.TypeOf(e.Request))}},
+		}
+	}
+
+	status := e.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{
+		Description: http.StatusText(status),
+		Content:     map[string]MediaType{contentTypeJSON: {Schema: b.responseSchema(e)}},
+	}
+	for _, contentType := range e.TextContent {
+		success.Content[contentType] = MediaType{Schema: &Schema{Type: "string"}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+	if !e.Raw {
+		op.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     map[string]MediaType{contentTypeJSON: {Schema: Ref(apiResponseSchema)}},
+		}
+	}
+
+	if e.Public {
+		op.Security = []SecurityRequirement{{}}
+	}
+
+	item[m] = op
+	return nil
+}
+
+// responseSchema returns the schema of the successful response of the endpoint
+func (b *Builder) responseSchema(e Endpoint) *Schema {
+	var data *Schema
+	if e.Response != nil {
+		data = b.reflector.Schema(reflect.TypeOf(e.Response))
+	}
+	if e.Raw {
+		if data == nil {
+			return &Schema{Type: "object"}
+		}
+		return data
+	}
+	if data == nil {
+		return Ref(apiResponseSchema)
+	}
+	if e.List {
+		data = &Schema{AllOf: []*Schema{
+			Ref(listResponseSchema),
+			{Properties: map[string]*Schema{"items": {Type: "array", Items: data}}},
+		}}
+	}
+	return &Schema{AllOf: []*Schema{
+		Ref(apiResponseSchema),
+		{Properties: map[string]*Schema{"data": data}},
+	}}
+}
+
+// Document returns the built document
+func (b *Builder) Document() *Document {
+	return b.doc
+}
+
+// StringParam returns a query parameter with a string value
+func StringParam(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "string"}}
+}
+
+// HeaderParam returns a header parameter with a string value
+func HeaderParam(name, description string) Parameter {
+	return Parameter{Name: name, In: "header", Description: description, Schema: &Schema{Type: "string"}}
+}
+
+// IntegerParam returns a query parameter with an integer value
+func IntegerParam(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "integer"}}
+}
+
+// EnumParam returns a query parameter with one of the given string values
+func EnumParam(name, description string, values ...string) Parameter {
+	enum := make([]any, 0, len(values))
+	for _, v := range values {
+		enum = append(enum, v)
+	}
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "string", Enum: enum}}
+}
+
+// Validate checks that all references of the document resolve to component schemas
+// and that the operation ids are unique
+func Validate(doc *Document) error {
+	if doc.OpenAPI != Version {
+		return fmt.Errorf("unsupported OpenAPI version %q", doc.OpenAPI)
+	}
+	operationIDs := make(map[string]string)
+	for p, item := range doc.Paths {
+		for method, op := range item {
+			where := strings.ToUpper(method) + " " + p
+			if other, ok := operationIDs[op.OperationID]; ok {
+				return fmt.Errorf("operation id %q of %s is also used by %s", op.OperationID, where, other)
+			}
+			operationIDs[op.OperationID] = where
+
+			for _, param := range op.Parameters {
+				if err := validateRefs(doc, param.Schema); err != nil {
+					return fmt.Errorf("%s: parameter %s: %w", where, param.Name, err)
+				}
+			}
+			if op.RequestBody != nil {
+				for _, mt := range op.RequestBody.Content {
+					if err := validateRefs(doc, mt.Schema); err != nil {
+						return fmt.Errorf("%s: request body: %w", where, err)
+					}
+				}
+			}
+			for status, resp := range op.Responses {
+				for _, mt := range resp.Content {
+					if err := validateRefs(doc, mt.Schema); err != nil {
+						return fmt.Errorf("%s: response %s: %w", where, status, err)
+					}
+				}
+			}
+		}
+	}
+	for name, s := range doc.Components.Schemas {
+		if err := validateRefs(doc, s); err != nil {
+			return fmt.Errorf("schema %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateRefs(doc *Document, s *Schema) error {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+		if !ok {
+			return fmt.Errorf("unsupported reference %q", s.Ref)
+		}
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			return fmt.Errorf("unresolved reference %q", s.Ref)
+		}
+	}
+	children := []*Schema{s.Items, s.AdditionalProperties}
+	children = append(children, s.AllOf...)
+	for _, p := range s.Properties {
+		children = append(children, p)
+	}
+	for _, c := range children {
+		if err := validateRefs(doc, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"reflect"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefineKubernetesTypes defines the schemas of the Kubernetes types embedded in the API models,
+// whose JSON encoding can't be derived from their fields
+func (r *Reflector) DefineKubernetesTypes() {
+	r.Define(reflect.TypeFor[metav1.Time](), "", &Schema{Type: "string", Format: "date-time"})
+	r.Define(reflect.TypeFor[metav1.Duration](), "", &Schema{Type: "string", Description: "Go duration, such as 30s or 5m"})
+	r.Define(reflect.TypeFor[extv1.JSONSchemaProps](), "JSONSchema", &Schema{
+		Type:                 "object",
+		Description:          "OpenAPI v3 schema of the parameters of a component type, trait or workflow",
+		AdditionalProperties: &Schema{},
+	})
+}
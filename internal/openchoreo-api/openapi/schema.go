@@ -0,0 +1,224 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Reflector derives the JSON schemas of Go types from their JSON encoding. Named struct types are
+// collected as component schemas and referenced, so that each model is described once in the document.
+type Reflector struct {
+	// Nullable allows null values for pointers, slices and maps, which are encoded as null when they are nil
+	Nullable bool
+
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+	defined map[reflect.Type]*Schema
+}
+
+// NewReflector creates a reflector without component schemas
+func NewReflector() *Reflector {
+	return &Reflector{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+		defined: map[reflect.Type]*Schema{
+			reflect.TypeFor[time.Time](): {Type: "string", Format: "date-time"},
+		},
+	}
+}
+
+// Define sets the schema of a type, for types whose JSON encoding can't be derived from their fields.
+// The schema is registered as a component when a name is given and inlined otherwise.
+func (r *Reflector) Define(t reflect.Type, name string, schema *Schema) {
+	if name == "" {
+		r.defined[t] = schema
+		return
+	}
+	r.names[t] = name
+	r.schemas[name] = schema
+}
+
+// Schema returns the schema of the type, a reference for named struct types
+func (r *Reflector) Schema(t reflect.Type) *Schema {
+	s := r.schema(t)
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		if r.Nullable {
+			return nullable(s)
+		}
+	}
+	return s
+}
+
+func (r *Reflector) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if s, ok := r.defined[t]; ok {
+		c := *s
+		return &c
+	}
+	if name, ok := r.names[t]; ok {
+		return Ref(name)
+	}
+
+	// Types with their own encoding are only known to be encoded as JSON
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return &Schema{}
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.Schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.Schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		name := r.componentName(t)
+		r.names[t] = name
+		// The name is registered before the fields are reflected, so that recursive types refer to themselves
+		r.schemas[name] = &Schema{}
+		r.schemas[name] = r.structSchema(t)
+		return Ref(name)
+	default:
+		// Interfaces may hold any value
+		return &Schema{}
+	}
+}
+
+// Components returns the component schemas of the reflected types by name
+func (r *Reflector) Components() map[string]*Schema {
+	return r.schemas
+}
+
+func (r *Reflector) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		// Embedded structs without a name have their fields inlined
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if name == "" && ft.Kind() == reflect.Struct && (f.Anonymous || strings.Contains(opts, "inline")) {
+			embedded := r.structSchema(ft)
+			for k, v := range embedded.Properties {
+				s.Properties[k] = v
+			}
+			// The fields of nil embedded pointers are omitted
+			if !r.Nullable || f.Type.Kind() != reflect.Pointer {
+				s.Required = append(s.Required, embedded.Required...)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s.Properties[name] = r.Schema(f.Type)
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			s.Required = append(s.Required, name)
+		}
+	}
+	slices.Sort(s.Required)
+	s.Required = slices.Compact(s.Required)
+	return s
+}
+
+// componentName returns a unique component name for the type, qualified by its package when the
+// name of the type is already used by a type of another package
+func (r *Reflector) componentName(t reflect.Type) string {
+	name := sanitizeName(t.Name())
+	if _, taken := r.schemas[name]; !taken {
+		return name
+	}
+	qualified := sanitizeName(capitalize(path.Base(t.PkgPath())) + t.Name())
+	candidate := qualified
+	for i := 2; ; i++ {
+		if _, taken := r.schemas[candidate]; !taken {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s%d", qualified, i)
+	}
+}
+
+// sanitizeName drops the characters that are not allowed in component names, such as those of type arguments
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-' {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// nullable returns a schema that also allows null
+func nullable(s *Schema) *Schema {
+	switch {
+	case s.Ref != "":
+		return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+	case s.Type == nil:
+		return s
+	}
+	if t, ok := s.Type.(string); ok {
+		s.Type = []string{t, "null"}
+	}
+	return s
+}
+
+// Ref returns a reference to a component schema
+func Ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testMeta struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type testItem struct {
+	testMeta  `json:",inline"`
+	Name      string     `json:"name"`
+	Count     int32      `json:"count,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Children  []testItem `json:"children,omitempty"`
+	Parent    *testItem  `json:"parent,omitempty"`
+	Raw       []byte     `json:"raw,omitempty"`
+	Ignored   string     `json:"-"`
+}
+
+func TestReflectorSchema(t *testing.T) {
+	r := NewReflector()
+
+	got := r.Schema(reflect.TypeFor[*testItem]())
+	if diff := cmp.Diff(Ref("testItem"), got); diff != "" {
+		t.Errorf("Schema() mismatch (-want +got):\n%s", diff)
+	}
+
+	want := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"labels":    {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+			"name":      {Type: "string"},
+			"count":     {Type: "integer", Format: "int32"},
+			"createdAt": {Type: "string", Format: "date-time"},
+			"children":  {Type: "array", Items: Ref("testItem")},
+			"parent":    Ref("testItem"),
+			"raw":       {Type: "string", Format: "byte"},
+		},
+		Required: []string{"createdAt", "name"},
+	}
+	if diff := cmp.Diff(want, r.Components()["testItem"]); diff != "" {
+		t.Errorf("component schema mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := r.Components()["testMeta"]; ok {
+		t.Errorf("inlined struct should not be a component schema")
+	}
+}
+
+func TestReflectorNullable(t *testing.T) {
+	r := NewReflector()
+	r.Nullable = true
+
+	r.Schema(reflect.TypeFor[testItem]())
+	got := r.Components()["testItem"].Properties
+	want := map[string]*Schema{
+		"labels":    {Type: []string{"object", "null"}, AdditionalProperties: &Schema{Type: "string"}},
+		"name":      {Type: "string"},
+		"count":     {Type: "integer", Format: "int32"},
+		"createdAt": {Type: "string", Format: "date-time"},
+		"children":  {Type: []string{"array", "null"}, Items: Ref("testItem")},
+		"parent":    {AnyOf: []*Schema{Ref("testItem"), {Type: "null"}}},
+		"raw":       {Type: []string{"string", "null"}, Format: "byte"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("nullable properties mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReflectorDefine(t *testing.T) {
+	r := NewReflector()
+	r.Define(reflect.TypeFor[testMeta](), "Meta", &Schema{Type: "object", Description: "defined"})
+
+	if diff := cmp.Diff(Ref("Meta"), r.Schema(reflect.TypeFor[testMeta]())); diff != "" {
+		t.Errorf("Schema() of a named definition mismatch (-want +got):\n%s", diff)
+	}
+
+	r.Define(reflect.TypeFor[testItem](), "", &Schema{Type: "string"})
+	if diff := cmp.Diff(&Schema{Type: "string"}, r.Schema(reflect.TypeFor[testItem]())); diff != "" {
+		t.Errorf("Schema() of an inline definition mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuilderAdd(t *testing.T) {
+	b := NewBuilder(Info{Title: "test", Version: "v1"}, NewReflector())
+
+	if err := b.Add("GET", "/items/{name}", Endpoint{
+		OperationID: "listItems",
+		Query:       []Parameter{StringParam("sort", "")},
+		Response:    testItem{},
+		List:        true,
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := b.Add("GET", "/items/{name}", Endpoint{OperationID: "other"}); err == nil {
+		t.Errorf("Add() of a duplicate operation should fail")
+	}
+	if err := b.Add("POST", "/items", Endpoint{OperationID: "listItems"}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	doc := b.Document()
+	op := doc.Paths["/items/{name}"]["get"]
+	wantParams := []Parameter{
+		{Name: "name", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+		StringParam("sort", ""),
+	}
+	if diff := cmp.Diff(wantParams, op.Parameters); diff != "" {
+		t.Errorf("parameters mismatch (-want +got):\n%s", diff)
+	}
+	if op.Responses["200"] == nil || op.Responses["default"] == nil {
+		t.Errorf("responses = %v, want 200 and default", op.Responses)
+	}
+
+	if err := Validate(doc); err == nil {
+		t.Errorf("Validate() should reject duplicate operation ids")
+	}
+	doc.Paths["/items"]["post"].OperationID = "createItem"
+	if err := Validate(doc); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	doc.Components.Schemas["broken"] = Ref("missing")
+	if err := Validate(doc); err == nil {
+		t.Errorf("Validate() should reject unresolved references")
+	}
+}
@@ -39,8 +39,8 @@ func NewBuildService(k8sClient client.Client, buildPlaneService *BuildPlaneServi
 	}
 }
 
-// ListBuildTemplates retrieves cluster workflow templates (argo) available for an organization in the buildplane
-func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string) ([]models.BuildTemplateResponse, error) {
+// ListBuildTemplates retrieves a page of the cluster workflow templates (argo) available for an organization in the buildplane
+func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildTemplateResponse], error) {
 	s.logger.Debug("Listing build templates", "org", orgName)
 
 	// Get the build plane Kubernetes client
@@ -50,42 +50,46 @@ func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string) (
 	}
 
 	// List ClusterWorkflowTemplates using the build plane client
-	var clusterWorkflowTemplates argo.ClusterWorkflowTemplateList
-	err = buildPlaneClient.List(ctx, &clusterWorkflowTemplates)
+	page, err := listResources(ctx, buildPlaneClient, opts,
+		func() *argo.ClusterWorkflowTemplateList { return &argo.ClusterWorkflowTemplateList{} },
+		func(list *argo.ClusterWorkflowTemplateList) []models.BuildTemplateResponse {
+			templateResponses := make([]models.BuildTemplateResponse, 0, len(list.Items))
+			for i := range list.Items {
+				templateResponses = append(templateResponses, toBuildTemplateResponse(&list.Items[i]))
+			}
+			return templateResponses
+		})
 	if err != nil {
 		s.logger.Error("Failed to list ClusterWorkflowTemplates", "error", err)
 		return nil, fmt.Errorf("failed to list ClusterWorkflowTemplates: %w", err)
 	}
 
-	s.logger.Debug("Found build templates", "count", len(clusterWorkflowTemplates.Items), "org", orgName)
-
-	templateResponses := make([]models.BuildTemplateResponse, 0, len(clusterWorkflowTemplates.Items))
-	for _, template := range clusterWorkflowTemplates.Items {
-		parameters := make([]models.BuildTemplateParameter, 0, len(template.Spec.Arguments.Parameters))
-		if template.Spec.Arguments.Parameters != nil {
-			for _, param := range template.Spec.Arguments.Parameters {
-				templateParam := models.BuildTemplateParameter{
-					Name: param.Name,
-				}
+	s.logger.Debug("Found build templates", "count", len(page.Items), "org", orgName)
+	return page, nil
+}
 
-				if param.Default != nil {
-					templateParam.Default = string(*param.Default)
-				}
+// toBuildTemplateResponse converts a cluster workflow template to its response format
+func toBuildTemplateResponse(template *argo.ClusterWorkflowTemplate) models.BuildTemplateResponse {
+	parameters := make([]models.BuildTemplateParameter, 0, len(template.Spec.Arguments.Parameters))
+	if template.Spec.Arguments.Parameters != nil {
+		for _, param := range template.Spec.Arguments.Parameters {
+			templateParam := models.BuildTemplateParameter{
+				Name: param.Name,
+			}
 
-				parameters = append(parameters, templateParam)
+			if param.Default != nil {
+				templateParam.Default = string(*param.Default)
 			}
-		}
 
-		templateResponse := models.BuildTemplateResponse{
-			Name:       template.Name,
-			Parameters: parameters,
-			CreatedAt:  template.CreationTimestamp.Time,
+			parameters = append(parameters, templateParam)
 		}
-
-		templateResponses = append(templateResponses, templateResponse)
 	}
 
-	return templateResponses, nil
+	return models.BuildTemplateResponse{
+		Name:       template.Name,
+		Parameters: parameters,
+		CreatedAt:  template.CreationTimestamp.Time,
+	}
 }
 
 // TriggerBuild creates a new workflow from a component's build configuration
@@ -194,44 +198,47 @@ func (s *BuildService) TriggerBuild(ctx context.Context, orgName, projectName, c
 	}, nil
 }
 
-// ListBuilds retrieves workflows for a component using spec.owner fields
-func (s *BuildService) ListBuilds(ctx context.Context, orgName, projectName, componentName string) ([]models.BuildResponse, error) {
+// ListBuilds retrieves a page of the workflows of a component using spec.owner fields
+func (s *BuildService) ListBuilds(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListPage[models.BuildResponse], error) {
 	s.logger.Debug("Listing builds", "org", orgName, "project", projectName, "component", componentName)
 
-	var workflowRuns openchoreov1alpha1.WorkflowRunList
-	err := s.k8sClient.List(ctx, &workflowRuns, client.InNamespace(orgName))
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.WorkflowRunList { return &openchoreov1alpha1.WorkflowRunList{} },
+		func(list *openchoreov1alpha1.WorkflowRunList) []models.BuildResponse {
+			buildResponses := make([]models.BuildResponse, 0, len(list.Items))
+			for _, workflowRun := range list.Items {
+				// Filter by spec.owner fields
+				if workflowRun.Spec.Owner.ProjectName != projectName || workflowRun.Spec.Owner.ComponentName != componentName {
+					continue
+				}
+
+				// Extract commit from the workflow schema
+				commit := extractCommitFromSchema(workflowRun.Spec.Workflow.Schema)
+				if commit == "" {
+					commit = "latest"
+				}
+
+				buildResponses = append(buildResponses, models.BuildResponse{
+					Name:          workflowRun.Name,
+					UUID:          string(workflowRun.UID),
+					ComponentName: componentName,
+					ProjectName:   projectName,
+					OrgName:       orgName,
+					Commit:        commit,
+					Status:        GetLatestWorkflowStatus(workflowRun.Status.Conditions),
+					CreatedAt:     workflowRun.CreationTimestamp.Time,
+					Image:         workflowRun.Status.ImageStatus.Image,
+				})
+			}
+			return buildResponses
+		},
+		client.InNamespace(orgName))
 	if err != nil {
 		s.logger.Error("Failed to list workflows", "error", err)
 		return nil, fmt.Errorf("failed to list workflows: %w", err)
 	}
 
-	buildResponses := make([]models.BuildResponse, 0, len(workflowRuns.Items))
-	for _, workflowRun := range workflowRuns.Items {
-		// Filter by spec.owner fields
-		if workflowRun.Spec.Owner.ProjectName != projectName || workflowRun.Spec.Owner.ComponentName != componentName {
-			continue
-		}
-
-		// Extract commit from the workflow schema
-		commit := extractCommitFromSchema(workflowRun.Spec.Workflow.Schema)
-		if commit == "" {
-			commit = "latest"
-		}
-
-		buildResponses = append(buildResponses, models.BuildResponse{
-			Name:          workflowRun.Name,
-			UUID:          string(workflowRun.UID),
-			ComponentName: componentName,
-			ProjectName:   projectName,
-			OrgName:       orgName,
-			Commit:        commit,
-			Status:        GetLatestWorkflowStatus(workflowRun.Status.Conditions),
-			CreatedAt:     workflowRun.CreationTimestamp.Time,
-			Image:         workflowRun.Status.ImageStatus.Image,
-		})
-	}
-
-	return buildResponses, nil
+	return page, nil
 }
 
 // extractCommitFromSchema extracts the commit hash from the workflow schema
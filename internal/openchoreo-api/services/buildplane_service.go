@@ -82,52 +82,56 @@ func (s *BuildPlaneService) GetBuildPlaneClient(ctx context.Context, orgName str
 	return buildPlaneClient, nil
 }
 
-// ListBuildPlanes retrieves all build planes for an organization
-func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string) ([]models.BuildPlaneResponse, error) {
+// ListBuildPlanes retrieves a page of the build planes of an organization
+func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildPlaneResponse], error) {
 	s.logger.Debug("Listing build planes", "org", orgName)
 
-	// List all build planes in the organization namespace
-	var buildPlanes openchoreov1alpha1.BuildPlaneList
-	err := s.k8sClient.List(ctx, &buildPlanes, client.InNamespace(orgName))
+	// List the build planes in the organization namespace
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.BuildPlaneList { return &openchoreov1alpha1.BuildPlaneList{} },
+		func(list *openchoreov1alpha1.BuildPlaneList) []models.BuildPlaneResponse {
+			buildPlaneResponses := make([]models.BuildPlaneResponse, 0, len(list.Items))
+			for i := range list.Items {
+				buildPlaneResponses = append(buildPlaneResponses, toBuildPlaneResponse(&list.Items[i]))
+			}
+			return buildPlaneResponses
+		},
+		client.InNamespace(orgName))
 	if err != nil {
 		s.logger.Error("Failed to list build planes", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list build planes: %w", err)
 	}
 
-	s.logger.Debug("Found build planes", "count", len(buildPlanes.Items), "org", orgName)
-
-	// Convert to response format
-	buildPlaneResponses := make([]models.BuildPlaneResponse, 0, len(buildPlanes.Items))
-	for _, buildPlane := range buildPlanes.Items {
-		displayName := buildPlane.Annotations[controller.AnnotationKeyDisplayName]
-		description := buildPlane.Annotations[controller.AnnotationKeyDescription]
-
-		// Determine status from conditions
-		status := ""
-
-		// Extract observer information if available
-		observerURL := ""
-		observerUsername := ""
-		if buildPlane.Spec.Observer.URL != "" {
-			observerURL = buildPlane.Spec.Observer.URL
-			observerUsername = buildPlane.Spec.Observer.Authentication.BasicAuth.Username
-		}
-
-		buildPlaneResponse := models.BuildPlaneResponse{
-			Name:                  buildPlane.Name,
-			Namespace:             buildPlane.Namespace,
-			DisplayName:           displayName,
-			Description:           description,
-			KubernetesClusterName: buildPlane.Name,
-			APIServerURL:          buildPlane.Spec.KubernetesCluster.Server,
-			ObserverURL:           observerURL,
-			ObserverUsername:      observerUsername,
-			CreatedAt:             buildPlane.CreationTimestamp.Time,
-			Status:                status,
-		}
-
-		buildPlaneResponses = append(buildPlaneResponses, buildPlaneResponse)
+	s.logger.Debug("Found build planes", "count", len(page.Items), "org", orgName)
+	return page, nil
+}
+
+// toBuildPlaneResponse converts a build plane to its response format
+func toBuildPlaneResponse(buildPlane *openchoreov1alpha1.BuildPlane) models.BuildPlaneResponse {
+	displayName := buildPlane.Annotations[controller.AnnotationKeyDisplayName]
+	description := buildPlane.Annotations[controller.AnnotationKeyDescription]
+
+	// Determine status from conditions
+	status := ""
+
+	// Extract observer information if available
+	observerURL := ""
+	observerUsername := ""
+	if buildPlane.Spec.Observer.URL != "" {
+		observerURL = buildPlane.Spec.Observer.URL
+		observerUsername = buildPlane.Spec.Observer.Authentication.BasicAuth.Username
 	}
 
-	return buildPlaneResponses, nil
+	return models.BuildPlaneResponse{
+		Name:                  buildPlane.Name,
+		Namespace:             buildPlane.Namespace,
+		DisplayName:           displayName,
+		Description:           description,
+		KubernetesClusterName: buildPlane.Name,
+		APIServerURL:          buildPlane.Spec.KubernetesCluster.Server,
+		ObserverURL:           observerURL,
+		ObserverUsername:      observerUsername,
+		CreatedAt:             buildPlane.CreationTimestamp.Time,
+		Status:                status,
+	}
 }
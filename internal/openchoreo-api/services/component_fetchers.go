@@ -105,6 +105,15 @@ func (f *WorkloadSpecFetcher) GetTypeName() string {
 }
 
 func (f *WorkloadSpecFetcher) FetchSpec(ctx context.Context, k8sClient client.Client, namespace, componentName string) (interface{}, error) {
+	spec, err := f.FetchWorkloadSpec(ctx, k8sClient, namespace, componentName)
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// FetchWorkloadSpec returns the spec of the workload of a component
+func (f *WorkloadSpecFetcher) FetchWorkloadSpec(ctx context.Context, k8sClient client.Client, namespace, componentName string) (*openchoreov1alpha1.WorkloadSpec, error) {
 	// List all Workloads in the namespace and filter by component owner
 	workloadList := &openchoreov1alpha1.WorkloadList{}
 	if err := k8sClient.List(ctx, workloadList, client.InNamespace(namespace)); err != nil {
@@ -8,15 +8,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/exp/slog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/freeze"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/promotion"
 )
 
 const (
@@ -100,8 +104,8 @@ func (s *ComponentService) CreateComponent(ctx context.Context, orgName, project
 	}, nil
 }
 
-// ListComponents lists all components in the given project
-func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectName string) ([]*models.ComponentResponse, error) {
+// ListComponents lists a page of the components in the given project
+func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentResponse], error) {
 	s.logger.Debug("Listing components", "org", orgName, "project", projectName)
 
 	// Verify project exists
@@ -113,26 +117,26 @@ func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectN
 		return nil, fmt.Errorf("failed to verify project: %w", err)
 	}
 
-	var componentList openchoreov1alpha1.ComponentList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &componentList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ComponentList { return &openchoreov1alpha1.ComponentList{} },
+		func(list *openchoreov1alpha1.ComponentList) []*models.ComponentResponse {
+			components := make([]*models.ComponentResponse, 0, len(list.Items))
+			for _, item := range list.Items {
+				// Only include components that belong to the specified project
+				if item.Spec.Owner.ProjectName == projectName {
+					components = append(components, s.toComponentResponse(&item, make(map[string]interface{})))
+				}
+			}
+			return components
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list components", "error", err)
 		return nil, fmt.Errorf("failed to list components: %w", err)
 	}
 
-	components := make([]*models.ComponentResponse, 0, len(componentList.Items))
-	for _, item := range componentList.Items {
-		// Only include components that belong to the specified project
-		if item.Spec.Owner.ProjectName == projectName {
-			components = append(components, s.toComponentResponse(&item, make(map[string]interface{})))
-		}
-	}
-
-	s.logger.Debug("Listed components", "org", orgName, "project", projectName, "count", len(components))
-	return components, nil
+	s.logger.Debug("Listed components", "org", orgName, "project", projectName, "count", len(page.Items))
+	return page, nil
 }
 
 // GetComponent retrieves a specific component
@@ -224,6 +228,130 @@ func (s *ComponentService) GetComponent(ctx context.Context, orgName, projectNam
 	return s.toComponentResponse(component, typeSpecs), nil
 }
 
+// UpdateComponentTraits replaces the traits of a component. Every trait must exist in the organization. The update is
+// rejected with ErrResourceVersionConflict when the resource version of the request is not the current one.
+func (s *ComponentService) UpdateComponentTraits(ctx context.Context, orgName, projectName, componentName string,
+	req *models.ComponentTraitsRequest) (*models.ComponentResponse, error) {
+	s.logger.Debug("Updating component traits", "org", orgName, "project", projectName, "component", componentName)
+
+	component, err := s.getComponentCR(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	for _, trait := range req.Traits {
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: trait.Name, Namespace: orgName}, &openchoreov1alpha1.Trait{}); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return nil, fmt.Errorf("%w: %s", ErrTraitNotFound, trait.Name)
+			}
+			return nil, fmt.Errorf("failed to get trait: %w", err)
+		}
+	}
+
+	withResourceVersion(component, req.ResourceVersion)
+	component.Spec.Traits = req.Traits
+	if err := s.k8sClient.Update(ctx, component); err != nil {
+		s.logger.Warn("Failed to update component traits", "component", componentName, "error", err)
+		return nil, writeError(err, ErrComponentNotFound, "update component")
+	}
+
+	s.logger.Debug("Updated component traits", "component", componentName, "traits", len(req.Traits))
+	return s.toComponentResponse(component, nil), nil
+}
+
+// DeleteComponent deletes a component together with its ComponentDeployments, which undeploys it from all
+// environments. Frozen environments are only undeployed from with a freeze override. The delete only succeeds
+// at the given resource version of the component if one is set, which is checked again right before the
+// ComponentDeployments are deleted, so that a conflicting delete doesn't undeploy the component.
+func (s *ComponentService) DeleteComponent(ctx context.Context, orgName, projectName, componentName, resourceVersion string,
+	override *models.FreezeOverride) error {
+	s.logger.Debug("Deleting component", "org", orgName, "project", projectName, "component", componentName)
+
+	component, err := s.getComponentCR(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return err
+	}
+	if resourceVersion != "" && resourceVersion != component.ResourceVersion {
+		return fmt.Errorf("%w: component %s is at version %s", ErrResourceVersionConflict, componentName, component.ResourceVersion)
+	}
+
+	var cds openchoreov1alpha1.ComponentDeploymentList
+	if err := s.k8sClient.List(ctx, &cds, client.InNamespace(orgName)); err != nil {
+		return fmt.Errorf("failed to list ComponentDeployments: %w", err)
+	}
+	var owned []*openchoreov1alpha1.ComponentDeployment
+	for i := range cds.Items {
+		cd := &cds.Items[i]
+		if cd.Spec.Owner.ProjectName == projectName && cd.Spec.Owner.ComponentName == componentName {
+			owned = append(owned, cd)
+		}
+	}
+	// Check all environments first, so that a frozen environment doesn't leave the component partially undeployed
+	var overrides []*freezeOverride
+	for _, cd := range owned {
+		cdOverride, err := s.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, cd.Spec.Environment,
+			"DeleteComponent", "ComponentDeployment/"+cd.Name, override)
+		if err != nil {
+			return err
+		}
+		overrides = append(overrides, cdOverride)
+	}
+	if resourceVersion != "" {
+		if err := s.checkComponentVersion(ctx, component, resourceVersion); err != nil {
+			return err
+		}
+	}
+	for i, cd := range owned {
+		if err := s.k8sClient.Delete(ctx, cd); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete ComponentDeployment %s: %w", cd.Name, err)
+		}
+		if err := s.recordFreezeOverride(ctx, overrides[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := s.k8sClient.Delete(ctx, component, deleteOptions(resourceVersion)...); err != nil {
+		s.logger.Warn("Failed to delete component", "component", componentName, "error", err)
+		return writeError(err, ErrComponentNotFound, "delete component")
+	}
+
+	s.logger.Debug("Deleted component", "component", componentName, "componentDeployments", len(owned))
+	return nil
+}
+
+// checkComponentVersion reads the component again and returns ErrResourceVersionConflict if it is no longer at
+// the resource version
+func (s *ComponentService) checkComponentVersion(ctx context.Context, component *openchoreov1alpha1.Component, resourceVersion string) error {
+	current := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(component), current); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return ErrComponentNotFound
+		}
+		return fmt.Errorf("failed to get component: %w", err)
+	}
+	if current.ResourceVersion != resourceVersion {
+		return fmt.Errorf("%w: component %s is at version %s", ErrResourceVersionConflict, component.Name, current.ResourceVersion)
+	}
+	return nil
+}
+
+// getComponentCR retrieves the Component CR of a component of an existing project
+func (s *ComponentService) getComponentCR(ctx context.Context, orgName, projectName, componentName string) (*openchoreov1alpha1.Component, error) {
+	if _, err := s.projectService.GetProject(ctx, orgName, projectName); err != nil {
+		return nil, err
+	}
+	component := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentName, Namespace: orgName}, component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	if component.Spec.Owner.ProjectName != projectName {
+		return nil, ErrComponentNotFound
+	}
+	return component, nil
+}
+
 // componentExists checks if a component already exists by name and namespace and belongs to the specified project
 func (s *ComponentService) componentExists(ctx context.Context, orgName, projectName, componentName string) (bool, error) {
 	component := &openchoreov1alpha1.Component{}
@@ -311,15 +439,18 @@ func (s *ComponentService) toComponentResponse(component *openchoreov1alpha1.Com
 	}
 
 	response := &models.ComponentResponse{
-		Name:        component.Name,
-		DisplayName: component.Annotations[controller.AnnotationKeyDisplayName],
-		Description: component.Annotations[controller.AnnotationKeyDescription],
-		Type:        string(component.Spec.Type),
-		ProjectName: projectName,
-		OrgName:     component.Namespace,
-		CreatedAt:   component.CreationTimestamp.Time,
-		Status:      status,
-		BuildConfig: buildConfig,
+		Name:            component.Name,
+		DisplayName:     component.Annotations[controller.AnnotationKeyDisplayName],
+		Description:     component.Annotations[controller.AnnotationKeyDescription],
+		Type:            string(component.Spec.Type),
+		ComponentType:   component.Spec.ComponentType,
+		ProjectName:     projectName,
+		OrgName:         component.Namespace,
+		CreatedAt:       component.CreationTimestamp.Time,
+		Status:          status,
+		Traits:          component.Spec.Traits,
+		BuildConfig:     buildConfig,
+		ResourceVersion: component.ResourceVersion,
 	}
 
 	for _, v := range typeSpecs {
@@ -361,7 +492,7 @@ func (s *ComponentService) GetComponentBindings(ctx context.Context, orgName, pr
 
 	bindings := make([]*models.BindingResponse, 0, len(environments))
 	for _, environment := range environments {
-		binding, err := s.getComponentBinding(ctx, orgName, projectName, componentName, environment, component.Type)
+		binding, err := s.getComponentBinding(ctx, orgName, projectName, componentName, environment, bindingType(component))
 		if err != nil {
 			// If binding not found for an environment, skip it rather than failing the entire request
 			if errors.Is(err, ErrBindingNotFound) {
@@ -386,7 +517,7 @@ func (s *ComponentService) GetComponentBinding(ctx context.Context, orgName, pro
 		return nil, err
 	}
 
-	return s.getComponentBinding(ctx, orgName, projectName, componentName, environment, component.Type)
+	return s.getComponentBinding(ctx, orgName, projectName, componentName, environment, bindingType(component))
 }
 
 // getComponentBinding retrieves the binding for a component in a specific environment
@@ -401,6 +532,8 @@ func (s *ComponentService) getComponentBinding(ctx context.Context, orgName, pro
 		bindingResponse, err = s.getWebApplicationBinding(ctx, orgName, componentName, environment)
 	case openchoreov1alpha1.ComponentTypeScheduledTask:
 		bindingResponse, err = s.getScheduledTaskBinding(ctx, orgName, componentName, environment)
+	case componentDeploymentBindingType:
+		bindingResponse, err = s.getComponentDeploymentBinding(ctx, orgName, projectName, componentName, environment)
 	default:
 		return nil, fmt.Errorf("unsupported component type: %s", componentType)
 	}
@@ -521,10 +654,18 @@ func (s *ComponentService) getScheduledTaskBinding(ctx context.Context, orgName,
 		},
 	}
 
-	// TODO: ScheduledTaskBinding doesn't have conditions in its status yet
-	// When conditions are added, implement the same status mapping logic as Service and WebApplication bindings
-	// For now, default to NotYetDeployed status
-	response.BindingStatus.Status = models.BindingStatusTypeUndeployed
+	// Extract status from conditions and map to UI-friendly status
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == statusReady {
+			response.BindingStatus.Reason = condition.Reason
+			response.BindingStatus.Message = condition.Message
+			response.BindingStatus.LastTransitioned = condition.LastTransitionTime.Time
+
+			// Map condition status and reason to UI-friendly status
+			response.BindingStatus.Status = s.mapConditionToBindingStatus(condition)
+			break
+		}
+	}
 
 	// ScheduledTaskBinding doesn't have endpoints, but we still extract the image
 	response.ScheduledTaskBinding = &models.ScheduledTaskBinding{
@@ -649,10 +790,20 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 		return nil, err
 	}
 
+	// Refuse the promotion if the target environment is in an active freeze window
+	override, err := s.checkEnvironmentFreeze(ctx, req.OrgName, req.ProjectName, req.ComponentName, req.TargetEnvironment,
+		"Promote", "Component/"+component.Name, req.FreezeOverride)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create or update the target binding
-	if err := s.createOrUpdateTargetBinding(ctx, req, component.Type); err != nil {
+	if err := s.createOrUpdateTargetBinding(ctx, req, bindingType(component)); err != nil {
 		return nil, fmt.Errorf("failed to create target binding: %w", err)
 	}
+	if err := s.recordFreezeOverride(ctx, override); err != nil {
+		return nil, err
+	}
 
 	// Return all bindings for the component after promotion
 	allEnvironments, err := s.getEnvironmentsFromDeploymentPipeline(ctx, req.OrgName, req.ProjectName)
@@ -760,253 +911,96 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 
 // createOrUpdateTargetBinding creates or updates the binding in the target environment
 func (s *ComponentService) createOrUpdateTargetBinding(ctx context.Context, req *PromoteComponentPayload, componentType string) error {
-	switch openchoreov1alpha1.DefinedComponentType(componentType) {
-	case openchoreov1alpha1.ComponentTypeService:
-		return s.createOrUpdateServiceBinding(ctx, req)
-	case openchoreov1alpha1.ComponentTypeWebApplication:
-		return s.createOrUpdateWebApplicationBinding(ctx, req)
-	case openchoreov1alpha1.ComponentTypeScheduledTask:
-		return s.createOrUpdateScheduledTaskBinding(ctx, req)
-	default:
-		return fmt.Errorf("unsupported component type: %s", componentType)
+	if err := promotion.Promote(ctx, s.k8sClient, componentType, promotion.Request{
+		Namespace:         req.OrgName,
+		ProjectName:       req.ProjectName,
+		ComponentName:     req.ComponentName,
+		SourceEnvironment: req.SourceEnvironment,
+		TargetEnvironment: req.TargetEnvironment,
+	}); err != nil {
+		return err
 	}
+	s.logger.Debug("Promoted the binding of the component", "org", req.OrgName, "component", req.ComponentName,
+		"type", componentType, "target", req.TargetEnvironment)
+	return nil
 }
 
 // getServiceBindingCR retrieves a ServiceBinding CR from the cluster
 func (s *ComponentService) getServiceBindingCR(ctx context.Context, orgName, componentName, environment string) (*openchoreov1alpha1.ServiceBinding, error) {
-	// List all ServiceBindings in the namespace and filter by owner and environment
-	bindingList := &openchoreov1alpha1.ServiceBindingList{}
-	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(orgName)); err != nil {
-		return nil, fmt.Errorf("failed to list service bindings: %w", err)
-	}
-
-	// Find the binding that matches the component and environment
-	for i := range bindingList.Items {
-		b := &bindingList.Items[i]
-		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
-			return b, nil
-		}
-	}
-
-	return nil, ErrBindingNotFound
-}
-
-// createOrUpdateServiceBinding creates or updates a ServiceBinding in the target environment
-func (s *ComponentService) createOrUpdateServiceBinding(ctx context.Context, req *PromoteComponentPayload) error {
-	// Get the source ServiceBinding CR using the new reusable method
-	sourceK8sBinding, err := s.getServiceBindingCR(ctx, req.OrgName, req.ComponentName, req.SourceEnvironment)
-	if err != nil {
-		return fmt.Errorf("failed to get source service binding: %w", err)
-	}
-
-	// First check if there's already a binding for this component in the target environment
-	existingTargetBinding, err := s.getServiceBindingCR(ctx, req.OrgName, req.ComponentName, req.TargetEnvironment)
-	var targetBindingName string
-
-	if err != nil && !errors.Is(err, ErrBindingNotFound) {
-		return fmt.Errorf("failed to check existing target binding: %w", err)
-	}
-
-	if errors.Is(err, ErrBindingNotFound) {
-		// No existing binding, generate new name
-		targetBindingName = fmt.Sprintf("%s-%s", req.ComponentName, req.TargetEnvironment)
-	} else {
-		// Existing binding found, use its name
-		targetBindingName = existingTargetBinding.Name
-	}
-
-	// Create or update the target ServiceBinding
-	targetBinding := &openchoreov1alpha1.ServiceBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      targetBindingName,
-			Namespace: req.OrgName,
-		},
-		Spec: openchoreov1alpha1.ServiceBindingSpec{
-			Owner: openchoreov1alpha1.ServiceOwner{
-				ProjectName:   req.ProjectName,
-				ComponentName: req.ComponentName,
-			},
-			Environment:  req.TargetEnvironment,
-			ClassName:    sourceK8sBinding.Spec.ClassName,
-			WorkloadSpec: sourceK8sBinding.Spec.WorkloadSpec,
-			APIs:         sourceK8sBinding.Spec.APIs,
-		},
-	}
-
-	if existingTargetBinding == nil {
-		// Create new binding
-		if err := s.k8sClient.Create(ctx, targetBinding); err != nil {
-			return fmt.Errorf("failed to create target service binding: %w", err)
-		}
-		s.logger.Debug("Created new ServiceBinding", "name", targetBindingName, "namespace", req.OrgName)
-	} else {
-		// Update existing binding
-		existingTargetBinding.Spec = targetBinding.Spec
-		if err := s.k8sClient.Update(ctx, existingTargetBinding); err != nil {
-			return fmt.Errorf("failed to update target service binding: %w", err)
-		}
-		s.logger.Debug("Updated existing ServiceBinding", "name", targetBindingName, "namespace", req.OrgName)
-	}
-
-	return nil
+	return promotion.GetServiceBinding(ctx, s.k8sClient, orgName, componentName, environment)
 }
 
 // getWebApplicationBindingCR retrieves a WebApplicationBinding CR from the cluster
 func (s *ComponentService) getWebApplicationBindingCR(ctx context.Context, orgName, componentName, environment string) (*openchoreov1alpha1.WebApplicationBinding, error) {
-	// List all WebApplicationBindings in the namespace and filter by owner and environment
-	bindingList := &openchoreov1alpha1.WebApplicationBindingList{}
-	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(orgName)); err != nil {
-		return nil, fmt.Errorf("failed to list web application bindings: %w", err)
-	}
-
-	// Find the binding that matches the component and environment
-	for i := range bindingList.Items {
-		b := &bindingList.Items[i]
-		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
-			return b, nil
-		}
-	}
-
-	return nil, ErrBindingNotFound
+	return promotion.GetWebApplicationBinding(ctx, s.k8sClient, orgName, componentName, environment)
 }
 
-// createOrUpdateWebApplicationBinding creates or updates a WebApplicationBinding in the target environment
-func (s *ComponentService) createOrUpdateWebApplicationBinding(ctx context.Context, req *PromoteComponentPayload) error {
-	// Get the source WebApplicationBinding CR using the new reusable method
-	sourceK8sBinding, err := s.getWebApplicationBindingCR(ctx, req.OrgName, req.ComponentName, req.SourceEnvironment)
-	if err != nil {
-		return fmt.Errorf("failed to get source web application binding: %w", err)
-	}
-
-	// First check if there's already a binding for this component in the target environment
-	existingTargetBinding, err := s.getWebApplicationBindingCR(ctx, req.OrgName, req.ComponentName, req.TargetEnvironment)
-	var targetBindingName string
-
-	if err != nil && !errors.Is(err, ErrBindingNotFound) {
-		return fmt.Errorf("failed to check existing target binding: %w", err)
-	}
-
-	if errors.Is(err, ErrBindingNotFound) {
-		// No existing binding, generate new name
-		targetBindingName = fmt.Sprintf("%s-%s", req.ComponentName, req.TargetEnvironment)
-	} else {
-		// Existing binding found, use its name
-		targetBindingName = existingTargetBinding.Name
-	}
+// getScheduledTaskBindingCR retrieves a ScheduledTaskBinding CR from the cluster
+func (s *ComponentService) getScheduledTaskBindingCR(ctx context.Context, orgName, componentName, environment string) (*openchoreov1alpha1.ScheduledTaskBinding, error) {
+	return promotion.GetScheduledTaskBinding(ctx, s.k8sClient, orgName, componentName, environment)
+}
 
-	// Create or update the target WebApplicationBinding
-	targetBinding := &openchoreov1alpha1.WebApplicationBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      targetBindingName,
-			Namespace: req.OrgName,
-		},
-		Spec: openchoreov1alpha1.WebApplicationBindingSpec{
-			Owner: openchoreov1alpha1.WebApplicationOwner{
-				ProjectName:   req.ProjectName,
-				ComponentName: req.ComponentName,
-			},
-			Environment:  req.TargetEnvironment,
-			ClassName:    sourceK8sBinding.Spec.ClassName,
-			WorkloadSpec: sourceK8sBinding.Spec.WorkloadSpec,
-			Overrides:    sourceK8sBinding.Spec.Overrides,
-		},
-	}
+// componentDeploymentBindingType is the binding type of components defined with a ComponentType.
+// These components are deployed to an environment through a ComponentEnvSnapshot and a ComponentDeployment.
+const componentDeploymentBindingType = promotion.BindingTypeComponentDeployment
 
-	if existingTargetBinding == nil {
-		// Create new binding
-		if err := s.k8sClient.Create(ctx, targetBinding); err != nil {
-			return fmt.Errorf("failed to create target web application binding: %w", err)
-		}
-		s.logger.Debug("Created new WebApplicationBinding", "name", targetBindingName, "namespace", req.OrgName)
-	} else {
-		// Update existing binding
-		existingTargetBinding.Spec = targetBinding.Spec
-		if err := s.k8sClient.Update(ctx, existingTargetBinding); err != nil {
-			return fmt.Errorf("failed to update target web application binding: %w", err)
-		}
-		s.logger.Debug("Updated existing WebApplicationBinding", "name", targetBindingName, "namespace", req.OrgName)
+// bindingType returns the type used to look up the environment bindings of a component
+func bindingType(component *models.ComponentResponse) string {
+	if component.ComponentType != "" {
+		return componentDeploymentBindingType
 	}
-
-	return nil
+	return component.Type
 }
 
-// getScheduledTaskBindingCR retrieves a ScheduledTaskBinding CR from the cluster
-func (s *ComponentService) getScheduledTaskBindingCR(ctx context.Context, orgName, componentName, environment string) (*openchoreov1alpha1.ScheduledTaskBinding, error) {
-	// List all ScheduledTaskBindings in the namespace and filter by owner and environment
-	bindingList := &openchoreov1alpha1.ScheduledTaskBindingList{}
-	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(orgName)); err != nil {
-		return nil, fmt.Errorf("failed to list scheduled task bindings: %w", err)
-	}
-
-	// Find the binding that matches the component and environment
-	for i := range bindingList.Items {
-		b := &bindingList.Items[i]
-		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
-			return b, nil
-		}
-	}
+// getComponentEnvSnapshotCR retrieves the ComponentEnvSnapshot of a component in an environment
+func (s *ComponentService) getComponentEnvSnapshotCR(ctx context.Context, orgName, projectName, componentName, environment string) (*openchoreov1alpha1.ComponentEnvSnapshot, error) {
+	return promotion.GetComponentEnvSnapshot(ctx, s.k8sClient, orgName, projectName, componentName, environment)
+}
 
-	return nil, ErrBindingNotFound
+// getComponentDeploymentCR retrieves the ComponentDeployment of a component in an environment
+func (s *ComponentService) getComponentDeploymentCR(ctx context.Context, orgName, projectName, componentName, environment string) (*openchoreov1alpha1.ComponentDeployment, error) {
+	return promotion.GetComponentDeployment(ctx, s.k8sClient, orgName, projectName, componentName, environment)
 }
 
-// createOrUpdateScheduledTaskBinding creates or updates a ScheduledTaskBinding in the target environment
-func (s *ComponentService) createOrUpdateScheduledTaskBinding(ctx context.Context, req *PromoteComponentPayload) error {
-	// Get the source ScheduledTaskBinding CR using the new reusable method
-	sourceK8sBinding, err := s.getScheduledTaskBindingCR(ctx, req.OrgName, req.ComponentName, req.SourceEnvironment)
+// getComponentDeploymentBinding retrieves the deployment of a ComponentType based component in an environment
+func (s *ComponentService) getComponentDeploymentBinding(ctx context.Context, orgName, projectName, componentName, environment string) (*models.BindingResponse, error) {
+	snapshot, err := s.getComponentEnvSnapshotCR(ctx, orgName, projectName, componentName, environment)
 	if err != nil {
-		return fmt.Errorf("failed to get source scheduled task binding: %w", err)
-	}
-
-	// First check if there's already a binding for this component in the target environment
-	existingTargetBinding, err := s.getScheduledTaskBindingCR(ctx, req.OrgName, req.ComponentName, req.TargetEnvironment)
-	var targetBindingName string
-
-	if err != nil && !errors.Is(err, ErrBindingNotFound) {
-		return fmt.Errorf("failed to check existing target binding: %w", err)
-	}
-
-	if errors.Is(err, ErrBindingNotFound) {
-		// No existing binding, generate new name
-		targetBindingName = fmt.Sprintf("%s-%s", req.ComponentName, req.TargetEnvironment)
-	} else {
-		// Existing binding found, use its name
-		targetBindingName = existingTargetBinding.Name
+		return nil, err
 	}
 
-	// Create or update the target ScheduledTaskBinding
-	targetBinding := &openchoreov1alpha1.ScheduledTaskBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      targetBindingName,
-			Namespace: req.OrgName,
+	response := &models.BindingResponse{
+		Name: snapshot.Name,
+		Type: snapshot.Spec.Component.Spec.ComponentType,
+		BindingStatus: models.BindingStatus{
+			Status: models.BindingStatusTypeUndeployed,
 		},
-		Spec: openchoreov1alpha1.ScheduledTaskBindingSpec{
-			Owner: openchoreov1alpha1.ScheduledTaskOwner{
-				ProjectName:   req.ProjectName,
-				ComponentName: req.ComponentName,
-			},
-			Environment:  req.TargetEnvironment,
-			ClassName:    sourceK8sBinding.Spec.ClassName,
-			WorkloadSpec: sourceK8sBinding.Spec.WorkloadSpec,
-			Overrides:    sourceK8sBinding.Spec.Overrides,
+		ComponentDeployment: &models.ComponentDeploymentBinding{
+			Image:        s.extractImageFromWorkloadSpec(snapshot.Spec.Workload.Spec.WorkloadTemplateSpec),
+			SnapshotName: snapshot.Name,
 		},
 	}
 
-	if existingTargetBinding == nil {
-		// Create new binding
-		if err := s.k8sClient.Create(ctx, targetBinding); err != nil {
-			return fmt.Errorf("failed to create target scheduled task binding: %w", err)
+	// The snapshot is only deployed once a ComponentDeployment exists for the environment
+	cd, err := s.getComponentDeploymentCR(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		if errors.Is(err, ErrBindingNotFound) {
+			return response, nil
 		}
-		s.logger.Debug("Created new ScheduledTaskBinding", "name", targetBindingName, "namespace", req.OrgName)
-	} else {
-		// Update existing binding
-		existingTargetBinding.Spec = targetBinding.Spec
-		if err := s.k8sClient.Update(ctx, existingTargetBinding); err != nil {
-			return fmt.Errorf("failed to update target scheduled task binding: %w", err)
+		return nil, err
+	}
+	response.Name = cd.Name
+	for _, condition := range cd.Status.Conditions {
+		if condition.Type == statusReady {
+			response.BindingStatus.Reason = condition.Reason
+			response.BindingStatus.Message = condition.Message
+			response.BindingStatus.LastTransitioned = condition.LastTransitionTime.Time
+			response.BindingStatus.Status = s.mapConditionToBindingStatus(condition)
+			break
 		}
-		s.logger.Debug("Updated existing ScheduledTaskBinding", "name", targetBindingName, "namespace", req.OrgName)
 	}
 
-	return nil
+	return response, nil
 }
 
 // UpdateComponentBinding updates a component binding
@@ -1043,6 +1037,7 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 
 	// Update the appropriate binding based on component type
 	var updatedBinding *models.BindingResponse
+	var override *freezeOverride
 	switch component.Type {
 	case string(openchoreov1alpha1.ComponentTypeService):
 		binding := &openchoreov1alpha1.ServiceBinding{}
@@ -1059,6 +1054,12 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			return nil, ErrBindingNotFound
 		}
 
+		override, err = s.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, binding.Spec.Environment,
+			"UpdateBinding", "ServiceBinding/"+binding.Name, req.FreezeOverride)
+		if err != nil {
+			return nil, err
+		}
+
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
 
@@ -1094,6 +1095,12 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			return nil, ErrBindingNotFound
 		}
 
+		override, err = s.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, binding.Spec.Environment,
+			"UpdateBinding", "WebApplicationBinding/"+binding.Name, req.FreezeOverride)
+		if err != nil {
+			return nil, err
+		}
+
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
 
@@ -1129,6 +1136,12 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			return nil, ErrBindingNotFound
 		}
 
+		override, err = s.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, binding.Spec.Environment,
+			"UpdateBinding", "ScheduledTaskBinding/"+binding.Name, req.FreezeOverride)
+		if err != nil {
+			return nil, err
+		}
+
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
 
@@ -1154,27 +1167,96 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 		return nil, fmt.Errorf("unsupported component type: %s", component.Type)
 	}
 
+	if err := s.recordFreezeOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
 	s.logger.Debug("Component binding updated successfully", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 	return updatedBinding, nil
 }
 
-// ComponentObserverResponse represents the response for observer URL requests
-type ComponentObserverResponse struct {
-	ObserverURL      string                    `json:"observerUrl,omitempty"`
-	ConnectionMethod *ObserverConnectionMethod `json:"connectionMethod,omitempty"`
-	Message          string                    `json:"message,omitempty"`
+// freezeOverride is an override of an active freeze window, which is recorded in the override audit trail of
+// its environment once the change it allowed succeeded
+type freezeOverride struct {
+	orgName     string
+	environment string
+	record      openchoreov1alpha1.FreezeOverrideRecord
+}
+
+// checkEnvironmentFreeze returns ErrEnvironmentFrozen if the environment is in an active freeze window.
+// When an override is supplied, the change is allowed and the override to record with recordFreezeOverride
+// is returned. It returns nil when the environment is not frozen.
+func (s *ComponentService) checkEnvironmentFreeze(ctx context.Context, orgName, projectName, componentName, environmentName,
+	action, target string, override *models.FreezeOverride) (*freezeOverride, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: environmentName, Namespace: orgName}, env); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	now := time.Now()
+	active, err := freeze.Evaluate(env.Spec.FreezeWindows, now)
+	if err != nil {
+		// A misconfigured window must not silently allow deployments
+		return nil, fmt.Errorf("failed to evaluate freeze windows for environment %s: %w", environmentName, err)
+	}
+	if active == nil {
+		return nil, nil
+	}
+
+	if override == nil || override.Justification == "" {
+		s.logger.Warn("Environment is frozen", "org", orgName, "environment", environmentName,
+			"window", active.Name, "until", active.End)
+		return nil, fmt.Errorf("%w: window %q (%s) is active until %s", ErrEnvironmentFrozen,
+			active.Name, active.Reason, active.End.UTC().Format(time.RFC3339))
+	}
+
+	return &freezeOverride{
+		orgName:     orgName,
+		environment: environmentName,
+		record: openchoreov1alpha1.FreezeOverrideRecord{
+			Window:        active.Name,
+			Justification: override.Justification,
+			Action:        action,
+			Target:        target,
+			Project:       projectName,
+			Component:     componentName,
+			Principal:     override.Principal,
+			Timestamp:     metav1.NewTime(now),
+		},
+	}, nil
 }
 
-// ObserverConnectionMethod contains the access method for the observer
-type ObserverConnectionMethod struct {
-	Type        string `json:"type,omitempty"`
-	Username    string `json:"username,omitempty"`
-	Password    string `json:"password,omitempty"`
-	BearerToken string `json:"bearerToken,omitempty"`
+// recordFreezeOverride records an override in the audit trail of its environment. It is called once the change the
+// override allowed succeeded, so that refused changes leave no record. A nil override is not recorded.
+func (s *ComponentService) recordFreezeOverride(ctx context.Context, override *freezeOverride) error {
+	if override == nil {
+		return nil
+	}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		env := &openchoreov1alpha1.Environment{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: override.environment, Namespace: override.orgName}, env); err != nil {
+			return err
+		}
+		env.Status.FreezeOverrides = freeze.AppendOverride(env.Status.FreezeOverrides, override.record)
+		return s.k8sClient.Status().Update(ctx, env)
+	})
+	if err != nil {
+		s.logger.Error("Failed to record freeze override", "org", override.orgName, "environment", override.environment,
+			"target", override.record.Target, "error", err)
+		return fmt.Errorf("%s was applied, but recording its freeze override failed: %w", override.record.Target, err)
+	}
+
+	s.logger.Warn("Freeze window overridden", "org", override.orgName, "project", override.record.Project,
+		"component", override.record.Component, "environment", override.environment, "window", override.record.Window,
+		"action", override.record.Action, "justification", override.record.Justification)
+	return nil
 }
 
 // GetComponentObserverURL retrieves the observer URL for component runtime logs
-func (s *ComponentService) GetComponentObserverURL(ctx context.Context, orgName, projectName, componentName, environmentName string) (*ComponentObserverResponse, error) {
+func (s *ComponentService) GetComponentObserverURL(ctx context.Context, orgName, projectName, componentName, environmentName string) (*models.ComponentObserverResponse, error) {
 	s.logger.Debug("Getting component observer URL", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
 
 	// 1. Verify component exists in project
@@ -1224,26 +1306,26 @@ func (s *ComponentService) GetComponentObserverURL(ctx context.Context, orgName,
 	// 5. Check if observer is configured in the dataplane
 	if dp.Spec.Observer.URL == "" {
 		s.logger.Debug("Observer URL not configured in dataplane", "dataplane", dp.Name)
-		return &ComponentObserverResponse{
+		return &models.ComponentObserverResponse{
 			Message: "observability-logs have not been configured",
 		}, nil
 	}
 
 	// 6. Return observer URL and connection method from DataPlane.Spec.Observer
-	connectionMethod := &ObserverConnectionMethod{
+	connectionMethod := &models.ObserverConnectionMethod{
 		Type:     "basic",
 		Username: dp.Spec.Observer.Authentication.BasicAuth.Username,
 		Password: dp.Spec.Observer.Authentication.BasicAuth.Password,
 	}
 
-	return &ComponentObserverResponse{
+	return &models.ComponentObserverResponse{
 		ObserverURL:      dp.Spec.Observer.URL,
 		ConnectionMethod: connectionMethod,
 	}, nil
 }
 
 // GetBuildObserverURL retrieves the observer URL for component build logs
-func (s *ComponentService) GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (*ComponentObserverResponse, error) {
+func (s *ComponentService) GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (*models.ComponentObserverResponse, error) {
 	s.logger.Debug("Getting build observer URL", "org", orgName, "project", projectName, "component", componentName)
 
 	// 1. Verify component exists in project
@@ -1273,26 +1355,26 @@ func (s *ComponentService) GetBuildObserverURL(ctx context.Context, orgName, pro
 	// 3. Check if observer is configured
 	if buildPlane.Spec.Observer.URL == "" {
 		s.logger.Debug("Observer URL not configured in build plane", "buildPlane", buildPlane.Name)
-		return &ComponentObserverResponse{
+		return &models.ComponentObserverResponse{
 			Message: "observability-logs have not been configured",
 		}, nil
 	}
 
 	// 4. Return observer URL and connection method from BuildPlane.Spec.Observer
-	connectionMethod := &ObserverConnectionMethod{
+	connectionMethod := &models.ObserverConnectionMethod{
 		Type:     "basic",
 		Username: buildPlane.Spec.Observer.Authentication.BasicAuth.Username,
 		Password: buildPlane.Spec.Observer.Authentication.BasicAuth.Password,
 	}
 
-	return &ComponentObserverResponse{
+	return &models.ComponentObserverResponse{
 		ObserverURL:      buildPlane.Spec.Observer.URL,
 		ConnectionMethod: connectionMethod,
 	}, nil
 }
 
 // GetComponentWorkloads retrieves workload data for a specific component
-func (s *ComponentService) GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (interface{}, error) {
+func (s *ComponentService) GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (*openchoreov1alpha1.WorkloadSpec, error) {
 	s.logger.Debug("Getting component workloads", "org", orgName, "project", projectName, "component", componentName)
 
 	// Verify project exists
@@ -1328,7 +1410,7 @@ func (s *ComponentService) GetComponentWorkloads(ctx context.Context, orgName, p
 
 	// Use the WorkloadSpecFetcher to get workload data
 	fetcher := &WorkloadSpecFetcher{}
-	workloadSpec, err := fetcher.FetchSpec(ctx, s.k8sClient, orgName, componentName)
+	workloadSpec, err := fetcher.FetchWorkloadSpec(ctx, s.k8sClient, orgName, componentName)
 	if err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			s.logger.Warn("Workload not found for component", "org", orgName, "project", projectName, "component", componentName)
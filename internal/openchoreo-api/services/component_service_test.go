@@ -0,0 +1,265 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+const testOrg = "test-org"
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build the scheme: %v", err)
+	}
+	return scheme
+}
+
+func newTestComponentService(t *testing.T, objs ...client.Object) (*ComponentService, client.Client) {
+	t.Helper()
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.Environment{}).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewComponentService(k8sClient, NewProjectService(k8sClient, logger), logger), k8sClient
+}
+
+func frozenEnvironment(name string) *openchoreov1alpha1.Environment {
+	now := time.Now()
+	return &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testOrg},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			FreezeWindows: []openchoreov1alpha1.FreezeWindow{{
+				Name:   "release-week",
+				Reason: "Quarterly release",
+				Start:  &metav1.Time{Time: now.Add(-time.Hour)},
+				End:    &metav1.Time{Time: now.Add(time.Hour)},
+			}},
+		},
+	}
+}
+
+func TestCheckEnvironmentFreeze(t *testing.T) {
+	ctx := context.Background()
+	service, k8sClient := newTestComponentService(t,
+		frozenEnvironment("production"),
+		&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "development", Namespace: testOrg}},
+	)
+
+	override, err := service.checkEnvironmentFreeze(ctx, testOrg, "shop", "cart", "development", "Promote", "Component/cart", nil)
+	if err != nil || override != nil {
+		t.Errorf("checkEnvironmentFreeze() of an unfrozen environment = %v, %v, want nil, nil", override, err)
+	}
+
+	_, err = service.checkEnvironmentFreeze(ctx, testOrg, "shop", "cart", "production", "Promote", "Component/cart", nil)
+	if !errors.Is(err, ErrEnvironmentFrozen) {
+		t.Errorf("checkEnvironmentFreeze() without an override = %v, want ErrEnvironmentFrozen", err)
+	}
+
+	_, err = service.checkEnvironmentFreeze(ctx, testOrg, "shop", "cart", "staging", "Promote", "Component/cart", nil)
+	if !errors.Is(err, ErrEnvironmentNotFound) {
+		t.Errorf("checkEnvironmentFreeze() of a missing environment = %v, want ErrEnvironmentNotFound", err)
+	}
+
+	override, err = service.checkEnvironmentFreeze(ctx, testOrg, "shop", "cart", "production", "Promote", "Component/cart",
+		&models.FreezeOverride{Justification: "hotfix", Principal: "alice"})
+	if err != nil || override == nil {
+		t.Fatalf("checkEnvironmentFreeze() with an override = %v, %v, want an override to record", override, err)
+	}
+
+	// The override is only recorded once the change it allowed succeeded
+	env := &openchoreov1alpha1.Environment{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "production", Namespace: testOrg}, env); err != nil {
+		t.Fatalf("failed to get the environment: %v", err)
+	}
+	if len(env.Status.FreezeOverrides) != 0 {
+		t.Errorf("freeze overrides = %+v before the change, want none", env.Status.FreezeOverrides)
+	}
+
+	if err := service.recordFreezeOverride(ctx, override); err != nil {
+		t.Fatalf("recordFreezeOverride() = %v", err)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "production", Namespace: testOrg}, env); err != nil {
+		t.Fatalf("failed to get the environment: %v", err)
+	}
+	if len(env.Status.FreezeOverrides) != 1 {
+		t.Fatalf("freeze overrides = %+v, want one record", env.Status.FreezeOverrides)
+	}
+	record := env.Status.FreezeOverrides[0]
+	if record.Window != "release-week" || record.Justification != "hotfix" || record.Action != "Promote" ||
+		record.Target != "Component/cart" || record.Project != "shop" || record.Component != "cart" || record.Principal != "alice" {
+		t.Errorf("freeze override record = %+v", record)
+	}
+
+	if err := service.recordFreezeOverride(ctx, nil); err != nil {
+		t.Errorf("recordFreezeOverride(nil) = %v, want nil", err)
+	}
+}
+
+func TestGetComponentDeploymentBinding(t *testing.T) {
+	ctx := context.Background()
+	snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "cart-staging", Namespace: testOrg},
+		Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+			Owner:       openchoreov1alpha1.ComponentEnvSnapshotOwner{ProjectName: "shop", ComponentName: "cart"},
+			Environment: "staging",
+			Workload: openchoreov1alpha1.Workload{
+				Spec: openchoreov1alpha1.WorkloadSpec{WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Containers: map[string]openchoreov1alpha1.Container{"main": {Image: "cart:v2"}},
+				}},
+			},
+		},
+	}
+	deployment := func(ready metav1.ConditionStatus, reason string) *openchoreov1alpha1.ComponentDeployment {
+		return &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-staging", Namespace: testOrg},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: "shop", ComponentName: "cart"},
+				Environment: "staging",
+			},
+			Status: openchoreov1alpha1.ComponentDeploymentStatus{
+				Conditions: []metav1.Condition{{Type: statusReady, Status: ready, Reason: reason, Message: reason}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		wantStatus models.BindingStatusType
+		wantErr    error
+	}{
+		{name: "not promoted", wantErr: ErrBindingNotFound},
+		{name: "snapshot without deployment", objs: []client.Object{snapshot}, wantStatus: models.BindingStatusTypeUndeployed},
+		{
+			name:       "ready deployment",
+			objs:       []client.Object{snapshot, deployment(metav1.ConditionTrue, "ResourcesActive")},
+			wantStatus: models.BindingStatusTypeReady,
+		},
+		{
+			name:       "progressing deployment",
+			objs:       []client.Object{snapshot, deployment(metav1.ConditionFalse, "ResourceHealthProgressing")},
+			wantStatus: models.BindingStatusTypeInProgress,
+		},
+		{
+			name:       "degraded deployment",
+			objs:       []client.Object{snapshot, deployment(metav1.ConditionFalse, "ResourceHealthDegraded")},
+			wantStatus: models.BindingStatusTypeFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newTestComponentService(t, tt.objs...)
+			binding, err := service.getComponentDeploymentBinding(ctx, testOrg, "shop", "cart", "staging")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("getComponentDeploymentBinding() = %v, %v, want %v", binding, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getComponentDeploymentBinding() = %v", err)
+			}
+			if binding.BindingStatus.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", binding.BindingStatus.Status, tt.wantStatus)
+			}
+			if binding.Name != "cart-staging" || binding.ComponentDeployment == nil ||
+				binding.ComponentDeployment.Image != "cart:v2" || binding.ComponentDeployment.SnapshotName != "cart-staging" {
+				t.Errorf("binding = %+v, want cart-staging of cart:v2", binding)
+			}
+		})
+	}
+}
+
+func TestDeleteComponent(t *testing.T) {
+	ctx := context.Background()
+	objects := func() []client.Object {
+		return []client.Object{
+			&openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: testOrg}},
+			&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: testOrg}},
+			&openchoreov1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "cart", Namespace: testOrg},
+				Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "shop"}},
+			},
+			&openchoreov1alpha1.ComponentDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "cart-staging", Namespace: testOrg},
+				Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+					Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: "shop", ComponentName: "cart"},
+					Environment: "staging",
+				},
+			},
+		}
+	}
+	newService := func(t *testing.T, funcs interceptor.Funcs) (*ComponentService, client.Client) {
+		k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objects()...).
+			WithInterceptorFuncs(funcs).Build()
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		return NewComponentService(k8sClient, NewProjectService(k8sClient, logger), logger), k8sClient
+	}
+	exists := func(k8sClient client.Client, obj client.Object, name string) bool {
+		err := k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: testOrg}, obj)
+		return err == nil
+	}
+	currentVersion := func(k8sClient client.Client) string {
+		component := &openchoreov1alpha1.Component{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: "cart", Namespace: testOrg}, component); err != nil {
+			t.Fatalf("failed to get the component: %v", err)
+		}
+		return component.ResourceVersion
+	}
+
+	t.Run("deletes the component and its deployments", func(t *testing.T) {
+		service, k8sClient := newService(t, interceptor.Funcs{})
+		if err := service.DeleteComponent(ctx, testOrg, "shop", "cart", currentVersion(k8sClient), nil); err != nil {
+			t.Fatalf("DeleteComponent() = %v", err)
+		}
+		if exists(k8sClient, &openchoreov1alpha1.Component{}, "cart") ||
+			exists(k8sClient, &openchoreov1alpha1.ComponentDeployment{}, "cart-staging") {
+			t.Error("the component or its deployment is left after the delete")
+		}
+	})
+
+	t.Run("keeps the deployments when the component changes during the delete", func(t *testing.T) {
+		service, k8sClient := newService(t, interceptor.Funcs{
+			// The component is updated concurrently while its deployments are listed
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*openchoreov1alpha1.ComponentDeploymentList); ok {
+					component := &openchoreov1alpha1.Component{}
+					if err := c.Get(ctx, client.ObjectKey{Name: "cart", Namespace: testOrg}, component); err != nil {
+						return err
+					}
+					component.Labels = map[string]string{"changed": "true"}
+					if err := c.Update(ctx, component); err != nil {
+						return err
+					}
+				}
+				return c.List(ctx, list, opts...)
+			},
+		})
+		version := currentVersion(k8sClient)
+
+		err := service.DeleteComponent(ctx, testOrg, "shop", "cart", version, nil)
+		if !errors.Is(err, ErrResourceVersionConflict) {
+			t.Fatalf("DeleteComponent() = %v, want ErrResourceVersionConflict", err)
+		}
+		if !exists(k8sClient, &openchoreov1alpha1.ComponentDeployment{}, "cart-staging") {
+			t.Error("the deployment was deleted by a conflicting delete")
+		}
+	})
+}
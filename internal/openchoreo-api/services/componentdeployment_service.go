@@ -0,0 +1,421 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// ComponentDeploymentService handles the deployments of ComponentType based components to environments,
+// and the ComponentEnvSnapshots and Releases the controllers derive from them
+type ComponentDeploymentService struct {
+	k8sClient        client.Client
+	componentService *ComponentService
+	logger           *slog.Logger
+}
+
+// NewComponentDeploymentService creates a new ComponentDeployment service
+func NewComponentDeploymentService(k8sClient client.Client, componentService *ComponentService, logger *slog.Logger) *ComponentDeploymentService {
+	return &ComponentDeploymentService{
+		k8sClient:        k8sClient,
+		componentService: componentService,
+		logger:           logger,
+	}
+}
+
+// ListComponentDeployments lists a page of the ComponentDeployments of a component
+func (s *ComponentDeploymentService) ListComponentDeployments(ctx context.Context, orgName, projectName, componentName string,
+	opts *models.ListOptions) (*models.ListPage[*models.ComponentDeploymentResponse], error) {
+	s.logger.Debug("Listing ComponentDeployments", "org", orgName, "project", projectName, "component", componentName)
+
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ComponentDeploymentList {
+			return &openchoreov1alpha1.ComponentDeploymentList{}
+		},
+		func(list *openchoreov1alpha1.ComponentDeploymentList) []*models.ComponentDeploymentResponse {
+			cds := make([]*models.ComponentDeploymentResponse, 0, len(list.Items))
+			for i := range list.Items {
+				cd := &list.Items[i]
+				if cd.Spec.Owner.ProjectName == projectName && cd.Spec.Owner.ComponentName == componentName {
+					cds = append(cds, toComponentDeploymentResponse(cd))
+				}
+			}
+			return cds
+		},
+		client.InNamespace(orgName))
+	if err != nil {
+		s.logger.Error("Failed to list ComponentDeployments", "error", err)
+		return nil, fmt.Errorf("failed to list ComponentDeployments: %w", err)
+	}
+	return page, nil
+}
+
+// GetComponentDeployment retrieves the ComponentDeployment of a component in an environment
+func (s *ComponentDeploymentService) GetComponentDeployment(ctx context.Context, orgName, projectName, componentName,
+	environment string) (*models.ComponentDeploymentResponse, error) {
+	s.logger.Debug("Getting ComponentDeployment", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	cd, err := s.getComponentDeployment(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	return toComponentDeploymentResponse(cd), nil
+}
+
+// PutComponentDeployment creates the ComponentDeployment of a component in an environment, or replaces the overrides
+// and rollout strategy of the existing one. It reports whether the ComponentDeployment was created. The update is
+// rejected with ErrResourceVersionConflict when the resource version of the request is not the current one.
+func (s *ComponentDeploymentService) PutComponentDeployment(ctx context.Context, orgName, projectName, componentName, environment string,
+	req *models.ComponentDeploymentRequest) (*models.ComponentDeploymentResponse, bool, error) {
+	s.logger.Debug("Putting ComponentDeployment", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	cd, err := s.getComponentDeployment(ctx, orgName, projectName, componentName, environment)
+	if err != nil && !errors.Is(err, ErrComponentDeploymentNotFound) {
+		return nil, false, err
+	}
+	created := cd == nil
+
+	// Changing the overrides redeploys the component, which is not allowed while the environment is frozen
+	target := fmt.Sprintf("ComponentDeployment/%s-%s", componentName, environment)
+	if cd != nil {
+		target = "ComponentDeployment/" + cd.Name
+	}
+	override, err := s.componentService.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, environment,
+		"UpdateComponentDeployment", target, req.FreezeOverride)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if created {
+		cd = &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", componentName, environment),
+				Namespace: orgName,
+			},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner: openchoreov1alpha1.ComponentDeploymentOwner{
+					ProjectName:   projectName,
+					ComponentName: componentName,
+				},
+				Environment: environment,
+			},
+		}
+	} else {
+		withResourceVersion(cd, req.ResourceVersion)
+	}
+	cd.Spec.Overrides = req.Overrides
+	cd.Spec.TraitOverrides = req.TraitOverrides
+	cd.Spec.ConfigurationOverrides = req.ConfigurationOverrides
+	cd.Spec.Rollout = req.Rollout
+
+	if created {
+		err = s.k8sClient.Create(ctx, cd)
+	} else {
+		err = s.k8sClient.Update(ctx, cd)
+	}
+	if err != nil {
+		s.logger.Warn("Failed to write ComponentDeployment", "name", cd.Name, "error", err)
+		return nil, false, writeError(err, ErrComponentDeploymentNotFound, "write ComponentDeployment")
+	}
+	if err := s.componentService.recordFreezeOverride(ctx, override); err != nil {
+		return nil, false, err
+	}
+
+	s.logger.Debug("Wrote ComponentDeployment", "name", cd.Name, "created", created)
+	return toComponentDeploymentResponse(cd), created, nil
+}
+
+// DeleteComponentDeployment deletes the ComponentDeployment of a component in an environment, which undeploys the
+// component from the environment. The delete only succeeds at the given resource version if one is set.
+func (s *ComponentDeploymentService) DeleteComponentDeployment(ctx context.Context, orgName, projectName, componentName, environment,
+	resourceVersion string, override *models.FreezeOverride) error {
+	s.logger.Debug("Deleting ComponentDeployment", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	cd, err := s.getComponentDeployment(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return err
+	}
+	frozenOverride, err := s.componentService.checkEnvironmentFreeze(ctx, orgName, projectName, componentName, environment,
+		"DeleteComponentDeployment", "ComponentDeployment/"+cd.Name, override)
+	if err != nil {
+		return err
+	}
+
+	if err := s.k8sClient.Delete(ctx, cd, deleteOptions(resourceVersion)...); err != nil {
+		s.logger.Warn("Failed to delete ComponentDeployment", "name", cd.Name, "error", err)
+		return writeError(err, ErrComponentDeploymentNotFound, "delete ComponentDeployment")
+	}
+	if err := s.componentService.recordFreezeOverride(ctx, frozenOverride); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Deleted ComponentDeployment", "name", cd.Name)
+	return nil
+}
+
+// RollbackComponentDeployment aborts the progressive rollout in progress of the ComponentDeployment of a component
+// in an environment, which restores the stable revision. It doesn't roll back a completed rollout, whose revision
+// is the stable one: it fails with ErrNoRolloutInProgress when no rollout is in progress. Rollbacks restore a
+// revision that was already deployed, so they are allowed while the environment is frozen. The rollback only
+// succeeds at the given resource version if one is set.
+func (s *ComponentDeploymentService) RollbackComponentDeployment(ctx context.Context, orgName, projectName, componentName, environment,
+	resourceVersion string) (*models.ComponentDeploymentResponse, error) {
+	s.logger.Debug("Rolling back ComponentDeployment", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	cd, err := s.getComponentDeployment(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	rollout := cd.Status.Rollout
+	if rollout == nil || rollout.CurrentRevision == "" || rollout.CurrentRevision == rollout.StableRevision {
+		return nil, ErrNoRolloutInProgress
+	}
+	switch rollout.Phase {
+	case openchoreov1alpha1.RolloutPhaseProgressing, openchoreov1alpha1.RolloutPhasePaused, openchoreov1alpha1.RolloutPhaseSwitching:
+	default:
+		return nil, ErrNoRolloutInProgress
+	}
+
+	withResourceVersion(cd, resourceVersion)
+	if cd.Annotations == nil {
+		cd.Annotations = make(map[string]string)
+	}
+	cd.Annotations[controller.AnnotationKeyRolloutAbort] = rollout.CurrentRevision
+	if err := s.k8sClient.Update(ctx, cd); err != nil {
+		s.logger.Warn("Failed to roll back ComponentDeployment", "name", cd.Name, "error", err)
+		return nil, writeError(err, ErrComponentDeploymentNotFound, "roll back ComponentDeployment")
+	}
+
+	s.logger.Debug("Rolled back ComponentDeployment", "name", cd.Name, "revision", rollout.CurrentRevision,
+		"stableRevision", rollout.StableRevision)
+	return toComponentDeploymentResponse(cd), nil
+}
+
+// ListComponentEnvSnapshots lists a page of the ComponentEnvSnapshots of a component
+func (s *ComponentDeploymentService) ListComponentEnvSnapshots(ctx context.Context, orgName, projectName, componentName string,
+	opts *models.ListOptions) (*models.ListPage[*models.ComponentEnvSnapshotResponse], error) {
+	s.logger.Debug("Listing ComponentEnvSnapshots", "org", orgName, "project", projectName, "component", componentName)
+
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ComponentEnvSnapshotList {
+			return &openchoreov1alpha1.ComponentEnvSnapshotList{}
+		},
+		func(list *openchoreov1alpha1.ComponentEnvSnapshotList) []*models.ComponentEnvSnapshotResponse {
+			snapshots := make([]*models.ComponentEnvSnapshotResponse, 0, len(list.Items))
+			for i := range list.Items {
+				snapshot := &list.Items[i]
+				if snapshot.Spec.Owner.ProjectName == projectName && snapshot.Spec.Owner.ComponentName == componentName {
+					snapshots = append(snapshots, toComponentEnvSnapshotResponse(snapshot))
+				}
+			}
+			return snapshots
+		},
+		client.InNamespace(orgName))
+	if err != nil {
+		s.logger.Error("Failed to list ComponentEnvSnapshots", "error", err)
+		return nil, fmt.Errorf("failed to list ComponentEnvSnapshots: %w", err)
+	}
+	return page, nil
+}
+
+// GetComponentEnvSnapshot retrieves the ComponentEnvSnapshot of a component in an environment
+func (s *ComponentDeploymentService) GetComponentEnvSnapshot(ctx context.Context, orgName, projectName, componentName,
+	environment string) (*models.ComponentEnvSnapshotResponse, error) {
+	s.logger.Debug("Getting ComponentEnvSnapshot", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+	snapshot, err := s.componentService.getComponentEnvSnapshotCR(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		if errors.Is(err, ErrBindingNotFound) {
+			return nil, ErrComponentEnvSnapshotNotFound
+		}
+		return nil, err
+	}
+	return toComponentEnvSnapshotResponse(snapshot), nil
+}
+
+// ListReleases lists a page of the Releases of a component
+func (s *ComponentDeploymentService) ListReleases(ctx context.Context, orgName, projectName, componentName string,
+	opts *models.ListOptions) (*models.ListPage[*models.ReleaseResponse], error) {
+	s.logger.Debug("Listing Releases", "org", orgName, "project", projectName, "component", componentName)
+
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ReleaseList { return &openchoreov1alpha1.ReleaseList{} },
+		func(list *openchoreov1alpha1.ReleaseList) []*models.ReleaseResponse {
+			releases := make([]*models.ReleaseResponse, 0, len(list.Items))
+			for i := range list.Items {
+				release := &list.Items[i]
+				if release.Spec.Owner.ProjectName == projectName && release.Spec.Owner.ComponentName == componentName {
+					releases = append(releases, toReleaseResponse(release))
+				}
+			}
+			return releases
+		},
+		client.InNamespace(orgName))
+	if err != nil {
+		s.logger.Error("Failed to list Releases", "error", err)
+		return nil, fmt.Errorf("failed to list Releases: %w", err)
+	}
+	return page, nil
+}
+
+// GetRelease retrieves the Release of a component in an environment
+func (s *ComponentDeploymentService) GetRelease(ctx context.Context, orgName, projectName, componentName,
+	environment string) (*models.ReleaseResponse, error) {
+	s.logger.Debug("Getting Release", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+
+	releaseList := &openchoreov1alpha1.ReleaseList{}
+	if err := s.k8sClient.List(ctx, releaseList, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if release.Spec.Owner.ProjectName == projectName && release.Spec.Owner.ComponentName == componentName &&
+			release.Spec.EnvironmentName == environment {
+			return toReleaseResponse(release), nil
+		}
+	}
+	return nil, ErrReleaseNotFound
+}
+
+// getComponentDeployment retrieves the ComponentDeployment CR of an existing component in an environment
+func (s *ComponentDeploymentService) getComponentDeployment(ctx context.Context, orgName, projectName, componentName,
+	environment string) (*openchoreov1alpha1.ComponentDeployment, error) {
+	if _, err := s.componentService.GetComponent(ctx, orgName, projectName, componentName, nil); err != nil {
+		return nil, err
+	}
+	cd, err := s.componentService.getComponentDeploymentCR(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		if errors.Is(err, ErrBindingNotFound) {
+			return nil, ErrComponentDeploymentNotFound
+		}
+		return nil, err
+	}
+	return cd, nil
+}
+
+func toComponentDeploymentResponse(cd *openchoreov1alpha1.ComponentDeployment) *models.ComponentDeploymentResponse {
+	return &models.ComponentDeploymentResponse{
+		Name:                   cd.Name,
+		OrgName:                cd.Namespace,
+		ProjectName:            cd.Spec.Owner.ProjectName,
+		ComponentName:          cd.Spec.Owner.ComponentName,
+		Environment:            cd.Spec.Environment,
+		Overrides:              cd.Spec.Overrides,
+		TraitOverrides:         cd.Spec.TraitOverrides,
+		ConfigurationOverrides: cd.Spec.ConfigurationOverrides,
+		Rollout:                cd.Spec.Rollout,
+		Status:                 readyStatus(cd.Status.Conditions),
+		Conditions:             toConditionResponses(cd.Status.Conditions),
+		RolloutStatus:          toRolloutEventStatus(cd.Status.Rollout),
+		ResourceVersion:        cd.ResourceVersion,
+		CreatedAt:              cd.CreationTimestamp.Time,
+	}
+}
+
+func toComponentEnvSnapshotResponse(snapshot *openchoreov1alpha1.ComponentEnvSnapshot) *models.ComponentEnvSnapshotResponse {
+	traits := make([]string, 0, len(snapshot.Spec.Traits))
+	for _, trait := range snapshot.Spec.Traits {
+		traits = append(traits, trait.Name)
+	}
+	return &models.ComponentEnvSnapshotResponse{
+		Name:                  snapshot.Name,
+		OrgName:               snapshot.Namespace,
+		ProjectName:           snapshot.Spec.Owner.ProjectName,
+		ComponentName:         snapshot.Spec.Owner.ComponentName,
+		Environment:           snapshot.Spec.Environment,
+		ComponentType:         snapshot.Spec.ComponentType.Name,
+		Traits:                traits,
+		Workload:              snapshot.Spec.Workload.Name,
+		ReleaseRef:            snapshot.Status.ReleaseRef,
+		RenderedResourceCount: snapshot.Status.RenderedResourceCount,
+		Conditions:            toConditionResponses(snapshot.Status.Conditions),
+		ResourceVersion:       snapshot.ResourceVersion,
+		CreatedAt:             snapshot.CreationTimestamp.Time,
+	}
+}
+
+func toReleaseResponse(release *openchoreov1alpha1.Release) *models.ReleaseResponse {
+	resources := make([]models.ReleaseResourceStatus, 0, len(release.Status.Resources))
+	for _, r := range release.Status.Resources {
+		resources = append(resources, models.ReleaseResourceStatus{
+			ID:           r.ID,
+			Group:        r.Group,
+			Version:      r.Version,
+			Kind:         r.Kind,
+			Name:         r.Name,
+			Namespace:    r.Namespace,
+			HealthStatus: string(r.HealthStatus),
+		})
+	}
+	return &models.ReleaseResponse{
+		Name:            release.Name,
+		OrgName:         release.Namespace,
+		ProjectName:     release.Spec.Owner.ProjectName,
+		ComponentName:   release.Spec.Owner.ComponentName,
+		Environment:     release.Spec.EnvironmentName,
+		Status:          readyStatus(release.Status.Conditions),
+		Resources:       resources,
+		Conditions:      toConditionResponses(release.Status.Conditions),
+		ResourceVersion: release.ResourceVersion,
+		CreatedAt:       release.CreationTimestamp.Time,
+	}
+}
+
+// toConditionResponses converts the status conditions of a resource to their API representation
+func toConditionResponses(conditions []metav1.Condition) []models.ConditionResponse {
+	var responses []models.ConditionResponse
+	for _, c := range conditions {
+		responses = append(responses, models.ConditionResponse{
+			Type:               c.Type,
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Time,
+		})
+	}
+	return responses
+}
+
+// toRolloutEventStatus converts the rollout status of a ComponentDeployment, nil when no rollout is configured
+func toRolloutEventStatus(r *openchoreov1alpha1.RolloutStatus) *models.RolloutEventStatus {
+	if r == nil {
+		return nil
+	}
+	return &models.RolloutEventStatus{
+		Phase:           string(r.Phase),
+		StableRevision:  r.StableRevision,
+		CurrentRevision: r.CurrentRevision,
+		CurrentStep:     r.CurrentStep,
+		CurrentWeight:   r.CurrentWeight,
+	}
+}
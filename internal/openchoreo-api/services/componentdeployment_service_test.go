@@ -0,0 +1,72 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func TestComponentDeploymentWritePreconditions(t *testing.T) {
+	ctx := context.Background()
+	componentService, k8sClient := newTestComponentService(t,
+		&openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: testOrg}},
+		&openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart", Namespace: testOrg},
+			Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "shop"}},
+		},
+		&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: testOrg}},
+	)
+	service := NewComponentDeploymentService(k8sClient, componentService, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	current := func() string {
+		cd := &openchoreov1alpha1.ComponentDeployment{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: "cart-staging", Namespace: testOrg}, cd); err != nil {
+			t.Fatalf("failed to get the ComponentDeployment: %v", err)
+		}
+		return cd.ResourceVersion
+	}
+
+	if err := service.DeleteComponentDeployment(ctx, testOrg, "shop", "cart", "staging", "", nil); !errors.Is(err, ErrComponentDeploymentNotFound) {
+		t.Errorf("DeleteComponentDeployment() before it exists = %v, want ErrComponentDeploymentNotFound", err)
+	}
+
+	_, created, err := service.PutComponentDeployment(ctx, testOrg, "shop", "cart", "staging", &models.ComponentDeploymentRequest{})
+	if err != nil || !created {
+		t.Fatalf("PutComponentDeployment() = %v, created %v, want it created", err, created)
+	}
+	stale := current()
+
+	cd, created, err := service.PutComponentDeployment(ctx, testOrg, "shop", "cart", "staging",
+		&models.ComponentDeploymentRequest{ResourceVersion: stale})
+	if err != nil || created {
+		t.Fatalf("PutComponentDeployment() at the current version = %v, created %v, want it updated", err, created)
+	}
+	if cd.ResourceVersion == stale {
+		t.Errorf("resource version after the update = %q, want a new one", cd.ResourceVersion)
+	}
+
+	_, _, err = service.PutComponentDeployment(ctx, testOrg, "shop", "cart", "staging",
+		&models.ComponentDeploymentRequest{ResourceVersion: stale})
+	if !errors.Is(err, ErrResourceVersionConflict) {
+		t.Errorf("PutComponentDeployment() at a stale version = %v, want ErrResourceVersionConflict", err)
+	}
+	if err := service.DeleteComponentDeployment(ctx, testOrg, "shop", "cart", "staging", stale, nil); !errors.Is(err, ErrResourceVersionConflict) {
+		t.Errorf("DeleteComponentDeployment() at a stale version = %v, want ErrResourceVersionConflict", err)
+	}
+	if err := service.DeleteComponentDeployment(ctx, testOrg, "shop", "cart", "staging", current(), nil); err != nil {
+		t.Errorf("DeleteComponentDeployment() at the current version = %v", err)
+	}
+	if _, _, err := service.PutComponentDeployment(ctx, testOrg, "shop", "billing", "staging", &models.ComponentDeploymentRequest{}); !errors.Is(err, ErrComponentNotFound) {
+		t.Errorf("PutComponentDeployment() of a missing component = %v, want ErrComponentNotFound", err)
+	}
+}
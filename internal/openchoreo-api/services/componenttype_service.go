@@ -10,6 +10,8 @@ import (
 
 	"golang.org/x/exp/slog"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -33,27 +35,27 @@ func NewComponentTypeService(k8sClient client.Client, logger *slog.Logger) *Comp
 	}
 }
 
-// ListComponentTypes lists all ComponentTypes in the given organization
-func (s *ComponentTypeService) ListComponentTypes(ctx context.Context, orgName string) ([]*models.ComponentTypeResponse, error) {
+// ListComponentTypes lists a page of the ComponentTypes in the given organization
+func (s *ComponentTypeService) ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentTypeResponse], error) {
 	s.logger.Debug("Listing ComponentTypes", "org", orgName)
 
-	var ctList openchoreov1alpha1.ComponentTypeList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &ctList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ComponentTypeList { return &openchoreov1alpha1.ComponentTypeList{} },
+		func(list *openchoreov1alpha1.ComponentTypeList) []*models.ComponentTypeResponse {
+			cts := make([]*models.ComponentTypeResponse, 0, len(list.Items))
+			for i := range list.Items {
+				cts = append(cts, s.toComponentTypeResponse(&list.Items[i]))
+			}
+			return cts
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list ComponentTypes", "error", err)
 		return nil, fmt.Errorf("failed to list ComponentTypes: %w", err)
 	}
 
-	cts := make([]*models.ComponentTypeResponse, 0, len(ctList.Items))
-	for i := range ctList.Items {
-		cts = append(cts, s.toComponentTypeResponse(&ctList.Items[i]))
-	}
-
-	s.logger.Debug("Listed ComponentTypes", "org", orgName, "count", len(cts))
-	return cts, nil
+	s.logger.Debug("Listed ComponentTypes", "org", orgName, "count", len(page.Items))
+	return page, nil
 }
 
 // GetComponentType retrieves a specific ComponentType
@@ -75,7 +77,9 @@ func (s *ComponentTypeService) GetComponentType(ctx context.Context, orgName, ct
 		return nil, fmt.Errorf("failed to get ComponentType: %w", err)
 	}
 
-	return s.toComponentTypeResponse(ct), nil
+	response := s.toComponentTypeResponse(ct)
+	response.Spec = &ct.Spec
+	return response, nil
 }
 
 // GetComponentTypeSchema retrieves the JSON schema for a ComponentType
@@ -149,5 +153,83 @@ func (s *ComponentTypeService) toComponentTypeResponse(ct *openchoreov1alpha1.Co
 		WorkloadType:     ct.Spec.WorkloadType,
 		AllowedWorkflows: allowedWorkflows,
 		CreatedAt:        ct.CreationTimestamp.Time,
+		ResourceVersion:  ct.ResourceVersion,
+	}
+}
+
+// CreateComponentType creates a ComponentType in the given organization
+func (s *ComponentTypeService) CreateComponentType(ctx context.Context, orgName string, req *models.ComponentTypeRequest) (*models.ComponentTypeResponse, error) {
+	s.logger.Debug("Creating ComponentType", "org", orgName, "name", req.Name)
+
+	ct := &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: orgName,
+		},
+		Spec: req.Spec,
+	}
+	setDisplayAnnotations(ct, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Create(ctx, ct); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			s.logger.Warn("ComponentType already exists", "org", orgName, "name", req.Name)
+			return nil, ErrComponentTypeAlreadyExists
+		}
+		s.logger.Error("Failed to create ComponentType", "error", err)
+		return nil, writeError(err, nil, "create ComponentType")
+	}
+
+	s.logger.Debug("Created ComponentType", "org", orgName, "name", req.Name)
+	response := s.toComponentTypeResponse(ct)
+	response.Spec = &ct.Spec
+	return response, nil
+}
+
+// UpdateComponentType replaces the spec, display name and description of a ComponentType. The update is rejected with
+// ErrResourceVersionConflict when the resource version of the request is not the current one.
+func (s *ComponentTypeService) UpdateComponentType(ctx context.Context, orgName, ctName string, req *models.ComponentTypeRequest) (*models.ComponentTypeResponse, error) {
+	s.logger.Debug("Updating ComponentType", "org", orgName, "name", ctName)
+
+	ct := &openchoreov1alpha1.ComponentType{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: ctName, Namespace: orgName}, ct); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("ComponentType not found", "org", orgName, "name", ctName)
+			return nil, ErrComponentTypeNotFound
+		}
+		s.logger.Error("Failed to get ComponentType", "error", err)
+		return nil, fmt.Errorf("failed to get ComponentType: %w", err)
 	}
+
+	withResourceVersion(ct, req.ResourceVersion)
+	ct.Spec = req.Spec
+	setDisplayAnnotations(ct, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Update(ctx, ct); err != nil {
+		s.logger.Warn("Failed to update ComponentType", "org", orgName, "name", ctName, "error", err)
+		return nil, writeError(err, ErrComponentTypeNotFound, "update ComponentType")
+	}
+
+	s.logger.Debug("Updated ComponentType", "org", orgName, "name", ctName)
+	response := s.toComponentTypeResponse(ct)
+	response.Spec = &ct.Spec
+	return response, nil
+}
+
+// DeleteComponentType deletes a ComponentType, only at the given resource version if one is set
+func (s *ComponentTypeService) DeleteComponentType(ctx context.Context, orgName, ctName, resourceVersion string) error {
+	s.logger.Debug("Deleting ComponentType", "org", orgName, "name", ctName)
+
+	ct := &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ctName,
+			Namespace: orgName,
+		},
+	}
+	if err := s.k8sClient.Delete(ctx, ct, deleteOptions(resourceVersion)...); err != nil {
+		s.logger.Warn("Failed to delete ComponentType", "org", orgName, "name", ctName, "error", err)
+		return writeError(err, ErrComponentTypeNotFound, "delete ComponentType")
+	}
+
+	s.logger.Debug("Deleted ComponentType", "org", orgName, "name", ctName)
+	return nil
 }
@@ -31,27 +31,27 @@ func NewDataPlaneService(k8sClient client.Client, logger *slog.Logger) *DataPlan
 	}
 }
 
-// ListDataPlanes lists all dataplanes in the specified organization
-func (s *DataPlaneService) ListDataPlanes(ctx context.Context, orgName string) ([]*models.DataPlaneResponse, error) {
+// ListDataPlanes lists a page of the dataplanes in the specified organization
+func (s *DataPlaneService) ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.DataPlaneResponse], error) {
 	s.logger.Debug("Listing dataplanes", "org", orgName)
 
-	var dpList openchoreov1alpha1.DataPlaneList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &dpList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.DataPlaneList { return &openchoreov1alpha1.DataPlaneList{} },
+		func(list *openchoreov1alpha1.DataPlaneList) []*models.DataPlaneResponse {
+			dataplanes := make([]*models.DataPlaneResponse, 0, len(list.Items))
+			for _, item := range list.Items {
+				dataplanes = append(dataplanes, s.toDataPlaneResponse(&item))
+			}
+			return dataplanes
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list dataplanes", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list dataplanes: %w", err)
 	}
 
-	dataplanes := make([]*models.DataPlaneResponse, 0, len(dpList.Items))
-	for _, item := range dpList.Items {
-		dataplanes = append(dataplanes, s.toDataPlaneResponse(&item))
-	}
-
-	s.logger.Debug("Listed dataplanes", "count", len(dataplanes), "org", orgName)
-	return dataplanes, nil
+	s.logger.Debug("Listed dataplanes", "count", len(page.Items), "org", orgName)
+	return page, nil
 }
 
 // GetDataPlane retrieves a specific dataplane
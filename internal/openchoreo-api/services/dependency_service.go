@@ -0,0 +1,165 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/exp/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dependency"
+)
+
+// DependencyService builds the dependency graph of components from the connections of their workloads
+type DependencyService struct {
+	k8sClient      client.Client
+	projectService *ProjectService
+	logger         *slog.Logger
+}
+
+// NewDependencyService creates a new dependency service
+func NewDependencyService(k8sClient client.Client, projectService *ProjectService, logger *slog.Logger) *DependencyService {
+	return &DependencyService{
+		k8sClient:      k8sClient,
+		projectService: projectService,
+		logger:         logger,
+	}
+}
+
+// GetOrganizationDependencyGraph returns the dependency graph of all components of the organization.
+// When an environment is given, the graph is built from the workloads deployed to that environment,
+// otherwise from the workload definitions of the components.
+func (s *DependencyService) GetOrganizationDependencyGraph(ctx context.Context, orgName, environment string) (*dependency.Graph, error) {
+	s.logger.Debug("Getting organization dependency graph", "org", orgName, "environment", environment)
+
+	workloads, err := s.listWorkloads(ctx, orgName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependency.Build(environment, workloads), nil
+}
+
+// GetProjectDependencyGraph returns the dependency graph of the components of the project,
+// including the components of other projects they are connected to.
+func (s *DependencyService) GetProjectDependencyGraph(ctx context.Context, orgName, projectName, environment string) (*dependency.Graph, error) {
+	s.logger.Debug("Getting project dependency graph", "org", orgName, "project", projectName, "environment", environment)
+
+	if _, err := s.projectService.GetProject(ctx, orgName, projectName); err != nil {
+		return nil, err
+	}
+
+	// Connections may cross projects, so the graph is built for the whole organization
+	graph, err := s.GetOrganizationDependencyGraph(ctx, orgName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.ForProject(projectName), nil
+}
+
+// GetUnmetDependencies returns the connections of the component that can't be satisfied in the environment
+// because the target component or endpoint is not deployed there.
+func (s *DependencyService) GetUnmetDependencies(ctx context.Context, orgName, projectName, componentName, environment string) ([]dependency.Edge, error) {
+	graph, err := s.GetOrganizationDependencyGraph(ctx, orgName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var unmet []dependency.Edge
+	for _, e := range graph.DependenciesOf(dependency.ComponentRef{Project: projectName, Component: componentName}) {
+		if e.Dangling {
+			unmet = append(unmet, e)
+		}
+	}
+	return unmet, nil
+}
+
+// listWorkloads returns the workloads of the organization, or the workloads deployed to the environment if one is given
+func (s *DependencyService) listWorkloads(ctx context.Context, orgName, environment string) ([]dependency.Workload, error) {
+	if environment == "" {
+		var workloadList openchoreov1alpha1.WorkloadList
+		if err := s.k8sClient.List(ctx, &workloadList, client.InNamespace(orgName)); err != nil {
+			s.logger.Error("Failed to list workloads", "error", err, "org", orgName)
+			return nil, fmt.Errorf("failed to list workloads: %w", err)
+		}
+
+		workloads := make([]dependency.Workload, 0, len(workloadList.Items))
+		for _, w := range workloadList.Items {
+			workloads = append(workloads, dependency.Workload{
+				Project:   w.Spec.Owner.ProjectName,
+				Component: w.Spec.Owner.ComponentName,
+				Spec:      w.Spec.WorkloadTemplateSpec,
+			})
+		}
+		return workloads, nil
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: environment, Namespace: orgName}, env); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Environment not found", "org", orgName, "environment", environment)
+			return nil, ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	var workloads []dependency.Workload
+
+	var serviceBindings openchoreov1alpha1.ServiceBindingList
+	if err := s.k8sClient.List(ctx, &serviceBindings, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list service bindings: %w", err)
+	}
+	for _, b := range serviceBindings.Items {
+		if b.Spec.Environment == environment {
+			workloads = append(workloads, dependency.Workload{
+				Project: b.Spec.Owner.ProjectName, Component: b.Spec.Owner.ComponentName, Spec: b.Spec.WorkloadSpec,
+			})
+		}
+	}
+
+	var webAppBindings openchoreov1alpha1.WebApplicationBindingList
+	if err := s.k8sClient.List(ctx, &webAppBindings, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list web application bindings: %w", err)
+	}
+	for _, b := range webAppBindings.Items {
+		if b.Spec.Environment == environment {
+			workloads = append(workloads, dependency.Workload{
+				Project: b.Spec.Owner.ProjectName, Component: b.Spec.Owner.ComponentName, Spec: b.Spec.WorkloadSpec,
+			})
+		}
+	}
+
+	var scheduledTaskBindings openchoreov1alpha1.ScheduledTaskBindingList
+	if err := s.k8sClient.List(ctx, &scheduledTaskBindings, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list scheduled task bindings: %w", err)
+	}
+	for _, b := range scheduledTaskBindings.Items {
+		if b.Spec.Environment == environment {
+			workloads = append(workloads, dependency.Workload{
+				Project: b.Spec.Owner.ProjectName, Component: b.Spec.Owner.ComponentName, Spec: b.Spec.WorkloadSpec,
+			})
+		}
+	}
+
+	// Components defined with a ComponentType are deployed from their snapshot of the environment
+	var snapshots openchoreov1alpha1.ComponentEnvSnapshotList
+	if err := s.k8sClient.List(ctx, &snapshots, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list component env snapshots: %w", err)
+	}
+	for _, snapshot := range snapshots.Items {
+		if snapshot.Spec.Environment == environment {
+			workloads = append(workloads, dependency.Workload{
+				Project:   snapshot.Spec.Owner.ProjectName,
+				Component: snapshot.Spec.Owner.ComponentName,
+				Spec:      snapshot.Spec.Workload.Spec.WorkloadTemplateSpec,
+			})
+		}
+	}
+
+	return workloads, nil
+}
@@ -82,11 +82,18 @@ func (s *DeploymentPipelineService) toDeploymentPipelineResponse(pipeline *openc
 	for _, path := range pipeline.Spec.PromotionPaths {
 		targetRefs := make([]models.TargetEnvironmentRef, 0, len(path.TargetEnvironmentRefs))
 		for _, target := range path.TargetEnvironmentRefs {
-			targetRefs = append(targetRefs, models.TargetEnvironmentRef{
+			targetRef := models.TargetEnvironmentRef{
 				Name:                     target.Name,
 				RequiresApproval:         target.RequiresApproval,
 				IsManualApprovalRequired: target.IsManualApprovalRequired,
-			})
+			}
+			if target.AutoPromote != nil {
+				targetRef.AutoPromote = &models.AutoPromotePolicy{}
+				if target.AutoPromote.HealthyFor != nil {
+					targetRef.AutoPromote.HealthyFor = target.AutoPromote.HealthyFor.Duration.String()
+				}
+			}
+			targetRefs = append(targetRefs, targetRef)
 		}
 		promotionPaths = append(promotionPaths, models.PromotionPath{
 			SourceEnvironmentRef:  path.SourceEnvironmentRef,
@@ -6,6 +6,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"golang.org/x/exp/slog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +14,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/freeze"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
@@ -31,27 +33,27 @@ func NewEnvironmentService(k8sClient client.Client, logger *slog.Logger) *Enviro
 	}
 }
 
-// ListEnvironments lists all environments in the specified organization
-func (s *EnvironmentService) ListEnvironments(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
+// ListEnvironments lists a page of the environments in the specified organization
+func (s *EnvironmentService) ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.EnvironmentResponse], error) {
 	s.logger.Debug("Listing environments", "org", orgName)
 
-	var envList openchoreov1alpha1.EnvironmentList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &envList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.EnvironmentList { return &openchoreov1alpha1.EnvironmentList{} },
+		func(list *openchoreov1alpha1.EnvironmentList) []*models.EnvironmentResponse {
+			environments := make([]*models.EnvironmentResponse, 0, len(list.Items))
+			for _, item := range list.Items {
+				environments = append(environments, s.toEnvironmentResponse(&item))
+			}
+			return environments
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list environments", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list environments: %w", err)
 	}
 
-	environments := make([]*models.EnvironmentResponse, 0, len(envList.Items))
-	for _, item := range envList.Items {
-		environments = append(environments, s.toEnvironmentResponse(&item))
-	}
-
-	s.logger.Debug("Listed environments", "count", len(environments), "org", orgName)
-	return environments, nil
+	s.logger.Debug("Listed environments", "count", len(page.Items), "org", orgName)
+	return page, nil
 }
 
 // GetEnvironment retrieves a specific environment
@@ -187,7 +189,7 @@ func (s *EnvironmentService) toEnvironmentResponse(env *openchoreov1alpha1.Envir
 		}
 	}
 
-	return &models.EnvironmentResponse{
+	resp := &models.EnvironmentResponse{
 		Name:         env.Name,
 		Namespace:    env.Namespace,
 		DisplayName:  displayName,
@@ -198,4 +200,17 @@ func (s *EnvironmentService) toEnvironmentResponse(env *openchoreov1alpha1.Envir
 		CreatedAt:    env.CreationTimestamp.Time,
 		Status:       status,
 	}
+
+	active, err := freeze.Evaluate(env.Spec.FreezeWindows, time.Now())
+	if err != nil {
+		s.logger.Warn("Failed to evaluate freeze windows", "environment", env.Name, "error", err)
+	} else if active != nil {
+		resp.ActiveFreeze = &models.ActiveFreezeResponse{
+			Window: active.Name,
+			Reason: active.Reason,
+			Until:  active.End,
+		}
+	}
+
+	return resp
 }
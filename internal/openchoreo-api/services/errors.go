@@ -3,48 +3,137 @@
 
 package services
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/promotion"
+)
 
 // Common service errors
 var (
-	ErrProjectAlreadyExists       = errors.New("project already exists")
-	ErrProjectNotFound            = errors.New("project not found")
-	ErrComponentAlreadyExists     = errors.New("component already exists")
-	ErrComponentNotFound          = errors.New("component not found")
-	ErrComponentTypeAlreadyExists = errors.New("component type already exists")
-	ErrComponentTypeNotFound      = errors.New("component type not found")
-	ErrTraitAlreadyExists         = errors.New("trait already exists")
-	ErrTraitNotFound              = errors.New("trait not found")
-	ErrOrganizationNotFound       = errors.New("organization not found")
-	ErrEnvironmentNotFound        = errors.New("environment not found")
-	ErrEnvironmentAlreadyExists   = errors.New("environment already exists")
-	ErrDataPlaneNotFound          = errors.New("dataplane not found")
-	ErrDataPlaneAlreadyExists     = errors.New("dataplane already exists")
-	ErrBindingNotFound            = errors.New("binding not found")
-	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
-	ErrInvalidPromotionPath       = errors.New("invalid promotion path")
-	ErrWorkflowNotFound           = errors.New("workflow not found")
+	ErrProjectAlreadyExists         = errors.New("project already exists")
+	ErrProjectNotFound              = errors.New("project not found")
+	ErrComponentAlreadyExists       = errors.New("component already exists")
+	ErrComponentNotFound            = errors.New("component not found")
+	ErrComponentTypeAlreadyExists   = errors.New("component type already exists")
+	ErrComponentTypeNotFound        = errors.New("component type not found")
+	ErrTraitAlreadyExists           = errors.New("trait already exists")
+	ErrTraitNotFound                = errors.New("trait not found")
+	ErrOrganizationNotFound         = errors.New("organization not found")
+	ErrEnvironmentNotFound          = errors.New("environment not found")
+	ErrEnvironmentAlreadyExists     = errors.New("environment already exists")
+	ErrDataPlaneNotFound            = errors.New("dataplane not found")
+	ErrDataPlaneAlreadyExists       = errors.New("dataplane already exists")
+	ErrBindingNotFound              = promotion.ErrBindingNotFound
+	ErrDeploymentPipelineNotFound   = errors.New("deployment pipeline not found")
+	ErrInvalidPromotionPath         = errors.New("invalid promotion path")
+	ErrWorkflowAlreadyExists        = errors.New("workflow already exists")
+	ErrWorkflowNotFound             = errors.New("workflow not found")
+	ErrComponentDeploymentNotFound  = errors.New("component deployment not found")
+	ErrComponentEnvSnapshotNotFound = errors.New("component env snapshot not found")
+	ErrReleaseNotFound              = errors.New("release not found")
+	ErrNoRolloutInProgress          = errors.New("no rollout in progress")
+	ErrReleaseTrainNotFound         = errors.New("release train not found")
+	ErrBuildNotFound                = errors.New("build not found")
+	ErrObserverNotConfigured        = errors.New("observer not configured")
+	ErrObserverUnavailable          = errors.New("observer unavailable")
+	ErrResourceVersionConflict      = errors.New("resource version conflict")
+	ErrInvalidResource              = errors.New("invalid resource")
+	ErrEnvironmentFrozen            = errors.New("environment is frozen")
+	ErrInvalidListOptions           = errors.New("invalid list options")
+	ErrInvalidEventKind             = errors.New("invalid event kind")
+	ErrInvalidInput                 = errors.New("invalid input")
 )
 
 // Error codes for API responses
 const (
-	CodeProjectExists              = "PROJECT_EXISTS"
-	CodeProjectNotFound            = "PROJECT_NOT_FOUND"
-	CodeComponentExists            = "COMPONENT_EXISTS"
-	CodeComponentNotFound          = "COMPONENT_NOT_FOUND"
-	CodeComponentTypeExists        = "COMPONENT_TYPE_EXISTS"
-	CodeComponentTypeNotFound      = "COMPONENT_TYPE_NOT_FOUND"
-	CodeTraitExists                = "TRAIT_EXISTS"
-	CodeTraitNotFound              = "TRAIT_NOT_FOUND"
-	CodeOrganizationNotFound       = "ORGANIZATION_NOT_FOUND"
-	CodeEnvironmentNotFound        = "ENVIRONMENT_NOT_FOUND"
-	CodeEnvironmentExists          = "ENVIRONMENT_EXISTS"
-	CodeDataPlaneNotFound          = "DATAPLANE_NOT_FOUND"
-	CodeDataPlaneExists            = "DATAPLANE_EXISTS"
-	CodeBindingNotFound            = "BINDING_NOT_FOUND"
-	CodeDeploymentPipelineNotFound = "DEPLOYMENT_PIPELINE_NOT_FOUND"
-	CodeInvalidPromotionPath       = "INVALID_PROMOTION_PATH"
-	CodeWorkflowNotFound           = "WORKFLOW_NOT_FOUND"
-	CodeInvalidInput               = "INVALID_INPUT"
-	CodeInternalError              = "INTERNAL_ERROR"
+	CodeProjectExists                = "PROJECT_EXISTS"
+	CodeProjectNotFound              = "PROJECT_NOT_FOUND"
+	CodeComponentExists              = "COMPONENT_EXISTS"
+	CodeComponentNotFound            = "COMPONENT_NOT_FOUND"
+	CodeComponentTypeExists          = "COMPONENT_TYPE_EXISTS"
+	CodeComponentTypeNotFound        = "COMPONENT_TYPE_NOT_FOUND"
+	CodeTraitExists                  = "TRAIT_EXISTS"
+	CodeTraitNotFound                = "TRAIT_NOT_FOUND"
+	CodeOrganizationNotFound         = "ORGANIZATION_NOT_FOUND"
+	CodeEnvironmentNotFound          = "ENVIRONMENT_NOT_FOUND"
+	CodeEnvironmentExists            = "ENVIRONMENT_EXISTS"
+	CodeDataPlaneNotFound            = "DATAPLANE_NOT_FOUND"
+	CodeDataPlaneExists              = "DATAPLANE_EXISTS"
+	CodeBindingNotFound              = "BINDING_NOT_FOUND"
+	CodeDeploymentPipelineNotFound   = "DEPLOYMENT_PIPELINE_NOT_FOUND"
+	CodeInvalidPromotionPath         = "INVALID_PROMOTION_PATH"
+	CodeWorkflowExists               = "WORKFLOW_EXISTS"
+	CodeWorkflowNotFound             = "WORKFLOW_NOT_FOUND"
+	CodeComponentDeploymentNotFound  = "COMPONENT_DEPLOYMENT_NOT_FOUND"
+	CodeComponentEnvSnapshotNotFound = "COMPONENT_ENV_SNAPSHOT_NOT_FOUND"
+	CodeReleaseNotFound              = "RELEASE_NOT_FOUND"
+	CodeNoRolloutInProgress          = "NO_ROLLOUT_IN_PROGRESS"
+	CodeReleaseTrainNotFound         = "RELEASE_TRAIN_NOT_FOUND"
+	CodeBuildNotFound                = "BUILD_NOT_FOUND"
+	CodeObserverNotConfigured        = "OBSERVER_NOT_CONFIGURED"
+	CodeObserverUnavailable          = "OBSERVER_UNAVAILABLE"
+	CodeResourceVersionConflict      = "RESOURCE_VERSION_CONFLICT"
+	CodePreconditionFailed           = "PRECONDITION_FAILED"
+	CodeEnvironmentFrozen            = "ENVIRONMENT_FROZEN"
+	CodeFieldConflict                = "FIELD_CONFLICT"
+	CodeApplyFailed                  = "APPLY_FAILED"
+	CodeIdempotencyKeyInvalid        = "IDEMPOTENCY_KEY_INVALID"
+	CodeIdempotencyKeyMismatch       = "IDEMPOTENCY_KEY_MISMATCH"
+	CodeIdempotencyKeyInUse          = "IDEMPOTENCY_KEY_IN_USE"
+	CodeIdempotencyStoreFull         = "IDEMPOTENCY_STORE_FULL"
+	CodeRateLimited                  = "RATE_LIMITED"
+	CodeInvalidInput                 = "INVALID_INPUT"
+	CodeUnauthorized                 = "UNAUTHORIZED"
+	CodeForbidden                    = "FORBIDDEN"
+	CodeInternalError                = "INTERNAL_ERROR"
 )
+
+// errorCodes maps the service errors to their error codes
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{ErrProjectAlreadyExists, CodeProjectExists},
+	{ErrProjectNotFound, CodeProjectNotFound},
+	{ErrComponentAlreadyExists, CodeComponentExists},
+	{ErrComponentNotFound, CodeComponentNotFound},
+	{ErrComponentTypeAlreadyExists, CodeComponentTypeExists},
+	{ErrComponentTypeNotFound, CodeComponentTypeNotFound},
+	{ErrTraitAlreadyExists, CodeTraitExists},
+	{ErrTraitNotFound, CodeTraitNotFound},
+	{ErrOrganizationNotFound, CodeOrganizationNotFound},
+	{ErrEnvironmentNotFound, CodeEnvironmentNotFound},
+	{ErrEnvironmentAlreadyExists, CodeEnvironmentExists},
+	{ErrDataPlaneNotFound, CodeDataPlaneNotFound},
+	{ErrDataPlaneAlreadyExists, CodeDataPlaneExists},
+	{ErrBindingNotFound, CodeBindingNotFound},
+	{ErrDeploymentPipelineNotFound, CodeDeploymentPipelineNotFound},
+	{ErrInvalidPromotionPath, CodeInvalidPromotionPath},
+	{ErrWorkflowAlreadyExists, CodeWorkflowExists},
+	{ErrWorkflowNotFound, CodeWorkflowNotFound},
+	{ErrComponentDeploymentNotFound, CodeComponentDeploymentNotFound},
+	{ErrComponentEnvSnapshotNotFound, CodeComponentEnvSnapshotNotFound},
+	{ErrReleaseNotFound, CodeReleaseNotFound},
+	{ErrNoRolloutInProgress, CodeNoRolloutInProgress},
+	{ErrReleaseTrainNotFound, CodeReleaseTrainNotFound},
+	{ErrBuildNotFound, CodeBuildNotFound},
+	{ErrObserverNotConfigured, CodeObserverNotConfigured},
+	{ErrObserverUnavailable, CodeObserverUnavailable},
+	{ErrResourceVersionConflict, CodeResourceVersionConflict},
+	{ErrEnvironmentFrozen, CodeEnvironmentFrozen},
+	{ErrInvalidResource, CodeInvalidInput},
+	{ErrInvalidListOptions, CodeInvalidInput},
+	{ErrInvalidEventKind, CodeInvalidInput},
+	{ErrInvalidInput, CodeInvalidInput},
+}
+
+// ErrorCode returns the error code of a service error, or an empty string if the error is not a service error
+func ErrorCode(err error) string {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return ""
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// eventHubKey identifies the resources watched by a hub
+type eventHubKey struct {
+	org  string
+	kind string
+}
+
+// eventHub watches the resources of a kind in an organization once and fans their status changes out to
+// the subscribers. It keeps the last reported event of each resource, to drop updates that don't change
+// the status and to report the current status to subscribers that join a running watch.
+// The reported events are shared between the subscribers and must not be modified.
+type eventHub struct {
+	key    eventHubKey
+	logger *slog.Logger
+
+	// cancel stops the watch, it is called with the watchers count guarded by the event service
+	cancel   context.CancelFunc
+	watchers int
+
+	mu          sync.Mutex
+	reported    map[string]*models.ResourceEvent
+	subscribers map[*eventSubscriber]struct{}
+}
+
+func newEventHub(key eventHubKey, logger *slog.Logger) *eventHub {
+	return &eventHub{
+		key:         key,
+		logger:      logger,
+		reported:    make(map[string]*models.ResourceEvent),
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// subscribe queues the current status of the resources of the subscriber's scope as ADDED events,
+// followed by their status changes
+func (h *eventHub) subscribe(sub *eventSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := make([]string, 0, len(h.reported))
+	for name := range h.reported {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	current := make([]*models.ResourceEvent, 0, len(names))
+	for _, name := range names {
+		if event := h.reported[name]; sub.scope.matches(event) {
+			added := *event
+			added.Type = models.ResourceEventAdded
+			current = append(current, &added)
+		}
+	}
+	sub.enqueueCurrent(current)
+	h.subscribers[sub] = struct{}{}
+}
+
+func (h *eventHub) unsubscribe(sub *eventSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, sub)
+}
+
+// publish reports an event to the subscribers of its scope, unless it doesn't change the reported status.
+// The type of the event is set from the reported events: the first event of a resource is ADDED.
+func (h *eventHub) publish(event *models.ResourceEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last, seen := h.reported[event.Name]
+	if event.Type == models.ResourceEventDeleted {
+		if !seen {
+			return
+		}
+		delete(h.reported, event.Name)
+	} else {
+		if seen && statusFingerprint(last) == statusFingerprint(event) {
+			return
+		}
+		event.Type = models.ResourceEventAdded
+		if seen {
+			event.Type = models.ResourceEventModified
+		}
+		h.reported[event.Name] = event
+	}
+	for sub := range h.subscribers {
+		if sub.scope.matches(event) {
+			sub.enqueue(event)
+		}
+	}
+}
+
+// watch lists and watches the resources until the context is done. The watch is re-established
+// from the last seen resource version when it ends, and from a fresh list when that version has expired.
+func (h *eventHub) watch(ctx context.Context, c client.WithWatch) {
+	resourceVersion := ""
+	for ctx.Err() == nil {
+		if resourceVersion == "" {
+			rv, err := h.relist(ctx, c)
+			if err != nil {
+				if ctx.Err() == nil {
+					h.logger.Warn("Failed to list resources for watch", "kind", h.key.kind, "org", h.key.org, "error", err)
+					sleepContext(ctx, watchRetryInterval)
+				}
+				continue
+			}
+			resourceVersion = rv
+		}
+
+		w, err := c.Watch(ctx, newEventObjectList(h.key.kind), client.InNamespace(h.key.org), &client.ListOptions{
+			Raw: &metav1.ListOptions{ResourceVersion: resourceVersion, AllowWatchBookmarks: true},
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				h.logger.Warn("Failed to watch resources", "kind", h.key.kind, "org", h.key.org, "error", err)
+				resourceVersion = ""
+				sleepContext(ctx, watchRetryInterval)
+			}
+			continue
+		}
+		resourceVersion = h.consumeWatch(ctx, w, resourceVersion)
+		w.Stop()
+	}
+}
+
+// relist reports the current status of the resources, including the deletion of reported resources
+// that no longer exist, and returns the resource version to watch from
+func (h *eventHub) relist(ctx context.Context, c client.Client) (string, error) {
+	list := newEventObjectList(h.key.kind)
+	if err := c.List(ctx, list, client.InNamespace(h.key.org)); err != nil {
+		return "", err
+	}
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return "", err
+	}
+
+	existing := make(map[string]bool, len(objects))
+	for _, obj := range objects {
+		event := toResourceEvent(models.ResourceEventAdded, obj.(client.Object))
+		existing[event.Name] = true
+		h.publish(event)
+	}
+	for _, deleted := range h.reportedExcept(existing) {
+		h.publish(deleted)
+	}
+	return list.GetResourceVersion(), nil
+}
+
+// reportedExcept returns DELETED events of the reported resources that are not in the existing resources
+func (h *eventHub) reportedExcept(existing map[string]bool) []*models.ResourceEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var deleted []*models.ResourceEvent
+	for name, last := range h.reported {
+		if !existing[name] {
+			event := *last
+			event.Type = models.ResourceEventDeleted
+			event.Timestamp = time.Now()
+			deleted = append(deleted, &event)
+		}
+	}
+	return deleted
+}
+
+// consumeWatch reports the events of a watch until it ends and returns the resource version to resume from,
+// which is empty when the watched version has expired and the resources have to be listed again
+func (h *eventHub) consumeWatch(ctx context.Context, w watch.Interface, resourceVersion string) string {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return resourceVersion
+			}
+			switch e.Type {
+			case watch.Error:
+				err := apierrors.FromObject(e.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					return ""
+				}
+				h.logger.Warn("Watch failed", "kind", h.key.kind, "org", h.key.org, "error", err)
+				return resourceVersion
+			case watch.Bookmark:
+				if obj, ok := e.Object.(client.Object); ok {
+					resourceVersion = obj.GetResourceVersion()
+				}
+			case watch.Added, watch.Modified, watch.Deleted:
+				obj, ok := e.Object.(client.Object)
+				if !ok {
+					continue
+				}
+				resourceVersion = obj.GetResourceVersion()
+				eventType := models.ResourceEventModified
+				if e.Type == watch.Deleted {
+					eventType = models.ResourceEventDeleted
+				}
+				h.publish(toResourceEvent(eventType, obj))
+			}
+		}
+	}
+}
+
+// eventSubscriber queues the events of a watcher, so that a slow watcher doesn't hold up the shared watches.
+// A watcher that falls more than maxQueuedEvents status changes behind its initial events is marked as
+// lagging and stops receiving events.
+type eventSubscriber struct {
+	scope EventScope
+	// notify is signalled when events are queued
+	notify chan struct{}
+
+	mu    sync.Mutex
+	queue []*models.ResourceEvent
+	// limit is the length of the queue beyond which the subscriber is lagging
+	limit   int
+	lagging bool
+}
+
+func newEventSubscriber(scope EventScope) *eventSubscriber {
+	return &eventSubscriber{scope: scope, notify: make(chan struct{}, 1), limit: maxQueuedEvents}
+}
+
+// enqueueCurrent queues the current status of the resources, which is not limited by maxQueuedEvents
+func (s *eventSubscriber) enqueueCurrent(events []*models.ResourceEvent) {
+	s.mu.Lock()
+	s.queue = append(s.queue, events...)
+	s.limit += len(events)
+	s.mu.Unlock()
+	s.signal()
+}
+
+// enqueue queues a status change, unless the subscriber is lagging
+func (s *eventSubscriber) enqueue(event *models.ResourceEvent) {
+	s.mu.Lock()
+	switch {
+	case s.lagging:
+	case len(s.queue) >= s.limit:
+		s.lagging = true
+	default:
+		s.queue = append(s.queue, event)
+	}
+	s.mu.Unlock()
+	s.signal()
+}
+
+func (s *eventSubscriber) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next queued event, or nil and whether the subscriber is lagging when the queue is empty
+func (s *eventSubscriber) next() (*models.ResourceEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queue) == 0 {
+		return nil, s.lagging
+	}
+	event := s.queue[0]
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
+	return event, false
+}
@@ -0,0 +1,296 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+const (
+	// eventBufferSize is the number of events buffered on the channel of a watcher
+	eventBufferSize = 64
+	// maxQueuedEvents is the number of status changes queued for a slow watcher before its watch is ended
+	maxQueuedEvents = 1024
+	// watchRetryInterval is the delay before a failed watch is re-established
+	watchRetryInterval = 2 * time.Second
+)
+
+// EventScope selects the resources whose events are reported.
+// An empty project or component name matches all projects or components.
+type EventScope struct {
+	OrgName       string
+	ProjectName   string
+	ComponentName string
+	// Kinds restricts the events to the given kinds, all kinds are reported when empty
+	Kinds []string
+}
+
+func (s EventScope) matches(event *models.ResourceEvent) bool {
+	return (s.ProjectName == "" || s.ProjectName == event.ProjectName) &&
+		(s.ComponentName == "" || s.ComponentName == event.ComponentName)
+}
+
+// EventService reports status changes of components and the builds, releases and deployments that belong to them
+type EventService struct {
+	k8sClient        client.Client
+	projectService   *ProjectService
+	componentService *ComponentService
+	logger           *slog.Logger
+
+	// hubs share the watches of the resources of a kind in an organization between the watchers
+	hubsMu sync.Mutex
+	hubs   map[eventHubKey]*eventHub
+}
+
+// NewEventService creates a new event service
+func NewEventService(k8sClient client.Client, projectService *ProjectService, componentService *ComponentService, logger *slog.Logger) *EventService {
+	return &EventService{
+		k8sClient:        k8sClient,
+		projectService:   projectService,
+		componentService: componentService,
+		logger:           logger,
+		hubs:             make(map[eventHubKey]*eventHub),
+	}
+}
+
+// ListEvents returns the current status of the resources of the scope as ADDED events
+func (s *EventService) ListEvents(ctx context.Context, scope EventScope) ([]*models.ResourceEvent, error) {
+	kinds, err := s.validateScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*models.ResourceEvent
+	for _, kind := range kinds {
+		list := newEventObjectList(kind)
+		if err := s.k8sClient.List(ctx, list, client.InNamespace(scope.OrgName)); err != nil {
+			return nil, fmt.Errorf("failed to list %s resources: %w", kind, err)
+		}
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract %s resources: %w", kind, err)
+		}
+		for _, obj := range objects {
+			event := toResourceEvent(models.ResourceEventAdded, obj.(client.Object))
+			if scope.matches(event) {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// WatchEvents watches the resources of the scope and reports their status changes on the returned channel.
+// The current status of each resource is reported first as an ADDED event. Updates that don't change the
+// status of a resource are not reported. The channel is closed once the context is done, or when the watcher
+// falls too far behind the status changes, in which case it has to watch again.
+func (s *EventService) WatchEvents(ctx context.Context, scope EventScope) (<-chan *models.ResourceEvent, error) {
+	kinds, err := s.validateScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	watchClient, ok := s.k8sClient.(client.WithWatch)
+	if !ok {
+		return nil, fmt.Errorf("kubernetes client does not support watches")
+	}
+
+	sub := newEventSubscriber(scope)
+	hubs := make([]*eventHub, 0, len(kinds))
+	for _, kind := range kinds {
+		hubs = append(hubs, s.subscribe(watchClient, eventHubKey{org: scope.OrgName, kind: kind}, sub))
+	}
+
+	events := make(chan *models.ResourceEvent, eventBufferSize)
+	go func() {
+		defer close(events)
+		defer s.unsubscribe(hubs, sub)
+		for {
+			event, lagging := sub.next()
+			if event == nil {
+				if lagging {
+					s.logger.Warn("Ending the watch of a watcher that fell behind", "org", scope.OrgName,
+						"project", scope.ProjectName, "component", scope.ComponentName)
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-sub.notify:
+				}
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// subscribe adds the subscriber to the hub of the key, and starts the watch of the hub if it has no other subscribers
+func (s *EventService) subscribe(c client.WithWatch, key eventHubKey, sub *eventSubscriber) *eventHub {
+	s.hubsMu.Lock()
+	hub, ok := s.hubs[key]
+	if !ok {
+		hub = newEventHub(key, s.logger)
+		s.hubs[key] = hub
+		ctx, cancel := context.WithCancel(context.Background())
+		hub.cancel = cancel
+		go hub.watch(ctx, c)
+	}
+	hub.watchers++
+	s.hubsMu.Unlock()
+
+	hub.subscribe(sub)
+	return hub
+}
+
+// unsubscribe removes the subscriber from the hubs, and stops the watches of the hubs that have no subscribers left
+func (s *EventService) unsubscribe(hubs []*eventHub, sub *eventSubscriber) {
+	for _, hub := range hubs {
+		hub.unsubscribe(sub)
+
+		s.hubsMu.Lock()
+		hub.watchers--
+		if hub.watchers == 0 {
+			hub.cancel()
+			delete(s.hubs, hub.key)
+		}
+		s.hubsMu.Unlock()
+	}
+}
+
+// validateScope checks that the project and component of the scope exist and returns the kinds to report
+func (s *EventService) validateScope(ctx context.Context, scope EventScope) ([]string, error) {
+	for _, kind := range scope.Kinds {
+		if !slices.Contains(models.EventKinds, kind) {
+			return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalidEventKind, kind)
+		}
+	}
+	if scope.ComponentName != "" {
+		if _, err := s.componentService.GetComponent(ctx, scope.OrgName, scope.ProjectName, scope.ComponentName, nil); err != nil {
+			return nil, err
+		}
+	} else if scope.ProjectName != "" {
+		if _, err := s.projectService.GetProject(ctx, scope.OrgName, scope.ProjectName); err != nil {
+			return nil, err
+		}
+	}
+	if len(scope.Kinds) == 0 {
+		return models.EventKinds, nil
+	}
+	return scope.Kinds, nil
+}
+
+func newEventObjectList(kind string) client.ObjectList {
+	switch kind {
+	case models.EventKindComponent:
+		return &openchoreov1alpha1.ComponentList{}
+	case models.EventKindWorkflowRun:
+		return &openchoreov1alpha1.WorkflowRunList{}
+	case models.EventKindRelease:
+		return &openchoreov1alpha1.ReleaseList{}
+	case models.EventKindComponentDeployment:
+		return &openchoreov1alpha1.ComponentDeploymentList{}
+	}
+	return nil
+}
+
+// toResourceEvent converts a watched resource to an event that describes its status
+func toResourceEvent(eventType models.ResourceEventType, obj client.Object) *models.ResourceEvent {
+	event := &models.ResourceEvent{
+		Type:            eventType,
+		Name:            obj.GetName(),
+		OrgName:         obj.GetNamespace(),
+		ResourceVersion: obj.GetResourceVersion(),
+		Timestamp:       time.Now(),
+	}
+
+	var conditions []metav1.Condition
+	switch o := obj.(type) {
+	case *openchoreov1alpha1.Component:
+		event.Kind = models.EventKindComponent
+		event.ProjectName = o.Spec.Owner.ProjectName
+		event.ComponentName = o.Name
+		conditions = o.Status.Conditions
+		event.Status = readyStatus(conditions)
+	case *openchoreov1alpha1.WorkflowRun:
+		event.Kind = models.EventKindWorkflowRun
+		event.ProjectName = o.Spec.Owner.ProjectName
+		event.ComponentName = o.Spec.Owner.ComponentName
+		conditions = o.Status.Conditions
+		event.Status = GetLatestWorkflowStatus(conditions)
+	case *openchoreov1alpha1.Release:
+		event.Kind = models.EventKindRelease
+		event.ProjectName = o.Spec.Owner.ProjectName
+		event.ComponentName = o.Spec.Owner.ComponentName
+		event.Environment = o.Spec.EnvironmentName
+		conditions = o.Status.Conditions
+		event.Status = readyStatus(conditions)
+	case *openchoreov1alpha1.ComponentDeployment:
+		event.Kind = models.EventKindComponentDeployment
+		event.ProjectName = o.Spec.Owner.ProjectName
+		event.ComponentName = o.Spec.Owner.ComponentName
+		event.Environment = o.Spec.Environment
+		conditions = o.Status.Conditions
+		event.Status = readyStatus(conditions)
+		event.Rollout = toRolloutEventStatus(o.Status.Rollout)
+	}
+
+	if ready := meta.FindStatusCondition(conditions, statusReady); ready != nil {
+		event.Reason = ready.Reason
+		event.Message = ready.Message
+	}
+	event.Conditions = toConditionResponses(conditions)
+	return event
+}
+
+// readyStatus summarizes the Ready condition of a resource
+func readyStatus(conditions []metav1.Condition) string {
+	ready := meta.FindStatusCondition(conditions, statusReady)
+	switch {
+	case ready == nil:
+		return "Pending"
+	case ready.Status == metav1.ConditionTrue:
+		return "Ready"
+	case ready.Status == metav1.ConditionFalse:
+		return "NotReady"
+	default:
+		return "Unknown"
+	}
+}
+
+// statusFingerprint identifies the reported status of an event, so that updates of other fields can be dropped
+func statusFingerprint(event *models.ResourceEvent) string {
+	data, _ := json.Marshal(struct {
+		Status     string
+		Conditions []models.ConditionResponse
+		Rollout    *models.RolloutEventStatus
+	}{event.Status, event.Conditions, event.Rollout})
+	return string(data)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func testRelease(name, project, component string, ready metav1.ConditionStatus) *openchoreov1alpha1.Release {
+	return &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testOrg},
+		Spec: openchoreov1alpha1.ReleaseSpec{
+			Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: project, ComponentName: component},
+			EnvironmentName: "development",
+		},
+		Status: openchoreov1alpha1.ReleaseStatus{
+			Conditions: []metav1.Condition{{Type: statusReady, Status: ready, Reason: "Test"}},
+		},
+	}
+}
+
+func newTestEventHub() *eventHub {
+	return newEventHub(eventHubKey{org: testOrg, kind: models.EventKindRelease}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// drain returns the queued events of the subscriber as type/name pairs
+func drain(sub *eventSubscriber) string {
+	var events []string
+	for event, _ := sub.next(); event != nil; event, _ = sub.next() {
+		events = append(events, string(event.Type)+"/"+event.Name)
+	}
+	return fmt.Sprint(events)
+}
+
+func TestEventHubDropsUnchangedStatus(t *testing.T) {
+	hub := newTestEventHub()
+	sub := newEventSubscriber(EventScope{OrgName: testOrg})
+	hub.subscribe(sub)
+
+	release := testRelease("cart-dev", "shop", "cart", metav1.ConditionFalse)
+	hub.publish(toResourceEvent(models.ResourceEventModified, release))
+
+	// Updates of other fields than the status are not reported
+	release.ResourceVersion = "2"
+	release.Labels = map[string]string{"team": "checkout"}
+	hub.publish(toResourceEvent(models.ResourceEventModified, release))
+
+	release.Status.Conditions[0].Status = metav1.ConditionTrue
+	hub.publish(toResourceEvent(models.ResourceEventModified, release))
+	hub.publish(toResourceEvent(models.ResourceEventDeleted, release))
+
+	// Deletions of resources that were never reported are not reported
+	hub.publish(toResourceEvent(models.ResourceEventDeleted, testRelease("unknown", "shop", "cart", metav1.ConditionTrue)))
+
+	want := fmt.Sprint([]string{"ADDED/cart-dev", "MODIFIED/cart-dev", "DELETED/cart-dev"})
+	if got := drain(sub); got != want {
+		t.Errorf("events = %s, want %s", got, want)
+	}
+}
+
+func TestEventHubFiltersByScope(t *testing.T) {
+	hub := newTestEventHub()
+	all := newEventSubscriber(EventScope{OrgName: testOrg})
+	project := newEventSubscriber(EventScope{OrgName: testOrg, ProjectName: "shop"})
+	component := newEventSubscriber(EventScope{OrgName: testOrg, ProjectName: "shop", ComponentName: "cart"})
+	for _, sub := range []*eventSubscriber{all, project, component} {
+		hub.subscribe(sub)
+	}
+
+	hub.publish(toResourceEvent(models.ResourceEventAdded, testRelease("cart-dev", "shop", "cart", metav1.ConditionTrue)))
+	hub.publish(toResourceEvent(models.ResourceEventAdded, testRelease("checkout-dev", "shop", "checkout", metav1.ConditionTrue)))
+	hub.publish(toResourceEvent(models.ResourceEventAdded, testRelease("invoice-dev", "billing", "invoice", metav1.ConditionTrue)))
+
+	for name, tt := range map[string]struct {
+		sub  *eventSubscriber
+		want []string
+	}{
+		"org":       {all, []string{"ADDED/cart-dev", "ADDED/checkout-dev", "ADDED/invoice-dev"}},
+		"project":   {project, []string{"ADDED/cart-dev", "ADDED/checkout-dev"}},
+		"component": {component, []string{"ADDED/cart-dev"}},
+	} {
+		if got := drain(tt.sub); got != fmt.Sprint(tt.want) {
+			t.Errorf("events of the %s scope = %s, want %v", name, got, tt.want)
+		}
+	}
+
+	// Subscribers joining a running watch receive the current status of their scope as ADDED events
+	hub.publish(toResourceEvent(models.ResourceEventModified, testRelease("cart-dev", "shop", "cart", metav1.ConditionFalse)))
+	late := newEventSubscriber(EventScope{OrgName: testOrg, ProjectName: "shop"})
+	hub.subscribe(late)
+	if got, want := drain(late), fmt.Sprint([]string{"ADDED/cart-dev", "ADDED/checkout-dev"}); got != want {
+		t.Errorf("initial events of a late subscriber = %s, want %s", got, want)
+	}
+	if got, want := drain(component), fmt.Sprint([]string{"MODIFIED/cart-dev"}); got != want {
+		t.Errorf("events of a running subscriber = %s, want %s", got, want)
+	}
+}
+
+func TestEventHubRelistReportsDeletions(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(
+		testRelease("cart-dev", "shop", "cart", metav1.ConditionTrue),
+		testRelease("checkout-dev", "shop", "checkout", metav1.ConditionTrue),
+	).Build()
+	hub := newTestEventHub()
+	sub := newEventSubscriber(EventScope{OrgName: testOrg})
+	hub.subscribe(sub)
+
+	if _, err := hub.relist(ctx, k8sClient); err != nil {
+		t.Fatalf("relist() = %v", err)
+	}
+	if got, want := drain(sub), fmt.Sprint([]string{"ADDED/cart-dev", "ADDED/checkout-dev"}); got != want {
+		t.Errorf("events of the first list = %s, want %s", got, want)
+	}
+
+	// A resource deleted while the watch was expired is reported as deleted by the next list
+	if err := k8sClient.Delete(ctx, testRelease("checkout-dev", "shop", "checkout", metav1.ConditionTrue)); err != nil {
+		t.Fatalf("failed to delete the release: %v", err)
+	}
+	if _, err := hub.relist(ctx, k8sClient); err != nil {
+		t.Fatalf("relist() = %v", err)
+	}
+	if got, want := drain(sub), fmt.Sprint([]string{"DELETED/checkout-dev"}); got != want {
+		t.Errorf("events of the relist = %s, want %s", got, want)
+	}
+}
+
+func TestEventHubConsumeWatch(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestEventHub()
+	sub := newEventSubscriber(EventScope{OrgName: testOrg})
+	hub.subscribe(sub)
+
+	release := testRelease("cart-dev", "shop", "cart", metav1.ConditionTrue)
+	release.ResourceVersion = "5"
+	w := watch.NewFake()
+	go func() {
+		w.Add(release)
+		w.Action(watch.Bookmark, &openchoreov1alpha1.Release{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "7"}})
+		w.Stop()
+	}()
+	if rv := hub.consumeWatch(ctx, w, "1"); rv != "7" {
+		t.Errorf("resource version after the watch ended = %q, want 7", rv)
+	}
+	if got, want := drain(sub), fmt.Sprint([]string{"ADDED/cart-dev"}); got != want {
+		t.Errorf("events = %s, want %s", got, want)
+	}
+
+	// An expired resource version requires a relist
+	expired := apierrors.NewResourceExpired("too old resource version")
+	w = watch.NewFake()
+	go w.Error(&expired.ErrStatus)
+	if rv := hub.consumeWatch(ctx, w, "7"); rv != "" {
+		t.Errorf("resource version after the watch expired = %q, want empty", rv)
+	}
+
+	// Other watch errors resume from the last seen version
+	w = watch.NewFake()
+	go w.Error(&apierrors.NewInternalError(fmt.Errorf("etcd unavailable")).ErrStatus)
+	if rv := hub.consumeWatch(ctx, w, "7"); rv != "7" {
+		t.Errorf("resource version after a failed watch = %q, want 7", rv)
+	}
+}
+
+func TestEventSubscriberLagging(t *testing.T) {
+	sub := newEventSubscriber(EventScope{OrgName: testOrg})
+	current := make([]*models.ResourceEvent, 3)
+	for i := range current {
+		current[i] = &models.ResourceEvent{Name: fmt.Sprintf("current-%d", i)}
+	}
+	// The initial events don't count towards the limit of queued status changes
+	sub.enqueueCurrent(current)
+	for i := 0; i <= maxQueuedEvents; i++ {
+		sub.enqueue(&models.ResourceEvent{Name: fmt.Sprintf("change-%d", i)})
+	}
+
+	queued := 0
+	for event, _ := sub.next(); event != nil; event, _ = sub.next() {
+		queued++
+	}
+	if queued != len(current)+maxQueuedEvents {
+		t.Errorf("queued events = %d, want %d", queued, len(current)+maxQueuedEvents)
+	}
+	if _, lagging := sub.next(); !lagging {
+		t.Error("subscriber beyond the limit is not lagging")
+	}
+}
+
+// receive returns the next event of the channel, or nil if it is closed
+func receive(t *testing.T, events <-chan *models.ResourceEvent) *models.ResourceEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+		return nil
+	}
+}
+
+func TestWatchEventsSharesWatches(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(
+		testRelease("cart-dev", "shop", "cart", metav1.ConditionTrue),
+	).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	projectService := NewProjectService(k8sClient, logger)
+	service := NewEventService(k8sClient, projectService, NewComponentService(k8sClient, projectService, logger), logger)
+
+	scope := EventScope{OrgName: testOrg, Kinds: []string{models.EventKindRelease}}
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	events1, err := service.WatchEvents(ctx1, scope)
+	if err != nil {
+		t.Fatalf("WatchEvents() = %v", err)
+	}
+	events2, err := service.WatchEvents(ctx2, scope)
+	if err != nil {
+		t.Fatalf("WatchEvents() = %v", err)
+	}
+
+	service.hubsMu.Lock()
+	hubs, watchers := len(service.hubs), service.hubs[eventHubKey{org: testOrg, kind: models.EventKindRelease}].watchers
+	service.hubsMu.Unlock()
+	if hubs != 1 || watchers != 2 {
+		t.Errorf("hubs = %d with %d watchers, want 1 with 2", hubs, watchers)
+	}
+
+	for _, events := range []<-chan *models.ResourceEvent{events1, events2} {
+		if event := receive(t, events); event == nil || event.Type != models.ResourceEventAdded || event.Name != "cart-dev" {
+			t.Errorf("first event = %+v, want ADDED cart-dev", event)
+		}
+	}
+
+	// The watch is stopped once its last watcher is done
+	cancel1()
+	if event := receive(t, events1); event != nil {
+		t.Errorf("event after the watch was cancelled = %+v", event)
+	}
+	cancel2()
+	if event := receive(t, events2); event != nil {
+		t.Errorf("event after the watch was cancelled = %+v", event)
+	}
+	service.hubsMu.Lock()
+	defer service.hubsMu.Unlock()
+	if len(service.hubs) != 0 {
+		t.Errorf("hubs after the watchers are done = %d, want 0", len(service.hubs))
+	}
+}
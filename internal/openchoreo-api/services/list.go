@@ -0,0 +1,248 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// continueToken is the opaque continue token of list pages. Pages of lists sorted by name are read
+// with Kubernetes list continuation, the other sort orders and field selectors need all items to be
+// listed and are paged by offset. Page is the number of the page the token continues with.
+type continueToken struct {
+	Kubernetes string `json:"k,omitempty"`
+	Offset     int    `json:"o,omitempty"`
+	Page       int    `json:"p,omitempty"`
+}
+
+// page returns the number of the page the token continues with, 1 for the first page
+func (t continueToken) page() int {
+	return max(t.Page, 1)
+}
+
+func encodeContinueToken(token continueToken) string {
+	data, _ := json.Marshal(token) // Marshaling a struct of strings and ints doesn't fail
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeContinueToken(s string) (continueToken, error) {
+	var token continueToken
+	if s == "" {
+		return token, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, fmt.Errorf("%w: malformed continue token", ErrInvalidListOptions)
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, fmt.Errorf("%w: malformed continue token", ErrInvalidListOptions)
+	}
+	return token, nil
+}
+
+// listResources lists a page of resources with the list options. newList creates an empty list of the
+// resources, and convert returns the response items of a listed page, leaving out the resources that
+// don't belong to the listed scope.
+func listResources[L client.ObjectList, T any](
+	ctx context.Context, c client.Client, opts *models.ListOptions,
+	newList func() L, convert func(L) []T, listOpts ...client.ListOption,
+) (*models.ListPage[T], error) {
+	if opts == nil {
+		opts = &models.ListOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidListOptions, err)
+	}
+	token, err := decodeContinueToken(opts.Continue)
+	if err != nil {
+		return nil, err
+	}
+	if opts.LabelSelector != "" {
+		selector, err := labels.Parse(opts.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid label selector: %w", ErrInvalidListOptions, err)
+		}
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	if opts.FieldSelector == "" && (opts.Sort == "" || opts.Sort == "name") {
+		return listByContinuation(ctx, c, opts.Limit, token, newList, convert, listOpts)
+	}
+
+	list := newList()
+	if err := c.List(ctx, list, listOpts...); err != nil {
+		return nil, err
+	}
+	return pageItems(convert(list), opts, token)
+}
+
+// listByContinuation reads the pages of the Kubernetes list until the limit is reached. The pages
+// are requested with the number of missing items as limit, so that no converted item is left over.
+func listByContinuation[L client.ObjectList, T any](
+	ctx context.Context, c client.Client, limit int, token continueToken,
+	newList func() L, convert func(L) []T, listOpts []client.ListOption,
+) (*models.ListPage[T], error) {
+	if token.Offset != 0 {
+		return nil, fmt.Errorf("%w: the continue token belongs to a differently sorted list", ErrInvalidListOptions)
+	}
+
+	page := &models.ListPage[T]{Items: []T{}, Total: -1, Page: token.page()}
+	next := token.Kubernetes
+	for {
+		pageOpts := slices.Clone(listOpts)
+		if limit > 0 {
+			pageOpts = append(pageOpts, client.Limit(int64(limit-len(page.Items))))
+		}
+		if next != "" {
+			pageOpts = append(pageOpts, client.Continue(next))
+		}
+
+		list := newList()
+		if err := c.List(ctx, list, pageOpts...); err != nil {
+			if apierrors.IsResourceExpired(err) {
+				return nil, fmt.Errorf("%w: the continue token has expired, restart the list", ErrInvalidListOptions)
+			}
+			return nil, err
+		}
+		page.Items = append(page.Items, convert(list)...)
+		next = list.GetContinue()
+
+		if next == "" || limit == 0 || len(page.Items) >= limit {
+			break
+		}
+	}
+
+	if next != "" {
+		page.Continue = encodeContinueToken(continueToken{Kubernetes: next, Page: page.Page + 1})
+	} else if token.Kubernetes == "" {
+		page.Total = len(page.Items)
+	}
+	return page, nil
+}
+
+// pageItems filters and sorts all items in memory and returns the page at the offset of the token
+func pageItems[T any](items []T, opts *models.ListOptions, token continueToken) (*models.ListPage[T], error) {
+	offset := token.Offset
+	if offset < 0 || (opts.Continue != "" && offset == 0) {
+		return nil, fmt.Errorf("%w: the continue token belongs to a differently sorted list", ErrInvalidListOptions)
+	}
+	requirements, err := parseFieldSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		item   T
+		object map[string]any
+	}
+	entries := make([]entry, 0, len(items))
+	for _, item := range items {
+		object, err := models.ToJSONObject(item)
+		if err != nil {
+			return nil, err
+		}
+		if matchesFieldSelector(object, requirements) {
+			entries = append(entries, entry{item: item, object: object})
+		}
+	}
+
+	field, descending := strings.CutPrefix(cmp.Or(opts.Sort, "name"), "-")
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		c := compareFields(a.object, b.object, field)
+		if c == 0 {
+			c = compareFields(a.object, b.object, "name")
+		}
+		if descending {
+			return -c
+		}
+		return c
+	})
+
+	page := &models.ListPage[T]{Items: []T{}, Total: len(entries), Page: token.page()}
+	end := len(entries)
+	if opts.Limit > 0 && offset+opts.Limit < end {
+		end = offset + opts.Limit
+		page.Continue = encodeContinueToken(continueToken{Offset: end, Page: page.Page + 1})
+	}
+	for i := offset; i < end; i++ {
+		page.Items = append(page.Items, entries[i].item)
+	}
+	return page, nil
+}
+
+// fieldRequirement is a requirement of a field selector
+type fieldRequirement struct {
+	field  string
+	value  string
+	negate bool
+}
+
+// parseFieldSelector parses comma separated field requirements of the forms field=value, field==value and field!=value
+func parseFieldSelector(selector string) ([]fieldRequirement, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	var requirements []fieldRequirement
+	for _, term := range strings.Split(selector, ",") {
+		var r fieldRequirement
+		var ok bool
+		if r.field, r.value, ok = strings.Cut(term, "!="); ok {
+			r.negate = true
+		} else if r.field, r.value, ok = strings.Cut(term, "=="); !ok {
+			r.field, r.value, ok = strings.Cut(term, "=")
+		}
+		r.field = strings.TrimSpace(r.field)
+		r.value = strings.TrimSpace(r.value)
+		if !ok || r.field == "" {
+			return nil, fmt.Errorf("%w: invalid field selector %q", ErrInvalidListOptions, term)
+		}
+		requirements = append(requirements, r)
+	}
+	return requirements, nil
+}
+
+func matchesFieldSelector(object map[string]any, requirements []fieldRequirement) bool {
+	for _, r := range requirements {
+		value, _ := models.LookupField(object, r.field)
+		if (fieldString(value) == r.value) == r.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// compareFields orders the values of a field of two items. Missing values are ordered first.
+func compareFields(a, b map[string]any, field string) int {
+	va, _ := models.LookupField(a, field)
+	vb, _ := models.LookupField(b, field)
+	na, aIsNumber := va.(float64)
+	nb, bIsNumber := vb.(float64)
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(na, nb)
+	}
+	return strings.Compare(fieldString(va), fieldString(vb))
+}
+
+func fieldString(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// pagingClient serves the projects in pages like the Kubernetes API server, with the index of the
+// next project as continue token
+type pagingClient struct {
+	client.Client
+	projects []openchoreov1alpha1.Project
+	// limits are the limits of the list requests
+	limits []int64
+}
+
+func (c *pagingClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	c.limits = append(c.limits, listOpts.Limit)
+
+	start := 0
+	if listOpts.Continue != "" {
+		var err error
+		if start, err = strconv.Atoi(listOpts.Continue); err != nil {
+			return apierrors.NewResourceExpired("the continue token has expired")
+		}
+	}
+	end := len(c.projects)
+	if listOpts.Limit > 0 && start+int(listOpts.Limit) < end {
+		end = start + int(listOpts.Limit)
+	}
+	projects := list.(*openchoreov1alpha1.ProjectList)
+	projects.Items = c.projects[start:end]
+	if end < len(c.projects) {
+		projects.Continue = strconv.Itoa(end)
+	}
+	return nil
+}
+
+func newPagingClient(count int) *pagingClient {
+	c := &pagingClient{}
+	for i := range count {
+		c.projects = append(c.projects, openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("p%d", i)}})
+	}
+	return c
+}
+
+// evenProjects converts the projects of a list, leaving out those with an odd number like resources of another scope
+func evenProjects(list *openchoreov1alpha1.ProjectList) []listItem {
+	var items []listItem
+	for _, project := range list.Items {
+		if n, _ := strconv.Atoi(project.Name[1:]); n%2 == 0 {
+			items = append(items, listItem{Name: project.Name})
+		}
+	}
+	return items
+}
+
+func newProjectList() *openchoreov1alpha1.ProjectList {
+	return &openchoreov1alpha1.ProjectList{}
+}
+
+func TestListByContinuation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fills the page over several requests", func(t *testing.T) {
+		c := newPagingClient(10)
+		page, err := listByContinuation(ctx, c, 3, continueToken{}, newProjectList, evenProjects, nil)
+		if err != nil {
+			t.Fatalf("listByContinuation() = %v", err)
+		}
+		if itemNames(page.Items) != "[p0 p2 p4]" || page.Total != -1 {
+			t.Errorf("page = %s of %d, want [p0 p2 p4] of -1", itemNames(page.Items), page.Total)
+		}
+		// Each request asks for the missing items only, so that no converted item is left over
+		if fmt.Sprint(c.limits) != "[3 1 1]" {
+			t.Errorf("request limits = %v, want [3 1 1]", c.limits)
+		}
+		token, err := decodeContinueToken(page.Continue)
+		if err != nil || token.Kubernetes != "5" || token.Page != 2 {
+			t.Fatalf("continue token = %+v, %v, want the Kubernetes token 5 of page 2", token, err)
+		}
+
+		next, err := listByContinuation(ctx, c, 3, token, newProjectList, evenProjects, nil)
+		if err != nil {
+			t.Fatalf("listByContinuation() of the next page = %v", err)
+		}
+		if itemNames(next.Items) != "[p6 p8]" || next.Continue != "" || next.Total != -1 {
+			t.Errorf("last page = %s of %d continued by %q, want [p6 p8] of -1", itemNames(next.Items), next.Total, next.Continue)
+		}
+		if page.Page != 1 || next.Page != 2 {
+			t.Errorf("page numbers = %d, %d, want 1, 2", page.Page, next.Page)
+		}
+	})
+
+	t.Run("lists all items without a limit", func(t *testing.T) {
+		page, err := listByContinuation(ctx, newPagingClient(5), 0, continueToken{}, newProjectList, evenProjects, nil)
+		if err != nil {
+			t.Fatalf("listByContinuation() = %v", err)
+		}
+		if itemNames(page.Items) != "[p0 p2 p4]" || page.Total != 3 || page.Continue != "" {
+			t.Errorf("page = %s of %d continued by %q, want [p0 p2 p4] of 3", itemNames(page.Items), page.Total, page.Continue)
+		}
+	})
+
+	t.Run("returns an empty page", func(t *testing.T) {
+		page, err := listByContinuation(ctx, newPagingClient(0), 3, continueToken{}, newProjectList, evenProjects, nil)
+		if err != nil {
+			t.Fatalf("listByContinuation() = %v", err)
+		}
+		if page.Items == nil || len(page.Items) != 0 || page.Total != 0 {
+			t.Errorf("page = %s of %d, want an empty page of 0", itemNames(page.Items), page.Total)
+		}
+	})
+
+	t.Run("rejects an expired continue token", func(t *testing.T) {
+		_, err := listByContinuation(ctx, newPagingClient(5), 3, continueToken{Kubernetes: "expired"}, newProjectList, evenProjects, nil)
+		if !errors.Is(err, ErrInvalidListOptions) {
+			t.Errorf("listByContinuation() = %v, want ErrInvalidListOptions", err)
+		}
+	})
+
+	t.Run("rejects a continue token of a sorted list", func(t *testing.T) {
+		_, err := listByContinuation(ctx, newPagingClient(5), 3, continueToken{Offset: 3}, newProjectList, evenProjects, nil)
+		if !errors.Is(err, ErrInvalidListOptions) {
+			t.Errorf("listByContinuation() = %v, want ErrInvalidListOptions", err)
+		}
+	})
+
+	t.Run("passes other errors through", func(t *testing.T) {
+		notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "projects"}, "")
+		_, err := listByContinuation(ctx, failingListClient{err: notFound}, 3, continueToken{}, newProjectList, evenProjects, nil)
+		if !apierrors.IsNotFound(err) {
+			t.Errorf("listByContinuation() = %v, want the list error", err)
+		}
+	})
+}
+
+type failingListClient struct {
+	client.Client
+	err error
+}
+
+func (c failingListClient) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return c.err
+}
+
+type listItem struct {
+	Name     string `json:"name"`
+	Status   string `json:"status,omitempty"`
+	Replicas int    `json:"replicas,omitempty"`
+}
+
+func itemNames(items []listItem) string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return fmt.Sprint(names)
+}
+
+func TestPageItems(t *testing.T) {
+	items := []listItem{
+		{Name: "d", Status: "Ready", Replicas: 10},
+		{Name: "a", Status: "Failed", Replicas: 2},
+		{Name: "c", Status: "Ready", Replicas: 2},
+		{Name: "b", Replicas: 9},
+	}
+	tests := []struct {
+		name         string
+		opts         models.ListOptions
+		token        continueToken
+		want         string
+		wantTotal    int
+		wantPage     int
+		wantContinue bool
+		wantErr      bool
+	}{
+		{name: "sorted by name", want: "[a b c d]", wantTotal: 4, wantPage: 1},
+		{name: "numbers sorted numerically", opts: models.ListOptions{Sort: "replicas"}, want: "[a c b d]", wantTotal: 4, wantPage: 1},
+		{name: "descending", opts: models.ListOptions{Sort: "-replicas"}, want: "[d b c a]", wantTotal: 4, wantPage: 1},
+		{name: "missing values first", opts: models.ListOptions{Sort: "status"}, want: "[b a c d]", wantTotal: 4, wantPage: 1},
+		{name: "field selector", opts: models.ListOptions{FieldSelector: "status=Ready"}, want: "[c d]", wantTotal: 2, wantPage: 1},
+		{
+			name: "first page", opts: models.ListOptions{Sort: "replicas", Limit: 3},
+			want: "[a c b]", wantTotal: 4, wantPage: 1, wantContinue: true,
+		},
+		{
+			name: "last page", opts: models.ListOptions{Sort: "replicas", Limit: 3, Continue: "token"}, token: continueToken{Offset: 3, Page: 2},
+			want: "[d]", wantTotal: 4, wantPage: 2,
+		},
+		{name: "continue token of a list sorted by name", opts: models.ListOptions{Sort: "replicas", Continue: "token"}, wantErr: true},
+		{name: "negative offset", opts: models.ListOptions{Continue: "token"}, token: continueToken{Offset: -1}, wantErr: true},
+		{name: "invalid field selector", opts: models.ListOptions{FieldSelector: "status"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := pageItems(items, &tt.opts, tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidListOptions) {
+					t.Errorf("pageItems() = %v, want ErrInvalidListOptions", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pageItems() = %v", err)
+			}
+			if got := itemNames(page.Items); got != tt.want || page.Total != tt.wantTotal || page.Page != tt.wantPage {
+				t.Errorf("page %d = %s of %d, want page %d = %s of %d", page.Page, got, page.Total, tt.wantPage, tt.want, tt.wantTotal)
+			}
+			if (page.Continue != "") != tt.wantContinue {
+				t.Errorf("continue = %q, want a token %v", page.Continue, tt.wantContinue)
+			}
+		})
+	}
+}
+
+func TestParseFieldSelector(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []fieldRequirement
+		wantErr  bool
+	}{
+		{selector: ""},
+		{selector: "status=Ready", want: []fieldRequirement{{field: "status", value: "Ready"}}},
+		{selector: "status==Ready", want: []fieldRequirement{{field: "status", value: "Ready"}}},
+		{selector: "status!=Ready", want: []fieldRequirement{{field: "status", value: "Ready", negate: true}}},
+		{selector: "status!=", want: []fieldRequirement{{field: "status", negate: true}}},
+		{
+			selector: " spec.type = Service ,status!=Failed",
+			want:     []fieldRequirement{{field: "spec.type", value: "Service"}, {field: "status", value: "Failed", negate: true}},
+		},
+		{selector: "status", wantErr: true},
+		{selector: "=Ready", wantErr: true},
+		{selector: "status=Ready,", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			got, err := parseFieldSelector(tt.selector)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidListOptions) {
+					t.Errorf("parseFieldSelector() = %v, want ErrInvalidListOptions", err)
+				}
+				return
+			}
+			if err != nil || fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("parseFieldSelector() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesFieldSelector(t *testing.T) {
+	object := map[string]any{"status": "Ready", "spec": map[string]any{"replicas": float64(3)}}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "status=Ready", want: true},
+		{selector: "status!=Ready", want: false},
+		{selector: "status!=Failed", want: true},
+		{selector: "spec.replicas==3", want: true},
+		{selector: "status=Ready,spec.replicas!=3", want: false},
+		{selector: "type=", want: true},
+		{selector: "type!=", want: false},
+	}
+	for _, tt := range tests {
+		requirements, err := parseFieldSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("parseFieldSelector(%q) = %v", tt.selector, err)
+		}
+		if got := matchesFieldSelector(object, requirements); got != tt.want {
+			t.Errorf("matchesFieldSelector(%q) = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestCompareFields(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b any
+		want int
+	}{
+		{name: "numbers", a: float64(9), b: float64(10), want: -1},
+		{name: "equal numbers", a: float64(2), b: float64(2), want: 0},
+		{name: "strings", a: "10", b: "9", want: -1},
+		{name: "number and string", a: float64(9), b: "10", want: 1},
+		{name: "missing value", a: nil, b: "a", want: -1},
+		{name: "booleans", a: false, b: true, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := map[string]any{"spec": map[string]any{"value": tt.a}}
+			b := map[string]any{"spec": map[string]any{"value": tt.b}}
+			if got := compareFields(a, b, "spec.value"); got != tt.want {
+				t.Errorf("compareFields(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := compareFields(b, a, "spec.value"); got != -tt.want {
+				t.Errorf("compareFields(%v, %v) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestContinueToken(t *testing.T) {
+	for _, token := range []continueToken{{}, {Kubernetes: "eyJydiI6MTJ9"}, {Offset: 50}} {
+		decoded, err := decodeContinueToken(encodeContinueToken(token))
+		if err != nil || decoded != token {
+			t.Errorf("decodeContinueToken(encodeContinueToken(%+v)) = %+v, %v", token, decoded, err)
+		}
+	}
+	if token, err := decodeContinueToken(""); err != nil || token != (continueToken{}) {
+		t.Errorf("decodeContinueToken(\"\") = %+v, %v, want an empty token", token, err)
+	}
+	for _, malformed := range []string{"not base64!", "bm90IGpzb24"} {
+		if _, err := decodeContinueToken(malformed); !errors.Is(err, ErrInvalidListOptions) {
+			t.Errorf("decodeContinueToken(%q) = %v, want ErrInvalidListOptions", malformed, err)
+		}
+	}
+}
+
+func TestListResourcesRejectsTokensOfOtherSortOrders(t *testing.T) {
+	ctx := context.Background()
+	c := newPagingClient(10)
+	list := func(opts *models.ListOptions) (*models.ListPage[listItem], error) {
+		return listResources(ctx, c, opts, newProjectList, evenProjects)
+	}
+
+	byName, err := list(&models.ListOptions{Limit: 2})
+	if err != nil || byName.Continue == "" {
+		t.Fatalf("list sorted by name = %v, %v, want a continued page", byName, err)
+	}
+	bySort, err := list(&models.ListOptions{Limit: 2, Sort: "-name"})
+	if err != nil || bySort.Continue == "" {
+		t.Fatalf("list sorted by -name = %v, %v, want a continued page", bySort, err)
+	}
+	if itemNames(bySort.Items) != "[p8 p6]" {
+		t.Errorf("list sorted by -name = %s, want [p8 p6]", itemNames(bySort.Items))
+	}
+
+	// A token continues the list it was returned for only
+	if _, err := list(&models.ListOptions{Limit: 2, Sort: "-name", Continue: byName.Continue}); !errors.Is(err, ErrInvalidListOptions) {
+		t.Errorf("sorted list continued by a token of the list sorted by name = %v, want ErrInvalidListOptions", err)
+	}
+	if _, err := list(&models.ListOptions{Limit: 2, Continue: bySort.Continue}); !errors.Is(err, ErrInvalidListOptions) {
+		t.Errorf("list sorted by name continued by a token of a sorted list = %v, want ErrInvalidListOptions", err)
+	}
+
+	next, err := list(&models.ListOptions{Limit: 2, Sort: "-name", Continue: bySort.Continue})
+	if err != nil || itemNames(next.Items) != "[p4 p2]" {
+		t.Errorf("next page sorted by -name = %v, %v, want [p4 p2]", next, err)
+	}
+}
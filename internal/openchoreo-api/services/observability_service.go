@@ -0,0 +1,518 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// observerRequestTimeout bounds the queries of the observer to its log and trace stores
+const observerRequestTimeout = 30 * time.Second
+
+// logStreamBufferSize is the number of followed log events buffered for a slow client
+const logStreamBufferSize = 100
+
+// maxLogStreamLineSize is the maximum size of a line of the log streams of observers
+const maxLogStreamLineSize = 1024 * 1024
+
+// Log types of the observer log queries
+const (
+	observerLogTypeRuntime = "RUNTIME"
+	observerLogTypeBuild   = "BUILD"
+)
+
+// healthSeverity orders the health of release resources from the worst to the best
+var healthSeverity = []openchoreov1alpha1.HealthStatus{
+	openchoreov1alpha1.HealthStatusDegraded,
+	openchoreov1alpha1.HealthStatusProgressing,
+	openchoreov1alpha1.HealthStatusUnknown,
+	openchoreov1alpha1.HealthStatusSuspended,
+	openchoreov1alpha1.HealthStatusHealthy,
+}
+
+// ObservabilityService reads the logs and traces of components from the observers of their data planes
+// and build planes, and the health of their releases
+type ObservabilityService struct {
+	k8sClient                  client.Client
+	componentService           *ComponentService
+	componentDeploymentService *ComponentDeploymentService
+	httpClient                 *http.Client
+	streamClient               *http.Client
+	logger                     *slog.Logger
+	now                        func() time.Time
+}
+
+// NewObservabilityService creates a new observability service
+func NewObservabilityService(k8sClient client.Client, componentService *ComponentService,
+	componentDeploymentService *ComponentDeploymentService, logger *slog.Logger) *ObservabilityService {
+	// Log streams are long-lived, so only the wait for the response headers is bounded
+	streamTransport := http.DefaultTransport.(*http.Transport).Clone()
+	streamTransport.ResponseHeaderTimeout = observerRequestTimeout
+	return &ObservabilityService{
+		k8sClient:                  k8sClient,
+		componentService:           componentService,
+		componentDeploymentService: componentDeploymentService,
+		httpClient:                 &http.Client{Timeout: observerRequestTimeout},
+		streamClient:               &http.Client{Transport: streamTransport},
+		logger:                     logger,
+		now:                        time.Now,
+	}
+}
+
+// observerLogsRequest is the request body of POST /api/logs/component/{componentId}
+type observerLogsRequest struct {
+	StartTime     string   `json:"startTime,omitempty"`
+	EndTime       string   `json:"endTime,omitempty"`
+	EnvironmentID string   `json:"environmentId,omitempty"`
+	Namespace     string   `json:"namespace,omitempty"`
+	SearchPhrase  string   `json:"searchPhrase,omitempty"`
+	LogLevels     []string `json:"logLevels,omitempty"`
+	Limit         int      `json:"limit,omitempty"`
+	SortOrder     string   `json:"sortOrder,omitempty"`
+	LogType       string   `json:"logType"`
+	BuildID       string   `json:"buildId,omitempty"`
+}
+
+// observerLogEntry contains the subset of the fields of an observer log entry returned by the API
+type observerLogEntry struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Log           string    `json:"log"`
+	LogLevel      string    `json:"logLevel"`
+	Version       string    `json:"version"`
+	PodID         string    `json:"podId"`
+	ContainerName string    `json:"containerName"`
+}
+
+// observerLogsResponse contains the subset of the observer logs response returned by the API
+type observerLogsResponse struct {
+	Logs       []observerLogEntry `json:"logs"`
+	TotalCount int                `json:"totalCount"`
+}
+
+// LogStreamEvent is an event of a followed log query: a log entry, or a gap of entries the observer skipped
+type LogStreamEvent struct {
+	Entry *models.LogEntry
+	Gap   *models.LogGap
+}
+
+// LogStream is a stream of the events of a followed log query. Its events are closed when the stream ends.
+type LogStream struct {
+	Events <-chan LogStreamEvent
+	err    error
+}
+
+// Err returns the error that ended the stream, once its events are closed. It is nil if the stream ended
+// because its context is done.
+func (s *LogStream) Err() error {
+	return s.err
+}
+
+// observerTracesRequest is the request body of POST /api/traces/component
+type observerTracesRequest struct {
+	StartTime   string `json:"startTime"`
+	EndTime     string `json:"endTime"`
+	ServiceName string `json:"serviceName"`
+	Limit       int    `json:"limit,omitempty"`
+	SortOrder   string `json:"sortOrder,omitempty"`
+}
+
+// observerTracesResponse is the response of POST /api/traces/component
+type observerTracesResponse struct {
+	Spans []struct {
+		TraceID         string    `json:"traceId"`
+		SpanID          string    `json:"spanId"`
+		Name            string    `json:"name"`
+		StartTime       time.Time `json:"startTime"`
+		EndTime         time.Time `json:"endTime"`
+		DurationInNanos int64     `json:"durationInNanos"`
+	} `json:"spans"`
+	TotalCount int `json:"totalCount"`
+}
+
+// GetComponentLogs retrieves the runtime logs of a component in an environment
+func (s *ObservabilityService) GetComponentLogs(ctx context.Context, orgName, projectName, componentName, environment string,
+	query *models.LogQuery) (*models.LogsResponse, error) {
+	s.logger.Debug("Getting component logs", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	observer, err := s.componentService.GetComponentObserverURL(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	start, end := query.Resolve(s.now())
+	req := observerLogsRequest{
+		StartTime:     start.UTC().Format(time.RFC3339),
+		EndTime:       end.UTC().Format(time.RFC3339),
+		EnvironmentID: environment,
+		Namespace:     s.releaseNamespace(ctx, orgName, projectName, componentName, environment),
+		SearchPhrase:  query.Search,
+		LogLevels:     query.Levels,
+		Limit:         observabilityLimit(query.Limit),
+		SortOrder:     query.SortOrder,
+		LogType:       observerLogTypeRuntime,
+	}
+
+	var resp observerLogsResponse
+	if err := s.queryObserver(ctx, observer, "/api/logs/component/"+url.PathEscape(componentName), req, &resp); err != nil {
+		return nil, err
+	}
+	logs := toLogsResponse(&resp)
+	logs.StartTime, logs.EndTime = &start, &end
+	return logs, nil
+}
+
+// FollowComponentLogs follows the runtime logs of a component in an environment from the start time of the query,
+// or from the current time if it has none, streaming the new entries until the context is done
+func (s *ObservabilityService) FollowComponentLogs(ctx context.Context, orgName, projectName, componentName, environment string,
+	query *models.LogQuery) (*LogStream, error) {
+	s.logger.Debug("Following component logs", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	observer, err := s.componentService.GetComponentObserverURL(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	start := query.StartTime
+	if start.IsZero() {
+		start = s.now()
+	}
+	// The end time, limit and sort order don't apply to followed logs
+	req := observerLogsRequest{
+		StartTime:     start.UTC().Format(time.RFC3339),
+		EnvironmentID: environment,
+		Namespace:     s.releaseNamespace(ctx, orgName, projectName, componentName, environment),
+		SearchPhrase:  query.Search,
+		LogLevels:     query.Levels,
+		LogType:       observerLogTypeRuntime,
+	}
+
+	path := "/api/logs/component/" + url.PathEscape(componentName) + "/follow"
+	httpReq, err := s.newObserverRequest(ctx, observer, path, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.streamClient.Do(httpReq)
+	if err != nil {
+		s.logger.Error("Failed to follow the logs of the observer", "error", err, "path", path)
+		return nil, fmt.Errorf("%w: %w", ErrObserverUnavailable, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, s.observerStatusError(resp, path)
+	}
+
+	events := make(chan LogStreamEvent, logStreamBufferSize)
+	stream := &LogStream{Events: events}
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+		stream.err = s.readLogStream(ctx, resp.Body, events)
+		if stream.err != nil {
+			s.logger.Error("Log stream of the observer failed", "error", stream.err, "path", path)
+		}
+	}()
+	return stream, nil
+}
+
+// readLogStream reads the Server-Sent Events of a log stream of an observer, sending its log entries and gaps until
+// the stream ends or the context is done
+func (s *ObservabilityService) readLogStream(ctx context.Context, body io.Reader, events chan<- LogStreamEvent) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogStreamLineSize)
+
+	var event, data string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			continue
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			continue
+		case line != "":
+			// Comments, such as heartbeats, and other fields are ignored
+			continue
+		}
+
+		var streamEvent LogStreamEvent
+		switch event {
+		case "log":
+			var entry observerLogEntry
+			if err := json.Unmarshal([]byte(data), &entry); err != nil {
+				return fmt.Errorf("%w: failed to decode observer log entry: %w", ErrObserverUnavailable, err)
+			}
+			streamEvent.Entry = toLogEntry(&entry)
+		case "gap":
+			var gap models.LogGap
+			if err := json.Unmarshal([]byte(data), &gap); err != nil {
+				return fmt.Errorf("%w: failed to decode observer log gap: %w", ErrObserverUnavailable, err)
+			}
+			streamEvent.Gap = &gap
+		case "error":
+			var observerErr struct {
+				Message string `json:"message"`
+			}
+			_ = json.Unmarshal([]byte(data), &observerErr)
+			return fmt.Errorf("%w: observer failed to follow the logs: %s", ErrObserverUnavailable, observerErr.Message)
+		}
+		event, data = "", ""
+		if streamEvent.Entry == nil && streamEvent.Gap == nil {
+			continue
+		}
+		select {
+		case events <- streamEvent:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: failed to read the log stream: %w", ErrObserverUnavailable, err)
+	}
+	return fmt.Errorf("%w: the observer closed the log stream", ErrObserverUnavailable)
+}
+
+// GetBuildLogs retrieves the logs of a build of a component
+func (s *ObservabilityService) GetBuildLogs(ctx context.Context, orgName, projectName, componentName, buildName string,
+	query *models.LogQuery) (*models.LogsResponse, error) {
+	s.logger.Debug("Getting build logs", "org", orgName, "project", projectName, "component", componentName, "build", buildName)
+
+	observer, err := s.componentService.GetBuildObserverURL(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	build := &openchoreov1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: buildName}, build); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrBuildNotFound
+		}
+		return nil, fmt.Errorf("failed to get build: %w", err)
+	}
+	if build.Spec.Owner.ProjectName != projectName || build.Spec.Owner.ComponentName != componentName {
+		return nil, ErrBuildNotFound
+	}
+
+	// Build logs are selected by the build, not by time
+	req := observerLogsRequest{
+		SearchPhrase: query.Search,
+		LogLevels:    query.Levels,
+		Limit:        observabilityLimit(query.Limit),
+		SortOrder:    query.SortOrder,
+		LogType:      observerLogTypeBuild,
+		BuildID:      buildName,
+	}
+
+	var resp observerLogsResponse
+	if err := s.queryObserver(ctx, observer, "/api/logs/component/"+url.PathEscape(componentName), req, &resp); err != nil {
+		return nil, err
+	}
+	return toLogsResponse(&resp), nil
+}
+
+// GetComponentTraces retrieves the spans of the traces of a component in an environment
+func (s *ObservabilityService) GetComponentTraces(ctx context.Context, orgName, projectName, componentName, environment string,
+	query *models.TraceQuery) (*models.TracesResponse, error) {
+	s.logger.Debug("Getting component traces", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	observer, err := s.componentService.GetComponentObserverURL(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	start, end := query.Resolve(s.now())
+	req := observerTracesRequest{
+		StartTime:   start.UTC().Format(time.RFC3339),
+		EndTime:     end.UTC().Format(time.RFC3339),
+		ServiceName: componentName,
+		Limit:       observabilityLimit(query.Limit),
+		SortOrder:   query.SortOrder,
+	}
+
+	var resp observerTracesResponse
+	if err := s.queryObserver(ctx, observer, "/api/traces/component", req, &resp); err != nil {
+		return nil, err
+	}
+	traces := &models.TracesResponse{
+		Spans:      make([]models.TraceSpan, 0, len(resp.Spans)),
+		TotalCount: resp.TotalCount,
+		StartTime:  start,
+		EndTime:    end,
+	}
+	for _, span := range resp.Spans {
+		traces.Spans = append(traces.Spans, models.TraceSpan{
+			TraceID:    span.TraceID,
+			SpanID:     span.SpanID,
+			Name:       span.Name,
+			StartTime:  span.StartTime,
+			EndTime:    span.EndTime,
+			DurationMs: float64(span.DurationInNanos) / float64(time.Millisecond),
+		})
+	}
+	return traces, nil
+}
+
+// GetReleaseHealth summarizes the health of the resources of the release of a component in an environment
+func (s *ObservabilityService) GetReleaseHealth(ctx context.Context, orgName, projectName, componentName,
+	environment string) (*models.ReleaseHealthResponse, error) {
+	s.logger.Debug("Getting release health", "org", orgName, "project", projectName, "component", componentName, "environment", environment)
+
+	release, err := s.componentDeploymentService.GetRelease(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &models.ReleaseHealthResponse{
+		Name:           release.Name,
+		OrgName:        release.OrgName,
+		ProjectName:    release.ProjectName,
+		ComponentName:  release.ComponentName,
+		Environment:    release.Environment,
+		Health:         string(openchoreov1alpha1.HealthStatusUnknown),
+		Status:         release.Status,
+		ResourceCounts: make(map[string]int),
+		Conditions:     release.Conditions,
+	}
+	worst := len(healthSeverity)
+	for _, resource := range release.Resources {
+		status := openchoreov1alpha1.HealthStatus(resource.HealthStatus)
+		severity := healthSeverityIndex(status)
+		health.ResourceCounts[string(healthSeverity[severity])]++
+		if status != openchoreov1alpha1.HealthStatusHealthy {
+			health.UnhealthyResources = append(health.UnhealthyResources, resource)
+		}
+		worst = min(worst, severity)
+	}
+	if worst < len(healthSeverity) {
+		health.Health = string(healthSeverity[worst])
+	}
+	return health, nil
+}
+
+// healthSeverityIndex returns the index of a health in healthSeverity. Resources without a known health are Unknown.
+func healthSeverityIndex(status openchoreov1alpha1.HealthStatus) int {
+	if i := slices.Index(healthSeverity, status); i >= 0 {
+		return i
+	}
+	return slices.Index(healthSeverity, openchoreov1alpha1.HealthStatusUnknown)
+}
+
+// releaseNamespace returns the data plane namespace of the release of a component, narrowing its log queries.
+// It is empty if the component has no release, such as the components that are not based on a ComponentType.
+func (s *ObservabilityService) releaseNamespace(ctx context.Context, orgName, projectName, componentName, environment string) string {
+	release, err := s.componentDeploymentService.GetRelease(ctx, orgName, projectName, componentName, environment)
+	if err != nil {
+		if !errors.Is(err, ErrReleaseNotFound) {
+			s.logger.Warn("Failed to get the release of the component", "error", err, "component", componentName)
+		}
+		return ""
+	}
+	for _, resource := range release.Resources {
+		if resource.Namespace != "" {
+			return resource.Namespace
+		}
+	}
+	return ""
+}
+
+// queryObserver posts a query to an observer API and decodes its response into out
+func (s *ObservabilityService) queryObserver(ctx context.Context, observer *models.ComponentObserverResponse, path string, query, out any) error {
+	req, err := s.newObserverRequest(ctx, observer, path, query)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Error("Failed to query the observer", "error", err, "path", path)
+		return fmt.Errorf("%w: %w", ErrObserverUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.observerStatusError(resp, path)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: failed to decode observer response: %w", ErrObserverUnavailable, err)
+	}
+	return nil
+}
+
+// newObserverRequest creates the request posting a query to an observer API
+func (s *ObservabilityService) newObserverRequest(ctx context.Context, observer *models.ComponentObserverResponse,
+	path string, query any) (*http.Request, error) {
+	if observer.ObserverURL == "" {
+		return nil, ErrObserverNotConfigured
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal observer query: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(observer.ObserverURL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create observer request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if method := observer.ConnectionMethod; method != nil && method.Username != "" {
+		req.SetBasicAuth(method.Username, method.Password)
+	}
+	return req, nil
+}
+
+// observerStatusError returns the error of a failed observer request, with the message of its response
+func (s *ObservabilityService) observerStatusError(resp *http.Response, path string) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	s.logger.Error("Observer query failed", "status", resp.StatusCode, "path", path, "response", string(msg))
+	return fmt.Errorf("%w: observer returned status %d: %s", ErrObserverUnavailable, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
+
+// observabilityLimit returns the limit of an observer query, the default if none is set
+func observabilityLimit(limit int) int {
+	if limit == 0 {
+		return models.DefaultObservabilityLimit
+	}
+	return limit
+}
+
+func toLogsResponse(resp *observerLogsResponse) *models.LogsResponse {
+	logs := &models.LogsResponse{
+		Entries:    make([]models.LogEntry, 0, len(resp.Logs)),
+		TotalCount: resp.TotalCount,
+	}
+	for i := range resp.Logs {
+		logs.Entries = append(logs.Entries, *toLogEntry(&resp.Logs[i]))
+	}
+	return logs
+}
+
+func toLogEntry(entry *observerLogEntry) *models.LogEntry {
+	return &models.LogEntry{
+		Timestamp: entry.Timestamp,
+		Level:     entry.LogLevel,
+		Log:       entry.Log,
+		Pod:       entry.PodID,
+		Container: entry.ContainerName,
+		Version:   entry.Version,
+	}
+}
@@ -30,23 +30,31 @@ func NewOrganizationService(k8sClient client.Client, logger *slog.Logger) *Organ
 	}
 }
 
-// ListOrganizations lists all organizations
-func (s *OrganizationService) ListOrganizations(ctx context.Context) ([]*models.OrganizationResponse, error) {
+// ListOrganizations lists a page of the organizations visible reports true for, or of all organizations if
+// visible is nil. The other organizations are left out before paging, so that pages are filled up to the limit.
+func (s *OrganizationService) ListOrganizations(
+	ctx context.Context, opts *models.ListOptions, visible func(orgName string) bool,
+) (*models.ListPage[*models.OrganizationResponse], error) {
 	s.logger.Debug("Listing organizations")
 
-	var orgList openchoreov1alpha1.OrganizationList
-	if err := s.k8sClient.List(ctx, &orgList); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.OrganizationList { return &openchoreov1alpha1.OrganizationList{} },
+		func(list *openchoreov1alpha1.OrganizationList) []*models.OrganizationResponse {
+			organizations := make([]*models.OrganizationResponse, 0, len(list.Items))
+			for _, item := range list.Items {
+				if visible == nil || visible(item.Name) {
+					organizations = append(organizations, s.toOrganizationResponse(&item))
+				}
+			}
+			return organizations
+		})
+	if err != nil {
 		s.logger.Error("Failed to list organizations", "error", err)
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
-	organizations := make([]*models.OrganizationResponse, 0, len(orgList.Items))
-	for _, item := range orgList.Items {
-		organizations = append(organizations, s.toOrganizationResponse(&item))
-	}
-
-	s.logger.Debug("Listed organizations", "count", len(organizations))
-	return organizations, nil
+	s.logger.Debug("Listed organizations", "count", len(page.Items))
+	return page, nil
 }
 
 // GetOrganization retrieves a specific organization
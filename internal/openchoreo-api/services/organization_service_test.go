@@ -0,0 +1,64 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func TestListOrganizationsLeavesOutInvisibleOrganizationsBeforePaging(t *testing.T) {
+	var objects []client.Object
+	for i := 0; i < 6; i++ {
+		objects = append(objects, &openchoreov1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("org-%d", i)}})
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objects...).Build()
+	service := NewOrganizationService(k8sClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	// Only the last organizations are visible, so that the first Kubernetes pages hold no visible organization
+	visible := func(orgName string) bool { return orgName >= "org-3" }
+
+	for _, sort := range []string{"name", "-name"} {
+		t.Run(sort, func(t *testing.T) {
+			var names []string
+			opts := &models.ListOptions{Limit: 2, Sort: sort}
+			for pages := 0; ; pages++ {
+				if pages == 3 {
+					t.Fatalf("listed more than 2 pages: %v", names)
+				}
+				page, err := service.ListOrganizations(context.Background(), opts, visible)
+				if err != nil {
+					t.Fatalf("ListOrganizations() = %v", err)
+				}
+				if page.Continue != "" && len(page.Items) != opts.Limit {
+					t.Errorf("page %d holds %d organizations, want a full page of %d", page.Page, len(page.Items), opts.Limit)
+				}
+				for _, org := range page.Items {
+					names = append(names, org.Name)
+				}
+				if page.Continue == "" {
+					break
+				}
+				opts.Continue = page.Continue
+			}
+			want := "org-3 org-4 org-5"
+			if sort == "-name" {
+				want = "org-5 org-4 org-3"
+			}
+			if got := strings.Join(names, " "); got != want {
+				t.Errorf("organizations = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -60,27 +60,27 @@ func (s *ProjectService) CreateProject(ctx context.Context, orgName string, req
 	return s.toProjectResponse(projectCR), nil
 }
 
-// ListProjects lists all projects in the given organization
-func (s *ProjectService) ListProjects(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+// ListProjects lists a page of the projects in the given organization
+func (s *ProjectService) ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ProjectResponse], error) {
 	s.logger.Debug("Listing projects", "org", orgName)
 
-	var projectList openchoreov1alpha1.ProjectList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &projectList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.ProjectList { return &openchoreov1alpha1.ProjectList{} },
+		func(list *openchoreov1alpha1.ProjectList) []*models.ProjectResponse {
+			projects := make([]*models.ProjectResponse, 0, len(list.Items))
+			for _, item := range list.Items {
+				projects = append(projects, s.toProjectResponse(&item))
+			}
+			return projects
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list projects", "error", err)
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	projects := make([]*models.ProjectResponse, 0, len(projectList.Items))
-	for _, item := range projectList.Items {
-		projects = append(projects, s.toProjectResponse(&item))
-	}
-
-	s.logger.Debug("Listed projects", "org", orgName, "count", len(projects))
-	return projects, nil
+	s.logger.Debug("Listed projects", "org", orgName, "count", len(page.Items))
+	return page, nil
 }
 
 // GetProject retrieves a specific project
@@ -0,0 +1,106 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/exp/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// ReleaseTrainService handles the promotion of release trains
+type ReleaseTrainService struct {
+	k8sClient        client.Client
+	componentService *ComponentService
+	logger           *slog.Logger
+}
+
+// NewReleaseTrainService creates a new ReleaseTrain service
+func NewReleaseTrainService(k8sClient client.Client, componentService *ComponentService, logger *slog.Logger) *ReleaseTrainService {
+	return &ReleaseTrainService{
+		k8sClient:        k8sClient,
+		componentService: componentService,
+		logger:           logger,
+	}
+}
+
+// PromoteReleaseTrain promotes a release train by setting its target environment. While the environment is frozen,
+// the promotion requires an override, which is granted in the status of the release train for the active window.
+// The release train controller records the override on the environment once the components were promoted.
+// The promotion is rejected with ErrResourceVersionConflict when the resource version of the request is not the
+// current one.
+func (s *ReleaseTrainService) PromoteReleaseTrain(ctx context.Context, orgName, projectName, trainName string,
+	req *models.PromoteReleaseTrainRequest) (*models.ReleaseTrainResponse, error) {
+	s.logger.Debug("Promoting ReleaseTrain", "org", orgName, "project", projectName, "train", trainName,
+		"environment", req.TargetEnvironment)
+
+	train := &openchoreov1alpha1.ReleaseTrain{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: trainName, Namespace: orgName}, train); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrReleaseTrainNotFound
+		}
+		return nil, fmt.Errorf("failed to get ReleaseTrain: %w", err)
+	}
+	if train.Spec.Owner.ProjectName != projectName {
+		return nil, ErrReleaseTrainNotFound
+	}
+	withResourceVersion(train, req.ResourceVersion)
+
+	override, err := s.componentService.checkEnvironmentFreeze(ctx, orgName, projectName, "", req.TargetEnvironment,
+		"PromoteReleaseTrain", "ReleaseTrain/"+train.Name, req.FreezeOverride)
+	if err != nil {
+		return nil, err
+	}
+	// The override is granted before the promotion is started, so that the controller finds it
+	if override != nil {
+		train.Status.FreezeOverride = &openchoreov1alpha1.ReleaseTrainFreezeOverride{
+			Environment:   override.environment,
+			Window:        override.record.Window,
+			Justification: override.record.Justification,
+			Principal:     override.record.Principal,
+			GrantedAt:     override.record.Timestamp,
+		}
+		if err := s.k8sClient.Status().Update(ctx, train); err != nil {
+			s.logger.Warn("Failed to grant ReleaseTrain freeze override", "name", train.Name, "error", err)
+			return nil, writeError(err, ErrReleaseTrainNotFound, "grant ReleaseTrain freeze override")
+		}
+		s.logger.Warn("Freeze override granted to ReleaseTrain", "org", orgName, "project", projectName,
+			"train", train.Name, "environment", override.environment, "window", override.record.Window,
+			"principal", override.record.Principal, "justification", override.record.Justification)
+	}
+
+	train.Spec.TargetEnvironment = req.TargetEnvironment
+	if err := s.k8sClient.Update(ctx, train); err != nil {
+		s.logger.Warn("Failed to promote ReleaseTrain", "name", train.Name, "error", err)
+		return nil, writeError(err, ErrReleaseTrainNotFound, "promote ReleaseTrain")
+	}
+
+	s.logger.Debug("Promoted ReleaseTrain", "name", train.Name, "environment", req.TargetEnvironment)
+	return toReleaseTrainResponse(train), nil
+}
+
+func toReleaseTrainResponse(train *openchoreov1alpha1.ReleaseTrain) *models.ReleaseTrainResponse {
+	components := make([]string, 0, len(train.Spec.Components))
+	for _, c := range train.Spec.Components {
+		components = append(components, c.Name)
+	}
+	return &models.ReleaseTrainResponse{
+		Name:               train.Name,
+		OrgName:            train.Namespace,
+		ProjectName:        train.Spec.Owner.ProjectName,
+		Components:         components,
+		SourceEnvironment:  train.Spec.SourceEnvironment,
+		TargetEnvironment:  train.Spec.TargetEnvironment,
+		CurrentEnvironment: train.Status.CurrentEnvironment,
+		Phase:              string(train.Status.Phase),
+		Conditions:         toConditionResponses(train.Status.Conditions),
+		ResourceVersion:    train.ResourceVersion,
+		CreatedAt:          train.CreationTimestamp.Time,
+	}
+}
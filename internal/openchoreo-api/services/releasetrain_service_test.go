@@ -0,0 +1,100 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/exp/slog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func newTestReleaseTrainService(t *testing.T, objs ...client.Object) (*ReleaseTrainService, client.Client) {
+	t.Helper()
+	k8sClient := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.Environment{}, &openchoreov1alpha1.ReleaseTrain{}).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	componentService := NewComponentService(k8sClient, NewProjectService(k8sClient, logger), logger)
+	return NewReleaseTrainService(k8sClient, componentService, logger), k8sClient
+}
+
+func TestPromoteReleaseTrain(t *testing.T) {
+	ctx := context.Background()
+	service, k8sClient := newTestReleaseTrainService(t,
+		frozenEnvironment("production"),
+		&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: testOrg}},
+		&openchoreov1alpha1.ReleaseTrain{
+			ObjectMeta: metav1.ObjectMeta{Name: "spring", Namespace: testOrg},
+			Spec: openchoreov1alpha1.ReleaseTrainSpec{
+				Owner:             openchoreov1alpha1.ReleaseTrainOwner{ProjectName: "shop"},
+				SourceEnvironment: "development",
+				TargetEnvironment: "development",
+				Components:        []openchoreov1alpha1.ReleaseTrainComponent{{Name: "cart"}},
+			},
+		},
+	)
+	getTrain := func() *openchoreov1alpha1.ReleaseTrain {
+		train := &openchoreov1alpha1.ReleaseTrain{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: "spring", Namespace: testOrg}, train); err != nil {
+			t.Fatalf("failed to get the release train: %v", err)
+		}
+		return train
+	}
+
+	_, err := service.PromoteReleaseTrain(ctx, testOrg, "billing", "spring", &models.PromoteReleaseTrainRequest{TargetEnvironment: "staging"})
+	if !errors.Is(err, ErrReleaseTrainNotFound) {
+		t.Errorf("PromoteReleaseTrain() of the train of another project = %v, want ErrReleaseTrainNotFound", err)
+	}
+
+	train, err := service.PromoteReleaseTrain(ctx, testOrg, "shop", "spring", &models.PromoteReleaseTrainRequest{TargetEnvironment: "staging"})
+	if err != nil {
+		t.Fatalf("PromoteReleaseTrain() to an unfrozen environment = %v", err)
+	}
+	if train.TargetEnvironment != "staging" || getTrain().Status.FreezeOverride != nil {
+		t.Errorf("PromoteReleaseTrain() to an unfrozen environment set target %q, override %+v",
+			train.TargetEnvironment, getTrain().Status.FreezeOverride)
+	}
+
+	_, err = service.PromoteReleaseTrain(ctx, testOrg, "shop", "spring", &models.PromoteReleaseTrainRequest{TargetEnvironment: "production"})
+	if !errors.Is(err, ErrEnvironmentFrozen) {
+		t.Errorf("PromoteReleaseTrain() to a frozen environment without an override = %v, want ErrEnvironmentFrozen", err)
+	}
+	if target := getTrain().Spec.TargetEnvironment; target != "staging" {
+		t.Errorf("target environment after a refused promotion = %q, want staging", target)
+	}
+
+	_, err = service.PromoteReleaseTrain(ctx, testOrg, "shop", "spring", &models.PromoteReleaseTrainRequest{
+		TargetEnvironment: "production",
+		FreezeOverride:    &models.FreezeOverride{Justification: "hotfix", Principal: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("PromoteReleaseTrain() with an override = %v", err)
+	}
+	promoted := getTrain()
+	if promoted.Spec.TargetEnvironment != "production" {
+		t.Errorf("target environment = %q, want production", promoted.Spec.TargetEnvironment)
+	}
+	granted := promoted.Status.FreezeOverride
+	if granted == nil || granted.Environment != "production" || granted.Window != "release-week" ||
+		granted.Justification != "hotfix" || granted.Principal != "alice" {
+		t.Errorf("granted override = %+v, want the release-week window of production granted to alice", granted)
+	}
+
+	// The override is recorded by the controller once the components were promoted
+	env := &openchoreov1alpha1.Environment{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "production", Namespace: testOrg}, env); err != nil {
+		t.Fatalf("failed to get the environment: %v", err)
+	}
+	if len(env.Status.FreezeOverrides) != 0 {
+		t.Errorf("override records = %+v, want none before the promotion", env.Status.FreezeOverrides)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// withResourceVersion sets the resource version an update is based on, so that the API server rejects
+// the update when the resource has changed since. An empty version keeps the version of the read resource.
+func withResourceVersion(obj client.Object, resourceVersion string) {
+	if resourceVersion != "" {
+		obj.SetResourceVersion(resourceVersion)
+	}
+}
+
+// deleteOptions returns the options of a delete that only succeeds at the given resource version, if any
+func deleteOptions(resourceVersion string) []client.DeleteOption {
+	if resourceVersion == "" {
+		return nil
+	}
+	return []client.DeleteOption{client.Preconditions{ResourceVersion: &resourceVersion}}
+}
+
+// writeError maps the errors of the API server to service errors. Conflicts become ErrResourceVersionConflict,
+// rejected resources ErrInvalidResource with the reason, and not found errors the given notFound error.
+func writeError(err error, notFound error, op string) error {
+	switch {
+	case apierrors.IsNotFound(err) && notFound != nil:
+		return notFound
+	case apierrors.IsConflict(err):
+		return fmt.Errorf("%w: %s", ErrResourceVersionConflict, err.Error())
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return fmt.Errorf("%w: %s", ErrInvalidResource, err.Error())
+	default:
+		return fmt.Errorf("failed to %s: %w", op, err)
+	}
+}
+
+// setDisplayAnnotations sets the display name and description annotations of a resource,
+// removing those that are empty
+func setDisplayAnnotations(obj client.Object, displayName, description string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for key, value := range map[string]string{
+		controller.AnnotationKeyDisplayName: displayName,
+		controller.AnnotationKeyDescription: description,
+	} {
+		if value == "" {
+			delete(annotations, key)
+		} else {
+			annotations[key] = value
+		}
+	}
+	obj.SetAnnotations(annotations)
+}
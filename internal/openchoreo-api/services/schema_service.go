@@ -18,6 +18,8 @@ import (
 	"k8s.io/kube-openapi/pkg/validation/spec"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 const objectType = "Object"
@@ -52,35 +54,68 @@ func NewSchemaService(k8sClient client.Client, logger *slog.Logger) *SchemaServi
 	}
 }
 
-// SchemaExplanation represents the structured schema information
-type SchemaExplanation struct {
-	Group       string                `json:"group"`
-	Kind        string                `json:"kind"`
-	Version     string                `json:"version"`
-	Field       string                `json:"field,omitempty"`
-	Type        string                `json:"type"`
-	Description string                `json:"description,omitempty"`
-	Properties  []PropertyDescription `json:"properties,omitempty"`
-	Required    []string              `json:"required,omitempty"`
+// ExplainSchema explains the schema of a Kubernetes resource kind
+func (s *SchemaService) ExplainSchema(ctx context.Context, kind, path string) (*models.SchemaExplanation, error) {
+	s.logger.Debug("Explaining schema", "kind", kind, "path", path)
+
+	gvk, fieldSchema, err := s.fieldSchema(kind, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the explanation
+	explanation := s.buildSchemaExplanation(gvk, path, fieldSchema)
+
+	s.logger.Debug("Schema explanation completed", "kind", kind, "path", path)
+	return explanation, nil
 }
 
-// PropertyDescription represents a single field/property in the schema
-type PropertyDescription struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Description string `json:"description,omitempty"`
-	Required    bool   `json:"required"`
+// JSONSchema returns the JSON schema of a field of a Kubernetes resource kind, or of the whole kind if the
+// path is empty. The Kubernetes extensions of the schema are left out.
+func (s *SchemaService) JSONSchema(ctx context.Context, kind, path string) (map[string]any, error) {
+	s.logger.Debug("Getting JSON schema", "kind", kind, "path", path)
+
+	_, fieldSchema, err := s.fieldSchema(kind, path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(fieldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode schema: %w", err)
+	}
+	var jsonSchema map[string]any
+	if err := json.Unmarshal(data, &jsonSchema); err != nil {
+		return nil, fmt.Errorf("failed to decode schema: %w", err)
+	}
+	removeSchemaExtensions(jsonSchema)
+	return jsonSchema, nil
 }
 
-// ExplainSchema explains the schema of a Kubernetes resource kind
-func (s *SchemaService) ExplainSchema(ctx context.Context, kind, path string) (*SchemaExplanation, error) {
-	s.logger.Debug("Explaining schema", "kind", kind, "path", path)
+// removeSchemaExtensions removes the x- extensions from a JSON schema and its subschemas
+func removeSchemaExtensions(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if strings.HasPrefix(key, "x-") {
+				delete(v, key)
+				continue
+			}
+			removeSchemaExtensions(child)
+		}
+	case []any:
+		for _, child := range v {
+			removeSchemaExtensions(child)
+		}
+	}
+}
 
+// fieldSchema returns the GVK of a kind and the OpenAPI schema of one of its fields, given by a dot separated path
+func (s *SchemaService) fieldSchema(kind, path string) (schema.GroupVersionKind, *spec.Schema, error) {
 	// Find the GVK for the given kind
 	gvk, err := s.findGVKForKind(kind)
 	if err != nil {
 		s.logger.Warn("Failed to find resource kind", "kind", kind, "error", err)
-		return nil, fmt.Errorf("failed to find resource for kind %s: %w", kind, err)
+		return gvk, nil, fmt.Errorf("failed to find resource for kind %s: %w", kind, err)
 	}
 
 	// Get OpenAPI v3 client
@@ -90,7 +125,7 @@ func (s *SchemaService) ExplainSchema(ctx context.Context, kind, path string) (*
 	paths, err := openAPIv3.Paths()
 	if err != nil {
 		s.logger.Error("Failed to get OpenAPI paths", "error", err)
-		return nil, fmt.Errorf("failed to get OpenAPI paths: %w", err)
+		return gvk, nil, fmt.Errorf("failed to get OpenAPI paths: %w", err)
 	}
 
 	// Find the group version
@@ -117,39 +152,37 @@ func (s *SchemaService) ExplainSchema(ctx context.Context, kind, path string) (*
 	}
 
 	if !ok {
-		return nil, fmt.Errorf("group version %s not found in OpenAPI spec", gv.String())
+		return gvk, nil, fmt.Errorf("group version %s not found in OpenAPI spec", gv.String())
 	}
 
 	// Get the OpenAPI spec bytes
 	openAPIBytes, err := groupVersion.Schema("application/json")
 	if err != nil {
 		s.logger.Error("Failed to get OpenAPI spec", "error", err)
-		return nil, fmt.Errorf("failed to get OpenAPI spec: %w", err)
+		return gvk, nil, fmt.Errorf("failed to get OpenAPI spec: %w", err)
 	}
 
 	// Parse the OpenAPI spec
 	var openAPISpec spec3.OpenAPI
 	if err := json.Unmarshal(openAPIBytes, &openAPISpec); err != nil {
 		s.logger.Error("Failed to parse OpenAPI spec", "error", err)
-		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+		return gvk, nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
 	}
 
 	// Find the schema for this kind
 	schemaRef, err := s.findSchemaForKind(&openAPISpec, gvk)
 	if err != nil {
 		s.logger.Warn("Failed to find schema", "kind", gvk.Kind, "error", err)
-		return nil, fmt.Errorf("failed to find schema for %s: %w", gvk.Kind, err)
+		return gvk, nil, fmt.Errorf("failed to find schema for %s: %w", gvk.Kind, err)
 	}
 
 	// Navigate to the field if path is provided
 	fieldSchema := schemaRef
-	fieldPath := ""
 	if path != "" {
-		fieldPath = path
 		parts := strings.Split(path, ".")
 		for _, part := range parts {
 			if len(fieldSchema.Properties) == 0 {
-				return nil, fmt.Errorf("field %q not found - no properties in schema", part)
+				return gvk, nil, fmt.Errorf("field %q not found - no properties in schema", part)
 			}
 
 			// Try exact match first
@@ -166,17 +199,13 @@ func (s *SchemaService) ExplainSchema(ctx context.Context, kind, path string) (*
 			}
 
 			if !ok {
-				return nil, fmt.Errorf("field %q not found in path", part)
+				return gvk, nil, fmt.Errorf("field %q not found in path", part)
 			}
 			fieldSchema = &propSchema
 		}
 	}
 
-	// Build the explanation
-	explanation := s.buildSchemaExplanation(gvk, fieldPath, fieldSchema)
-
-	s.logger.Debug("Schema explanation completed", "kind", kind, "path", path)
-	return explanation, nil
+	return gvk, fieldSchema, nil
 }
 
 // findGVKForKind finds the GroupVersionKind for a given kind name
@@ -258,8 +287,8 @@ func (s *SchemaService) findSchemaForKind(openAPISpec *spec3.OpenAPI, gvk schema
 }
 
 // buildSchemaExplanation builds the schema explanation from the schema
-func (s *SchemaService) buildSchemaExplanation(gvk schema.GroupVersionKind, fieldPath string, fieldSchema *spec.Schema) *SchemaExplanation {
-	explanation := &SchemaExplanation{
+func (s *SchemaService) buildSchemaExplanation(gvk schema.GroupVersionKind, fieldPath string, fieldSchema *spec.Schema) *models.SchemaExplanation {
+	explanation := &models.SchemaExplanation{
 		Group:       gvk.Group,
 		Kind:        gvk.Kind,
 		Version:     gvk.Version,
@@ -278,7 +307,7 @@ func (s *SchemaService) buildSchemaExplanation(gvk schema.GroupVersionKind, fiel
 }
 
 // extractProperties extracts property information from a schema
-func (s *SchemaService) extractProperties(schema *spec.Schema) []PropertyDescription {
+func (s *SchemaService) extractProperties(schema *spec.Schema) []models.PropertyDescription {
 	if schema.Properties == nil {
 		return nil
 	}
@@ -290,7 +319,7 @@ func (s *SchemaService) extractProperties(schema *spec.Schema) []PropertyDescrip
 	}
 	sort.Strings(fieldNames)
 
-	properties := make([]PropertyDescription, 0, len(fieldNames))
+	properties := make([]models.PropertyDescription, 0, len(fieldNames))
 	for _, name := range fieldNames {
 		propSchema := schema.Properties[name]
 
@@ -305,7 +334,7 @@ func (s *SchemaService) extractProperties(schema *spec.Schema) []PropertyDescrip
 			}
 		}
 
-		properties = append(properties, PropertyDescription{
+		properties = append(properties, models.PropertyDescription{
 			Name:        name,
 			Type:        getSchemaType(&propSchema),
 			Description: propSchema.Description,
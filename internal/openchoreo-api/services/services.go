@@ -11,19 +11,24 @@ import (
 )
 
 type Services struct {
-	ProjectService            *ProjectService
-	ComponentService          *ComponentService
-	ComponentTypeService      *ComponentTypeService
-	WorkflowService           *WorkflowService
-	TraitService              *TraitService
-	OrganizationService       *OrganizationService
-	EnvironmentService        *EnvironmentService
-	DataPlaneService          *DataPlaneService
-	BuildService              *BuildService
-	BuildPlaneService         *BuildPlaneService
-	DeploymentPipelineService *DeploymentPipelineService
-	SchemaService             *SchemaService
-	k8sClient                 client.Client // Direct access to K8s client for apply operations
+	ProjectService             *ProjectService
+	ComponentService           *ComponentService
+	ComponentTypeService       *ComponentTypeService
+	WorkflowService            *WorkflowService
+	TraitService               *TraitService
+	OrganizationService        *OrganizationService
+	EnvironmentService         *EnvironmentService
+	DataPlaneService           *DataPlaneService
+	BuildService               *BuildService
+	BuildPlaneService          *BuildPlaneService
+	DeploymentPipelineService  *DeploymentPipelineService
+	SchemaService              *SchemaService
+	DependencyService          *DependencyService
+	EventService               *EventService
+	ComponentDeploymentService *ComponentDeploymentService
+	ObservabilityService       *ObservabilityService
+	ReleaseTrainService        *ReleaseTrainService
+	k8sClient                  client.Client // Direct access to K8s client for apply operations
 }
 
 // NewServices creates and initializes all services
@@ -64,20 +69,40 @@ func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeM
 	// Create Schema service
 	schemaService := NewSchemaService(k8sClient, logger.With("service", "schema"))
 
+	// Create dependency service (depends on project service)
+	dependencyService := NewDependencyService(k8sClient, projectService, logger.With("service", "dependency"))
+
+	// Create event service (depends on project and component services)
+	eventService := NewEventService(k8sClient, projectService, componentService, logger.With("service", "event"))
+
+	// Create ComponentDeployment service (depends on component service)
+	componentDeploymentService := NewComponentDeploymentService(k8sClient, componentService, logger.With("service", "componentdeployment"))
+
+	// Create observability service (depends on component and ComponentDeployment services)
+	observabilityService := NewObservabilityService(k8sClient, componentService, componentDeploymentService, logger.With("service", "observability"))
+
+	// Create ReleaseTrain service (depends on component service)
+	releaseTrainService := NewReleaseTrainService(k8sClient, componentService, logger.With("service", "releasetrain"))
+
 	return &Services{
-		ProjectService:            projectService,
-		ComponentService:          componentService,
-		ComponentTypeService:      componentTypeService,
-		WorkflowService:           workflowService,
-		TraitService:              traitService,
-		OrganizationService:       organizationService,
-		EnvironmentService:        environmentService,
-		DataPlaneService:          dataplaneService,
-		BuildService:              buildService,
-		BuildPlaneService:         buildPlaneService,
-		DeploymentPipelineService: deploymentPipelineService,
-		SchemaService:             schemaService,
-		k8sClient:                 k8sClient,
+		ProjectService:             projectService,
+		ComponentService:           componentService,
+		ComponentTypeService:       componentTypeService,
+		WorkflowService:            workflowService,
+		TraitService:               traitService,
+		OrganizationService:        organizationService,
+		EnvironmentService:         environmentService,
+		DataPlaneService:           dataplaneService,
+		BuildService:               buildService,
+		BuildPlaneService:          buildPlaneService,
+		DeploymentPipelineService:  deploymentPipelineService,
+		SchemaService:              schemaService,
+		DependencyService:          dependencyService,
+		EventService:               eventService,
+		ComponentDeploymentService: componentDeploymentService,
+		ObservabilityService:       observabilityService,
+		ReleaseTrainService:        releaseTrainService,
+		k8sClient:                  k8sClient,
 	}
 }
 
@@ -10,6 +10,8 @@ import (
 
 	"golang.org/x/exp/slog"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -33,27 +35,27 @@ func NewTraitService(k8sClient client.Client, logger *slog.Logger) *TraitService
 	}
 }
 
-// ListTraits lists all Traits in the given organization
-func (s *TraitService) ListTraits(ctx context.Context, orgName string) ([]*models.TraitResponse, error) {
+// ListTraits lists a page of the Traits in the given organization
+func (s *TraitService) ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.TraitResponse], error) {
 	s.logger.Debug("Listing Traits", "org", orgName)
 
-	var traitList openchoreov1alpha1.TraitList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &traitList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.TraitList { return &openchoreov1alpha1.TraitList{} },
+		func(list *openchoreov1alpha1.TraitList) []*models.TraitResponse {
+			traits := make([]*models.TraitResponse, 0, len(list.Items))
+			for i := range list.Items {
+				traits = append(traits, s.toTraitResponse(&list.Items[i]))
+			}
+			return traits
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list Traits", "error", err)
 		return nil, fmt.Errorf("failed to list Traits: %w", err)
 	}
 
-	traits := make([]*models.TraitResponse, 0, len(traitList.Items))
-	for i := range traitList.Items {
-		traits = append(traits, s.toTraitResponse(&traitList.Items[i]))
-	}
-
-	s.logger.Debug("Listed Traits", "org", orgName, "count", len(traits))
-	return traits, nil
+	s.logger.Debug("Listed Traits", "org", orgName, "count", len(page.Items))
+	return page, nil
 }
 
 // GetTrait retrieves a specific Trait
@@ -75,7 +77,9 @@ func (s *TraitService) GetTrait(ctx context.Context, orgName, traitName string)
 		return nil, fmt.Errorf("failed to get Trait: %w", err)
 	}
 
-	return s.toTraitResponse(trait), nil
+	response := s.toTraitResponse(trait)
+	response.Spec = &trait.Spec
+	return response, nil
 }
 
 // GetTraitSchema retrieves the JSON schema for an Trait
@@ -137,9 +141,87 @@ func (s *TraitService) toTraitResponse(trait *openchoreov1alpha1.Trait) *models.
 	description := trait.Annotations[controller.AnnotationKeyDescription]
 
 	return &models.TraitResponse{
-		Name:        trait.Name,
-		DisplayName: displayName,
-		Description: description,
-		CreatedAt:   trait.CreationTimestamp.Time,
+		Name:            trait.Name,
+		DisplayName:     displayName,
+		Description:     description,
+		CreatedAt:       trait.CreationTimestamp.Time,
+		ResourceVersion: trait.ResourceVersion,
+	}
+}
+
+// CreateTrait creates a Trait in the given organization
+func (s *TraitService) CreateTrait(ctx context.Context, orgName string, req *models.TraitRequest) (*models.TraitResponse, error) {
+	s.logger.Debug("Creating Trait", "org", orgName, "name", req.Name)
+
+	trait := &openchoreov1alpha1.Trait{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: orgName,
+		},
+		Spec: req.Spec,
+	}
+	setDisplayAnnotations(trait, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Create(ctx, trait); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			s.logger.Warn("Trait already exists", "org", orgName, "name", req.Name)
+			return nil, ErrTraitAlreadyExists
+		}
+		s.logger.Error("Failed to create Trait", "error", err)
+		return nil, writeError(err, nil, "create Trait")
+	}
+
+	s.logger.Debug("Created Trait", "org", orgName, "name", req.Name)
+	response := s.toTraitResponse(trait)
+	response.Spec = &trait.Spec
+	return response, nil
+}
+
+// UpdateTrait replaces the spec, display name and description of a Trait. The update is rejected with
+// ErrResourceVersionConflict when the resource version of the request is not the current one.
+func (s *TraitService) UpdateTrait(ctx context.Context, orgName, traitName string, req *models.TraitRequest) (*models.TraitResponse, error) {
+	s.logger.Debug("Updating Trait", "org", orgName, "name", traitName)
+
+	trait := &openchoreov1alpha1.Trait{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: traitName, Namespace: orgName}, trait); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Trait not found", "org", orgName, "name", traitName)
+			return nil, ErrTraitNotFound
+		}
+		s.logger.Error("Failed to get Trait", "error", err)
+		return nil, fmt.Errorf("failed to get Trait: %w", err)
 	}
+
+	withResourceVersion(trait, req.ResourceVersion)
+	trait.Spec = req.Spec
+	setDisplayAnnotations(trait, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Update(ctx, trait); err != nil {
+		s.logger.Warn("Failed to update Trait", "org", orgName, "name", traitName, "error", err)
+		return nil, writeError(err, ErrTraitNotFound, "update Trait")
+	}
+
+	s.logger.Debug("Updated Trait", "org", orgName, "name", traitName)
+	response := s.toTraitResponse(trait)
+	response.Spec = &trait.Spec
+	return response, nil
+}
+
+// DeleteTrait deletes a Trait, only at the given resource version if one is set
+func (s *TraitService) DeleteTrait(ctx context.Context, orgName, traitName, resourceVersion string) error {
+	s.logger.Debug("Deleting Trait", "org", orgName, "name", traitName)
+
+	trait := &openchoreov1alpha1.Trait{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      traitName,
+			Namespace: orgName,
+		},
+	}
+	if err := s.k8sClient.Delete(ctx, trait, deleteOptions(resourceVersion)...); err != nil {
+		s.logger.Warn("Failed to delete Trait", "org", orgName, "name", traitName, "error", err)
+		return writeError(err, ErrTraitNotFound, "delete Trait")
+	}
+
+	s.logger.Debug("Deleted Trait", "org", orgName, "name", traitName)
+	return nil
 }
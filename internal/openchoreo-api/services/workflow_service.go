@@ -9,6 +9,8 @@ import (
 
 	"golang.org/x/exp/slog"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -32,27 +34,27 @@ func NewWorkflowService(k8sClient client.Client, logger *slog.Logger) *WorkflowS
 	}
 }
 
-// ListWorkflows lists all Workflows in the given organization
-func (s *WorkflowService) ListWorkflows(ctx context.Context, orgName string) ([]*models.WorkflowResponse, error) {
+// ListWorkflows lists a page of the Workflows in the given organization
+func (s *WorkflowService) ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.WorkflowResponse], error) {
 	s.logger.Debug("Listing Workflows", "org", orgName)
 
-	var wfList openchoreov1alpha1.WorkflowList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &wfList, listOpts...); err != nil {
+	page, err := listResources(ctx, s.k8sClient, opts,
+		func() *openchoreov1alpha1.WorkflowList { return &openchoreov1alpha1.WorkflowList{} },
+		func(list *openchoreov1alpha1.WorkflowList) []*models.WorkflowResponse {
+			wfs := make([]*models.WorkflowResponse, 0, len(list.Items))
+			for i := range list.Items {
+				wfs = append(wfs, s.toWorkflowResponse(&list.Items[i]))
+			}
+			return wfs
+		},
+		client.InNamespace(orgName))
+	if err != nil {
 		s.logger.Error("Failed to list Workflows", "error", err)
 		return nil, fmt.Errorf("failed to list Workflows: %w", err)
 	}
 
-	wfs := make([]*models.WorkflowResponse, 0, len(wfList.Items))
-	for i := range wfList.Items {
-		wfs = append(wfs, s.toWorkflowResponse(&wfList.Items[i]))
-	}
-
-	s.logger.Debug("Listed Workflows", "org", orgName, "count", len(wfs))
-	return wfs, nil
+	s.logger.Debug("Listed Workflows", "org", orgName, "count", len(page.Items))
+	return page, nil
 }
 
 // GetWorkflow retrieves a specific Workflow
@@ -74,7 +76,9 @@ func (s *WorkflowService) GetWorkflow(ctx context.Context, orgName, wfName strin
 		return nil, fmt.Errorf("failed to get Workflow: %w", err)
 	}
 
-	return s.toWorkflowResponse(wf), nil
+	response := s.toWorkflowResponse(wf)
+	response.Spec = &wf.Spec
+	return response, nil
 }
 
 // GetWorkflowSchema retrieves the JSON schema for a Workflow
@@ -118,9 +122,87 @@ func (s *WorkflowService) GetWorkflowSchema(ctx context.Context, orgName, wfName
 
 func (s *WorkflowService) toWorkflowResponse(wf *openchoreov1alpha1.Workflow) *models.WorkflowResponse {
 	return &models.WorkflowResponse{
-		Name:        wf.Name,
-		DisplayName: wf.Annotations[controller.AnnotationKeyDisplayName],
-		Description: wf.Annotations[controller.AnnotationKeyDescription],
-		CreatedAt:   wf.CreationTimestamp.Time,
+		Name:            wf.Name,
+		DisplayName:     wf.Annotations[controller.AnnotationKeyDisplayName],
+		Description:     wf.Annotations[controller.AnnotationKeyDescription],
+		CreatedAt:       wf.CreationTimestamp.Time,
+		ResourceVersion: wf.ResourceVersion,
+	}
+}
+
+// CreateWorkflow creates a Workflow in the given organization
+func (s *WorkflowService) CreateWorkflow(ctx context.Context, orgName string, req *models.WorkflowRequest) (*models.WorkflowResponse, error) {
+	s.logger.Debug("Creating Workflow", "org", orgName, "name", req.Name)
+
+	wf := &openchoreov1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: orgName,
+		},
+		Spec: req.Spec,
+	}
+	setDisplayAnnotations(wf, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Create(ctx, wf); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			s.logger.Warn("Workflow already exists", "org", orgName, "name", req.Name)
+			return nil, ErrWorkflowAlreadyExists
+		}
+		s.logger.Error("Failed to create Workflow", "error", err)
+		return nil, writeError(err, nil, "create Workflow")
+	}
+
+	s.logger.Debug("Created Workflow", "org", orgName, "name", req.Name)
+	response := s.toWorkflowResponse(wf)
+	response.Spec = &wf.Spec
+	return response, nil
+}
+
+// UpdateWorkflow replaces the spec, display name and description of a Workflow. The update is rejected with
+// ErrResourceVersionConflict when the resource version of the request is not the current one.
+func (s *WorkflowService) UpdateWorkflow(ctx context.Context, orgName, wfName string, req *models.WorkflowRequest) (*models.WorkflowResponse, error) {
+	s.logger.Debug("Updating Workflow", "org", orgName, "name", wfName)
+
+	wf := &openchoreov1alpha1.Workflow{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: wfName, Namespace: orgName}, wf); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Workflow not found", "org", orgName, "name", wfName)
+			return nil, ErrWorkflowNotFound
+		}
+		s.logger.Error("Failed to get Workflow", "error", err)
+		return nil, fmt.Errorf("failed to get Workflow: %w", err)
 	}
+
+	withResourceVersion(wf, req.ResourceVersion)
+	wf.Spec = req.Spec
+	setDisplayAnnotations(wf, req.DisplayName, req.Description)
+
+	if err := s.k8sClient.Update(ctx, wf); err != nil {
+		s.logger.Warn("Failed to update Workflow", "org", orgName, "name", wfName, "error", err)
+		return nil, writeError(err, ErrWorkflowNotFound, "update Workflow")
+	}
+
+	s.logger.Debug("Updated Workflow", "org", orgName, "name", wfName)
+	response := s.toWorkflowResponse(wf)
+	response.Spec = &wf.Spec
+	return response, nil
+}
+
+// DeleteWorkflow deletes a Workflow, only at the given resource version if one is set
+func (s *WorkflowService) DeleteWorkflow(ctx context.Context, orgName, wfName, resourceVersion string) error {
+	s.logger.Debug("Deleting Workflow", "org", orgName, "name", wfName)
+
+	wf := &openchoreov1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      wfName,
+			Namespace: orgName,
+		},
+	}
+	if err := s.k8sClient.Delete(ctx, wf, deleteOptions(resourceVersion)...); err != nil {
+		s.logger.Warn("Failed to delete Workflow", "org", orgName, "name", wfName, "error", err)
+		return writeError(err, ErrWorkflowNotFound, "delete Workflow")
+	}
+
+	s.logger.Debug("Deleted Workflow", "org", orgName, "name", wfName)
+	return nil
 }
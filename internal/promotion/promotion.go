@@ -0,0 +1,341 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package promotion promotes a component from one environment to another by copying its environment binding.
+// It is shared by manual promotions through the API and automatic promotions of the DeploymentPipeline controller.
+package promotion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ErrBindingNotFound is returned when a component has no binding in an environment
+var ErrBindingNotFound = errors.New("binding not found")
+
+// BindingTypeComponentDeployment is the binding type of components defined with a ComponentType.
+// These components are deployed to an environment through a ComponentEnvSnapshot and a ComponentDeployment.
+const BindingTypeComponentDeployment = "ComponentDeployment"
+
+// Request identifies the component to promote and the environments it is promoted between
+type Request struct {
+	Namespace         string
+	ProjectName       string
+	ComponentName     string
+	SourceEnvironment string
+	TargetEnvironment string
+}
+
+// Promote creates or updates the binding of the component in the target environment from its binding in the
+// source environment. The binding type is the type of the component, or BindingTypeComponentDeployment.
+func Promote(ctx context.Context, c client.Client, bindingType string, req Request) error {
+	switch openchoreov1alpha1.DefinedComponentType(bindingType) {
+	case openchoreov1alpha1.ComponentTypeService:
+		return promoteServiceBinding(ctx, c, req)
+	case openchoreov1alpha1.ComponentTypeWebApplication:
+		return promoteWebApplicationBinding(ctx, c, req)
+	case openchoreov1alpha1.ComponentTypeScheduledTask:
+		return promoteScheduledTaskBinding(ctx, c, req)
+	case BindingTypeComponentDeployment:
+		return promoteComponentEnvSnapshot(ctx, c, req)
+	default:
+		return fmt.Errorf("unsupported component type: %s", bindingType)
+	}
+}
+
+// targetName returns the name of a binding created in the target environment. Existing bindings keep their name.
+func targetName(req Request) string {
+	return fmt.Sprintf("%s-%s", req.ComponentName, req.TargetEnvironment)
+}
+
+// GetServiceBinding returns the ServiceBinding of a component in an environment
+func GetServiceBinding(ctx context.Context, c client.Client, namespace, componentName, environment string) (*openchoreov1alpha1.ServiceBinding, error) {
+	bindingList := &openchoreov1alpha1.ServiceBindingList{}
+	if err := c.List(ctx, bindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list service bindings: %w", err)
+	}
+	for i := range bindingList.Items {
+		b := &bindingList.Items[i]
+		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
+			return b, nil
+		}
+	}
+	return nil, ErrBindingNotFound
+}
+
+func promoteServiceBinding(ctx context.Context, c client.Client, req Request) error {
+	source, err := GetServiceBinding(ctx, c, req.Namespace, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return fmt.Errorf("failed to get source service binding: %w", err)
+	}
+	target, err := GetServiceBinding(ctx, c, req.Namespace, req.ComponentName, req.TargetEnvironment)
+	if err != nil && !errors.Is(err, ErrBindingNotFound) {
+		return fmt.Errorf("failed to check existing target binding: %w", err)
+	}
+
+	spec := openchoreov1alpha1.ServiceBindingSpec{
+		Owner: openchoreov1alpha1.ServiceOwner{
+			ProjectName:   req.ProjectName,
+			ComponentName: req.ComponentName,
+		},
+		Environment:  req.TargetEnvironment,
+		ClassName:    source.Spec.ClassName,
+		WorkloadSpec: source.Spec.WorkloadSpec,
+		APIs:         source.Spec.APIs,
+	}
+	if target == nil {
+		target = &openchoreov1alpha1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: targetName(req), Namespace: req.Namespace},
+			Spec:       spec,
+		}
+		if err := c.Create(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target service binding: %w", err)
+		}
+		return nil
+	}
+	target.Spec = spec
+	if err := c.Update(ctx, target); err != nil {
+		return fmt.Errorf("failed to update target service binding: %w", err)
+	}
+	return nil
+}
+
+// GetWebApplicationBinding returns the WebApplicationBinding of a component in an environment
+func GetWebApplicationBinding(ctx context.Context, c client.Client, namespace, componentName, environment string) (*openchoreov1alpha1.WebApplicationBinding, error) {
+	bindingList := &openchoreov1alpha1.WebApplicationBindingList{}
+	if err := c.List(ctx, bindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list web application bindings: %w", err)
+	}
+	for i := range bindingList.Items {
+		b := &bindingList.Items[i]
+		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
+			return b, nil
+		}
+	}
+	return nil, ErrBindingNotFound
+}
+
+func promoteWebApplicationBinding(ctx context.Context, c client.Client, req Request) error {
+	source, err := GetWebApplicationBinding(ctx, c, req.Namespace, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return fmt.Errorf("failed to get source web application binding: %w", err)
+	}
+	target, err := GetWebApplicationBinding(ctx, c, req.Namespace, req.ComponentName, req.TargetEnvironment)
+	if err != nil && !errors.Is(err, ErrBindingNotFound) {
+		return fmt.Errorf("failed to check existing target binding: %w", err)
+	}
+
+	spec := openchoreov1alpha1.WebApplicationBindingSpec{
+		Owner: openchoreov1alpha1.WebApplicationOwner{
+			ProjectName:   req.ProjectName,
+			ComponentName: req.ComponentName,
+		},
+		Environment:  req.TargetEnvironment,
+		ClassName:    source.Spec.ClassName,
+		WorkloadSpec: source.Spec.WorkloadSpec,
+		Overrides:    source.Spec.Overrides,
+	}
+	if target == nil {
+		target = &openchoreov1alpha1.WebApplicationBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: targetName(req), Namespace: req.Namespace},
+			Spec:       spec,
+		}
+		if err := c.Create(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target web application binding: %w", err)
+		}
+		return nil
+	}
+	target.Spec = spec
+	if err := c.Update(ctx, target); err != nil {
+		return fmt.Errorf("failed to update target web application binding: %w", err)
+	}
+	return nil
+}
+
+// GetScheduledTaskBinding returns the ScheduledTaskBinding of a component in an environment
+func GetScheduledTaskBinding(ctx context.Context, c client.Client, namespace, componentName, environment string) (*openchoreov1alpha1.ScheduledTaskBinding, error) {
+	bindingList := &openchoreov1alpha1.ScheduledTaskBindingList{}
+	if err := c.List(ctx, bindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list scheduled task bindings: %w", err)
+	}
+	for i := range bindingList.Items {
+		b := &bindingList.Items[i]
+		if b.Spec.Owner.ComponentName == componentName && b.Spec.Environment == environment {
+			return b, nil
+		}
+	}
+	return nil, ErrBindingNotFound
+}
+
+func promoteScheduledTaskBinding(ctx context.Context, c client.Client, req Request) error {
+	source, err := GetScheduledTaskBinding(ctx, c, req.Namespace, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return fmt.Errorf("failed to get source scheduled task binding: %w", err)
+	}
+	target, err := GetScheduledTaskBinding(ctx, c, req.Namespace, req.ComponentName, req.TargetEnvironment)
+	if err != nil && !errors.Is(err, ErrBindingNotFound) {
+		return fmt.Errorf("failed to check existing target binding: %w", err)
+	}
+
+	spec := openchoreov1alpha1.ScheduledTaskBindingSpec{
+		Owner: openchoreov1alpha1.ScheduledTaskOwner{
+			ProjectName:   req.ProjectName,
+			ComponentName: req.ComponentName,
+		},
+		Environment:  req.TargetEnvironment,
+		ClassName:    source.Spec.ClassName,
+		WorkloadSpec: source.Spec.WorkloadSpec,
+		Overrides:    source.Spec.Overrides,
+	}
+	if target == nil {
+		target = &openchoreov1alpha1.ScheduledTaskBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: targetName(req), Namespace: req.Namespace},
+			Spec:       spec,
+		}
+		if err := c.Create(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target scheduled task binding: %w", err)
+		}
+		return nil
+	}
+	target.Spec = spec
+	if err := c.Update(ctx, target); err != nil {
+		return fmt.Errorf("failed to update target scheduled task binding: %w", err)
+	}
+	return nil
+}
+
+// GetComponentEnvSnapshot returns the ComponentEnvSnapshot of a component in an environment
+func GetComponentEnvSnapshot(ctx context.Context, c client.Client, namespace, projectName, componentName, environment string) (*openchoreov1alpha1.ComponentEnvSnapshot, error) {
+	ownedBy := func(snapshot *openchoreov1alpha1.ComponentEnvSnapshot) bool {
+		return snapshot.Spec.Owner.ProjectName == projectName && snapshot.Spec.Owner.ComponentName == componentName &&
+			snapshot.Spec.Environment == environment
+	}
+
+	snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+	found, err := getByName(ctx, c, namespace, componentName, environment, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component env snapshot: %w", err)
+	}
+	if found && ownedBy(snapshot) {
+		return snapshot, nil
+	}
+
+	snapshotList := &openchoreov1alpha1.ComponentEnvSnapshotList{}
+	if err := c.List(ctx, snapshotList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component env snapshots: %w", err)
+	}
+	for i := range snapshotList.Items {
+		if ownedBy(&snapshotList.Items[i]) {
+			return &snapshotList.Items[i], nil
+		}
+	}
+	return nil, ErrBindingNotFound
+}
+
+// GetComponentDeployment returns the ComponentDeployment of a component in an environment
+func GetComponentDeployment(ctx context.Context, c client.Client, namespace, projectName, componentName, environment string) (*openchoreov1alpha1.ComponentDeployment, error) {
+	ownedBy := func(cd *openchoreov1alpha1.ComponentDeployment) bool {
+		return cd.Spec.Owner.ProjectName == projectName && cd.Spec.Owner.ComponentName == componentName &&
+			cd.Spec.Environment == environment
+	}
+
+	cd := &openchoreov1alpha1.ComponentDeployment{}
+	found, err := getByName(ctx, c, namespace, componentName, environment, cd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component deployment: %w", err)
+	}
+	if found && ownedBy(cd) {
+		return cd, nil
+	}
+
+	deploymentList := &openchoreov1alpha1.ComponentDeploymentList{}
+	if err := c.List(ctx, deploymentList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component deployments: %w", err)
+	}
+	for i := range deploymentList.Items {
+		if ownedBy(&deploymentList.Items[i]) {
+			return &deploymentList.Items[i], nil
+		}
+	}
+	return nil, ErrBindingNotFound
+}
+
+// getByName gets the resource of a component in an environment by its name <component>-<environment>.
+// The controllers, the API and promotions name the snapshots and deployments they create this way, so
+// that they are found without listing all resources of the namespace. Resources applied under other
+// names are left to the callers to find by listing.
+func getByName(ctx context.Context, c client.Client, namespace, componentName, environment string, obj client.Object) (bool, error) {
+	key := client.ObjectKey{Namespace: namespace, Name: fmt.Sprintf("%s-%s", componentName, environment)}
+	if err := c.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// promoteComponentEnvSnapshot promotes a ComponentType based component by copying the ComponentEnvSnapshot of the
+// source environment, with its pinned ComponentType, Traits and Workload, to the target environment.
+// The ComponentDeployment of the target environment keeps its overrides and is created if it does not exist.
+func promoteComponentEnvSnapshot(ctx context.Context, c client.Client, req Request) error {
+	source, err := GetComponentEnvSnapshot(ctx, c, req.Namespace, req.ProjectName, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return fmt.Errorf("failed to get source component env snapshot: %w", err)
+	}
+	target, err := GetComponentEnvSnapshot(ctx, c, req.Namespace, req.ProjectName, req.ComponentName, req.TargetEnvironment)
+	if err != nil && !errors.Is(err, ErrBindingNotFound) {
+		return fmt.Errorf("failed to check existing target component env snapshot: %w", err)
+	}
+
+	spec := *source.Spec.DeepCopy()
+	spec.Environment = req.TargetEnvironment
+
+	if target == nil {
+		// The snapshot is owned by the Component, same as the snapshot of the root environment
+		target = &openchoreov1alpha1.ComponentEnvSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            targetName(req),
+				Namespace:       req.Namespace,
+				OwnerReferences: source.OwnerReferences,
+			},
+			Spec: spec,
+		}
+		if err := c.Create(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target component env snapshot: %w", err)
+		}
+	} else {
+		target.Spec = spec
+		if err := c.Update(ctx, target); err != nil {
+			return fmt.Errorf("failed to update target component env snapshot: %w", err)
+		}
+	}
+
+	// Ensure the snapshot gets deployed to the target environment
+	if _, err := GetComponentDeployment(ctx, c, req.Namespace, req.ProjectName, req.ComponentName, req.TargetEnvironment); err != nil {
+		if !errors.Is(err, ErrBindingNotFound) {
+			return fmt.Errorf("failed to check existing target component deployment: %w", err)
+		}
+		cd := &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: targetName(req), Namespace: req.Namespace},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner: openchoreov1alpha1.ComponentDeploymentOwner{
+					ProjectName:   req.ProjectName,
+					ComponentName: req.ComponentName,
+				},
+				Environment: req.TargetEnvironment,
+			},
+		}
+		if err := c.Create(ctx, cd); err != nil {
+			return fmt.Errorf("failed to create target component deployment: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const testNamespace = "test-org"
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build the scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func workload(image string) openchoreov1alpha1.WorkloadTemplateSpec {
+	return openchoreov1alpha1.WorkloadTemplateSpec{
+		Containers: map[string]openchoreov1alpha1.Container{"main": {Image: image}},
+	}
+}
+
+func TestPromote(t *testing.T) {
+	ctx := context.Background()
+	req := Request{
+		Namespace:         testNamespace,
+		ProjectName:       "shop",
+		ComponentName:     "cart",
+		SourceEnvironment: "development",
+		TargetEnvironment: "staging",
+	}
+
+	t.Run("creates the target binding", func(t *testing.T) {
+		c := newTestClient(t, &openchoreov1alpha1.ScheduledTaskBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-development", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.ScheduledTaskBindingSpec{
+				Owner:        openchoreov1alpha1.ScheduledTaskOwner{ProjectName: "shop", ComponentName: "cart"},
+				Environment:  "development",
+				ClassName:    "default",
+				WorkloadSpec: workload("cart:v2"),
+			},
+		})
+		if err := Promote(ctx, c, string(openchoreov1alpha1.ComponentTypeScheduledTask), req); err != nil {
+			t.Fatalf("Promote() = %v", err)
+		}
+		target, err := GetScheduledTaskBinding(ctx, c, testNamespace, "cart", "staging")
+		if err != nil {
+			t.Fatalf("GetScheduledTaskBinding() = %v", err)
+		}
+		if target.Name != "cart-staging" || target.Spec.ClassName != "default" || target.Spec.WorkloadSpec.Containers["main"].Image != "cart:v2" {
+			t.Errorf("target binding = %s %+v", target.Name, target.Spec)
+		}
+	})
+
+	t.Run("updates an existing target binding", func(t *testing.T) {
+		newBinding := func(name, environment, image string) *openchoreov1alpha1.ServiceBinding {
+			return &openchoreov1alpha1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+				Spec: openchoreov1alpha1.ServiceBindingSpec{
+					Owner:        openchoreov1alpha1.ServiceOwner{ProjectName: "shop", ComponentName: "cart"},
+					Environment:  environment,
+					WorkloadSpec: workload(image),
+				},
+			}
+		}
+		c := newTestClient(t, newBinding("cart-development", "development", "cart:v2"), newBinding("cart-stg", "staging", "cart:v1"))
+		if err := Promote(ctx, c, string(openchoreov1alpha1.ComponentTypeService), req); err != nil {
+			t.Fatalf("Promote() = %v", err)
+		}
+		target, err := GetServiceBinding(ctx, c, testNamespace, "cart", "staging")
+		if err != nil {
+			t.Fatalf("GetServiceBinding() = %v", err)
+		}
+		if target.Name != "cart-stg" || target.Spec.WorkloadSpec.Containers["main"].Image != "cart:v2" {
+			t.Errorf("target binding = %s %+v, want cart-stg updated to cart:v2", target.Name, target.Spec)
+		}
+	})
+
+	t.Run("copies the snapshot and creates the deployment", func(t *testing.T) {
+		c := newTestClient(t, &openchoreov1alpha1.ComponentEnvSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "cart-development", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+				Owner:       openchoreov1alpha1.ComponentEnvSnapshotOwner{ProjectName: "shop", ComponentName: "cart"},
+				Environment: "development",
+				Workload: openchoreov1alpha1.Workload{
+					Spec: openchoreov1alpha1.WorkloadSpec{WorkloadTemplateSpec: workload("cart:v2")},
+				},
+			},
+		})
+		if err := Promote(ctx, c, BindingTypeComponentDeployment, req); err != nil {
+			t.Fatalf("Promote() = %v", err)
+		}
+		snapshot, err := GetComponentEnvSnapshot(ctx, c, testNamespace, "shop", "cart", "staging")
+		if err != nil {
+			t.Fatalf("GetComponentEnvSnapshot() = %v", err)
+		}
+		if snapshot.Spec.Workload.Spec.Containers["main"].Image != "cart:v2" {
+			t.Errorf("target snapshot workload = %+v, want cart:v2", snapshot.Spec.Workload.Spec)
+		}
+		if _, err := GetComponentDeployment(ctx, c, testNamespace, "shop", "cart", "staging"); err != nil {
+			t.Errorf("GetComponentDeployment() = %v, want the created deployment", err)
+		}
+	})
+
+	t.Run("updates the snapshot and keeps the deployment overrides", func(t *testing.T) {
+		newSnapshot := func(name, environment, image string) *openchoreov1alpha1.ComponentEnvSnapshot {
+			return &openchoreov1alpha1.ComponentEnvSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+				Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+					Owner:       openchoreov1alpha1.ComponentEnvSnapshotOwner{ProjectName: "shop", ComponentName: "cart"},
+					Environment: environment,
+					Workload: openchoreov1alpha1.Workload{
+						Spec: openchoreov1alpha1.WorkloadSpec{WorkloadTemplateSpec: workload(image)},
+					},
+				},
+			}
+		}
+		overrides := &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+		c := newTestClient(t,
+			newSnapshot("cart-development", "development", "cart:v2"),
+			newSnapshot("cart-staging", "staging", "cart:v1"),
+			&openchoreov1alpha1.ComponentDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "cart-stg", Namespace: testNamespace},
+				Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+					Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: "shop", ComponentName: "cart"},
+					Environment: "staging",
+					Overrides:   overrides,
+				},
+			},
+		)
+		if err := Promote(ctx, c, BindingTypeComponentDeployment, req); err != nil {
+			t.Fatalf("Promote() = %v", err)
+		}
+		snapshot, err := GetComponentEnvSnapshot(ctx, c, testNamespace, "shop", "cart", "staging")
+		if err != nil || snapshot.Name != "cart-staging" || snapshot.Spec.Workload.Spec.Containers["main"].Image != "cart:v2" {
+			t.Errorf("target snapshot = %v, %v, want cart-staging updated to cart:v2", snapshot, err)
+		}
+		var deployments openchoreov1alpha1.ComponentDeploymentList
+		if err := c.List(ctx, &deployments); err != nil {
+			t.Fatalf("failed to list the deployments: %v", err)
+		}
+		if len(deployments.Items) != 1 || string(deployments.Items[0].Spec.Overrides.Raw) != string(overrides.Raw) {
+			t.Errorf("deployments = %+v, want cart-stg with its overrides", deployments.Items)
+		}
+	})
+
+	t.Run("fails without a source binding", func(t *testing.T) {
+		err := Promote(ctx, newTestClient(t), string(openchoreov1alpha1.ComponentTypeWebApplication), req)
+		if !errors.Is(err, ErrBindingNotFound) {
+			t.Errorf("Promote() = %v, want ErrBindingNotFound", err)
+		}
+	})
+
+	t.Run("rejects an unknown binding type", func(t *testing.T) {
+		if err := Promote(ctx, newTestClient(t), "Unknown", req); err == nil {
+			t.Error("Promote() = nil, want an error")
+		}
+	})
+}
+
+func TestGetComponentDeployment(t *testing.T) {
+	ctx := context.Background()
+	newDeployment := func(name, project, component, environment string) *openchoreov1alpha1.ComponentDeployment {
+		return &openchoreov1alpha1.ComponentDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+				Owner:       openchoreov1alpha1.ComponentDeploymentOwner{ProjectName: project, ComponentName: component},
+				Environment: environment,
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		objs      []client.Object
+		want      string
+		wantLists int
+	}{
+		{
+			name:      "named after the component and environment",
+			objs:      []client.Object{newDeployment("cart-staging", "shop", "cart", "staging")},
+			want:      "cart-staging",
+			wantLists: 0,
+		},
+		{
+			name:      "named otherwise",
+			objs:      []client.Object{newDeployment("cart-stg", "shop", "cart", "staging")},
+			want:      "cart-stg",
+			wantLists: 1,
+		},
+		{
+			name: "name taken by a component of another project",
+			objs: []client.Object{
+				newDeployment("cart-staging", "billing", "cart", "staging"),
+				newDeployment("shop-cart-staging", "shop", "cart", "staging"),
+			},
+			want:      "shop-cart-staging",
+			wantLists: 1,
+		},
+		{
+			name:      "not deployed",
+			objs:      []client.Object{newDeployment("cart-development", "shop", "cart", "development")},
+			wantLists: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists := 0
+			c := interceptor.NewClient(newTestClient(t, tt.objs...).(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					lists++
+					return c.List(ctx, list, opts...)
+				},
+			})
+			cd, err := GetComponentDeployment(ctx, c, testNamespace, "shop", "cart", "staging")
+			if tt.want == "" {
+				if !errors.Is(err, ErrBindingNotFound) {
+					t.Errorf("GetComponentDeployment() = %v, %v, want ErrBindingNotFound", cd, err)
+				}
+			} else if err != nil || cd.Name != tt.want {
+				t.Errorf("GetComponentDeployment() = %v, %v, want %s", cd, err, tt.want)
+			}
+			if lists != tt.wantLists {
+				t.Errorf("lists = %d, want %d", lists, tt.wantLists)
+			}
+		})
+	}
+}
@@ -15,10 +15,13 @@ import (
 func NewApplyCmd(impl api.CommandImplementationInterface) *cobra.Command {
 	return (&builder.CommandBuilder{
 		Command: constants.Apply,
-		Flags:   []flags.Flag{flags.ApplyFileFlag},
+		Flags:   []flags.Flag{flags.ApplyFileFlag, flags.DryRun, flags.ForceConflicts, flags.Atomic},
 		RunE: func(fg *builder.FlagGetter) error {
 			return impl.Apply(api.ApplyParams{
 				FilePath: fg.GetString(flags.ApplyFileFlag),
+				DryRun:   fg.GetBool(flags.DryRun),
+				Force:    fg.GetBool(flags.ForceConflicts),
+				Atomic:   fg.GetBool(flags.Atomic),
 			})
 		},
 	}).Build()
@@ -0,0 +1,37 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewMCPCmd creates the mcp command
+func NewMCPCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.MCP.Use,
+		Short: constants.MCP.Short,
+		Long:  constants.MCP.Long,
+	}
+	cmd.AddCommand(newServeCmd(impl))
+	return cmd
+}
+
+func newServeCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.MCPServe,
+		Flags:   []flags.Flag{flags.MCPToolsets, flags.ReadOnly},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ServeMCP(api.ServeMCPParams{
+				Toolsets: fg.GetString(flags.MCPToolsets),
+				ReadOnly: fg.GetBool(flags.ReadOnly),
+			})
+		},
+	}).Build()
+}
@@ -78,9 +78,18 @@ Examples:
 		Short: "Apply OpenChoreo resources by file name",
 		Long: fmt.Sprintf(`Apply a configuration file to create or update OpenChoreo resources.
 
+Resources are applied with server-side apply. All resources of a file or directory are sent
+in a single request.
+
 	Examples:
 	  # Apply an organization configuration
-	  %[1]s apply -f organization.yaml`,
+	  %[1]s apply -f organization.yaml
+
+	  # Show the changes the resources of a directory would make
+	  %[1]s apply -f manifests/ --dry-run
+
+	  # Apply all resources of a directory, or none if one of them is invalid
+	  %[1]s apply -f manifests/ --atomic`,
 			messages.DefaultCLIName),
 	}
 
@@ -246,6 +255,10 @@ This command allows you to:
   # Stream logs from a specific build
   choreoctl logs --type build --build product-catalog-build-01 --organization acme-corp --project online-store \
    --component product-catalog --follow
+
+  # Follow the logs of a specific deployment through the API server
+  choreoctl logs --type deployment --deployment product-catalog-dev-01 --organization acme-corp --project online-store \
+  --component product-catalog --environment development --follow
   `,
 	}
 
@@ -625,6 +638,32 @@ If no organization is specified, you will be prompted to select one interactivel
 	// FlagDeployableArtifactDesc is used for the --deployableartifact flag.
 	FlagDeployableArtifactDesc = "Deployable artifact name stored in this configuration context"
 
+	// ------------------------------------------------------------------------
+	// MCP Command Definitions
+	// ------------------------------------------------------------------------
+
+	MCP = Command{
+		Use:   "mcp",
+		Short: "Serve OpenChoreo to AI assistants over MCP",
+		Long:  "Serve the OpenChoreo MCP (Model Context Protocol) tools to AI assistants running on this machine.",
+	}
+
+	MCPServe = Command{
+		Use:   "serve",
+		Short: "Start a stdio MCP server backed by the OpenChoreo API server",
+		Long: `Start an MCP server communicating over stdin and stdout, for assistants that launch MCP servers as
+local processes. The tool calls and resource reads are forwarded to the MCP endpoint of the configured
+control plane, and are authorized with its token.`,
+		Example: fmt.Sprintf(`  # Serve all toolsets
+  %[1]s mcp serve
+
+  # Serve the project and component toolsets
+  %[1]s mcp serve --toolsets project,component
+
+  # Serve only the tools that don't modify resources
+  %[1]s mcp serve --read-only`, messages.DefaultCLIName),
+	}
+
 	// ------------------------------------------------------------------------
 	// Delete Command Definitions
 	// ------------------------------------------------------------------------
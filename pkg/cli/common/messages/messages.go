@@ -64,6 +64,11 @@ const (
 	DeleteFileFlag             = "Path to the configuration file to delete (e.g., manifests/deployment.yaml)"
 	WorkloadDescriptorFlag     = "Path to the workload descriptor file (e.g., workload.yaml)"
 	FlagWaitDesc               = "Wait for resources to be deleted before returning"
+	FlagDryRunDesc             = "Show the changes the resources would make without applying them"
+	FlagForceConflictsDesc     = "Take over fields managed by other field managers instead of failing"
+	FlagAtomicDesc             = "Apply none of the resources if any of them fails validation"
 	FlagEnvironmentOrderDesc   = "Comma-separated list of environment names in promotion order (e.g., dev,staging,prod)"
 	FlagDeploymentPipelineDesc = "Name of the deployment pipeline (e.g., dev-prod-pipeline)"
+	FlagMCPToolsetsDesc        = "Comma-separated list of MCP toolsets to serve (e.g., organization,project,component). Serves all toolsets if omitted"
+	FlagReadOnlyDesc           = "Serve only the tools that don't modify resources"
 )
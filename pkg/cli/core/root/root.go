@@ -10,6 +10,7 @@ import (
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/create"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/delete"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/mcp"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/version"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
@@ -33,6 +34,7 @@ func BuildRootCmd(config *config.CLIConfig, impl api.CommandImplementationInterf
 		// logs.NewLogsCmd(impl),
 		configContext.NewConfigCmd(impl),
 		delete.NewDeleteCmd(impl),
+		mcp.NewMCPCmd(impl),
 		version.NewVersionCmd(),
 	)
 
@@ -106,6 +106,35 @@ var (
 		Usage:     messages.ApplyFileFlag,
 	}
 
+	DryRun = Flag{
+		Name:  "dry-run",
+		Usage: messages.FlagDryRunDesc,
+		Type:  "bool",
+	}
+
+	ForceConflicts = Flag{
+		Name:  "force-conflicts",
+		Usage: messages.FlagForceConflictsDesc,
+		Type:  "bool",
+	}
+
+	Atomic = Flag{
+		Name:  "atomic",
+		Usage: messages.FlagAtomicDesc,
+		Type:  "bool",
+	}
+
+	MCPToolsets = Flag{
+		Name:  "toolsets",
+		Usage: messages.FlagMCPToolsetsDesc,
+	}
+
+	ReadOnly = Flag{
+		Name:  "read-only",
+		Usage: messages.FlagReadOnlyDesc,
+		Type:  "bool",
+	}
+
 	LogType = Flag{
 		Name:  "type",
 		Usage: messages.FlagLogTypeDesc,
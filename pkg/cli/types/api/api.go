@@ -24,6 +24,7 @@ type CommandImplementationInterface interface {
 	DeploymentPipelineAPI
 	ConfigurationGroupAPI
 	WorkloadAPI
+	MCPAPI
 }
 
 // OrganizationAPI defines organization-related operations
@@ -126,3 +127,8 @@ type ConfigurationGroupAPI interface {
 type WorkloadAPI interface {
 	CreateWorkload(params CreateWorkloadParams) error
 }
+
+// MCPAPI defines methods for serving the OpenChoreo MCP tools to local assistants
+type MCPAPI interface {
+	ServeMCP(params ServeMCPParams) error
+}
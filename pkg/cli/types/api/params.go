@@ -74,6 +74,15 @@ type CreateComponentParams struct {
 // ApplyParams defines parameters for applying configuration files
 type ApplyParams struct {
 	FilePath string
+	DryRun   bool
+	Force    bool
+	Atomic   bool
+}
+
+// ServeMCPParams defines parameters for serving the OpenChoreo MCP tools over stdio
+type ServeMCPParams struct {
+	Toolsets string
+	ReadOnly bool
 }
 
 type DeleteParams struct {
@@ -0,0 +1,170 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RegisterPrompts registers the prompts whose tools are enabled. Prompts are curated workflows
+// that guide an assistant through the tools of several toolsets.
+func (t *Toolsets) RegisterPrompts(s *mcp.Server) {
+	if t.DeploymentToolset != nil && t.ObservabilityToolset != nil {
+		t.RegisterDiagnoseFailingDeploymentPrompt(s)
+	}
+	if t.PlatformToolset != nil && t.BuildToolset != nil && t.SchemaToolset != nil {
+		t.RegisterScaffoldComponentPrompt(s)
+	}
+	if t.DeploymentToolset != nil && t.InfrastructureToolset != nil && t.ObservabilityToolset != nil {
+		t.RegisterPromoteToNextEnvironmentPrompt(s)
+	}
+}
+
+func (t *Toolsets) RegisterDiagnoseFailingDeploymentPrompt(s *mcp.Server) {
+	s.AddPrompt(&mcp.Prompt{
+		Name:        "diagnose_failing_deployment",
+		Title:       "Diagnose failing deployment",
+		Description: "Find the root cause of a component deployment that is failing or unhealthy in an environment",
+		Arguments: []*mcp.PromptArgument{
+			promptArgument("org_name", "Organization of the component", true),
+			promptArgument("project_name", "Project of the component", true),
+			promptArgument("component_name", "Component whose deployment is failing", true),
+			promptArgument("environment", "Environment the deployment is failing in", true),
+		},
+	}, func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		args, err := promptArguments(req, "org_name", "project_name", "component_name", "environment")
+		if err != nil {
+			return nil, err
+		}
+		return promptResult("Diagnose a failing deployment", fmt.Sprintf(
+			`The deployment of component %[3]q of project %[2]q in organization %[1]q to environment %[4]q is failing.
+Find the root cause:
+
+1. Call get_release_health to find the unhealthy resources of the release and their conditions.
+2. Call get_component_deployment to check the status conditions, overrides and rollout strategy of the deployment.
+3. Call get_component_logs with the ERROR and WARN levels for the environment. If there are no entries,
+   widen the time range or drop the level filter.
+4. If the component serves requests, call get_component_traces to look for failing or slow spans.
+5. If the release never became ready after a new build, call list_builds and get_build_logs for the latest build.
+
+Report the root cause with the evidence supporting it, and propose a fix. Don't change any resource
+before the user confirms the fix.`,
+			args["org_name"], args["project_name"], args["component_name"], args["environment"])), nil
+	})
+}
+
+func (t *Toolsets) RegisterScaffoldComponentPrompt(s *mcp.Server) {
+	s.AddPrompt(&mcp.Prompt{
+		Name:        "scaffold_component",
+		Title:       "Scaffold a component from a repository",
+		Description: "Create a component for the source code of a Git repository using the component types, traits and workflows of the platform",
+		Arguments: []*mcp.PromptArgument{
+			promptArgument("org_name", "Organization to create the component in", true),
+			promptArgument("project_name", "Project to create the component in", true),
+			promptArgument("repository_url", "URL of the Git repository of the component", true),
+			promptArgument("branch", "Branch to build, the default branch of the repository if not set", false),
+			promptArgument("component_name", "Name of the component, derived from the repository if not set", false),
+		},
+	}, func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		args, err := promptArguments(req, "org_name", "project_name", "repository_url")
+		if err != nil {
+			return nil, err
+		}
+		branch := args["branch"]
+		if branch == "" {
+			branch = "the default branch"
+		}
+		name := args["component_name"]
+		if name == "" {
+			name = "a name derived from the repository"
+		}
+		return promptResult("Scaffold a component from a repository", fmt.Sprintf(
+			`Scaffold a component named %[4]s in project %[2]q of organization %[1]q from %[5]s of the
+repository %[3]s:
+
+1. Inspect the repository to find the language, the build tool, the Dockerfile if any, and the ports
+   the application listens on.
+2. Call list_component_types and get_component_type to choose the component type matching the workload,
+   such as a service, web application or scheduled task.
+3. Call list_traits to find the traits the component needs, such as persistent volumes or API management.
+4. Call list_workflows and get_workflow to choose the workflow building the source code.
+5. Call explain_schema for the Component kind to check the fields of the component.
+6. Present the Component YAML with the parameters of its type, traits and workflow, and ask the user to
+   confirm it. Apply the confirmed YAML with choreoctl apply -f.
+7. Once the component exists, call trigger_build and follow the build with list_builds.
+
+Use only the component types, traits and workflows defined by the platform, and only the parameters
+their schemas define.`,
+			args["org_name"], args["project_name"], args["repository_url"], name, branch)), nil
+	})
+}
+
+func (t *Toolsets) RegisterPromoteToNextEnvironmentPrompt(s *mcp.Server) {
+	s.AddPrompt(&mcp.Prompt{
+		Name:        "promote_to_next_environment",
+		Title:       "Promote to the next environment",
+		Description: "Promote a component to the next environment of its deployment pipeline once it is healthy",
+		Arguments: []*mcp.PromptArgument{
+			promptArgument("org_name", "Organization of the component", true),
+			promptArgument("project_name", "Project of the component", true),
+			promptArgument("component_name", "Component to promote", true),
+			promptArgument("environment", "Environment to promote the component from", true),
+		},
+	}, func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		args, err := promptArguments(req, "org_name", "project_name", "component_name", "environment")
+		if err != nil {
+			return nil, err
+		}
+		return promptResult("Promote to the next environment", fmt.Sprintf(
+			`Promote component %[3]q of project %[2]q in organization %[1]q from environment %[4]q to the next
+environment of its deployment pipeline:
+
+1. Call get_deployment_pipeline for the project to find the target environments of %[4]q. If there are
+   several, ask the user which one. If the promotion requires approval, stop and tell the user who must
+   approve it.
+2. Call get_release_health for %[4]q. Don't promote a release that is not Healthy.
+3. Call get_environment for the target environment. If it has an active deployment freeze, stop unless
+   the user gives a justification to override it.
+4. Call get_component_env_snapshot for %[4]q and get_component_deployment for the target environment, and
+   summarize what changes for the target environment.
+5. Ask the user to confirm the promotion, then call promote_component from %[4]q to the target
+   environment, with the justification of the user as freeze_override_justification if there is a freeze.
+6. Follow get_release_health for the target environment until it is Healthy or Degraded, and report
+   the outcome. If it is Degraded while a rollout is in progress, offer to roll it back with
+   rollback_component_deployment.`,
+			args["org_name"], args["project_name"], args["component_name"], args["environment"])), nil
+	})
+}
+
+// promptArgument returns the argument of a prompt
+func promptArgument(name, description string, required bool) *mcp.PromptArgument {
+	return &mcp.PromptArgument{Name: name, Description: description, Required: required}
+}
+
+// promptArguments returns the arguments of a prompt request, checking that the required ones are set
+func promptArguments(req *mcp.GetPromptRequest, required ...string) (map[string]string, error) {
+	args := req.Params.Arguments
+	for _, name := range required {
+		if args[name] == "" {
+			return nil, fmt.Errorf("argument %s is required", name)
+		}
+	}
+	if args == nil {
+		args = map[string]string{}
+	}
+	return args, nil
+}
+
+// promptResult returns a prompt made of a user message
+func promptResult(description, text string) *mcp.GetPromptResult {
+	return &mcp.GetPromptResult{
+		Description: description,
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: text}},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestPromptRegistration(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	result, err := clientSession.ListPrompts(context.Background(), &mcp.ListPromptsParams{})
+	if err != nil {
+		t.Fatalf("Failed to list prompts: %v", err)
+	}
+	prompts := make(map[string]bool)
+	for _, prompt := range result.Prompts {
+		prompts[prompt.Name] = true
+	}
+	for _, name := range []string{"diagnose_failing_deployment", "scaffold_component", "promote_to_next_environment"} {
+		if !prompts[name] {
+			t.Errorf("Expected prompt %q to be registered", name)
+		}
+	}
+}
+
+func TestPartialPromptRegistration(t *testing.T) {
+	clientSession := setupTestServerWithToolset(t, &Toolsets{DeploymentToolset: NewMockCoreToolsetHandler()})
+	defer clientSession.Close()
+
+	result, err := clientSession.ListPrompts(context.Background(), &mcp.ListPromptsParams{})
+	if err == nil && len(result.Prompts) > 0 {
+		t.Errorf("Expected no prompts without the observability toolset, got %d", len(result.Prompts))
+	}
+}
+
+func TestGetPrompt(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         map[string]string
+		wantKeywords []string
+	}{
+		{
+			name: "diagnose_failing_deployment",
+			args: map[string]string{
+				"org_name": testOrgName, "project_name": testProjectName,
+				"component_name": testComponentName, "environment": testEnvName,
+			},
+			wantKeywords: []string{testComponentName, "get_release_health", "get_component_logs"},
+		},
+		{
+			name: "scaffold_component",
+			args: map[string]string{
+				"org_name": testOrgName, "project_name": testProjectName,
+				"repository_url": "https://github.com/openchoreo/sample-workloads",
+			},
+			wantKeywords: []string{"https://github.com/openchoreo/sample-workloads", "list_component_types", "trigger_build"},
+		},
+		{
+			name: "promote_to_next_environment",
+			args: map[string]string{
+				"org_name": testOrgName, "project_name": testProjectName,
+				"component_name": testComponentName, "environment": testEnvName,
+			},
+			wantKeywords: []string{testComponentName, "get_deployment_pipeline", "freeze", "promote_component"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientSession, _ := setupTestServer(t)
+			defer clientSession.Close()
+
+			result, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{Name: tt.name, Arguments: tt.args})
+			if err != nil {
+				t.Fatalf("Failed to get prompt: %v", err)
+			}
+			if len(result.Messages) != 1 || result.Messages[0].Role != "user" {
+				t.Fatalf("Expected one user message, got %+v", result.Messages)
+			}
+			text, ok := result.Messages[0].Content.(*mcp.TextContent)
+			if !ok {
+				t.Fatalf("Expected text content, got %T", result.Messages[0].Content)
+			}
+			for _, keyword := range tt.wantKeywords {
+				if !strings.Contains(text.Text, keyword) {
+					t.Errorf("Expected prompt to contain %q", keyword)
+				}
+			}
+
+			if _, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{Name: tt.name}); err == nil {
+				t.Error("Expected getting the prompt without its required arguments to fail")
+			}
+		})
+	}
+}
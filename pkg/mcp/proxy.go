@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// remoteToolsetHandler enables the toolsets of a server proxying a remote OpenChoreo MCP server. Its methods are
+// never called, since the tool calls and resource reads of the proxy are forwarded to the remote server.
+type remoteToolsetHandler struct {
+	OrganizationToolsetHandler
+	ProjectToolsetHandler
+	ComponentToolsetHandler
+	BuildToolsetHandler
+	DeploymentToolsetHandler
+	InfrastructureToolsetHandler
+	SchemaToolsetHandler
+	PlatformToolsetHandler
+	ObservabilityToolsetHandler
+}
+
+// NewSTDIOProxy creates the MCP server served over stdio that proxies the given toolsets of a remote OpenChoreo
+// MCP server. The tools, resource templates and prompts of the toolsets are served by the proxy, and the tool calls
+// and resource reads are forwarded to the remote server, which authorizes them with the credentials of its session.
+func NewSTDIOProxy(remote *mcp.ClientSession, toolsets map[ToolsetType]bool, readOnly bool) *mcp.Server {
+	handler := &remoteToolsetHandler{}
+	tools := &Toolsets{ReadOnly: readOnly, remote: remote}
+	for toolsetType := range toolsets {
+		switch toolsetType {
+		case ToolsetOrganization:
+			tools.OrganizationToolset = handler
+		case ToolsetProject:
+			tools.ProjectToolset = handler
+		case ToolsetComponent:
+			tools.ComponentToolset = handler
+		case ToolsetBuild:
+			tools.BuildToolset = handler
+		case ToolsetDeployment:
+			tools.DeploymentToolset = handler
+		case ToolsetInfrastructure:
+			tools.InfrastructureToolset = handler
+		case ToolsetSchema:
+			tools.SchemaToolset = handler
+		case ToolsetPlatform:
+			tools.PlatformToolset = handler
+		case ToolsetObservability:
+			tools.ObservabilityToolset = handler
+		}
+	}
+	return NewSTDIO(tools)
+}
+
+// forwardToolCall calls a tool of the remote server, returning its result as is
+func (t *Toolsets) forwardToolCall(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return t.remote.CallTool(ctx, &mcp.CallToolParams{
+		Meta:      req.Params.Meta,
+		Name:      req.Params.Name,
+		Arguments: req.Params.Arguments,
+	})
+}
+
+// forwardResourceRead reads a resource of the remote server
+func (t *Toolsets) forwardResourceRead(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	return t.remote.ReadResource(ctx, req.Params)
+}
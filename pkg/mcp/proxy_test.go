@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// setupTestProxy creates a proxy of the given toolsets of a test MCP server, returning a client session of the proxy
+func setupTestProxy(
+	t *testing.T, toolsets map[ToolsetType]bool, readOnly bool,
+) (*mcp.ClientSession, *MockCoreToolsetHandler) {
+	t.Helper()
+	remote, mockHandler := setupTestServer(t)
+	t.Cleanup(func() { remote.Close() })
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := NewSTDIOProxy(remote, toolsets, readOnly).Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("Failed to connect proxy: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	return clientSession, mockHandler
+}
+
+func TestProxyForwardsToolCalls(t *testing.T) {
+	clientSession, mockHandler := setupTestProxy(t, map[ToolsetType]bool{ToolsetProject: true}, false)
+	defer clientSession.Close()
+
+	result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "get_project",
+		Arguments: map[string]any{"org_name": testOrgName, "project_name": testProjectName},
+	})
+	if err != nil {
+		t.Fatalf("Failed to call tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected tool call to succeed, got %+v", result.Content)
+	}
+	if result.StructuredContent == nil {
+		t.Error("Expected the structured content of the remote result")
+	}
+	calls := mockHandler.calls["GetProject"]
+	if len(calls) != 1 {
+		t.Fatalf("Expected GetProject to be called once on the remote server, got %d calls", len(calls))
+	}
+	if args := calls[0].([]interface{}); args[0] != testOrgName || args[1] != testProjectName {
+		t.Errorf("Expected GetProject(%q, %q), got %v", testOrgName, testProjectName, args)
+	}
+}
+
+func TestProxyForwardsResourceReads(t *testing.T) {
+	clientSession, mockHandler := setupTestProxy(t, map[ToolsetType]bool{ToolsetProject: true}, false)
+	defer clientSession.Close()
+
+	uri := "openchoreo://orgs/" + testOrgName + "/projects/" + testProjectName
+	result, err := clientSession.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: uri})
+	if err != nil {
+		t.Fatalf("Failed to read resource: %v", err)
+	}
+	if len(result.Contents) != 1 || !strings.Contains(result.Contents[0].Text, "project1") {
+		t.Errorf("Expected the project read from the remote server, got %+v", result.Contents)
+	}
+	if len(mockHandler.calls["GetProject"]) != 1 {
+		t.Errorf("Expected GetProject to be called on the remote server")
+	}
+}
+
+func TestProxyToolsetSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		toolsets  map[ToolsetType]bool
+		readOnly  bool
+		wantTools []string
+		noTools   []string
+	}{
+		{
+			name:      "selected toolsets",
+			toolsets:  map[ToolsetType]bool{ToolsetComponent: true},
+			wantTools: []string{"list_components", "update_component_traits", "delete_component"},
+			noTools:   []string{"list_projects", "trigger_build"},
+		},
+		{
+			name:      "read-only",
+			toolsets:  map[ToolsetType]bool{ToolsetComponent: true, ToolsetDeployment: true},
+			readOnly:  true,
+			wantTools: []string{"list_components", "get_component_deployment", "get_release"},
+			noTools:   []string{"update_component_traits", "delete_component", "promote_component", "rollback_component_deployment"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientSession, _ := setupTestProxy(t, tt.toolsets, tt.readOnly)
+			defer clientSession.Close()
+
+			result, err := clientSession.ListTools(context.Background(), &mcp.ListToolsParams{})
+			if err != nil {
+				t.Fatalf("Failed to list tools: %v", err)
+			}
+			tools := make(map[string]*mcp.Tool)
+			for _, tool := range result.Tools {
+				tools[tool.Name] = tool
+			}
+			for _, name := range tt.wantTools {
+				if tools[name] == nil {
+					t.Errorf("Expected tool %q to be registered", name)
+				}
+			}
+			for _, name := range tt.noTools {
+				if tools[name] != nil {
+					t.Errorf("Expected tool %q not to be registered", name)
+				}
+			}
+			if tt.readOnly {
+				for name, tool := range tools {
+					if tool.Annotations == nil || !tool.Annotations.ReadOnlyHint {
+						t.Errorf("Expected tool %q of a read-only server to be annotated as read-only", name)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestToolAnnotations(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	result, err := clientSession.ListTools(context.Background(), &mcp.ListToolsParams{})
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	for _, tool := range result.Tools {
+		if tool.Annotations == nil {
+			t.Errorf("Expected tool %q to be annotated", tool.Name)
+			continue
+		}
+		readOnly := strings.HasPrefix(tool.Name, "get_") || strings.HasPrefix(tool.Name, "list_") ||
+			tool.Name == "explain_schema"
+		if tool.Annotations.ReadOnlyHint != readOnly {
+			t.Errorf("Expected tool %q to have ReadOnlyHint %v", tool.Name, readOnly)
+		}
+		destructive := strings.HasPrefix(tool.Name, "delete_") || strings.HasPrefix(tool.Name, "rollback_")
+		if !readOnly && (tool.Annotations.DestructiveHint == nil || *tool.Annotations.DestructiveHint != destructive) {
+			t.Errorf("Expected tool %q to have DestructiveHint %v", tool.Name, destructive)
+		}
+	}
+}
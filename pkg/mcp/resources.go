@@ -0,0 +1,312 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ResourceScheme is the URI scheme of the OpenChoreo resources exposed by the MCP server
+const ResourceScheme = "openchoreo"
+
+// Kinds of the resources exposed by the MCP server
+const (
+	ResourceKindOrganization        = "Organization"
+	ResourceKindEnvironment         = "Environment"
+	ResourceKindProject             = "Project"
+	ResourceKindComponent           = "Component"
+	ResourceKindComponentDeployment = "ComponentDeployment"
+	ResourceKindRelease             = "Release"
+	ResourceKindReleaseHealth       = "ReleaseHealth"
+)
+
+// resourcePaths are the URI paths of the kinds of resources. The variables of the paths are
+// the fields of a ResourceRef.
+var resourcePaths = []struct {
+	kind string
+	path string
+}{
+	{ResourceKindOrganization, "orgs/{org}"},
+	{ResourceKindEnvironment, "orgs/{org}/environments/{environment}"},
+	{ResourceKindProject, "orgs/{org}/projects/{project}"},
+	{ResourceKindComponent, "orgs/{org}/projects/{project}/components/{component}"},
+	{ResourceKindComponentDeployment, "orgs/{org}/projects/{project}/components/{component}/component-deployments/{environment}"},
+	{ResourceKindRelease, "orgs/{org}/projects/{project}/components/{component}/releases/{environment}"},
+	{ResourceKindReleaseHealth, "orgs/{org}/projects/{project}/components/{component}/releases/{environment}/health"},
+}
+
+// subscribableKinds are the kinds of resources clients can subscribe to. Their changes are the status changes
+// of the components and of the resources that belong to them.
+var subscribableKinds = []string{
+	ResourceKindComponent, ResourceKindComponentDeployment, ResourceKindRelease, ResourceKindReleaseHealth,
+}
+
+// ResourceRef identifies a resource exposed by the MCP server
+type ResourceRef struct {
+	Kind          string
+	OrgName       string
+	ProjectName   string
+	ComponentName string
+	Environment   string
+}
+
+// ParseResourceURI parses the URI of a resource, such as openchoreo://orgs/{org}/projects/{project}
+func ParseResourceURI(uri string) (ResourceRef, error) {
+	path, ok := strings.CutPrefix(uri, ResourceScheme+"://")
+	if !ok {
+		return ResourceRef{}, fmt.Errorf("resource URI %q must start with %s://", uri, ResourceScheme)
+	}
+	segments := strings.Split(path, "/")
+	for _, p := range resourcePaths {
+		if ref, ok := matchResourcePath(p.kind, p.path, segments); ok {
+			return ref, nil
+		}
+	}
+	return ResourceRef{}, fmt.Errorf("unknown resource URI %q", uri)
+}
+
+// matchResourcePath returns the reference of the resource whose URI path segments match the path of a kind
+func matchResourcePath(kind, path string, segments []string) (ResourceRef, bool) {
+	pattern := strings.Split(path, "/")
+	if len(pattern) != len(segments) {
+		return ResourceRef{}, false
+	}
+	ref := ResourceRef{Kind: kind}
+	fields := map[string]*string{
+		"{org}":         &ref.OrgName,
+		"{project}":     &ref.ProjectName,
+		"{component}":   &ref.ComponentName,
+		"{environment}": &ref.Environment,
+	}
+	for i, segment := range segments {
+		field, ok := fields[pattern[i]]
+		if !ok {
+			if segment != pattern[i] {
+				return ResourceRef{}, false
+			}
+			continue
+		}
+		value, err := url.PathUnescape(segment)
+		if err != nil || value == "" {
+			return ResourceRef{}, false
+		}
+		*field = value
+	}
+	return ref, true
+}
+
+// resourceReader reads a resource, which is returned to clients as JSON
+type resourceReader func(ctx context.Context, ref ResourceRef) (any, error)
+
+// resourceReaders returns the readers of the kinds of resources whose toolsets are enabled
+func (t *Toolsets) resourceReaders() map[string]resourceReader {
+	readers := make(map[string]resourceReader)
+	if t.OrganizationToolset != nil {
+		readers[ResourceKindOrganization] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.OrganizationToolset.GetOrganization(ctx, ref.OrgName)
+		}
+	}
+	if t.InfrastructureToolset != nil {
+		readers[ResourceKindEnvironment] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.InfrastructureToolset.GetEnvironment(ctx, ref.OrgName, ref.Environment)
+		}
+	}
+	if t.ProjectToolset != nil {
+		readers[ResourceKindProject] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.ProjectToolset.GetProject(ctx, ref.OrgName, ref.ProjectName)
+		}
+	}
+	if t.ComponentToolset != nil {
+		readers[ResourceKindComponent] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.ComponentToolset.GetComponent(ctx, ref.OrgName, ref.ProjectName, ref.ComponentName, nil)
+		}
+	}
+	if t.DeploymentToolset != nil {
+		readers[ResourceKindComponentDeployment] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.DeploymentToolset.GetComponentDeployment(ctx, ref.OrgName, ref.ProjectName, ref.ComponentName, ref.Environment)
+		}
+		readers[ResourceKindRelease] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.DeploymentToolset.GetRelease(ctx, ref.OrgName, ref.ProjectName, ref.ComponentName, ref.Environment)
+		}
+	}
+	if t.ObservabilityToolset != nil {
+		readers[ResourceKindReleaseHealth] = func(ctx context.Context, ref ResourceRef) (any, error) {
+			return t.ObservabilityToolset.GetReleaseHealth(ctx, ref.OrgName, ref.ProjectName, ref.ComponentName, ref.Environment)
+		}
+	}
+	return readers
+}
+
+// resourceDescriptions describe the kinds of resources to clients
+var resourceDescriptions = map[string]string{
+	ResourceKindOrganization: "An organization, the top-level tenant boundary of projects, environments and infrastructure",
+	ResourceKindEnvironment:  "An environment of an organization, such as development or production, with its data plane",
+	ResourceKindProject:      "A project, the bounded context grouping the components of an application",
+	ResourceKindComponent:    "A component of a project with its type, status and build configuration",
+	ResourceKindComponentDeployment: "The deployment of a component to an environment with its overrides, " +
+		"rollout strategy and status",
+	ResourceKindRelease: "The Kubernetes resources a component released to the data plane of an environment, " +
+		"with their health",
+	ResourceKindReleaseHealth: "The overall health of the release of a component in an environment and its " +
+		"unhealthy resources",
+}
+
+// RegisterResources registers the resource templates of the kinds of resources whose toolsets are enabled
+func (t *Toolsets) RegisterResources(s *mcp.Server) {
+	readers := t.resourceReaders()
+	for _, p := range resourcePaths {
+		read, ok := readers[p.kind]
+		if !ok {
+			continue
+		}
+		description := resourceDescriptions[p.kind]
+		if t.ResourceWatcher != nil && slices.Contains(subscribableKinds, p.kind) {
+			description += ". Subscribe to it to be notified of its status changes."
+		}
+		handler := resourceHandler(p.kind, read)
+		if t.remote != nil {
+			handler = t.forwardResourceRead
+		}
+		s.AddResourceTemplate(&mcp.ResourceTemplate{
+			Name:        p.kind,
+			URITemplate: ResourceScheme + "://" + p.path,
+			Description: description,
+			MIMEType:    "application/json",
+		}, handler)
+	}
+}
+
+// resourceHandler returns the handler reading the resources of a kind
+func resourceHandler(kind string, read resourceReader) mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		ref, err := ParseResourceURI(req.Params.URI)
+		if err != nil || ref.Kind != kind {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		resource, err := read(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{
+			{URI: req.Params.URI, MIMEType: "application/json", Text: string(data)},
+		}}, nil
+	}
+}
+
+// subscriptions watches the resources clients subscribed to and notifies the subscribed sessions of their
+// changes. A resource is watched once for all its subscribers, and its watch stops with its last subscriber.
+type subscriptions struct {
+	tools  *Toolsets
+	server *mcp.Server
+
+	mu      sync.Mutex
+	watches map[string]*resourceWatch
+}
+
+// resourceWatch is the watch of a subscribed resource
+type resourceWatch struct {
+	sessions map[*mcp.ServerSession]bool
+	cancel   context.CancelFunc
+}
+
+func newSubscriptions(tools *Toolsets) *subscriptions {
+	return &subscriptions{tools: tools, watches: make(map[string]*resourceWatch)}
+}
+
+// serverOptions returns the options of a server supporting the subscriptions, nil if resources can't be watched
+func (s *subscriptions) serverOptions() *mcp.ServerOptions {
+	if s.tools.ResourceWatcher == nil {
+		return nil
+	}
+	return &mcp.ServerOptions{
+		SubscribeHandler: s.subscribe,
+		UnsubscribeHandler: func(_ context.Context, req *mcp.UnsubscribeRequest) error {
+			s.remove(req.Params.URI, req.Session)
+			return nil
+		},
+	}
+}
+
+// subscribe subscribes the session of a request to a resource. Clients may only subscribe to the
+// resources they are allowed to read.
+func (s *subscriptions) subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	uri := req.Params.URI
+	ref, err := ParseResourceURI(uri)
+	if err != nil {
+		return err
+	}
+	read, ok := s.tools.resourceReaders()[ref.Kind]
+	if !ok || !slices.Contains(subscribableKinds, ref.Kind) {
+		return fmt.Errorf("resources of kind %s don't support subscriptions", ref.Kind)
+	}
+	if _, err := read(ctx, ref); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	watch, ok := s.watches[uri]
+	if !ok {
+		// The watch outlives the subscribe request
+		watchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		changes, err := s.tools.ResourceWatcher.WatchResource(watchCtx, ref)
+		if err != nil {
+			cancel()
+			return err
+		}
+		watch = &resourceWatch{sessions: make(map[*mcp.ServerSession]bool), cancel: cancel}
+		s.watches[uri] = watch
+		go s.notify(watchCtx, uri, watch, changes)
+	}
+	if !watch.sessions[req.Session] {
+		watch.sessions[req.Session] = true
+		// Sessions that end without unsubscribing are removed once they are closed
+		go func() {
+			_ = req.Session.Wait()
+			s.remove(uri, req.Session)
+		}()
+	}
+	return nil
+}
+
+// notify notifies the subscribers of a resource of its changes until its watch ends
+func (s *subscriptions) notify(ctx context.Context, uri string, watch *resourceWatch, changes <-chan struct{}) {
+	for range changes {
+		_ = s.server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.watches[uri] == watch {
+		delete(s.watches, uri)
+		watch.cancel()
+	}
+}
+
+// remove unsubscribes a session from a resource, stopping its watch once it has no subscribers
+func (s *subscriptions) remove(uri string, session *mcp.ServerSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	watch, ok := s.watches[uri]
+	if !ok {
+		return
+	}
+	delete(watch.sessions, session)
+	if len(watch.sessions) == 0 {
+		delete(s.watches, uri)
+		watch.cancel()
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseResourceURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    ResourceRef
+		wantErr bool
+	}{
+		{
+			uri:  "openchoreo://orgs/my-org",
+			want: ResourceRef{Kind: ResourceKindOrganization, OrgName: "my-org"},
+		},
+		{
+			uri:  "openchoreo://orgs/my-org/environments/dev",
+			want: ResourceRef{Kind: ResourceKindEnvironment, OrgName: "my-org", Environment: "dev"},
+		},
+		{
+			uri:  "openchoreo://orgs/my-org/projects/my-project/components/my-component",
+			want: ResourceRef{Kind: ResourceKindComponent, OrgName: "my-org", ProjectName: "my-project", ComponentName: "my-component"},
+		},
+		{
+			uri: "openchoreo://orgs/my-org/projects/my-project/components/my-component/component-deployments/dev",
+			want: ResourceRef{
+				Kind: ResourceKindComponentDeployment, OrgName: "my-org", ProjectName: "my-project",
+				ComponentName: "my-component", Environment: "dev",
+			},
+		},
+		{
+			uri: "openchoreo://orgs/my-org/projects/my-project/components/my-component/releases/dev/health",
+			want: ResourceRef{
+				Kind: ResourceKindReleaseHealth, OrgName: "my-org", ProjectName: "my-project",
+				ComponentName: "my-component", Environment: "dev",
+			},
+		},
+		{
+			uri:  "openchoreo://orgs/my%20org",
+			want: ResourceRef{Kind: ResourceKindOrganization, OrgName: "my org"},
+		},
+		{uri: "https://orgs/my-org", wantErr: true},
+		{uri: "openchoreo://orgs/", wantErr: true},
+		{uri: "openchoreo://orgs/my-org/projects", wantErr: true},
+		{uri: "openchoreo://orgs/my-org/builds/my-build", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			got, err := ParseResourceURI(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResourceURI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseResourceURI() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceTemplates(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	result, err := clientSession.ListResourceTemplates(context.Background(), &mcp.ListResourceTemplatesParams{})
+	if err != nil {
+		t.Fatalf("Failed to list resource templates: %v", err)
+	}
+	templates := make(map[string]string)
+	for _, template := range result.ResourceTemplates {
+		templates[template.Name] = template.URITemplate
+		if template.MIMEType != "application/json" {
+			t.Errorf("Resource template %q has MIME type %q, want application/json", template.Name, template.MIMEType)
+		}
+	}
+	for _, p := range resourcePaths {
+		if templates[p.kind] != "openchoreo://"+p.path {
+			t.Errorf("Resource template %q = %q, want %q", p.kind, templates[p.kind], "openchoreo://"+p.path)
+		}
+	}
+}
+
+func TestReadResource(t *testing.T) {
+	tests := []struct {
+		uri      string
+		method   string
+		wantArgs []interface{}
+		wantText string
+	}{
+		{
+			uri:      "openchoreo://orgs/my-org/projects/my-project",
+			method:   "GetProject",
+			wantArgs: []interface{}{testOrgName, testProjectName},
+			wantText: `"name":"project1"`,
+		},
+		{
+			uri:      "openchoreo://orgs/my-org/projects/my-project/components/my-component",
+			method:   "GetComponent",
+			wantArgs: []interface{}{testOrgName, testProjectName, testComponentName, []string(nil)},
+			wantText: `"name":"component1"`,
+		},
+		{
+			uri:      "openchoreo://orgs/my-org/projects/my-project/components/my-component/releases/dev/health",
+			method:   "GetReleaseHealth",
+			wantArgs: []interface{}{testOrgName, testProjectName, testComponentName, testEnvName},
+			wantText: `"health":"Healthy"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			clientSession, mockHandler := setupTestServer(t)
+			defer clientSession.Close()
+
+			result, err := clientSession.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: tt.uri})
+			if err != nil {
+				t.Fatalf("Failed to read resource: %v", err)
+			}
+			if len(result.Contents) != 1 || !strings.Contains(result.Contents[0].Text, tt.wantText) {
+				t.Errorf("Unexpected contents of %s: %+v", tt.uri, result.Contents)
+			}
+			calls := mockHandler.calls[tt.method]
+			if len(calls) != 1 {
+				t.Fatalf("Expected one call to %s, got %d", tt.method, len(calls))
+			}
+			if diff := cmp.Diff(tt.wantArgs, calls[0]); diff != "" {
+				t.Errorf("%s arguments mismatch (-want +got):\n%s", tt.method, diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceNotFound(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	_, err := clientSession.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: "openchoreo://orgs/my-org/builds/my-build"})
+	if err == nil {
+		t.Fatal("Expected reading an unknown resource to fail")
+	}
+}
+
+// fakeResourceWatcher reports the changes sent on its channel for the watched resources
+type fakeResourceWatcher struct {
+	watched chan ResourceRef
+	changes chan struct{}
+}
+
+func (w *fakeResourceWatcher) WatchResource(ctx context.Context, ref ResourceRef) (<-chan struct{}, error) {
+	w.watched <- ref
+	return w.changes, nil
+}
+
+func TestResourceSubscription(t *testing.T) {
+	mockHandler := NewMockCoreToolsetHandler()
+	watcher := &fakeResourceWatcher{watched: make(chan ResourceRef, 1), changes: make(chan struct{})}
+	toolsets := &Toolsets{ComponentToolset: mockHandler, ResourceWatcher: watcher}
+	server := newServer("test-openchoreo-api", toolsets)
+	toolsets.Register(server)
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+	updated := make(chan string, 1)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(_ context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			updated <- req.Params.URI
+		},
+	})
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer clientSession.Close()
+
+	if err := clientSession.Subscribe(ctx, &mcp.SubscribeParams{URI: "openchoreo://orgs/my-org"}); err == nil {
+		t.Error("Expected subscribing to an organization to fail")
+	}
+
+	uri := "openchoreo://orgs/my-org/projects/my-project/components/my-component"
+	if err := clientSession.Subscribe(ctx, &mcp.SubscribeParams{URI: uri}); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	want := ResourceRef{Kind: ResourceKindComponent, OrgName: testOrgName, ProjectName: testProjectName, ComponentName: testComponentName}
+	if diff := cmp.Diff(want, <-watcher.watched); diff != "" {
+		t.Errorf("Watched resource mismatch (-want +got):\n%s", diff)
+	}
+
+	watcher.changes <- struct{}{}
+	select {
+	case got := <-updated:
+		if got != uri {
+			t.Errorf("Notified of the update of %q, want %q", got, uri)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the resource updated notification")
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/openapi"
+)
+
+// Error codes of the tool errors raised by the MCP server itself. The errors of the toolset handlers
+// have the error codes of the OpenChoreo API.
+const (
+	CodeConfirmationRequired = "CONFIRMATION_REQUIRED"
+	CodeInvalidInput         = "INVALID_INPUT"
+	CodeInternalError        = "INTERNAL_ERROR"
+)
+
+// ToolError is the structured content of a failed tool call
+type ToolError struct {
+	// Code identifies the error, e.g. COMPONENT_NOT_FOUND
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ToolError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// ErrorCoder maps the errors of the toolset handlers to error codes
+type ErrorCoder interface {
+	// ErrorCode returns the code of an error, or an empty string if the error has no code
+	ErrorCode(err error) string
+}
+
+// maxSummaryNames is the number of item names listed in the summary of a list
+const maxSummaryNames = 10
+
+// deletedResult is the result of the tools that delete a resource
+type deletedResult struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Deleted bool   `json:"deleted"`
+}
+
+func deleted(kind, name string, err error) (*deletedResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &deletedResult{Kind: kind, Name: name, Deleted: true}, nil
+}
+
+// handleToolResult returns the result of a tool as structured content, along with a summary of the result and its
+// JSON encoding as text content. Errors are returned as tool errors with their error code.
+func (t *Toolsets) handleToolResult(result any, err error) (*mcp.CallToolResult, any, error) {
+	if err != nil {
+		return t.toolError(err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return t.toolError(err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summarize(result)},
+			&mcp.TextContent{Text: string(data)},
+		},
+	}, result, nil
+}
+
+// toolError returns the result of a failed tool call, with the error as structured content
+func (t *Toolsets) toolError(err error) (*mcp.CallToolResult, any, error) {
+	var toolErr *ToolError
+	if !errors.As(err, &toolErr) {
+		toolErr = &ToolError{Code: CodeInternalError, Message: err.Error()}
+		if t.ErrorCodes != nil {
+			if code := t.ErrorCodes.ErrorCode(err); code != "" {
+				toolErr.Code = code
+			}
+		}
+	}
+	return &mcp.CallToolResult{
+		IsError:           true,
+		Content:           []mcp.Content{&mcp.TextContent{Text: toolErr.Error()}},
+		StructuredContent: map[string]any{"error": toolErr},
+	}, nil, nil
+}
+
+// listResult returns the list response of a page, with its items restricted to the selected fields of the options
+func listResult[T any](page *models.ListPage[T], opts *models.ListOptions, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return models.NewListPageResponse(page, opts)
+}
+
+// itemsResult returns the list response of all items
+func itemsResult[T any](items []T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return listResult(&models.ListPage[T]{Items: items, Total: len(items)}, nil, nil)
+}
+
+// summarize returns a human-readable summary of a tool result, naming the resources it holds
+func summarize(result any) string {
+	if d, ok := result.(*deletedResult); ok {
+		return fmt.Sprintf("Deleted %s %s", d.Kind, d.Name)
+	}
+	object, err := models.ToJSONObject(result)
+	if err != nil {
+		return kindOf(reflect.TypeOf(result))
+	}
+
+	t := reflect.TypeOf(result)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Name() != "" && strings.HasPrefix(t.Name(), "ListResponse[") {
+		return summarizeList(t, object)
+	}
+
+	summary := kindOf(t)
+	if name, ok := object["name"].(string); ok && name != "" {
+		summary += " " + name
+	}
+	var details []string
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		switch value := object[key].(type) {
+		case []any:
+			details = append(details, fmt.Sprintf("%s: %d", key, len(value)))
+		case string:
+			if value != "" && (key == "status" || key == "health") {
+				details = append(details, fmt.Sprintf("%s: %s", key, value))
+			}
+		case float64:
+			if key == "totalCount" {
+				details = append(details, fmt.Sprintf("%s: %d", key, int(value)))
+			}
+		}
+	}
+	if len(details) > 0 {
+		summary += " (" + strings.Join(details, ", ") + ")"
+	}
+	return summary
+}
+
+// summarizeList summarizes a list response with the number and names of its items
+func summarizeList(t reflect.Type, object map[string]any) string {
+	kind := "items"
+	if items, ok := t.FieldByName("Items"); ok && items.Type.Elem().Kind() != reflect.Map {
+		kind = kindOf(items.Type.Elem()) + "s"
+	}
+	items, _ := object["items"].([]any)
+	summary := fmt.Sprintf("%d %s", len(items), kind)
+	if total, ok := object["totalCount"].(float64); ok {
+		summary = fmt.Sprintf("%d of %d %s", len(items), int(total), kind)
+	}
+
+	var names []string
+	for _, item := range items {
+		if fields, ok := item.(map[string]any); ok {
+			if name, ok := fields["name"].(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) > maxSummaryNames {
+		names = append(names[:maxSummaryNames], "...")
+	}
+	if len(names) > 0 {
+		summary += ": " + strings.Join(names, ", ")
+	}
+	if next, ok := object["continue"].(string); ok && next != "" {
+		summary += fmt.Sprintf(" (more items with continue %q)", next)
+	}
+	return summary
+}
+
+// kindOf returns the kind of resource a type describes, e.g. "Component" for ComponentResponse
+func kindOf(t reflect.Type) string {
+	if t == nil {
+		return "Result"
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	name := strings.TrimSuffix(t.Name(), "Response")
+	if name == "" {
+		return "Result"
+	}
+	return name
+}
+
+// outputSchema returns the JSON schema of the structured content of tools returning values of type T
+func outputSchema[T any]() map[string]any {
+	reflector := openapi.NewReflector()
+	reflector.Nullable = true
+	reflector.DefineKubernetesTypes()
+
+	root := reflector.Schema(reflect.TypeFor[T]())
+	components := reflector.Components()
+	if name, ok := strings.CutPrefix(root.Ref, "#/components/schemas/"); ok {
+		root = components[name]
+	}
+	return jsonSchemaDocument(root, components)
+}
+
+// listOutputSchema returns the JSON schema of the structured content of list tools returning items of type T.
+// No field of the items is required, since the fields of the items can be selected.
+func listOutputSchema[T any]() map[string]any {
+	schema := outputSchema[models.ListResponse[T]]()
+	if defs, ok := schema["$defs"].(map[string]any); ok {
+		for _, def := range defs {
+			if def, ok := def.(map[string]any); ok {
+				delete(def, "required")
+			}
+		}
+	}
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		if items, ok := properties["items"].(map[string]any); ok {
+			if item, ok := items["items"].(map[string]any); ok {
+				delete(item, "required")
+			}
+		}
+	}
+	return schema
+}
+
+// jsonSchemaDocument returns a JSON schema document of the root schema, with the component schemas as definitions
+func jsonSchemaDocument(root *openapi.Schema, components map[string]*openapi.Schema) map[string]any {
+	doc := struct {
+		*openapi.Schema
+		Defs map[string]*openapi.Schema `json:"$defs,omitempty"`
+	}{Schema: root, Defs: components}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		panic(fmt.Sprintf("marshaling output schema: %v", err))
+	}
+	data = []byte(strings.ReplaceAll(string(data), `"#/components/schemas/`, `"#/$defs/`))
+
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		panic(fmt.Sprintf("unmarshaling output schema: %v", err))
+	}
+	return schema
+}
@@ -4,16 +4,23 @@
 package mcp
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/modelcontextprotocol/go-sdk/auth"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-func NewHTTPServer(tools *Toolsets) http.Handler {
-	server := mcp.NewServer(&mcp.Implementation{
-		Name:    "openchoreo-api",
-		Version: "1.0.0",
-	}, nil)
+type contextKey string
+
+const tokenInfoKey contextKey = "tokenInfo"
+
+// NewHTTPServer creates the MCP server served over streamable HTTP.
+// The bearer token info verified for a request, if any, is available to the tool handlers
+// through TokenInfoFromContext, including in the given receiving middleware.
+func NewHTTPServer(tools *Toolsets, middleware ...mcp.Middleware) http.Handler {
+	server := newServer("openchoreo-api", tools)
+	server.AddReceivingMiddleware(append([]mcp.Middleware{tokenInfoMiddleware}, middleware...)...)
 	tools.Register(server)
 	return mcp.NewStreamableHTTPHandler(func(r *http.Request) *mcp.Server {
 		return server
@@ -21,10 +28,34 @@ func NewHTTPServer(tools *Toolsets) http.Handler {
 }
 
 func NewSTDIO(tools *Toolsets) *mcp.Server {
-	server := mcp.NewServer(&mcp.Implementation{
-		Name:    "openchoreo-cli",
-		Version: "1.0.0",
-	}, nil)
+	server := newServer("openchoreo-cli", tools)
 	tools.Register(server)
 	return server
 }
+
+// newServer creates an MCP server supporting subscriptions to resources if the toolsets can watch them
+func newServer(name string, tools *Toolsets) *mcp.Server {
+	subscriptions := newSubscriptions(tools)
+	subscriptions.server = mcp.NewServer(&mcp.Implementation{
+		Name:    name,
+		Version: "1.0.0",
+	}, subscriptions.serverOptions())
+	return subscriptions.server
+}
+
+// TokenInfoFromContext returns the bearer token info of the MCP request being handled, or nil if there is none.
+func TokenInfoFromContext(ctx context.Context) *auth.TokenInfo {
+	tokenInfo, _ := ctx.Value(tokenInfoKey).(*auth.TokenInfo)
+	return tokenInfo
+}
+
+// tokenInfoMiddleware passes the bearer token info of the request on to the handlers through the context,
+// since the handlers of the streamable HTTP transport don't run in the context of the HTTP request.
+func tokenInfoMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if extra := req.GetExtra(); extra != nil && extra.TokenInfo != nil {
+			ctx = context.WithValue(ctx, tokenInfoKey, extra.TokenInfo)
+		}
+		return next(ctx, method, req)
+	}
+}
@@ -5,9 +5,17 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
@@ -22,6 +30,8 @@ const (
 	ToolsetDeployment     ToolsetType = "deployment"
 	ToolsetInfrastructure ToolsetType = "infrastructure"
 	ToolsetSchema         ToolsetType = "schema"
+	ToolsetPlatform       ToolsetType = "platform"
+	ToolsetObservability  ToolsetType = "observability"
 )
 
 type Toolsets struct {
@@ -32,69 +42,204 @@ type Toolsets struct {
 	DeploymentToolset     DeploymentToolsetHandler
 	InfrastructureToolset InfrastructureToolsetHandler
 	SchemaToolset         SchemaToolsetHandler
+	PlatformToolset       PlatformToolsetHandler
+	ObservabilityToolset  ObservabilityToolsetHandler
+
+	// ResourceWatcher notifies the subscribers of resources of their changes. Subscriptions are not
+	// supported when it is nil.
+	ResourceWatcher ResourceWatcher
+
+	// Schemas provides the JSON schemas of the structured arguments of the write tools. The arguments
+	// are described as plain objects when it is nil.
+	Schemas SchemaProvider
+
+	// ErrorCodes provides the codes of the errors returned by the toolset handlers. Errors without a
+	// code are reported as internal errors.
+	ErrorCodes ErrorCoder
+
+	// ReadOnly registers only the tools that don't modify resources
+	ReadOnly bool
+
+	// remote is the session of the OpenChoreo MCP server the tool calls and resource reads are forwarded to,
+	// if the toolsets proxy a remote server
+	remote *mcp.ClientSession
+}
+
+// AllToolsets are the toolsets enabled by default
+var AllToolsets = []ToolsetType{
+	ToolsetOrganization, ToolsetProject, ToolsetComponent, ToolsetBuild, ToolsetDeployment,
+	ToolsetInfrastructure, ToolsetSchema, ToolsetPlatform, ToolsetObservability,
+}
+
+// ParseToolsets parses a comma separated list of toolsets, such as "organization,project"
+func ParseToolsets(toolsetsStr string) map[ToolsetType]bool {
+	toolsetsMap := make(map[ToolsetType]bool)
+	if toolsetsStr == "" {
+		return toolsetsMap
+	}
+
+	toolsets := strings.Split(toolsetsStr, ",")
+	for _, ts := range toolsets {
+		ts = strings.TrimSpace(ts)
+		if ts != "" {
+			toolsetsMap[ToolsetType(ts)] = true
+		}
+	}
+	return toolsetsMap
+}
+
+// SchemaProvider provides the JSON schemas of the fields of OpenChoreo resource kinds
+type SchemaProvider interface {
+	// JSONSchema returns the JSON schema of the field of a kind at a dot separated path, e.g. "spec.rollout".
+	JSONSchema(ctx context.Context, kind, path string) (map[string]any, error)
+}
+
+// ResourceWatcher watches the resources exposed by the MCP server
+type ResourceWatcher interface {
+	// WatchResource sends on the returned channel when the resource changes, until the context is done.
+	// The channel is closed when the watch ends.
+	WatchResource(ctx context.Context, ref ResourceRef) (<-chan struct{}, error)
 }
 
 // OrganizationToolsetHandler handles organization operations
 type OrganizationToolsetHandler interface {
-	GetOrganization(ctx context.Context, name string) (string, error)
+	GetOrganization(ctx context.Context, name string) (*models.OrganizationResponse, error)
+	// ListOrganizations lists the organizations the caller is allowed to view
+	ListOrganizations(ctx context.Context) ([]*models.OrganizationResponse, error)
 }
 
 // ProjectToolsetHandler handles organization and project operations
 type ProjectToolsetHandler interface {
 	// Project operations
-	ListProjects(ctx context.Context, orgName string) (string, error)
-	GetProject(ctx context.Context, orgName, projectName string) (string, error)
-	CreateProject(ctx context.Context, orgName string, req *models.CreateProjectRequest) (string, error)
+	ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ProjectResponse], error)
+	GetProject(ctx context.Context, orgName, projectName string) (*models.ProjectResponse, error)
+	CreateProject(ctx context.Context, orgName string, req *models.CreateProjectRequest) (*models.ProjectResponse, error)
 }
 
 // ComponentToolsetHandler handles component operations
 type ComponentToolsetHandler interface {
-	CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (string, error)
-	ListComponents(ctx context.Context, orgName, projectName string) (string, error)
+	CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (*models.ComponentResponse, error)
+	ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentResponse], error)
 	GetComponent(
 		ctx context.Context, orgName, projectName, componentName string, additionalResources []string,
-	) (string, error)
-	GetComponentBinding(ctx context.Context, orgName, projectName, componentName, environment string) (string, error)
+	) (*models.ComponentResponse, error)
+	GetComponentBinding(ctx context.Context, orgName, projectName, componentName, environment string) (*models.BindingResponse, error)
 	UpdateComponentBinding(
 		ctx context.Context, orgName, projectName, componentName, bindingName string,
 		req *models.UpdateBindingRequest,
-	) (string, error)
-	GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (string, error)
+	) (*models.BindingResponse, error)
+	GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (*openchoreov1alpha1.WorkloadSpec, error)
+	UpdateComponentTraits(
+		ctx context.Context, orgName, projectName, componentName string, req *models.ComponentTraitsRequest,
+	) (*models.ComponentResponse, error)
+	DeleteComponent(
+		ctx context.Context, orgName, projectName, componentName, resourceVersion string,
+		override *models.FreezeOverride,
+	) error
 }
 
 // BuildToolsetHandler handles build operations
 type BuildToolsetHandler interface {
-	ListBuildTemplates(ctx context.Context, orgName string) (string, error)
-	TriggerBuild(ctx context.Context, orgName, projectName, componentName, commit string) (string, error)
-	ListBuilds(ctx context.Context, orgName, projectName, componentName string) (string, error)
-	GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (string, error)
-	ListBuildPlanes(ctx context.Context, orgName string) (string, error)
+	ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildTemplateResponse], error)
+	TriggerBuild(ctx context.Context, orgName, projectName, componentName, commit string) (*models.BuildResponse, error)
+	ListBuilds(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListPage[models.BuildResponse], error)
+	GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (*models.ComponentObserverResponse, error)
+	ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[models.BuildPlaneResponse], error)
 }
 
 // DeploymentToolsetHandler handles deployment operations
 type DeploymentToolsetHandler interface {
-	GetProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (string, error)
+	GetProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (*models.DeploymentPipelineResponse, error)
 	GetComponentObserverURL(
 		ctx context.Context, orgName, projectName, componentName, environmentName string,
-	) (string, error)
+	) (*models.ComponentObserverResponse, error)
+
+	// ComponentDeployment operations
+	ListComponentDeployments(
+		ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+	) (*models.ListPage[*models.ComponentDeploymentResponse], error)
+	GetComponentDeployment(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ComponentDeploymentResponse, error)
+	PutComponentDeployment(
+		ctx context.Context, orgName, projectName, componentName, environment string,
+		req *models.ComponentDeploymentRequest,
+	) (*models.ComponentDeploymentResponse, error)
+	DeleteComponentDeployment(
+		ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string,
+		override *models.FreezeOverride,
+	) error
+	PromoteComponent(
+		ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
+	) ([]*models.BindingResponse, error)
+	RollbackComponentDeployment(
+		ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string,
+	) (*models.ComponentDeploymentResponse, error)
+
+	// ComponentEnvSnapshot and Release operations, these resources are managed by the controllers
+	ListComponentEnvSnapshots(
+		ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+	) (*models.ListPage[*models.ComponentEnvSnapshotResponse], error)
+	GetComponentEnvSnapshot(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ComponentEnvSnapshotResponse, error)
+	ListReleases(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListPage[*models.ReleaseResponse], error)
+	GetRelease(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ReleaseResponse, error)
 }
 
 // InfrastructureToolsetHandler handles infrastructure operations
 type InfrastructureToolsetHandler interface {
 	// Environment operations
-	ListEnvironments(ctx context.Context, orgName string) (string, error)
-	GetEnvironment(ctx context.Context, orgName, envName string) (string, error)
-	CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (string, error)
+	ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.EnvironmentResponse], error)
+	GetEnvironment(ctx context.Context, orgName, envName string) (*models.EnvironmentResponse, error)
+	CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (*models.EnvironmentResponse, error)
 
 	// DataPlane operations
-	ListDataPlanes(ctx context.Context, orgName string) (string, error)
-	GetDataPlane(ctx context.Context, orgName, dpName string) (string, error)
-	CreateDataPlane(ctx context.Context, orgName string, req *models.CreateDataPlaneRequest) (string, error)
+	ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.DataPlaneResponse], error)
+	GetDataPlane(ctx context.Context, orgName, dpName string) (*models.DataPlaneResponse, error)
+	CreateDataPlane(ctx context.Context, orgName string, req *models.CreateDataPlaneRequest) (*models.DataPlaneResponse, error)
 }
 
 // SchemaToolsetHandler handles schema and resource explanation operations
 type SchemaToolsetHandler interface {
-	ExplainSchema(ctx context.Context, kind, path string) (string, error)
+	ExplainSchema(ctx context.Context, kind, path string) (*models.SchemaExplanation, error)
+}
+
+// PlatformToolsetHandler handles the ComponentTypes, Traits and Workflows platform engineers
+// define for the components of an organization
+type PlatformToolsetHandler interface {
+	// ComponentType operations
+	ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.ComponentTypeResponse], error)
+	GetComponentType(ctx context.Context, orgName, name string) (*models.ComponentTypeResponse, error)
+	CreateComponentType(ctx context.Context, orgName string, req *models.ComponentTypeRequest) (*models.ComponentTypeResponse, error)
+	UpdateComponentType(ctx context.Context, orgName, name string, req *models.ComponentTypeRequest) (*models.ComponentTypeResponse, error)
+	DeleteComponentType(ctx context.Context, orgName, name, resourceVersion string) error
+	GetComponentTypeSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error)
+
+	// Trait operations
+	ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.TraitResponse], error)
+	GetTrait(ctx context.Context, orgName, name string) (*models.TraitResponse, error)
+	CreateTrait(ctx context.Context, orgName string, req *models.TraitRequest) (*models.TraitResponse, error)
+	UpdateTrait(ctx context.Context, orgName, name string, req *models.TraitRequest) (*models.TraitResponse, error)
+	DeleteTrait(ctx context.Context, orgName, name, resourceVersion string) error
+	GetTraitSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error)
+
+	// Workflow operations
+	ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListPage[*models.WorkflowResponse], error)
+	GetWorkflow(ctx context.Context, orgName, name string) (*models.WorkflowResponse, error)
+	CreateWorkflow(ctx context.Context, orgName string, req *models.WorkflowRequest) (*models.WorkflowResponse, error)
+	UpdateWorkflow(ctx context.Context, orgName, name string, req *models.WorkflowRequest) (*models.WorkflowResponse, error)
+	DeleteWorkflow(ctx context.Context, orgName, name, resourceVersion string) error
+}
+
+// ObservabilityToolsetHandler handles the logs, traces and release health used to diagnose components
+type ObservabilityToolsetHandler interface {
+	GetComponentLogs(
+		ctx context.Context, orgName, projectName, componentName, environment string, query *models.LogQuery,
+	) (*models.LogsResponse, error)
+	GetBuildLogs(
+		ctx context.Context, orgName, projectName, componentName, buildName string, query *models.LogQuery,
+	) (*models.LogsResponse, error)
+	GetComponentTraces(
+		ctx context.Context, orgName, projectName, componentName, environment string, query *models.TraceQuery,
+	) (*models.TracesResponse, error)
+	GetReleaseHealth(ctx context.Context, orgName, projectName, componentName, environment string) (*models.ReleaseHealthResponse, error)
 }
 
 // RegisterFunc is a function type for registering MCP tools
@@ -114,17 +259,6 @@ func defaultStringProperty() map[string]any {
 	}
 }
 
-func handleToolResult(result string, err error) (*mcp.CallToolResult, map[string]string, error) {
-	if err != nil {
-		return nil, nil, err
-	}
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: result},
-		},
-	}, map[string]string{"message": result}, nil
-}
-
 func arrayProperty(description, itemType string) map[string]any {
 	return map[string]any{
 		"type":        "array",
@@ -135,6 +269,136 @@ func arrayProperty(description, itemType string) map[string]any {
 	}
 }
 
+func objectProperty(description string) map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": description,
+	}
+}
+
+func integerProperty(description string) map[string]any {
+	return map[string]any{
+		"type":        "integer",
+		"description": description,
+	}
+}
+
+func booleanProperty(description string) map[string]any {
+	return map[string]any{
+		"type":        "boolean",
+		"description": description,
+	}
+}
+
+// readOnlyTool returns the annotations of the tools that don't modify resources
+func readOnlyTool() *mcp.ToolAnnotations {
+	return &mcp.ToolAnnotations{ReadOnlyHint: true}
+}
+
+// writeTool returns the annotations of the tools that modify resources. Destructive tools delete or replace
+// resources, and calling idempotent tools again with the same arguments has no further effect.
+func writeTool(destructive, idempotent bool) *mcp.ToolAnnotations {
+	return &mcp.ToolAnnotations{DestructiveHint: &destructive, IdempotentHint: idempotent}
+}
+
+// addTool adds a tool to the server, unless the toolsets are read-only and the tool modifies resources.
+// The calls of the tool are forwarded to the remote server if the toolsets proxy one.
+func addTool[In any](t *Toolsets, s *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, any]) {
+	if t.ReadOnly && (tool.Annotations == nil || !tool.Annotations.ReadOnlyHint) {
+		return
+	}
+	if t.remote != nil {
+		s.AddTool(tool, t.forwardToolCall)
+		return
+	}
+	mcp.AddTool(s, tool, handler)
+}
+
+// confirmProperty is the confirmation argument of destructive tools
+func confirmProperty(operation string) map[string]any {
+	return booleanProperty(fmt.Sprintf("Must be true to confirm that the user asked to %s. "+
+		"Ask the user for confirmation before setting it", operation))
+}
+
+// requireConfirmation fails destructive tools that were called without confirmation
+func requireConfirmation(confirm bool, operation string) error {
+	if !confirm {
+		return &ToolError{
+			Code:    CodeConfirmationRequired,
+			Message: fmt.Sprintf("refusing to %s without confirmation: ask the user to confirm and set confirm to true", operation),
+		}
+	}
+	return nil
+}
+
+// schemaTimeout bounds the time spent reading the schema of a tool argument at registration
+const schemaTimeout = 10 * time.Second
+
+// resourceSchemaProperty returns the JSON schema of the field of a kind at a path, with the description of the
+// fallback property. The fallback is returned when the schema can't be read.
+func (t *Toolsets) resourceSchemaProperty(kind, path string, fallback map[string]any) map[string]any {
+	if t.Schemas == nil {
+		return fallback
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), schemaTimeout)
+	defer cancel()
+	property, err := t.Schemas.JSONSchema(ctx, kind, path)
+	if err != nil || property == nil || !resolvable(property) {
+		return fallback
+	}
+	if description, ok := fallback["description"]; ok {
+		property["description"] = description
+	}
+	return property
+}
+
+// resolvable reports whether the SDK can validate tool arguments with a schema. Tools with schemas that
+// can't be resolved are rejected when they are added.
+func resolvable(property map[string]any) bool {
+	data, err := json.Marshal(property)
+	if err != nil {
+		return false
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return false
+	}
+	_, err = schema.Resolve(&jsonschema.ResolveOptions{ValidateDefaults: true})
+	return err == nil
+}
+
+// listArgs are the pagination, filtering, sorting and field selection arguments of list tools
+type listArgs struct {
+	Limit         int      `json:"limit"`
+	Continue      string   `json:"continue"`
+	LabelSelector string   `json:"label_selector"`
+	FieldSelector string   `json:"field_selector"`
+	Sort          string   `json:"sort"`
+	Fields        []string `json:"fields"`
+}
+
+func (a listArgs) options() *models.ListOptions {
+	return &models.ListOptions{
+		Limit:         a.Limit,
+		Continue:      a.Continue,
+		LabelSelector: a.LabelSelector,
+		FieldSelector: a.FieldSelector,
+		Sort:          a.Sort,
+		Fields:        a.Fields,
+	}
+}
+
+// createListSchema creates the schema of a list tool, adding the list arguments to the properties
+func createListSchema(properties map[string]any, required []string) map[string]any {
+	properties["limit"] = integerProperty("Maximum number of items to return. Returns all items if omitted")
+	properties["continue"] = stringProperty("Continue token of the previous page to fetch the next page")
+	properties["label_selector"] = stringProperty("Kubernetes label selector, e.g. 'team=payments,tier!=frontend'")
+	properties["field_selector"] = stringProperty("Selects items by their fields, e.g. 'status=Ready,type!=Service'")
+	properties["sort"] = stringProperty("Field to sort by, prefixed with '-' for descending order, e.g. '-createdAt'")
+	properties["fields"] = arrayProperty("Fields to include in each item, e.g. ['name', 'status']", "string")
+	return createSchema(properties, required)
+}
+
 func createSchema(properties map[string]any, required []string) map[string]any {
 	schema := map[string]any{
 		"type":       "object",
@@ -147,7 +411,7 @@ func createSchema(properties map[string]any, required []string) map[string]any {
 }
 
 func (t *Toolsets) RegisterGetOrganization(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_organization",
 		Description: "Get information about organizations. Organizations are the top-level tenant boundary " +
 			"containing projects, environments, and infrastructure. If no name provided, lists all " +
@@ -155,32 +419,44 @@ func (t *Toolsets) RegisterGetOrganization(s *mcp.Server) {
 		InputSchema: createSchema(map[string]any{
 			"name": stringProperty("Optional organization identifier. If omitted, lists all accessible organizations"),
 		}, []string{}),
+		OutputSchema: outputSchema[models.ListResponse[*models.OrganizationResponse]](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		Name string `json:"name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
+		if args.Name == "" {
+			return t.handleToolResult(itemsResult(t.OrganizationToolset.ListOrganizations(ctx)))
+		}
 		result, err := t.OrganizationToolset.GetOrganization(ctx, args.Name)
-		return handleToolResult(result, err)
+		if err != nil {
+			return t.toolError(err)
+		}
+		return t.handleToolResult(itemsResult([]*models.OrganizationResponse{result}, nil))
 	})
 }
 
 func (t *Toolsets) RegisterListProjects(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_projects",
 		Description: "List all projects in an organization. Projects are logical groupings of related " +
 			"components that share deployment pipelines.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name": stringProperty("Use get_organization to discover valid names"),
 		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.ProjectResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.ProjectToolset.ListProjects(ctx, args.OrgName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.ProjectToolset.ListProjects(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterGetProject(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_project",
 		Description: "Get detailed information about a specific project including deployment pipeline " +
 			"configuration and component summary.",
@@ -188,17 +464,19 @@ func (t *Toolsets) RegisterGetProject(s *mcp.Server) {
 			"org_name":     defaultStringProperty(),
 			"project_name": stringProperty("Use list_projects to discover valid names"),
 		}, []string{"org_name", "project_name"}),
+		OutputSchema: outputSchema[models.ProjectResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName     string `json:"org_name"`
 		ProjectName string `json:"project_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ProjectToolset.GetProject(ctx, args.OrgName, args.ProjectName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterCreateProject(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "create_project",
 		Description: "Create a new project in an organization. Project names must be DNS-compatible " +
 			"(lowercase, alphanumeric, hyphens only, max 63 chars).",
@@ -208,40 +486,46 @@ func (t *Toolsets) RegisterCreateProject(s *mcp.Server) {
 				"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)"),
 			"description": stringProperty("Human-readable description"),
 		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[models.ProjectResponse](),
+		Annotations:  writeTool(false, false),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName     string `json:"org_name"`
 		Name        string `json:"name"`
 		Description string `json:"description"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		projectReq := &models.CreateProjectRequest{
 			Name:        args.Name,
 			Description: args.Description,
 		}
 		result, err := t.ProjectToolset.CreateProject(ctx, args.OrgName, projectReq)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterListComponents(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_components",
 		Description: "List all components in a project. Components are deployable units (services, jobs, etc.) " +
 			"with independent build and deployment lifecycles.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name":     defaultStringProperty(),
 			"project_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name"}),
+		OutputSchema: listOutputSchema[*models.ComponentResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName     string `json:"org_name"`
 		ProjectName string `json:"project_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.ComponentToolset.ListComponents(ctx, args.OrgName, args.ProjectName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.ComponentToolset.ListComponents(ctx, args.OrgName, args.ProjectName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterGetComponent(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_component",
 		Description: "Get detailed information about a component including configuration, deployment status, " +
 			"and builds. Use additional_resources to include 'bindings', 'workloads', 'builds', or 'endpoints'.",
@@ -252,21 +536,23 @@ func (t *Toolsets) RegisterGetComponent(s *mcp.Server) {
 			"additional_resources": arrayProperty(
 				"Additional data to include: 'bindings', 'workloads', 'builds', 'endpoints'", "string"),
 		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: outputSchema[models.ComponentResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName             string   `json:"org_name"`
 		ProjectName         string   `json:"project_name"`
 		ComponentName       string   `json:"component_name"`
 		AdditionalResources []string `json:"additional_resources"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ComponentToolset.GetComponent(
 			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.AdditionalResources,
 		)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterComponentBinding(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_component_binding",
 		Description: "Get environment-specific configuration for a component. Bindings define how a component " +
 			"behaves in a particular environment (replicas, env vars, resource limits, etc.).",
@@ -277,21 +563,23 @@ func (t *Toolsets) RegisterComponentBinding(s *mcp.Server) {
 			"environment": stringProperty(
 				"E.g., 'dev', 'staging', 'production'. Use list_environments to discover"),
 		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.BindingResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
 		Environment   string `json:"environment"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ComponentToolset.GetComponentBinding(
 			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
 		)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterGetComponentObserverURL(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_component_observer_url",
 		Description: "Get the observability dashboard URL for a deployed component in a specific environment. " +
 			"Provides access to real-time logs, metrics, traces, and debugging tools.",
@@ -301,21 +589,23 @@ func (t *Toolsets) RegisterGetComponentObserverURL(s *mcp.Server) {
 			"component_name":   defaultStringProperty(),
 			"environment_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name", "component_name", "environment_name"}),
+		OutputSchema: outputSchema[models.ComponentObserverResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName         string `json:"org_name"`
 		ProjectName     string `json:"project_name"`
 		ComponentName   string `json:"component_name"`
 		EnvironmentName string `json:"environment_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.DeploymentToolset.GetComponentObserverURL(
 			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.EnvironmentName,
 		)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterGetBuildObserverURL(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_build_observer_url",
 		Description: "Get the observability dashboard URL for component builds. Provides access to real-time " +
 			"build logs, pipeline stages, and build history.",
@@ -324,18 +614,20 @@ func (t *Toolsets) RegisterGetBuildObserverURL(s *mcp.Server) {
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: outputSchema[models.ComponentObserverResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.BuildToolset.GetBuildObserverURL(ctx, args.OrgName, args.ProjectName, args.ComponentName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterGetComponentWorkloads(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_component_workloads",
 		Description: "Get real-time workload information for a component across all environments. Shows " +
 			"running pods, their status, resource usage, and container details. For Kubernetes users: Similar " +
@@ -345,34 +637,106 @@ func (t *Toolsets) RegisterGetComponentWorkloads(s *mcp.Server) {
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: outputSchema[openchoreov1alpha1.WorkloadSpec](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ComponentToolset.GetComponentWorkloads(ctx, args.OrgName, args.ProjectName, args.ComponentName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUpdateComponentTraits(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "update_component_traits",
+		Description: "Replace the traits attached to a component. Each trait instance sets the parameters of a trait " +
+			"of the organization; use get_trait_schema to discover them. With resource_version, the update fails " +
+			"if the component has changed since it was read.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"traits": t.resourceSchemaProperty("Component", "spec.traits", arrayProperty(
+				"Trait instances with the trait 'name', a unique 'instanceName' and the trait 'parameters'. "+
+					"An empty list detaches all traits", "object")),
+			"resource_version": stringProperty("Resource version returned by get_component"),
+		}, []string{"org_name", "project_name", "component_name", "traits"}),
+		OutputSchema: outputSchema[models.ComponentResponse](),
+		Annotations:  writeTool(false, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string                              `json:"org_name"`
+		ProjectName     string                              `json:"project_name"`
+		ComponentName   string                              `json:"component_name"`
+		Traits          []openchoreov1alpha1.ComponentTrait `json:"traits"`
+		ResourceVersion string                              `json:"resource_version"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.UpdateComponentTraits(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName,
+			&models.ComponentTraitsRequest{Traits: args.Traits, ResourceVersion: args.ResourceVersion},
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeleteComponent(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "delete_component",
+		Description: "Delete a component and undeploy it from all environments. Fails while one of its environments " +
+			"is frozen unless a freeze override justification is given.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":                      defaultStringProperty(),
+			"project_name":                  defaultStringProperty(),
+			"component_name":                defaultStringProperty(),
+			"resource_version":              stringProperty("Only delete the component at this resource version"),
+			"freeze_override_justification": stringProperty("Justification to undeploy while an environment is frozen"),
+			"confirm":                       confirmProperty("delete the component"),
+		}, []string{"org_name", "project_name", "component_name", "confirm"}),
+		OutputSchema: outputSchema[deletedResult](),
+		Annotations:  writeTool(true, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                     string `json:"org_name"`
+		ProjectName                 string `json:"project_name"`
+		ComponentName               string `json:"component_name"`
+		ResourceVersion             string `json:"resource_version"`
+		FreezeOverrideJustification string `json:"freeze_override_justification"`
+		Confirm                     bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "delete the component"); err != nil {
+			return t.toolError(err)
+		}
+		err := t.ComponentToolset.DeleteComponent(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.ResourceVersion,
+			freezeOverride(args.FreezeOverrideJustification),
+		)
+		return t.handleToolResult(deleted("Component", args.ComponentName, err))
 	})
 }
 
 func (t *Toolsets) RegisterListEnvironments(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_environments",
 		Description: "List all environments in an organization. Environments are deployment targets representing " +
 			"pipeline stages (dev, staging, production) or isolated tenants.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name": defaultStringProperty(),
 		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.EnvironmentResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.InfrastructureToolset.ListEnvironments(ctx, args.OrgName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.InfrastructureToolset.ListEnvironments(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterGetEnvironments(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_environment",
 		Description: "Get detailed information about an environment including associated data plane, deployed " +
 			"components, resource quotas, and network configuration.",
@@ -380,33 +744,39 @@ func (t *Toolsets) RegisterGetEnvironments(s *mcp.Server) {
 			"org_name": defaultStringProperty(),
 			"env_name": stringProperty("Use list_environments to discover valid names"),
 		}, []string{"org_name", "env_name"}),
+		OutputSchema: outputSchema[models.EnvironmentResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
 		EnvName string `json:"env_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.InfrastructureToolset.GetEnvironment(ctx, args.OrgName, args.EnvName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterListDataPlanes(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_dataplanes",
 		Description: "List all data planes in an organization. Data planes are Kubernetes clusters or cluster " +
 			"regions where component workloads actually execute.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name": defaultStringProperty(),
 		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.DataPlaneResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.InfrastructureToolset.ListDataPlanes(ctx, args.OrgName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.InfrastructureToolset.ListDataPlanes(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterGetDataPlane(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_dataplane",
 		Description: "Get detailed information about a data plane including cluster details, capacity, health " +
 			"status, associated environments, and network configuration.",
@@ -414,33 +784,39 @@ func (t *Toolsets) RegisterGetDataPlane(s *mcp.Server) {
 			"org_name": defaultStringProperty(),
 			"dp_name":  stringProperty("Use list_dataplanes to discover valid names"),
 		}, []string{"org_name", "dp_name"}),
+		OutputSchema: outputSchema[models.DataPlaneResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
 		DpName  string `json:"dp_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.InfrastructureToolset.GetDataPlane(ctx, args.OrgName, args.DpName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterListBuildTemplates(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_build_templates",
 		Description: "List available build templates in an organization. Build templates define how source code " +
 			"is transformed into container images (Docker, Buildpacks, Kaniko, etc.).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name": defaultStringProperty(),
 		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[models.BuildTemplateResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.BuildToolset.ListBuildTemplates(ctx, args.OrgName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.BuildToolset.ListBuildTemplates(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterTriggerBuild(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "trigger_build",
 		Description: "Trigger a new build for a component at a specific commit. Creates a container image that " +
 			"can be deployed to environments. Builds run asynchronously; use list_builds to monitor progress.",
@@ -450,55 +826,65 @@ func (t *Toolsets) RegisterTriggerBuild(s *mcp.Server) {
 			"component_name": defaultStringProperty(),
 			"commit":         stringProperty("Git commit SHA (full or short) or tag"),
 		}, []string{"org_name", "project_name", "component_name", "commit"}),
+		OutputSchema: outputSchema[models.BuildResponse](),
+		Annotations:  writeTool(false, false),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
 		Commit        string `json:"commit"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.BuildToolset.TriggerBuild(ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Commit)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterListBuilds(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_builds",
 		Description: "List all builds for a component showing build history, status (queued, running, " +
 			"succeeded, failed), commit information, and generated image tags.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name":       defaultStringProperty(),
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: listOutputSchema[models.BuildResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.BuildToolset.ListBuilds(ctx, args.OrgName, args.ProjectName, args.ComponentName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.BuildToolset.ListBuilds(ctx, args.OrgName, args.ProjectName, args.ComponentName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterListBuildPlanes(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "list_buildplanes",
 		Description: "List all build planes in an organization. Build planes are dedicated infrastructure where " +
 			"component builds execute (isolated from runtime workloads).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createListSchema(map[string]any{
 			"org_name": defaultStringProperty(),
 		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[models.BuildPlaneResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
-		result, err := t.BuildToolset.ListBuildPlanes(ctx, args.OrgName)
-		return handleToolResult(result, err)
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.BuildToolset.ListBuildPlanes(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
 func (t *Toolsets) RegisterGetDeploymentPipeline(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "get_deployment_pipeline",
 		Description: "Get the deployment pipeline configuration for a project. Shows the progression path for " +
 			"builds through environments (e.g., dev → staging → production) and promotion policies.",
@@ -506,17 +892,19 @@ func (t *Toolsets) RegisterGetDeploymentPipeline(s *mcp.Server) {
 			"org_name":     defaultStringProperty(),
 			"project_name": defaultStringProperty(),
 		}, []string{"org_name", "project_name"}),
+		OutputSchema: outputSchema[models.DeploymentPipelineResponse](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName     string `json:"org_name"`
 		ProjectName string `json:"project_name"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.DeploymentToolset.GetProjectDeploymentPipeline(ctx, args.OrgName, args.ProjectName)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
 	})
 }
 
 func (t *Toolsets) RegisterExplainSchema(s *mcp.Server) {
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(t, s, &mcp.Tool{
 		Name: "explain_schema",
 		Description: "Get the schema definition of a Kubernetes resource in structured JSON format. " +
 			"Returns detailed information about resource fields including types, descriptions, and required status. " +
@@ -528,15 +916,956 @@ func (t *Toolsets) RegisterExplainSchema(s *mcp.Server) {
 			"kind": stringProperty("The Kubernetes resource kind to explain (e.g., 'Component', 'Project', 'Environment')"),
 			"path": stringProperty("Optional: field path to drill down into (e.g., 'spec', 'spec.build', 'metadata')"),
 		}, []string{"kind"}),
+		OutputSchema: outputSchema[models.SchemaExplanation](),
+		Annotations:  readOnlyTool(),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		Kind string `json:"kind"`
 		Path string `json:"path"`
-	}) (*mcp.CallToolResult, map[string]string, error) {
+	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.SchemaToolset.ExplainSchema(ctx, args.Kind, args.Path)
-		return handleToolResult(result, err)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListComponentTypes(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_component_types",
+		Description: "List the component types of an organization. " +
+			"ComponentTypes define the kinds of components developers can create, such as services or scheduled tasks, and the Kubernetes resources rendered for them.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.ComponentTypeResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.PlatformToolset.ListComponentTypes(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetComponentType(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_type",
+		Description: "Get a component type with its full spec and resource version. Pass the resource version to " +
+			"update_component_type to make sure no concurrent change is overwritten.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name":     stringProperty("Use list_component_types to discover valid names"),
+		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[models.ComponentTypeResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		Name    string `json:"name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.GetComponentType(ctx, args.OrgName, args.Name)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterCreateComponentType(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "create_component_type",
+		Description: "Create a new component type in an organization. The spec is validated by the control plane " +
+			"and the request is rejected if it is invalid.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name": stringProperty(
+				"DNS-compatible identifier (lowercase, alphanumeric, hyphens only)"),
+			"display_name": stringProperty("Human-readable name"),
+			"description":  stringProperty("Human-readable description"),
+			"spec":         t.resourceSchemaProperty("ComponentType", "spec", objectProperty("The ComponentType spec. Use explain_schema with kind 'ComponentType' and path 'spec' to discover its fields; workloadType and resources are required")),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.ComponentTypeResponse](),
+		Annotations:  writeTool(false, false),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName     string                               `json:"org_name"`
+		Name        string                               `json:"name"`
+		DisplayName string                               `json:"display_name"`
+		Description string                               `json:"description"`
+		Spec        openchoreov1alpha1.ComponentTypeSpec `json:"spec"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.CreateComponentType(ctx, args.OrgName, &models.ComponentTypeRequest{
+			Name:        args.Name,
+			DisplayName: args.DisplayName,
+			Description: args.Description,
+			Spec:        args.Spec,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUpdateComponentType(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "update_component_type",
+		Description: "Replace the spec, display name and description of an existing component type. With resource_version, " +
+			"the update fails if the component type has changed since it was read.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_component_types to discover valid names"),
+			"display_name":     stringProperty("Human-readable name"),
+			"description":      stringProperty("Human-readable description"),
+			"spec":             t.resourceSchemaProperty("ComponentType", "spec", objectProperty("The ComponentType spec. Use explain_schema with kind 'ComponentType' and path 'spec' to discover its fields; workloadType and resources are required")),
+			"resource_version": stringProperty("Resource version returned by get_component_type"),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.ComponentTypeResponse](),
+		Annotations:  writeTool(false, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string                               `json:"org_name"`
+		Name            string                               `json:"name"`
+		DisplayName     string                               `json:"display_name"`
+		Description     string                               `json:"description"`
+		Spec            openchoreov1alpha1.ComponentTypeSpec `json:"spec"`
+		ResourceVersion string                               `json:"resource_version"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.UpdateComponentType(ctx, args.OrgName, args.Name, &models.ComponentTypeRequest{
+			Name:            args.Name,
+			DisplayName:     args.DisplayName,
+			Description:     args.Description,
+			Spec:            args.Spec,
+			ResourceVersion: args.ResourceVersion,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeleteComponentType(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "delete_component_type",
+		Description: "Delete a component type from an organization. Components that still use it can no longer be " +
+			"rendered, so check for usages first.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_component_types to discover valid names"),
+			"resource_version": stringProperty("Only delete the component type at this resource version"),
+			"confirm":          confirmProperty("delete the component type"),
+		}, []string{"org_name", "name", "confirm"}),
+		OutputSchema: outputSchema[deletedResult](),
+		Annotations:  writeTool(true, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string `json:"org_name"`
+		Name            string `json:"name"`
+		ResourceVersion string `json:"resource_version"`
+		Confirm         bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "delete the component type"); err != nil {
+			return t.toolError(err)
+		}
+		err := t.PlatformToolset.DeleteComponentType(ctx, args.OrgName, args.Name, args.ResourceVersion)
+		return t.handleToolResult(deleted("ComponentType", args.Name, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetComponentTypeSchema(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_type_schema",
+		Description: "Get the JSON schema of the parameters of a component type, which components of that type " +
+			"set in their spec and ComponentDeployments override per environment.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name":     stringProperty("Use list_component_types to discover valid names"),
+		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[extv1.JSONSchemaProps](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		Name    string `json:"name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.GetComponentTypeSchema(ctx, args.OrgName, args.Name)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListTraits(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_traits",
+		Description: "List the traits of an organization. " +
+			"Traits add capabilities such as storage or autoscaling to components by creating and patching rendered resources.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.TraitResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.PlatformToolset.ListTraits(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetTrait(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_trait",
+		Description: "Get a trait with its full spec and resource version. Pass the resource version to " +
+			"update_trait to make sure no concurrent change is overwritten.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name":     stringProperty("Use list_traits to discover valid names"),
+		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[models.TraitResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		Name    string `json:"name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.GetTrait(ctx, args.OrgName, args.Name)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterCreateTrait(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "create_trait",
+		Description: "Create a new trait in an organization. The spec is validated by the control plane " +
+			"and the request is rejected if it is invalid.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name": stringProperty(
+				"DNS-compatible identifier (lowercase, alphanumeric, hyphens only)"),
+			"display_name": stringProperty("Human-readable name"),
+			"description":  stringProperty("Human-readable description"),
+			"spec":         t.resourceSchemaProperty("Trait", "spec", objectProperty("The Trait spec. Use explain_schema with kind 'Trait' and path 'spec' to discover its fields")),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.TraitResponse](),
+		Annotations:  writeTool(false, false),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName     string                       `json:"org_name"`
+		Name        string                       `json:"name"`
+		DisplayName string                       `json:"display_name"`
+		Description string                       `json:"description"`
+		Spec        openchoreov1alpha1.TraitSpec `json:"spec"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.CreateTrait(ctx, args.OrgName, &models.TraitRequest{
+			Name:        args.Name,
+			DisplayName: args.DisplayName,
+			Description: args.Description,
+			Spec:        args.Spec,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUpdateTrait(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "update_trait",
+		Description: "Replace the spec, display name and description of an existing trait. With resource_version, " +
+			"the update fails if the trait has changed since it was read.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_traits to discover valid names"),
+			"display_name":     stringProperty("Human-readable name"),
+			"description":      stringProperty("Human-readable description"),
+			"spec":             t.resourceSchemaProperty("Trait", "spec", objectProperty("The Trait spec. Use explain_schema with kind 'Trait' and path 'spec' to discover its fields")),
+			"resource_version": stringProperty("Resource version returned by get_trait"),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.TraitResponse](),
+		Annotations:  writeTool(false, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string                       `json:"org_name"`
+		Name            string                       `json:"name"`
+		DisplayName     string                       `json:"display_name"`
+		Description     string                       `json:"description"`
+		Spec            openchoreov1alpha1.TraitSpec `json:"spec"`
+		ResourceVersion string                       `json:"resource_version"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.UpdateTrait(ctx, args.OrgName, args.Name, &models.TraitRequest{
+			Name:            args.Name,
+			DisplayName:     args.DisplayName,
+			Description:     args.Description,
+			Spec:            args.Spec,
+			ResourceVersion: args.ResourceVersion,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeleteTrait(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "delete_trait",
+		Description: "Delete a trait from an organization. Components that still use it can no longer be " +
+			"rendered, so check for usages first.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_traits to discover valid names"),
+			"resource_version": stringProperty("Only delete the trait at this resource version"),
+			"confirm":          confirmProperty("delete the trait"),
+		}, []string{"org_name", "name", "confirm"}),
+		OutputSchema: outputSchema[deletedResult](),
+		Annotations:  writeTool(true, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string `json:"org_name"`
+		Name            string `json:"name"`
+		ResourceVersion string `json:"resource_version"`
+		Confirm         bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "delete the trait"); err != nil {
+			return t.toolError(err)
+		}
+		err := t.PlatformToolset.DeleteTrait(ctx, args.OrgName, args.Name, args.ResourceVersion)
+		return t.handleToolResult(deleted("Trait", args.Name, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetTraitSchema(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_trait_schema",
+		Description: "Get the JSON schema of the parameters of a trait, which the trait instances attached with " +
+			"update_component_traits set.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name":     stringProperty("Use list_traits to discover valid names"),
+		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[extv1.JSONSchemaProps](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		Name    string `json:"name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.GetTraitSchema(ctx, args.OrgName, args.Name)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListWorkflows(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_workflows",
+		Description: "List the workflows of an organization. " +
+			"Workflows are the templates of the build workflows that components run.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+		}, []string{"org_name"}),
+		OutputSchema: listOutputSchema[*models.WorkflowResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.PlatformToolset.ListWorkflows(ctx, args.OrgName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetWorkflow(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_workflow",
+		Description: "Get a workflow with its full spec and resource version. Pass the resource version to " +
+			"update_workflow to make sure no concurrent change is overwritten.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name":     stringProperty("Use list_workflows to discover valid names"),
+		}, []string{"org_name", "name"}),
+		OutputSchema: outputSchema[models.WorkflowResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName string `json:"org_name"`
+		Name    string `json:"name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.GetWorkflow(ctx, args.OrgName, args.Name)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterCreateWorkflow(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "create_workflow",
+		Description: "Create a new workflow in an organization. The spec is validated by the control plane " +
+			"and the request is rejected if it is invalid.",
+		InputSchema: createSchema(map[string]any{
+			"org_name": defaultStringProperty(),
+			"name": stringProperty(
+				"DNS-compatible identifier (lowercase, alphanumeric, hyphens only)"),
+			"display_name": stringProperty("Human-readable name"),
+			"description":  stringProperty("Human-readable description"),
+			"spec":         t.resourceSchemaProperty("Workflow", "spec", objectProperty("The Workflow spec. Use explain_schema with kind 'Workflow' and path 'spec' to discover its fields; resource is required")),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.WorkflowResponse](),
+		Annotations:  writeTool(false, false),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName     string                          `json:"org_name"`
+		Name        string                          `json:"name"`
+		DisplayName string                          `json:"display_name"`
+		Description string                          `json:"description"`
+		Spec        openchoreov1alpha1.WorkflowSpec `json:"spec"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.CreateWorkflow(ctx, args.OrgName, &models.WorkflowRequest{
+			Name:        args.Name,
+			DisplayName: args.DisplayName,
+			Description: args.Description,
+			Spec:        args.Spec,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUpdateWorkflow(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "update_workflow",
+		Description: "Replace the spec, display name and description of an existing workflow. With resource_version, " +
+			"the update fails if the workflow has changed since it was read.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_workflows to discover valid names"),
+			"display_name":     stringProperty("Human-readable name"),
+			"description":      stringProperty("Human-readable description"),
+			"spec":             t.resourceSchemaProperty("Workflow", "spec", objectProperty("The Workflow spec. Use explain_schema with kind 'Workflow' and path 'spec' to discover its fields; resource is required")),
+			"resource_version": stringProperty("Resource version returned by get_workflow"),
+		}, []string{"org_name", "name", "spec"}),
+		OutputSchema: outputSchema[models.WorkflowResponse](),
+		Annotations:  writeTool(false, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string                          `json:"org_name"`
+		Name            string                          `json:"name"`
+		DisplayName     string                          `json:"display_name"`
+		Description     string                          `json:"description"`
+		Spec            openchoreov1alpha1.WorkflowSpec `json:"spec"`
+		ResourceVersion string                          `json:"resource_version"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.PlatformToolset.UpdateWorkflow(ctx, args.OrgName, args.Name, &models.WorkflowRequest{
+			Name:            args.Name,
+			DisplayName:     args.DisplayName,
+			Description:     args.Description,
+			Spec:            args.Spec,
+			ResourceVersion: args.ResourceVersion,
+		})
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeleteWorkflow(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "delete_workflow",
+		Description: "Delete a workflow from an organization. Components that still use it can no longer be " +
+			"rendered, so check for usages first.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"name":             stringProperty("Use list_workflows to discover valid names"),
+			"resource_version": stringProperty("Only delete the workflow at this resource version"),
+			"confirm":          confirmProperty("delete the workflow"),
+		}, []string{"org_name", "name", "confirm"}),
+		OutputSchema: outputSchema[deletedResult](),
+		Annotations:  writeTool(true, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string `json:"org_name"`
+		Name            string `json:"name"`
+		ResourceVersion string `json:"resource_version"`
+		Confirm         bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "delete the workflow"); err != nil {
+			return t.toolError(err)
+		}
+		err := t.PlatformToolset.DeleteWorkflow(ctx, args.OrgName, args.Name, args.ResourceVersion)
+		return t.handleToolResult(deleted("Workflow", args.Name, err))
+	})
+}
+
+func (t *Toolsets) RegisterListComponentDeployments(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_component_deployments",
+		Description: "List the ComponentDeployments of a component. A ComponentDeployment deploys a ComponentType " +
+			"based component to an environment, with the environment specific overrides and rollout strategy.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: listOutputSchema[*models.ComponentDeploymentResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.DeploymentToolset.ListComponentDeployments(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, opts,
+		)
+		return t.handleToolResult(listResult(page, opts, err))
 	})
 }
 
+func (t *Toolsets) RegisterGetComponentDeployment(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_deployment",
+		Description: "Get the ComponentDeployment of a component in an environment, with its overrides, " +
+			"rollout strategy, status conditions and resource version.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.ComponentDeploymentResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetComponentDeployment(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterPutComponentDeployment(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "put_component_deployment",
+		Description: "Deploy a component to an environment, or replace the overrides of its existing deployment. " +
+			"Overrides set the envOverrides parameters of the ComponentType and traits for the environment. " +
+			"Fails while the environment is frozen unless a freeze override justification is given.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+			"overrides":      objectProperty("Values of the envOverrides parameters of the ComponentType"),
+			"trait_overrides": objectProperty(
+				"Values of the envOverrides parameters of the traits, by trait instance name"),
+			"configuration_overrides": t.resourceSchemaProperty("ComponentDeployment", "spec.configurationOverrides",
+				objectProperty("Environment variables and files, with 'env' and 'files' lists")),
+			"rollout": t.resourceSchemaProperty("ComponentDeployment", "spec.rollout", objectProperty(
+				"Rollout strategy with 'type' Canary or BlueGreen. Use explain_schema with kind "+
+					"'ComponentDeployment' and path 'spec.rollout' to discover its fields")),
+			"resource_version":              stringProperty("Resource version returned by get_component_deployment"),
+			"freeze_override_justification": stringProperty("Justification to deploy while the environment is frozen"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.ComponentDeploymentResponse](),
+		Annotations:  writeTool(false, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                     string                                        `json:"org_name"`
+		ProjectName                 string                                        `json:"project_name"`
+		ComponentName               string                                        `json:"component_name"`
+		Environment                 string                                        `json:"environment"`
+		Overrides                   *runtime.RawExtension                         `json:"overrides"`
+		TraitOverrides              map[string]runtime.RawExtension               `json:"trait_overrides"`
+		ConfigurationOverrides      *openchoreov1alpha1.EnvConfigurationOverrides `json:"configuration_overrides"`
+		Rollout                     *openchoreov1alpha1.RolloutStrategy           `json:"rollout"`
+		ResourceVersion             string                                        `json:"resource_version"`
+		FreezeOverrideJustification string                                        `json:"freeze_override_justification"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.PutComponentDeployment(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+			&models.ComponentDeploymentRequest{
+				Overrides:              args.Overrides,
+				TraitOverrides:         args.TraitOverrides,
+				ConfigurationOverrides: args.ConfigurationOverrides,
+				Rollout:                args.Rollout,
+				ResourceVersion:        args.ResourceVersion,
+				FreezeOverride:         freezeOverride(args.FreezeOverrideJustification),
+			},
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeleteComponentDeployment(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "delete_component_deployment",
+		Description: "Undeploy a component from an environment by deleting its ComponentDeployment. " +
+			"Fails while the environment is frozen unless a freeze override justification is given.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":                      defaultStringProperty(),
+			"project_name":                  defaultStringProperty(),
+			"component_name":                defaultStringProperty(),
+			"environment":                   stringProperty("Use list_environments to discover valid names"),
+			"resource_version":              stringProperty("Only delete the ComponentDeployment at this resource version"),
+			"freeze_override_justification": stringProperty("Justification to undeploy while the environment is frozen"),
+			"confirm":                       confirmProperty("undeploy the component from the environment"),
+		}, []string{"org_name", "project_name", "component_name", "environment", "confirm"}),
+		OutputSchema: outputSchema[deletedResult](),
+		Annotations:  writeTool(true, true),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                     string `json:"org_name"`
+		ProjectName                 string `json:"project_name"`
+		ComponentName               string `json:"component_name"`
+		Environment                 string `json:"environment"`
+		ResourceVersion             string `json:"resource_version"`
+		FreezeOverrideJustification string `json:"freeze_override_justification"`
+		Confirm                     bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "undeploy the component from the environment"); err != nil {
+			return t.toolError(err)
+		}
+		err := t.DeploymentToolset.DeleteComponentDeployment(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment, args.ResourceVersion,
+			freezeOverride(args.FreezeOverrideJustification),
+		)
+		return t.handleToolResult(deleted("ComponentDeployment", args.ComponentName+"-"+args.Environment, err))
+	})
+}
+
+func (t *Toolsets) RegisterPromoteComponent(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "promote_component",
+		Description: "Promote a component from an environment to the next one of its deployment pipeline. Use " +
+			"get_deployment_pipeline to discover the allowed promotion paths. Fails while the target environment " +
+			"is frozen unless a freeze override justification is given.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":                      defaultStringProperty(),
+			"project_name":                  defaultStringProperty(),
+			"component_name":                defaultStringProperty(),
+			"source_env":                    stringProperty("Environment to promote from"),
+			"target_env":                    stringProperty("Environment to promote to"),
+			"freeze_override_justification": stringProperty("Justification to promote while the target environment is frozen"),
+		}, []string{"org_name", "project_name", "component_name", "source_env", "target_env"}),
+		OutputSchema: outputSchema[models.ListResponse[*models.BindingResponse]](),
+		Annotations:  writeTool(false, false),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                     string `json:"org_name"`
+		ProjectName                 string `json:"project_name"`
+		ComponentName               string `json:"component_name"`
+		SourceEnv                   string `json:"source_env"`
+		TargetEnv                   string `json:"target_env"`
+		FreezeOverrideJustification string `json:"freeze_override_justification"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.PromoteComponent(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName,
+			&models.PromoteComponentRequest{
+				SourceEnvironment: args.SourceEnv,
+				TargetEnvironment: args.TargetEnv,
+				FreezeOverride:    freezeOverride(args.FreezeOverrideJustification),
+			},
+		)
+		return t.handleToolResult(itemsResult(result, err))
+	})
+}
+
+func (t *Toolsets) RegisterRollbackComponentDeployment(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "rollback_component_deployment",
+		Description: "Roll back the rollout in progress of a component in an environment by aborting it, restoring " +
+			"the stable revision. A completed rollout can't be rolled back: fails if no canary or blue-green rollout " +
+			"is in progress.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"project_name":     defaultStringProperty(),
+			"component_name":   defaultStringProperty(),
+			"environment":      stringProperty("Use list_environments to discover valid names"),
+			"resource_version": stringProperty("Resource version returned by get_component_deployment"),
+			"confirm":          confirmProperty("roll back the rollout"),
+		}, []string{"org_name", "project_name", "component_name", "environment", "confirm"}),
+		OutputSchema: outputSchema[models.ComponentDeploymentResponse](),
+		Annotations:  writeTool(true, false),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string `json:"org_name"`
+		ProjectName     string `json:"project_name"`
+		ComponentName   string `json:"component_name"`
+		Environment     string `json:"environment"`
+		ResourceVersion string `json:"resource_version"`
+		Confirm         bool   `json:"confirm"`
+	}) (*mcp.CallToolResult, any, error) {
+		if err := requireConfirmation(args.Confirm, "roll back the rollout"); err != nil {
+			return t.toolError(err)
+		}
+		result, err := t.DeploymentToolset.RollbackComponentDeployment(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment, args.ResourceVersion,
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListComponentEnvSnapshots(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_component_env_snapshots",
+		Description: "List the environment snapshots of a component. A snapshot freezes the ComponentType, traits " +
+			"and workload deployed to an environment, and is promoted from environment to environment.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: listOutputSchema[*models.ComponentEnvSnapshotResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.DeploymentToolset.ListComponentEnvSnapshots(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, opts,
+		)
+		return t.handleToolResult(listResult(page, opts, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetComponentEnvSnapshot(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_env_snapshot",
+		Description: "Get the snapshot of a component in an environment: the frozen ComponentType, traits and " +
+			"workload, the generated release and the number of rendered resources.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.ComponentEnvSnapshotResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetComponentEnvSnapshot(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListReleases(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "list_releases",
+		Description: "List the releases of a component. A release holds the Kubernetes resources applied to the " +
+			"data plane of an environment.",
+		InputSchema: createListSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+		}, []string{"org_name", "project_name", "component_name"}),
+		OutputSchema: listOutputSchema[*models.ReleaseResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.options()
+		page, err := t.DeploymentToolset.ListReleases(ctx, args.OrgName, args.ProjectName, args.ComponentName, opts)
+		return t.handleToolResult(listResult(page, opts, err))
+	})
+}
+
+func (t *Toolsets) RegisterGetRelease(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_release",
+		Description: "Get the release of a component in an environment with the health of each resource " +
+			"applied to the data plane. Use it to find out why a deployment is not ready.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.ReleaseResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetRelease(ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment)
+		return t.handleToolResult(result, err)
+	})
+}
+
+// timeRangeArgs are the time range arguments of log and trace tools
+type timeRangeArgs struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+func (a timeRangeArgs) timeRange() (models.TimeRange, error) {
+	var timeRange models.TimeRange
+	var err error
+	if a.StartTime != "" {
+		if timeRange.StartTime, err = time.Parse(time.RFC3339, a.StartTime); err != nil {
+			return timeRange, &ToolError{Code: CodeInvalidInput, Message: fmt.Sprintf("start_time must be an RFC 3339 time: %v", err)}
+		}
+	}
+	if a.EndTime != "" {
+		if timeRange.EndTime, err = time.Parse(time.RFC3339, a.EndTime); err != nil {
+			return timeRange, &ToolError{Code: CodeInvalidInput, Message: fmt.Sprintf("end_time must be an RFC 3339 time: %v", err)}
+		}
+	}
+	return timeRange, nil
+}
+
+// addTimeRangeProperties adds the time range arguments to the properties of a tool schema
+func addTimeRangeProperties(properties map[string]any) map[string]any {
+	properties["start_time"] = stringProperty("RFC 3339 start of the time range, e.g. '2025-01-02T15:04:05Z'. " +
+		"Defaults to an hour before the end time")
+	properties["end_time"] = stringProperty("RFC 3339 end of the time range. Defaults to now")
+	return properties
+}
+
+// addLogFilterProperties adds the filter arguments of log tools to the properties of a tool schema
+func addLogFilterProperties(properties map[string]any) map[string]any {
+	properties["levels"] = arrayProperty("Only return entries of these log levels: "+
+		strings.Join(models.LogLevels, ", "), "string")
+	properties["search"] = stringProperty("Only return entries containing this phrase, e.g. an error message")
+	properties["limit"] = integerProperty(fmt.Sprintf("Maximum number of entries to return, %d by default",
+		models.DefaultObservabilityLimit))
+	properties["sort_order"] = stringProperty("'desc' for the newest entries first (default) or 'asc'")
+	return properties
+}
+
+func (t *Toolsets) RegisterGetComponentLogs(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_logs",
+		Description: "Get the runtime logs of a component in an environment, newest first. Filter by time range, " +
+			"log level and search phrase to find the errors behind a failing deployment.",
+		InputSchema: createSchema(addLogFilterProperties(addTimeRangeProperties(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+		})), []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.LogsResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string   `json:"org_name"`
+		ProjectName   string   `json:"project_name"`
+		ComponentName string   `json:"component_name"`
+		Environment   string   `json:"environment"`
+		Levels        []string `json:"levels"`
+		Search        string   `json:"search"`
+		Limit         int      `json:"limit"`
+		SortOrder     string   `json:"sort_order"`
+		timeRangeArgs
+	}) (*mcp.CallToolResult, any, error) {
+		timeRange, err := args.timeRange()
+		if err != nil {
+			return t.toolError(err)
+		}
+		result, err := t.ObservabilityToolset.GetComponentLogs(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+			&models.LogQuery{
+				TimeRange: timeRange,
+				Levels:    args.Levels,
+				Search:    args.Search,
+				Limit:     args.Limit,
+				SortOrder: args.SortOrder,
+			},
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetBuildLogs(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_build_logs",
+		Description: "Get the logs of a build of a component to find out why it failed. " +
+			"Use list_builds to discover the build names.",
+		InputSchema: createSchema(addLogFilterProperties(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"build_name":     stringProperty("Use list_builds to discover valid names"),
+		}), []string{"org_name", "project_name", "component_name", "build_name"}),
+		OutputSchema: outputSchema[models.LogsResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string   `json:"org_name"`
+		ProjectName   string   `json:"project_name"`
+		ComponentName string   `json:"component_name"`
+		BuildName     string   `json:"build_name"`
+		Levels        []string `json:"levels"`
+		Search        string   `json:"search"`
+		Limit         int      `json:"limit"`
+		SortOrder     string   `json:"sort_order"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ObservabilityToolset.GetBuildLogs(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BuildName,
+			&models.LogQuery{
+				Levels:    args.Levels,
+				Search:    args.Search,
+				Limit:     args.Limit,
+				SortOrder: args.SortOrder,
+			},
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetComponentTraces(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_component_traces",
+		Description: "Get the spans of the distributed traces of a component in an environment, with their " +
+			"durations. Use it to find slow or failing requests.",
+		InputSchema: createSchema(addTimeRangeProperties(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+			"limit": integerProperty(fmt.Sprintf("Maximum number of spans to return, %d by default",
+				models.DefaultObservabilityLimit)),
+			"sort_order": stringProperty("'desc' for the newest spans first (default) or 'asc'"),
+		}), []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.TracesResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Limit         int    `json:"limit"`
+		SortOrder     string `json:"sort_order"`
+		timeRangeArgs
+	}) (*mcp.CallToolResult, any, error) {
+		timeRange, err := args.timeRange()
+		if err != nil {
+			return t.toolError(err)
+		}
+		result, err := t.ObservabilityToolset.GetComponentTraces(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+			&models.TraceQuery{TimeRange: timeRange, Limit: args.Limit, SortOrder: args.SortOrder},
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetReleaseHealth(s *mcp.Server) {
+	addTool(t, s, &mcp.Tool{
+		Name: "get_release_health",
+		Description: "Get the health of a component in an environment: the overall health of the resources its " +
+			"release applied to the data plane, the resources that are not healthy and the release conditions. " +
+			"Start here to diagnose a failing deployment.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"environment":    stringProperty("Use list_environments to discover valid names"),
+		}, []string{"org_name", "project_name", "component_name", "environment"}),
+		OutputSchema: outputSchema[models.ReleaseHealthResponse](),
+		Annotations:  readOnlyTool(),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ObservabilityToolset.GetReleaseHealth(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environment,
+		)
+		return t.handleToolResult(result, err)
+	})
+}
+
+// freezeOverride returns the freeze override of a justification, nil if there is none
+func freezeOverride(justification string) *models.FreezeOverride {
+	if justification == "" {
+		return nil
+	}
+	return &models.FreezeOverride{Justification: justification}
+}
+
 // organizationToolRegistrations returns the list of organization toolset registration functions
 func (t *Toolsets) organizationToolRegistrations() []RegisterFunc {
 	return []RegisterFunc{
@@ -561,6 +1890,8 @@ func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 		t.RegisterGetComponent,
 		t.RegisterComponentBinding,
 		t.RegisterGetComponentWorkloads,
+		t.RegisterUpdateComponentTraits,
+		t.RegisterDeleteComponent,
 	}
 }
 
@@ -580,6 +1911,16 @@ func (t *Toolsets) deploymentToolRegistrations() []RegisterFunc {
 	return []RegisterFunc{
 		t.RegisterGetDeploymentPipeline,
 		t.RegisterGetComponentObserverURL,
+		t.RegisterListComponentDeployments,
+		t.RegisterGetComponentDeployment,
+		t.RegisterPutComponentDeployment,
+		t.RegisterDeleteComponentDeployment,
+		t.RegisterPromoteComponent,
+		t.RegisterRollbackComponentDeployment,
+		t.RegisterListComponentEnvSnapshots,
+		t.RegisterGetComponentEnvSnapshot,
+		t.RegisterListReleases,
+		t.RegisterGetRelease,
 	}
 }
 
@@ -600,6 +1941,39 @@ func (t *Toolsets) schemaToolRegistrations() []RegisterFunc {
 	}
 }
 
+// platformToolRegistrations returns the list of platform toolset registration functions
+func (t *Toolsets) platformToolRegistrations() []RegisterFunc {
+	return []RegisterFunc{
+		t.RegisterListComponentTypes,
+		t.RegisterGetComponentType,
+		t.RegisterCreateComponentType,
+		t.RegisterUpdateComponentType,
+		t.RegisterDeleteComponentType,
+		t.RegisterGetComponentTypeSchema,
+		t.RegisterListTraits,
+		t.RegisterGetTrait,
+		t.RegisterCreateTrait,
+		t.RegisterUpdateTrait,
+		t.RegisterDeleteTrait,
+		t.RegisterGetTraitSchema,
+		t.RegisterListWorkflows,
+		t.RegisterGetWorkflow,
+		t.RegisterCreateWorkflow,
+		t.RegisterUpdateWorkflow,
+		t.RegisterDeleteWorkflow,
+	}
+}
+
+// observabilityToolRegistrations returns the list of observability toolset registration functions
+func (t *Toolsets) observabilityToolRegistrations() []RegisterFunc {
+	return []RegisterFunc{
+		t.RegisterGetReleaseHealth,
+		t.RegisterGetComponentLogs,
+		t.RegisterGetBuildLogs,
+		t.RegisterGetComponentTraces,
+	}
+}
+
 func (t *Toolsets) Register(s *mcp.Server) {
 	// Register organization tools if OrganizationToolset is enabled
 	if t.OrganizationToolset != nil {
@@ -649,4 +2023,22 @@ func (t *Toolsets) Register(s *mcp.Server) {
 			registerFunc(s)
 		}
 	}
+
+	// Register platform tools if PlatformToolset is enabled
+	if t.PlatformToolset != nil {
+		for _, registerFunc := range t.platformToolRegistrations() {
+			registerFunc(s)
+		}
+	}
+
+	// Register observability tools if ObservabilityToolset is enabled
+	if t.ObservabilityToolset != nil {
+		for _, registerFunc := range t.observabilityToolRegistrations() {
+			registerFunc(s)
+		}
+	}
+
+	// Register the resources and prompts of the enabled toolsets
+	t.RegisterResources(s)
+	t.RegisterPrompts(s)
 }
@@ -6,12 +6,20 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
 
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
@@ -39,151 +47,385 @@ func (m *MockCoreToolsetHandler) recordCall(method string, args ...interface{})
 	m.calls[method] = append(m.calls[method], args)
 }
 
-func (m *MockCoreToolsetHandler) GetOrganization(ctx context.Context, name string) (string, error) {
+func (m *MockCoreToolsetHandler) GetOrganization(ctx context.Context, name string) (*models.OrganizationResponse, error) {
 	m.recordCall("GetOrganization", name)
-	return `{"name":"test-org"}`, nil
+	return &models.OrganizationResponse{Name: "test-org"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListProjects(ctx context.Context, orgName string) (string, error) {
-	m.recordCall("ListProjects", orgName)
-	return `[{"name":"project1"}]`, nil
+func (m *MockCoreToolsetHandler) ListOrganizations(ctx context.Context) ([]*models.OrganizationResponse, error) {
+	m.recordCall("ListOrganizations")
+	return []*models.OrganizationResponse{{Name: "test-org"}}, nil
 }
 
-func (m *MockCoreToolsetHandler) GetProject(ctx context.Context, orgName, projectName string) (string, error) {
+func (m *MockCoreToolsetHandler) ListProjects(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ProjectResponse], error) {
+	m.recordCall("ListProjects", orgName, opts)
+	return &models.ListPage[*models.ProjectResponse]{Items: []*models.ProjectResponse{{Name: "project1"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetProject(ctx context.Context, orgName, projectName string) (*models.ProjectResponse, error) {
 	m.recordCall("GetProject", orgName, projectName)
-	return `{"name":"project1"}`, nil
+	return &models.ProjectResponse{Name: "project1"}, nil
 }
 
 func (m *MockCoreToolsetHandler) CreateProject(
 	ctx context.Context, orgName string, req *models.CreateProjectRequest,
-) (string, error) {
+) (*models.ProjectResponse, error) {
 	m.recordCall("CreateProject", orgName, req)
-	return `{"name":"new-project"}`, nil
+	return &models.ProjectResponse{Name: "new-project"}, nil
 }
 
 func (m *MockCoreToolsetHandler) CreateComponent(
 	ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest,
-) (string, error) {
+) (*models.ComponentResponse, error) {
 	m.recordCall("CreateComponent", orgName, projectName, req)
-	return `{"name":"new-component"}`, nil
+	return &models.ComponentResponse{Name: "new-component"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListComponents(ctx context.Context, orgName, projectName string) (string, error) {
-	m.recordCall("ListComponents", orgName, projectName)
-	return `[{"name":"component1"}]`, nil
+func (m *MockCoreToolsetHandler) ListComponents(
+	ctx context.Context, orgName, projectName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentResponse], error) {
+	m.recordCall("ListComponents", orgName, projectName, opts)
+	return &models.ListPage[*models.ComponentResponse]{Items: []*models.ComponentResponse{{Name: "component1"}}, Total: 1}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetComponent(
 	ctx context.Context, orgName, projectName, componentName string, additionalResources []string,
-) (string, error) {
+) (*models.ComponentResponse, error) {
 	m.recordCall("GetComponent", orgName, projectName, componentName, additionalResources)
-	return `{"name":"component1"}`, nil
+	return &models.ComponentResponse{Name: "component1"}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetComponentBinding(
 	ctx context.Context, orgName, projectName, componentName, environment string,
-) (string, error) {
+) (*models.BindingResponse, error) {
 	m.recordCall("GetComponentBinding", orgName, projectName, componentName, environment)
-	return `{"environment":"dev"}`, nil
+	return &models.BindingResponse{Name: "my-component-dev", Environment: "dev"}, nil
 }
 
 func (m *MockCoreToolsetHandler) UpdateComponentBinding(
 	ctx context.Context, orgName, projectName, componentName, bindingName string,
 	req *models.UpdateBindingRequest,
-) (string, error) {
+) (*models.BindingResponse, error) {
 	m.recordCall("UpdateComponentBinding", orgName, projectName, componentName, bindingName, req)
-	return `{"status":"updated"}`, nil
+	return &models.BindingResponse{Name: "my-component-dev"}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetComponentObserverURL(
 	ctx context.Context, orgName, projectName, componentName, environmentName string,
-) (string, error) {
+) (*models.ComponentObserverResponse, error) {
 	m.recordCall("GetComponentObserverURL", orgName, projectName, componentName, environmentName)
-	return `{"url":"http://observer.example.com"}`, nil
+	return &models.ComponentObserverResponse{ObserverURL: "http://observer.example.com"}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetBuildObserverURL(
 	ctx context.Context, orgName, projectName, componentName string,
-) (string, error) {
+) (*models.ComponentObserverResponse, error) {
 	m.recordCall("GetBuildObserverURL", orgName, projectName, componentName)
-	return `{"url":"http://build-observer.example.com"}`, nil
+	return &models.ComponentObserverResponse{ObserverURL: "http://build-observer.example.com"}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetComponentWorkloads(
 	ctx context.Context, orgName, projectName, componentName string,
-) (string, error) {
+) (*openchoreov1alpha1.WorkloadSpec, error) {
 	m.recordCall("GetComponentWorkloads", orgName, projectName, componentName)
-	return `[{"name":"workload1"}]`, nil
+	return &openchoreov1alpha1.WorkloadSpec{}, nil
+}
+
+func (m *MockCoreToolsetHandler) UpdateComponentTraits(
+	ctx context.Context, orgName, projectName, componentName string, req *models.ComponentTraitsRequest,
+) (*models.ComponentResponse, error) {
+	m.recordCall("UpdateComponentTraits", orgName, projectName, componentName, req)
+	return &models.ComponentResponse{Name: "component1"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListEnvironments(ctx context.Context, orgName string) (string, error) {
-	m.recordCall("ListEnvironments", orgName)
-	return `[{"name":"dev"}]`, nil
+func (m *MockCoreToolsetHandler) DeleteComponent(
+	ctx context.Context, orgName, projectName, componentName, resourceVersion string, override *models.FreezeOverride,
+) error {
+	m.recordCall("DeleteComponent", orgName, projectName, componentName, resourceVersion, override)
+	return nil
 }
 
-func (m *MockCoreToolsetHandler) GetEnvironment(ctx context.Context, orgName, envName string) (string, error) {
+func (m *MockCoreToolsetHandler) ListEnvironments(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.EnvironmentResponse], error) {
+	m.recordCall("ListEnvironments", orgName, opts)
+	return &models.ListPage[*models.EnvironmentResponse]{Items: []*models.EnvironmentResponse{{Name: "dev"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetEnvironment(ctx context.Context, orgName, envName string) (*models.EnvironmentResponse, error) {
 	m.recordCall("GetEnvironment", orgName, envName)
-	return `{"name":"dev"}`, nil
+	return &models.EnvironmentResponse{Name: "dev"}, nil
 }
 
 func (m *MockCoreToolsetHandler) CreateEnvironment(
 	ctx context.Context, orgName string, req *models.CreateEnvironmentRequest,
-) (string, error) {
+) (*models.EnvironmentResponse, error) {
 	m.recordCall("CreateEnvironment", orgName, req)
-	return `{"name":"new-env"}`, nil
+	return &models.EnvironmentResponse{Name: "new-env"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListDataPlanes(ctx context.Context, orgName string) (string, error) {
-	m.recordCall("ListDataPlanes", orgName)
-	return `[{"name":"dp1"}]`, nil
+func (m *MockCoreToolsetHandler) ListDataPlanes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.DataPlaneResponse], error) {
+	m.recordCall("ListDataPlanes", orgName, opts)
+	return &models.ListPage[*models.DataPlaneResponse]{Items: []*models.DataPlaneResponse{{Name: "dp1"}}, Total: 1}, nil
 }
 
-func (m *MockCoreToolsetHandler) GetDataPlane(ctx context.Context, orgName, dpName string) (string, error) {
+func (m *MockCoreToolsetHandler) GetDataPlane(ctx context.Context, orgName, dpName string) (*models.DataPlaneResponse, error) {
 	m.recordCall("GetDataPlane", orgName, dpName)
-	return `{"name":"dp1"}`, nil
+	return &models.DataPlaneResponse{Name: "dp1"}, nil
 }
 
 func (m *MockCoreToolsetHandler) CreateDataPlane(
 	ctx context.Context, orgName string, req *models.CreateDataPlaneRequest,
-) (string, error) {
+) (*models.DataPlaneResponse, error) {
 	m.recordCall("CreateDataPlane", orgName, req)
-	return `{"name":"new-dp"}`, nil
+	return &models.DataPlaneResponse{Name: "new-dp"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListBuildTemplates(ctx context.Context, orgName string) (string, error) {
-	m.recordCall("ListBuildTemplates", orgName)
-	return `[{"name":"template1"}]`, nil
+func (m *MockCoreToolsetHandler) ListBuildTemplates(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[models.BuildTemplateResponse], error) {
+	m.recordCall("ListBuildTemplates", orgName, opts)
+	return &models.ListPage[models.BuildTemplateResponse]{Items: []models.BuildTemplateResponse{{Name: "template1"}}, Total: 1}, nil
 }
 
 func (m *MockCoreToolsetHandler) TriggerBuild(
 	ctx context.Context, orgName, projectName, componentName, commit string,
-) (string, error) {
+) (*models.BuildResponse, error) {
 	m.recordCall("TriggerBuild", orgName, projectName, componentName, commit)
-	return `{"buildId":"build-123"}`, nil
+	return &models.BuildResponse{Name: "build-123"}, nil
 }
 
 func (m *MockCoreToolsetHandler) ListBuilds(
-	ctx context.Context, orgName, projectName, componentName string,
-) (string, error) {
-	m.recordCall("ListBuilds", orgName, projectName, componentName)
-	return `[{"id":"build-123"}]`, nil
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[models.BuildResponse], error) {
+	m.recordCall("ListBuilds", orgName, projectName, componentName, opts)
+	return &models.ListPage[models.BuildResponse]{Items: []models.BuildResponse{{Name: "build-123"}}, Total: 1}, nil
 }
 
-func (m *MockCoreToolsetHandler) ListBuildPlanes(ctx context.Context, orgName string) (string, error) {
-	m.recordCall("ListBuildPlanes", orgName)
-	return `[{"name":"bp1"}]`, nil
+func (m *MockCoreToolsetHandler) ListBuildPlanes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[models.BuildPlaneResponse], error) {
+	m.recordCall("ListBuildPlanes", orgName, opts)
+	return &models.ListPage[models.BuildPlaneResponse]{Items: []models.BuildPlaneResponse{{Name: "bp1"}}, Total: 1}, nil
 }
 
 func (m *MockCoreToolsetHandler) GetProjectDeploymentPipeline(
 	ctx context.Context, orgName, projectName string,
-) (string, error) {
+) (*models.DeploymentPipelineResponse, error) {
 	m.recordCall("GetProjectDeploymentPipeline", orgName, projectName)
-	return `{"stages":[]}`, nil
+	return &models.DeploymentPipelineResponse{Name: "default"}, nil
 }
 
-func (m *MockCoreToolsetHandler) ExplainSchema(ctx context.Context, kind, path string) (string, error) {
+func (m *MockCoreToolsetHandler) ExplainSchema(ctx context.Context, kind, path string) (*models.SchemaExplanation, error) {
 	m.recordCall("ExplainSchema", kind, path)
-	return `{"group":"openchoreo.dev","kind":"Component","version":"v1alpha1","type":"Object"}`, nil
+	return &models.SchemaExplanation{Group: "openchoreo.dev", Kind: "Component", Version: "v1alpha1", Type: "Object"}, nil
+}
+
+func (m *MockCoreToolsetHandler) ListComponentTypes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentTypeResponse], error) {
+	m.recordCall("ListComponentTypes", orgName, opts)
+	return &models.ListPage[*models.ComponentTypeResponse]{Items: []*models.ComponentTypeResponse{{Name: "componenttype1"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentType(ctx context.Context, orgName, name string) (*models.ComponentTypeResponse, error) {
+	m.recordCall("GetComponentType", orgName, name)
+	return &models.ComponentTypeResponse{Name: "componenttype1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) CreateComponentType(
+	ctx context.Context, orgName string, req *models.ComponentTypeRequest,
+) (*models.ComponentTypeResponse, error) {
+	m.recordCall("CreateComponentType", orgName, req)
+	return &models.ComponentTypeResponse{Name: "new-componenttype"}, nil
+}
+
+func (m *MockCoreToolsetHandler) UpdateComponentType(
+	ctx context.Context, orgName, name string, req *models.ComponentTypeRequest,
+) (*models.ComponentTypeResponse, error) {
+	m.recordCall("UpdateComponentType", orgName, name, req)
+	return &models.ComponentTypeResponse{Name: "componenttype1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) DeleteComponentType(ctx context.Context, orgName, name, resourceVersion string) error {
+	m.recordCall("DeleteComponentType", orgName, name, resourceVersion)
+	return nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentTypeSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error) {
+	m.recordCall("GetComponentTypeSchema", orgName, name)
+	return &extv1.JSONSchemaProps{Type: "object"}, nil
+}
+
+func (m *MockCoreToolsetHandler) ListTraits(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.TraitResponse], error) {
+	m.recordCall("ListTraits", orgName, opts)
+	return &models.ListPage[*models.TraitResponse]{Items: []*models.TraitResponse{{Name: "trait1"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetTrait(ctx context.Context, orgName, name string) (*models.TraitResponse, error) {
+	m.recordCall("GetTrait", orgName, name)
+	return &models.TraitResponse{Name: "trait1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) CreateTrait(
+	ctx context.Context, orgName string, req *models.TraitRequest,
+) (*models.TraitResponse, error) {
+	m.recordCall("CreateTrait", orgName, req)
+	return &models.TraitResponse{Name: "new-trait"}, nil
+}
+
+func (m *MockCoreToolsetHandler) UpdateTrait(
+	ctx context.Context, orgName, name string, req *models.TraitRequest,
+) (*models.TraitResponse, error) {
+	m.recordCall("UpdateTrait", orgName, name, req)
+	return &models.TraitResponse{Name: "trait1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) DeleteTrait(ctx context.Context, orgName, name, resourceVersion string) error {
+	m.recordCall("DeleteTrait", orgName, name, resourceVersion)
+	return nil
+}
+
+func (m *MockCoreToolsetHandler) GetTraitSchema(ctx context.Context, orgName, name string) (*extv1.JSONSchemaProps, error) {
+	m.recordCall("GetTraitSchema", orgName, name)
+	return &extv1.JSONSchemaProps{Type: "object"}, nil
+}
+
+func (m *MockCoreToolsetHandler) ListWorkflows(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (*models.ListPage[*models.WorkflowResponse], error) {
+	m.recordCall("ListWorkflows", orgName, opts)
+	return &models.ListPage[*models.WorkflowResponse]{Items: []*models.WorkflowResponse{{Name: "workflow1"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetWorkflow(ctx context.Context, orgName, name string) (*models.WorkflowResponse, error) {
+	m.recordCall("GetWorkflow", orgName, name)
+	return &models.WorkflowResponse{Name: "workflow1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) CreateWorkflow(
+	ctx context.Context, orgName string, req *models.WorkflowRequest,
+) (*models.WorkflowResponse, error) {
+	m.recordCall("CreateWorkflow", orgName, req)
+	return &models.WorkflowResponse{Name: "new-workflow"}, nil
+}
+
+func (m *MockCoreToolsetHandler) UpdateWorkflow(
+	ctx context.Context, orgName, name string, req *models.WorkflowRequest,
+) (*models.WorkflowResponse, error) {
+	m.recordCall("UpdateWorkflow", orgName, name, req)
+	return &models.WorkflowResponse{Name: "workflow1"}, nil
+}
+
+func (m *MockCoreToolsetHandler) DeleteWorkflow(ctx context.Context, orgName, name, resourceVersion string) error {
+	m.recordCall("DeleteWorkflow", orgName, name, resourceVersion)
+	return nil
+}
+
+func (m *MockCoreToolsetHandler) ListComponentDeployments(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentDeploymentResponse], error) {
+	m.recordCall("ListComponentDeployments", orgName, projectName, componentName, opts)
+	return &models.ListPage[*models.ComponentDeploymentResponse]{Items: []*models.ComponentDeploymentResponse{{Name: "my-component-dev"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment string,
+) (*models.ComponentDeploymentResponse, error) {
+	m.recordCall("GetComponentDeployment", orgName, projectName, componentName, environment)
+	return &models.ComponentDeploymentResponse{Name: "my-component-dev"}, nil
+}
+
+func (m *MockCoreToolsetHandler) PutComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment string,
+	req *models.ComponentDeploymentRequest,
+) (*models.ComponentDeploymentResponse, error) {
+	m.recordCall("PutComponentDeployment", orgName, projectName, componentName, environment, req)
+	return &models.ComponentDeploymentResponse{Name: "my-component-dev"}, nil
+}
+
+func (m *MockCoreToolsetHandler) DeleteComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string,
+	override *models.FreezeOverride,
+) error {
+	m.recordCall("DeleteComponentDeployment", orgName, projectName, componentName, environment, resourceVersion, override)
+	return nil
+}
+
+func (m *MockCoreToolsetHandler) PromoteComponent(
+	ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
+) ([]*models.BindingResponse, error) {
+	m.recordCall("PromoteComponent", orgName, projectName, componentName, req)
+	return []*models.BindingResponse{{Name: "my-component-staging"}}, nil
+}
+
+func (m *MockCoreToolsetHandler) RollbackComponentDeployment(
+	ctx context.Context, orgName, projectName, componentName, environment, resourceVersion string,
+) (*models.ComponentDeploymentResponse, error) {
+	m.recordCall("RollbackComponentDeployment", orgName, projectName, componentName, environment, resourceVersion)
+	return &models.ComponentDeploymentResponse{Name: "my-component-dev"}, nil
+}
+
+func (m *MockCoreToolsetHandler) ListComponentEnvSnapshots(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ComponentEnvSnapshotResponse], error) {
+	m.recordCall("ListComponentEnvSnapshots", orgName, projectName, componentName, opts)
+	return &models.ListPage[*models.ComponentEnvSnapshotResponse]{Items: []*models.ComponentEnvSnapshotResponse{{Name: "my-component-dev"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentEnvSnapshot(
+	ctx context.Context, orgName, projectName, componentName, environment string,
+) (*models.ComponentEnvSnapshotResponse, error) {
+	m.recordCall("GetComponentEnvSnapshot", orgName, projectName, componentName, environment)
+	return &models.ComponentEnvSnapshotResponse{Name: "my-component-dev"}, nil
+}
+
+func (m *MockCoreToolsetHandler) ListReleases(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (*models.ListPage[*models.ReleaseResponse], error) {
+	m.recordCall("ListReleases", orgName, projectName, componentName, opts)
+	return &models.ListPage[*models.ReleaseResponse]{Items: []*models.ReleaseResponse{{Name: "my-component-dev"}}, Total: 1}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetRelease(
+	ctx context.Context, orgName, projectName, componentName, environment string,
+) (*models.ReleaseResponse, error) {
+	m.recordCall("GetRelease", orgName, projectName, componentName, environment)
+	return &models.ReleaseResponse{Name: "my-component-dev"}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentLogs(
+	ctx context.Context, orgName, projectName, componentName, environment string, query *models.LogQuery,
+) (*models.LogsResponse, error) {
+	m.recordCall("GetComponentLogs", orgName, projectName, componentName, environment, query)
+	return &models.LogsResponse{}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetBuildLogs(
+	ctx context.Context, orgName, projectName, componentName, buildName string, query *models.LogQuery,
+) (*models.LogsResponse, error) {
+	m.recordCall("GetBuildLogs", orgName, projectName, componentName, buildName, query)
+	return &models.LogsResponse{}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentTraces(
+	ctx context.Context, orgName, projectName, componentName, environment string, query *models.TraceQuery,
+) (*models.TracesResponse, error) {
+	m.recordCall("GetComponentTraces", orgName, projectName, componentName, environment, query)
+	return &models.TracesResponse{}, nil
+}
+
+func (m *MockCoreToolsetHandler) GetReleaseHealth(
+	ctx context.Context, orgName, projectName, componentName, environment string,
+) (*models.ReleaseHealthResponse, error) {
+	m.recordCall("GetReleaseHealth", orgName, projectName, componentName, environment)
+	return &models.ReleaseHealthResponse{Name: "my-component-dev", Health: "Healthy"}, nil
 }
 
 func setupTestServer(t *testing.T) (*mcp.ClientSession, *MockCoreToolsetHandler) {
@@ -197,6 +439,8 @@ func setupTestServer(t *testing.T) (*mcp.ClientSession, *MockCoreToolsetHandler)
 		DeploymentToolset:     mockHandler,
 		InfrastructureToolset: mockHandler,
 		SchemaToolset:         mockHandler,
+		PlatformToolset:       mockHandler,
+		ObservabilityToolset:  mockHandler,
 	}
 	clientSession := setupTestServerWithToolset(t, toolsets)
 	return clientSession, mockHandler
@@ -332,9 +576,16 @@ var allToolSpecs = []toolTestSpec{
 		descriptionKeywords: []string{"list", "component"},
 		descriptionMinLen:   10,
 		requiredParams:      []string{"org_name", "project_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
 		testArgs: map[string]any{
-			"org_name":     testOrgName,
-			"project_name": testProjectName,
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"limit":          10,
+			"continue":       "token",
+			"label_selector": "team=payments",
+			"field_selector": "status=Ready",
+			"sort":           "-createdAt",
+			"fields":         []string{"name", "status"},
 		},
 		expectedMethod: "ListComponents",
 		validateCall: func(t *testing.T, args []interface{}) {
@@ -344,6 +595,17 @@ var allToolSpecs = []toolTestSpec{
 			if args[1] != testProjectName {
 				t.Errorf("Expected project name %q, got %v", testProjectName, args[1])
 			}
+			want := &models.ListOptions{
+				Limit:         10,
+				Continue:      "token",
+				LabelSelector: "team=payments",
+				FieldSelector: "status=Ready",
+				Sort:          "-createdAt",
+				Fields:        []string{"name", "status"},
+			}
+			if diff := cmp.Diff(want, args[2]); diff != "" {
+				t.Errorf("List options mismatch (-want +got):\n%s", diff)
+			}
 		},
 	},
 	{
@@ -459,6 +721,57 @@ var allToolSpecs = []toolTestSpec{
 			}
 		},
 	},
+	{
+		name:                "update_component_traits",
+		toolset:             "component",
+		descriptionKeywords: []string{"traits", "component", "get_trait_schema"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "traits"},
+		optionalParams:      []string{"resource_version"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"traits": []any{
+				map[string]any{"name": "persistent-volume", "instanceName": "data", "parameters": map[string]any{"size": "1Gi"}},
+			},
+			"resource_version": "42",
+		},
+		expectedMethod: "UpdateComponentTraits",
+		validateCall: func(t *testing.T, args []interface{}) {
+			req := args[3].(*models.ComponentTraitsRequest)
+			if len(req.Traits) != 1 || req.Traits[0].Name != "persistent-volume" || req.Traits[0].InstanceName != "data" {
+				t.Errorf("Expected the persistent-volume trait instance data, got %+v", req.Traits)
+			}
+			if req.ResourceVersion != "42" {
+				t.Errorf("Expected resource version 42, got %q", req.ResourceVersion)
+			}
+		},
+	},
+	{
+		name:                "delete_component",
+		toolset:             "component",
+		descriptionKeywords: []string{"delete", "component", "frozen"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "confirm"},
+		optionalParams:      []string{"resource_version", "freeze_override_justification"},
+		testArgs: map[string]any{
+			"org_name":                      testOrgName,
+			"project_name":                  testProjectName,
+			"component_name":                testComponentName,
+			"freeze_override_justification": "decommission",
+			"confirm":                       true,
+		},
+		expectedMethod: "DeleteComponent",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[2] != testComponentName || args[3] != "" {
+				t.Errorf("Expected component %q without resource version, got %v", testComponentName, args)
+			}
+			if override := args[4].(*models.FreezeOverride); override == nil || override.Justification != "decommission" {
+				t.Errorf("Expected freeze override, got %v", override)
+			}
+		},
+	},
 	{
 		name:                "list_environments",
 		toolset:             "infrastructure",
@@ -616,143 +929,778 @@ var allToolSpecs = []toolTestSpec{
 		},
 	},
 	{
-		name:                "explain_schema",
-		toolset:             "schema",
-		descriptionKeywords: []string{"schema", "resource"},
+		name:                "list_component_types",
+		toolset:             "platform",
+		descriptionKeywords: []string{"list", "component types"},
 		descriptionMinLen:   10,
-		requiredParams:      []string{"kind"},
-		optionalParams:      []string{"path"},
-		testArgs: map[string]any{
-			"kind": "Component",
-			"path": "spec",
-		},
-		expectedMethod: "ExplainSchema",
+		requiredParams:      []string{"org_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs:            map[string]any{"org_name": testOrgName},
+		expectedMethod:      "ListComponentTypes",
 		validateCall: func(t *testing.T, args []interface{}) {
-			if args[0] != "Component" || args[1] != "spec" {
-				t.Errorf("Expected (Component, spec), got (%v, %v)", args[0], args[1])
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
 			}
 		},
 	},
-}
-
-// TestToolRegistration verifies that all expected tools are registered
-func TestToolRegistration(t *testing.T) {
-	clientSession, _ := setupTestServer(t)
-	defer clientSession.Close()
-
-	ctx := context.Background()
-	toolsResult, err := clientSession.ListTools(ctx, nil)
-	if err != nil {
-		t.Fatalf("Failed to list tools: %v", err)
-	}
-
-	// Build expected tool names from allToolSpecs
-	expectedTools := make(map[string]bool)
-	for _, spec := range allToolSpecs {
-		expectedTools[spec.name] = true
-	}
-
-	// Check all expected tools are present
-	registeredTools := make(map[string]bool)
-	for _, tool := range toolsResult.Tools {
-		registeredTools[tool.Name] = true
-		if !expectedTools[tool.Name] {
-			t.Errorf("Unexpected tool %q found in registered tools", tool.Name)
-		}
-	}
-
-	// Check no tools are missing
-	for expected := range expectedTools {
-		if !registeredTools[expected] {
-			t.Errorf("Expected tool %q not found in registered tools", expected)
-		}
-	}
-
-	if len(toolsResult.Tools) != len(expectedTools) {
-		t.Errorf("Expected %d tools, got %d", len(expectedTools), len(toolsResult.Tools))
-	}
-}
-
-// TestToolDescriptions verifies that tool descriptions are meaningful and distinguishable
-func TestToolDescriptions(t *testing.T) {
-	clientSession, _ := setupTestServer(t)
-	defer clientSession.Close()
-
-	ctx := context.Background()
-	toolsResult, err := clientSession.ListTools(ctx, nil)
-	if err != nil {
-		t.Fatalf("Failed to list tools: %v", err)
-	}
-
-	toolsByName := make(map[string]*mcp.Tool)
-	for _, tool := range toolsResult.Tools {
-		toolsByName[tool.Name] = tool
-	}
-
-	// Test each tool's description using specs from allToolSpecs
-	for _, spec := range allToolSpecs {
-		t.Run(spec.name, func(t *testing.T) {
-			tool, exists := toolsByName[spec.name]
-			if !exists {
-				t.Fatalf("Tool %q not found", spec.name)
+	{
+		name:                "get_component_type",
+		toolset:             "platform",
+		descriptionKeywords: []string{"component type", "resource version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "componenttype1"},
+		expectedMethod:      "GetComponentType",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "componenttype1" {
+				t.Errorf("Expected (%s, componenttype1), got (%v, %v)", testOrgName, args[0], args[1])
 			}
-
-			desc := strings.ToLower(tool.Description)
-
-			// Check minimum length
-			if len(desc) < spec.descriptionMinLen {
-				t.Errorf("Description too short: got %d chars, want at least %d", len(desc), spec.descriptionMinLen)
+		},
+	},
+	{
+		name:                "create_component_type",
+		toolset:             "platform",
+		descriptionKeywords: []string{"create", "component type"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description"},
+		testArgs: map[string]any{
+			"org_name":     testOrgName,
+			"name":         "new-componenttype",
+			"display_name": "New ComponentType",
+			"spec":         map[string]any{"workloadType": "deployment", "resources": []any{map[string]any{"id": "deployment"}}},
+		},
+		expectedMethod: "CreateComponentType",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
 			}
-
-			// Check for required keywords
-			for _, word := range spec.descriptionKeywords {
-				if !strings.Contains(desc, strings.ToLower(word)) {
-					t.Errorf("Description missing required keyword %q: %s", word, tool.Description)
-				}
+			req := args[1].(*models.ComponentTypeRequest)
+			if req.Name != "new-componenttype" || req.DisplayName != "New ComponentType" {
+				t.Errorf("Expected name new-componenttype and display name 'New ComponentType', got %q and %q", req.Name, req.DisplayName)
 			}
-		})
-	}
-
-	// Ensure descriptions are unique across all tools
-	descriptions := make(map[string]string)
-	for _, tool := range toolsResult.Tools {
-		if existingTool, exists := descriptions[tool.Description]; exists {
-			t.Errorf("Duplicate description found: %q used by both %q and %q",
-				tool.Description, tool.Name, existingTool)
-		}
-		descriptions[tool.Description] = tool.Name
-	}
-}
-
-// TestToolSchemas verifies that tool input schemas have required properties defined
-func TestToolSchemas(t *testing.T) {
-	clientSession, _ := setupTestServer(t)
-	defer clientSession.Close()
-
-	ctx := context.Background()
-	toolsResult, err := clientSession.ListTools(ctx, nil)
-	if err != nil {
-		t.Fatalf("Failed to list tools: %v", err)
-	}
-
-	toolsByName := make(map[string]*mcp.Tool)
-	for _, tool := range toolsResult.Tools {
-		toolsByName[tool.Name] = tool
-	}
-
-	// Test each tool's schema using specs from allToolSpecs
-	for _, spec := range allToolSpecs {
-		t.Run(spec.name, func(t *testing.T) {
-			tool, exists := toolsByName[spec.name]
-			if !exists {
-				t.Fatalf("Tool %q not found", spec.name)
+		},
+	},
+	{
+		name:                "update_component_type",
+		toolset:             "platform",
+		descriptionKeywords: []string{"replace", "component type", "resource_version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description", "resource_version"},
+		testArgs: map[string]any{
+			"org_name":         testOrgName,
+			"name":             "componenttype1",
+			"spec":             map[string]any{"workloadType": "deployment", "resources": []any{map[string]any{"id": "deployment"}}},
+			"resource_version": "42",
+		},
+		expectedMethod: "UpdateComponentType",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "componenttype1" {
+				t.Errorf("Expected (%s, componenttype1), got (%v, %v)", testOrgName, args[0], args[1])
 			}
-
-			if tool.InputSchema == nil {
-				t.Fatal("InputSchema is nil")
+			req := args[2].(*models.ComponentTypeRequest)
+			if req.ResourceVersion != "42" {
+				t.Errorf("Expected resource version 42, got %q", req.ResourceVersion)
 			}
-
-			// Convert InputSchema to map for inspection
+		},
+	},
+	{
+		name:                "delete_component_type",
+		toolset:             "platform",
+		descriptionKeywords: []string{"delete", "component type"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "confirm"},
+		optionalParams:      []string{"resource_version"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "componenttype1", "resource_version": "42", "confirm": true},
+		expectedMethod:      "DeleteComponentType",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "componenttype1" || args[2] != "42" {
+				t.Errorf("Expected (%s, componenttype1, 42), got %v", testOrgName, args)
+			}
+		},
+	},
+	{
+		name:                "get_component_type_schema",
+		toolset:             "platform",
+		descriptionKeywords: []string{"schema", "parameters", "component type"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "componenttype1"},
+		expectedMethod:      "GetComponentTypeSchema",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "componenttype1" {
+				t.Errorf("Expected (%s, componenttype1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+		},
+	},
+	{
+		name:                "list_traits",
+		toolset:             "platform",
+		descriptionKeywords: []string{"list", "traits"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs:            map[string]any{"org_name": testOrgName},
+		expectedMethod:      "ListTraits",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
+			}
+		},
+	},
+	{
+		name:                "get_trait",
+		toolset:             "platform",
+		descriptionKeywords: []string{"trait", "resource version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "trait1"},
+		expectedMethod:      "GetTrait",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "trait1" {
+				t.Errorf("Expected (%s, trait1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+		},
+	},
+	{
+		name:                "create_trait",
+		toolset:             "platform",
+		descriptionKeywords: []string{"create", "trait"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description"},
+		testArgs: map[string]any{
+			"org_name":     testOrgName,
+			"name":         "new-trait",
+			"display_name": "New Trait",
+			"spec":         map[string]any{"creates": []any{}},
+		},
+		expectedMethod: "CreateTrait",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
+			}
+			req := args[1].(*models.TraitRequest)
+			if req.Name != "new-trait" || req.DisplayName != "New Trait" {
+				t.Errorf("Expected name new-trait and display name 'New Trait', got %q and %q", req.Name, req.DisplayName)
+			}
+		},
+	},
+	{
+		name:                "update_trait",
+		toolset:             "platform",
+		descriptionKeywords: []string{"replace", "trait", "resource_version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description", "resource_version"},
+		testArgs: map[string]any{
+			"org_name":         testOrgName,
+			"name":             "trait1",
+			"spec":             map[string]any{"creates": []any{}},
+			"resource_version": "42",
+		},
+		expectedMethod: "UpdateTrait",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "trait1" {
+				t.Errorf("Expected (%s, trait1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+			req := args[2].(*models.TraitRequest)
+			if req.ResourceVersion != "42" {
+				t.Errorf("Expected resource version 42, got %q", req.ResourceVersion)
+			}
+		},
+	},
+	{
+		name:                "delete_trait",
+		toolset:             "platform",
+		descriptionKeywords: []string{"delete", "trait"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "confirm"},
+		optionalParams:      []string{"resource_version"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "trait1", "resource_version": "42", "confirm": true},
+		expectedMethod:      "DeleteTrait",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "trait1" || args[2] != "42" {
+				t.Errorf("Expected (%s, trait1, 42), got %v", testOrgName, args)
+			}
+		},
+	},
+	{
+		name:                "get_trait_schema",
+		toolset:             "platform",
+		descriptionKeywords: []string{"schema", "parameters", "trait"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "trait1"},
+		expectedMethod:      "GetTraitSchema",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "trait1" {
+				t.Errorf("Expected (%s, trait1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+		},
+	},
+	{
+		name:                "list_workflows",
+		toolset:             "platform",
+		descriptionKeywords: []string{"list", "workflows"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs:            map[string]any{"org_name": testOrgName},
+		expectedMethod:      "ListWorkflows",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
+			}
+		},
+	},
+	{
+		name:                "get_workflow",
+		toolset:             "platform",
+		descriptionKeywords: []string{"workflow", "resource version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "workflow1"},
+		expectedMethod:      "GetWorkflow",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "workflow1" {
+				t.Errorf("Expected (%s, workflow1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+		},
+	},
+	{
+		name:                "create_workflow",
+		toolset:             "platform",
+		descriptionKeywords: []string{"create", "workflow"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description"},
+		testArgs: map[string]any{
+			"org_name":     testOrgName,
+			"name":         "new-workflow",
+			"display_name": "New Workflow",
+			"spec":         map[string]any{"resource": map[string]any{"kind": "Workflow"}},
+		},
+		expectedMethod: "CreateWorkflow",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName {
+				t.Errorf("Expected org name %q, got %v", testOrgName, args[0])
+			}
+			req := args[1].(*models.WorkflowRequest)
+			if req.Name != "new-workflow" || req.DisplayName != "New Workflow" {
+				t.Errorf("Expected name new-workflow and display name 'New Workflow', got %q and %q", req.Name, req.DisplayName)
+			}
+		},
+	},
+	{
+		name:                "update_workflow",
+		toolset:             "platform",
+		descriptionKeywords: []string{"replace", "workflow", "resource_version"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "spec"},
+		optionalParams:      []string{"display_name", "description", "resource_version"},
+		testArgs: map[string]any{
+			"org_name":         testOrgName,
+			"name":             "workflow1",
+			"spec":             map[string]any{"resource": map[string]any{"kind": "Workflow"}},
+			"resource_version": "42",
+		},
+		expectedMethod: "UpdateWorkflow",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "workflow1" {
+				t.Errorf("Expected (%s, workflow1), got (%v, %v)", testOrgName, args[0], args[1])
+			}
+			req := args[2].(*models.WorkflowRequest)
+			if req.ResourceVersion != "42" {
+				t.Errorf("Expected resource version 42, got %q", req.ResourceVersion)
+			}
+		},
+	},
+	{
+		name:                "delete_workflow",
+		toolset:             "platform",
+		descriptionKeywords: []string{"delete", "workflow"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "name", "confirm"},
+		optionalParams:      []string{"resource_version"},
+		testArgs:            map[string]any{"org_name": testOrgName, "name": "workflow1", "resource_version": "42", "confirm": true},
+		expectedMethod:      "DeleteWorkflow",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != "workflow1" || args[2] != "42" {
+				t.Errorf("Expected (%s, workflow1, 42), got %v", testOrgName, args)
+			}
+		},
+	},
+	{
+		name:                "list_component_deployments",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"list", "componentdeployments"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+		},
+		expectedMethod: "ListComponentDeployments",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+		},
+	},
+	{
+		name:                "get_component_deployment",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"componentdeployment", "environment"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+		},
+		expectedMethod: "GetComponentDeployment",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+		},
+	},
+	{
+		name:                "list_component_env_snapshots",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"list", "snapshots"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+		},
+		expectedMethod: "ListComponentEnvSnapshots",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+		},
+	},
+	{
+		name:                "get_component_env_snapshot",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"snapshot", "environment"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+		},
+		expectedMethod: "GetComponentEnvSnapshot",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+		},
+	},
+	{
+		name:                "list_releases",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"list", "releases"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name"},
+		optionalParams:      []string{"limit", "continue", "label_selector", "field_selector", "sort", "fields"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+		},
+		expectedMethod: "ListReleases",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+		},
+	},
+	{
+		name:                "get_release",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"release", "environment"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+		},
+		expectedMethod: "GetRelease",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+		},
+	},
+	{
+		name:                "put_component_deployment",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"deploy", "overrides", "frozen"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		optionalParams: []string{
+			"overrides", "trait_overrides", "configuration_overrides", "rollout", "resource_version",
+			"freeze_override_justification",
+		},
+		testArgs: map[string]any{
+			"org_name":                      testOrgName,
+			"project_name":                  testProjectName,
+			"component_name":                testComponentName,
+			"environment":                   testEnvName,
+			"overrides":                     map[string]any{"replicas": 3},
+			"rollout":                       map[string]any{"type": "BlueGreen"},
+			"resource_version":              "42",
+			"freeze_override_justification": "hotfix",
+		},
+		expectedMethod: "PutComponentDeployment",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+			req := args[4].(*models.ComponentDeploymentRequest)
+			if req.Overrides == nil || string(req.Overrides.Raw) != `{"replicas":3}` {
+				t.Errorf("Expected replicas override, got %v", req.Overrides)
+			}
+			if req.Rollout == nil || req.Rollout.Type != "BlueGreen" {
+				t.Errorf("Expected BlueGreen rollout, got %v", req.Rollout)
+			}
+			if req.ResourceVersion != "42" || req.FreezeOverride == nil || req.FreezeOverride.Justification != "hotfix" {
+				t.Errorf("Expected resource version 42 and freeze override, got %q and %v", req.ResourceVersion, req.FreezeOverride)
+			}
+		},
+	},
+	{
+		name:                "delete_component_deployment",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"undeploy", "componentdeployment"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment", "confirm"},
+		optionalParams:      []string{"resource_version", "freeze_override_justification"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+			"confirm":        true,
+		},
+		expectedMethod: "DeleteComponentDeployment",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[3] != testEnvName || args[4] != "" {
+				t.Errorf("Expected environment %q without resource version, got %v", testEnvName, args)
+			}
+			if override := args[5].(*models.FreezeOverride); override != nil {
+				t.Errorf("Expected no freeze override, got %v", override)
+			}
+		},
+	},
+	{
+		name:                "promote_component",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"promote", "get_deployment_pipeline", "frozen"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "source_env", "target_env"},
+		optionalParams:      []string{"freeze_override_justification"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"source_env":     testEnvName,
+			"target_env":     "staging",
+		},
+		expectedMethod: "PromoteComponent",
+		validateCall: func(t *testing.T, args []interface{}) {
+			req := args[3].(*models.PromoteComponentRequest)
+			if req.SourceEnvironment != testEnvName || req.TargetEnvironment != "staging" {
+				t.Errorf("Expected promotion from %s to staging, got %s to %s",
+					testEnvName, req.SourceEnvironment, req.TargetEnvironment)
+			}
+			if req.FreezeOverride != nil {
+				t.Errorf("Expected no freeze override, got %v", req.FreezeOverride)
+			}
+		},
+	},
+	{
+		name:                "rollback_component_deployment",
+		toolset:             "deployment",
+		descriptionKeywords: []string{"roll back", "rollout", "stable"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment", "confirm"},
+		optionalParams:      []string{"resource_version"},
+		testArgs: map[string]any{
+			"org_name":         testOrgName,
+			"project_name":     testProjectName,
+			"component_name":   testComponentName,
+			"environment":      testEnvName,
+			"resource_version": "42",
+			"confirm":          true,
+		},
+		expectedMethod: "RollbackComponentDeployment",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[3] != testEnvName || args[4] != "42" {
+				t.Errorf("Expected environment %q at resource version 42, got %v", testEnvName, args)
+			}
+		},
+	},
+	{
+		name:                "get_component_logs",
+		toolset:             "observability",
+		descriptionKeywords: []string{"logs", "environment"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		optionalParams:      []string{"start_time", "end_time", "levels", "search", "limit", "sort_order"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+			"start_time":     "2025-01-02T15:04:05Z",
+			"levels":         []any{"ERROR"},
+			"search":         "timeout",
+			"limit":          20,
+		},
+		expectedMethod: "GetComponentLogs",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+			query := args[4].(*models.LogQuery)
+			if query.StartTime.Format(time.RFC3339) != "2025-01-02T15:04:05Z" || !query.EndTime.IsZero() {
+				t.Errorf("Expected the start time only, got %v", query.TimeRange)
+			}
+			if len(query.Levels) != 1 || query.Levels[0] != "ERROR" || query.Search != "timeout" || query.Limit != 20 {
+				t.Errorf("Expected ERROR entries containing timeout limited to 20, got %+v", query)
+			}
+		},
+	},
+	{
+		name:                "get_build_logs",
+		toolset:             "observability",
+		descriptionKeywords: []string{"logs", "build"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "build_name"},
+		optionalParams:      []string{"levels", "search", "limit", "sort_order"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"build_name":     "my-component-build-1",
+			"sort_order":     "asc",
+		},
+		expectedMethod: "GetBuildLogs",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[3] != "my-component-build-1" {
+				t.Errorf("Expected build my-component-build-1, got %v", args[3])
+			}
+			if query := args[4].(*models.LogQuery); query.SortOrder != "asc" {
+				t.Errorf("Expected ascending order, got %+v", query)
+			}
+		},
+	},
+	{
+		name:                "get_component_traces",
+		toolset:             "observability",
+		descriptionKeywords: []string{"traces", "spans"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		optionalParams:      []string{"start_time", "end_time", "limit", "sort_order"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+			"end_time":       "2025-01-02T15:04:05Z",
+		},
+		expectedMethod: "GetComponentTraces",
+		validateCall: func(t *testing.T, args []interface{}) {
+			query := args[4].(*models.TraceQuery)
+			if !query.StartTime.IsZero() || query.EndTime.Format(time.RFC3339) != "2025-01-02T15:04:05Z" {
+				t.Errorf("Expected the end time only, got %v", query.TimeRange)
+			}
+		},
+	},
+	{
+		name:                "get_release_health",
+		toolset:             "observability",
+		descriptionKeywords: []string{"health", "release"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"org_name", "project_name", "component_name", "environment"},
+		testArgs: map[string]any{
+			"org_name":       testOrgName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+		},
+		expectedMethod: "GetReleaseHealth",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+				t.Errorf("Expected (%s, %s, %s), got %v", testOrgName, testProjectName, testComponentName, args)
+			}
+			if args[3] != testEnvName {
+				t.Errorf("Expected environment %q, got %v", testEnvName, args[3])
+			}
+		},
+	},
+	{
+		name:                "explain_schema",
+		toolset:             "schema",
+		descriptionKeywords: []string{"schema", "resource"},
+		descriptionMinLen:   10,
+		requiredParams:      []string{"kind"},
+		optionalParams:      []string{"path"},
+		testArgs: map[string]any{
+			"kind": "Component",
+			"path": "spec",
+		},
+		expectedMethod: "ExplainSchema",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if args[0] != "Component" || args[1] != "spec" {
+				t.Errorf("Expected (Component, spec), got (%v, %v)", args[0], args[1])
+			}
+		},
+	},
+}
+
+// TestToolRegistration verifies that all expected tools are registered
+func TestToolRegistration(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	ctx := context.Background()
+	toolsResult, err := clientSession.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+
+	// Build expected tool names from allToolSpecs
+	expectedTools := make(map[string]bool)
+	for _, spec := range allToolSpecs {
+		expectedTools[spec.name] = true
+	}
+
+	// Check all expected tools are present
+	registeredTools := make(map[string]bool)
+	for _, tool := range toolsResult.Tools {
+		registeredTools[tool.Name] = true
+		if !expectedTools[tool.Name] {
+			t.Errorf("Unexpected tool %q found in registered tools", tool.Name)
+		}
+	}
+
+	// Check no tools are missing
+	for expected := range expectedTools {
+		if !registeredTools[expected] {
+			t.Errorf("Expected tool %q not found in registered tools", expected)
+		}
+	}
+
+	if len(toolsResult.Tools) != len(expectedTools) {
+		t.Errorf("Expected %d tools, got %d", len(expectedTools), len(toolsResult.Tools))
+	}
+}
+
+// TestToolDescriptions verifies that tool descriptions are meaningful and distinguishable
+func TestToolDescriptions(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	ctx := context.Background()
+	toolsResult, err := clientSession.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+
+	toolsByName := make(map[string]*mcp.Tool)
+	for _, tool := range toolsResult.Tools {
+		toolsByName[tool.Name] = tool
+	}
+
+	// Test each tool's description using specs from allToolSpecs
+	for _, spec := range allToolSpecs {
+		t.Run(spec.name, func(t *testing.T) {
+			tool, exists := toolsByName[spec.name]
+			if !exists {
+				t.Fatalf("Tool %q not found", spec.name)
+			}
+
+			desc := strings.ToLower(tool.Description)
+
+			// Check minimum length
+			if len(desc) < spec.descriptionMinLen {
+				t.Errorf("Description too short: got %d chars, want at least %d", len(desc), spec.descriptionMinLen)
+			}
+
+			// Check for required keywords
+			for _, word := range spec.descriptionKeywords {
+				if !strings.Contains(desc, strings.ToLower(word)) {
+					t.Errorf("Description missing required keyword %q: %s", word, tool.Description)
+				}
+			}
+		})
+	}
+
+	// Ensure descriptions are unique across all tools
+	descriptions := make(map[string]string)
+	for _, tool := range toolsResult.Tools {
+		if existingTool, exists := descriptions[tool.Description]; exists {
+			t.Errorf("Duplicate description found: %q used by both %q and %q",
+				tool.Description, tool.Name, existingTool)
+		}
+		descriptions[tool.Description] = tool.Name
+	}
+}
+
+// TestToolSchemas verifies that tool input schemas have required properties defined
+func TestToolSchemas(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	ctx := context.Background()
+	toolsResult, err := clientSession.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+
+	toolsByName := make(map[string]*mcp.Tool)
+	for _, tool := range toolsResult.Tools {
+		toolsByName[tool.Name] = tool
+	}
+
+	// Test each tool's schema using specs from allToolSpecs
+	for _, spec := range allToolSpecs {
+		t.Run(spec.name, func(t *testing.T) {
+			tool, exists := toolsByName[spec.name]
+			if !exists {
+				t.Fatalf("Tool %q not found", spec.name)
+			}
+
+			if tool.InputSchema == nil {
+				t.Fatal("InputSchema is nil")
+			}
+
+			// Convert InputSchema to map for inspection
 			schemaMap, ok := tool.InputSchema.(map[string]any)
 			if !ok {
 				t.Fatalf("Expected InputSchema to be map[string]any, got %T", tool.InputSchema)
@@ -764,6 +1712,12 @@ func TestToolSchemas(t *testing.T) {
 				t.Errorf("Expected schema type 'object', got %v", schemaMap["type"])
 			}
 
+			// Verify the tool declares the schema of its structured content
+			outputSchema, ok := tool.OutputSchema.(map[string]any)
+			if !ok || outputSchema["type"] != "object" {
+				t.Errorf("Expected an output schema of type 'object', got %v", tool.OutputSchema)
+			}
+
 			// Check required parameters
 			if len(spec.requiredParams) > 0 {
 				requiredInSchema := make(map[string]bool)
@@ -826,6 +1780,12 @@ func TestToolParameterWiring(t *testing.T) {
 			if len(result.Content) == 0 {
 				t.Fatal("Expected non-empty result content")
 			}
+			if result.IsError {
+				t.Fatalf("Expected the tool to succeed, got %v", result.Content)
+			}
+			if result.StructuredContent == nil {
+				t.Fatal("Expected structured content")
+			}
 
 			// Verify the correct handler method was called
 			calls, ok := mockHandler.calls[spec.expectedMethod]
@@ -845,8 +1805,8 @@ func TestToolParameterWiring(t *testing.T) {
 	}
 }
 
-// TestToolResponseFormat verifies that tool responses are valid JSON
-// This tests the response structure which is consistent across all tools
+// TestToolResponseFormat verifies that tool responses hold the result as structured content, with a summary
+// and the JSON encoding of the result as text content
 func TestToolResponseFormat(t *testing.T) {
 	clientSession, _ := setupTestServer(t)
 	defer clientSession.Close()
@@ -862,21 +1822,74 @@ func TestToolResponseFormat(t *testing.T) {
 		t.Fatalf("Failed to call tool: %v", err)
 	}
 
-	if len(result.Content) == 0 {
-		t.Fatal("Expected non-empty result content")
+	if len(result.Content) != 2 {
+		t.Fatalf("Expected a summary and the JSON result as content, got %d contents", len(result.Content))
 	}
-
-	// Get the text content
-	textContent, ok := result.Content[0].(*mcp.TextContent)
+	summary, ok := result.Content[0].(*mcp.TextContent)
 	if !ok {
 		t.Fatal("Expected TextContent")
 	}
+	if want := "1 of 1 Organizations: test-org"; summary.Text != want {
+		t.Errorf("Summary = %q, want %q", summary.Text, want)
+	}
 
-	// Verify the response is valid JSON
-	var data interface{}
+	// Verify the response is valid JSON matching the structured content
+	textContent, ok := result.Content[1].(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected TextContent")
+	}
+	var data map[string]any
 	if err := json.Unmarshal([]byte(textContent.Text), &data); err != nil {
 		t.Errorf("Response is not valid JSON: %v\nResponse: %s", err, textContent.Text)
 	}
+	if diff := cmp.Diff(data, result.StructuredContent); diff != "" {
+		t.Errorf("Structured content mismatch (-text +structured):\n%s", diff)
+	}
+	items, _ := data["items"].([]any)
+	if len(items) != 1 || items[0].(map[string]any)["name"] != "test-org" {
+		t.Errorf("Unexpected items %v", data["items"])
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		result any
+		want   string
+	}{
+		{
+			result: &models.ComponentResponse{Name: "api", Status: "Ready"},
+			want:   "Component api (status: Ready)",
+		},
+		{
+			result: &models.ReleaseHealthResponse{Name: "api-dev", Health: "Degraded", UnhealthyResources: make([]models.ReleaseResourceStatus, 2)},
+			want:   "ReleaseHealth api-dev (health: Degraded, unhealthyResources: 2)",
+		},
+		{
+			result: models.ListResponse[*models.ProjectResponse]{
+				Items:      []*models.ProjectResponse{{Name: "a"}, {Name: "b"}},
+				TotalCount: ptr.To(5),
+				Continue:   "next",
+			},
+			want: `2 of 5 Projects: a, b (more items with continue "next")`,
+		},
+		{
+			result: models.ListResponse[map[string]any]{Items: []map[string]any{{"name": "a"}}, TotalCount: ptr.To(1)},
+			want:   "1 of 1 items: a",
+		},
+		{
+			result: models.ListResponse[*models.ProjectResponse]{Items: []*models.ProjectResponse{{Name: "a"}}, Continue: "next"},
+			want:   `1 Projects: a (more items with continue "next")`,
+		},
+		{
+			result: &deletedResult{Kind: "Trait", Name: "autoscaler", Deleted: true},
+			want:   "Deleted Trait autoscaler",
+		},
+	}
+	for _, tt := range tests {
+		if got := summarize(tt.result); got != tt.want {
+			t.Errorf("summarize(%T) = %q, want %q", tt.result, got, tt.want)
+		}
+	}
 }
 
 // TestToolErrorHandling verifies that the MCP SDK validates required parameters
@@ -1002,6 +2015,180 @@ func TestPartialToolsetRegistration(t *testing.T) {
 	}
 }
 
+// TestToolConfirmation verifies that destructive tools are not run without the confirmation of the user
+func TestToolConfirmation(t *testing.T) {
+	clientSession, mockHandler := setupTestServer(t)
+	defer clientSession.Close()
+
+	ctx := context.Background()
+	for _, spec := range allToolSpecs {
+		if _, ok := spec.testArgs["confirm"]; !ok {
+			continue
+		}
+		t.Run(spec.name, func(t *testing.T) {
+			for _, confirm := range []any{nil, false} {
+				mockHandler.calls = make(map[string][]interface{})
+				args := make(map[string]any)
+				for name, value := range spec.testArgs {
+					args[name] = value
+				}
+				delete(args, "confirm")
+				if confirm != nil {
+					args["confirm"] = confirm
+				}
+
+				result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: spec.name, Arguments: args})
+				if err == nil && !result.IsError {
+					t.Errorf("Expected %s with confirm %v to fail", spec.name, confirm)
+				}
+				if err == nil {
+					if code := toolErrorCode(t, result); code != CodeConfirmationRequired {
+						t.Errorf("Error code = %q, want %q", code, CodeConfirmationRequired)
+					}
+				}
+				if len(mockHandler.calls) > 0 {
+					t.Errorf("Handler should not be called without confirmation, but got calls: %v", mockHandler.calls)
+				}
+			}
+		})
+	}
+}
+
+// toolErrorCode returns the code of the structured error of a failed tool call
+func toolErrorCode(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("Expected a structured error, got %v", result.StructuredContent)
+	}
+	toolErr, _ := structured["error"].(map[string]any)
+	code, _ := toolErr["code"].(string)
+	return code
+}
+
+// failingHandler fails to get projects with the wrapped error
+type failingHandler struct {
+	*MockCoreToolsetHandler
+	err error
+}
+
+func (h failingHandler) GetProject(ctx context.Context, orgName, projectName string) (*models.ProjectResponse, error) {
+	return nil, h.err
+}
+
+// fakeErrorCoder reports the code of errors wrapping errNotFound
+type fakeErrorCoder struct{}
+
+var errNotFound = errors.New("project not found")
+
+func (fakeErrorCoder) ErrorCode(err error) string {
+	if errors.Is(err, errNotFound) {
+		return "PROJECT_NOT_FOUND"
+	}
+	return ""
+}
+
+// TestToolErrorCodes verifies that failed tool calls return structured errors with the codes of the errors
+func TestToolErrorCodes(t *testing.T) {
+	tests := []struct {
+		err      error
+		wantCode string
+	}{
+		{err: fmt.Errorf("getting project: %w", errNotFound), wantCode: "PROJECT_NOT_FOUND"},
+		{err: errors.New("connection refused"), wantCode: CodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantCode, func(t *testing.T) {
+			handler := failingHandler{MockCoreToolsetHandler: NewMockCoreToolsetHandler(), err: tt.err}
+			clientSession := setupTestServerWithToolset(t, &Toolsets{ProjectToolset: handler, ErrorCodes: fakeErrorCoder{}})
+			defer clientSession.Close()
+
+			result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{
+				Name:      "get_project",
+				Arguments: map[string]any{"org_name": testOrgName, "project_name": testProjectName},
+			})
+			if err != nil {
+				t.Fatalf("Failed to call tool: %v", err)
+			}
+			if !result.IsError {
+				t.Fatal("Expected the tool call to fail")
+			}
+			if code := toolErrorCode(t, result); code != tt.wantCode {
+				t.Errorf("Error code = %q, want %q", code, tt.wantCode)
+			}
+			text, ok := result.Content[0].(*mcp.TextContent)
+			if !ok || text.Text != tt.wantCode+": "+tt.err.Error() {
+				t.Errorf("Unexpected error content %v", result.Content)
+			}
+		})
+	}
+}
+
+// fakeSchemaProvider returns the schema of a ComponentType spec, a schema that can't be resolved for a Trait
+// spec and fails for the other kinds
+type fakeSchemaProvider struct{}
+
+func (fakeSchemaProvider) JSONSchema(ctx context.Context, kind, path string) (map[string]any, error) {
+	switch kind {
+	case "ComponentType":
+	case "Trait":
+		return map[string]any{"$ref": "#/$defs/TraitSpec"}, nil
+	default:
+		return nil, fmt.Errorf("kind %s not found", kind)
+	}
+	return map[string]any{
+		"type":     "object",
+		"required": []any{"workloadType"},
+		"properties": map[string]any{
+			"workloadType": map[string]any{"type": "string"},
+		},
+	}, nil
+}
+
+// TestToolResourceSchemas verifies that the structured arguments are described with the schemas of the provider
+func TestToolResourceSchemas(t *testing.T) {
+	mockHandler := NewMockCoreToolsetHandler()
+	clientSession := setupTestServerWithToolset(t, &Toolsets{PlatformToolset: mockHandler, Schemas: fakeSchemaProvider{}})
+	defer clientSession.Close()
+
+	ctx := context.Background()
+	toolsResult, err := clientSession.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	specs := make(map[string]map[string]any)
+	for _, tool := range toolsResult.Tools {
+		properties := tool.InputSchema.(map[string]any)["properties"].(map[string]any)
+		if spec, ok := properties["spec"].(map[string]any); ok {
+			specs[tool.Name] = spec
+		}
+	}
+
+	if _, ok := specs["create_component_type"]["properties"].(map[string]any)["workloadType"]; !ok {
+		t.Errorf("Expected the ComponentType spec schema of the provider, got %v", specs["create_component_type"])
+	}
+	if !strings.Contains(specs["create_component_type"]["description"].(string), "explain_schema") {
+		t.Errorf("Expected the spec schema to keep its description, got %v", specs["create_component_type"])
+	}
+	for _, name := range []string{"create_trait", "create_workflow"} {
+		if diff := cmp.Diff([]string{"description", "type"}, slices.Sorted(maps.Keys(specs[name]))); diff != "" {
+			t.Errorf("Expected the plain object schema of %s when the provider fails (-want +got):\n%s", name, diff)
+		}
+	}
+
+	// The arguments are validated against the schema of the provider
+	result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_component_type",
+		Arguments: map[string]any{"org_name": testOrgName, "name": "componenttype1", "spec": map[string]any{}},
+	})
+	if err == nil && !result.IsError {
+		t.Error("Expected create_component_type without a workloadType to fail")
+	}
+	if len(mockHandler.calls) > 0 {
+		t.Errorf("Handler should not be called when the spec is invalid, but got calls: %v", mockHandler.calls)
+	}
+}
+
 // getToolsetForTool returns the toolset name for a given tool name
 func getToolsetForTool(toolName string) string {
 	for _, spec := range allToolSpecs {